@@ -9,6 +9,10 @@ import (
 	"github.com/kumahq/kuma/v3/pkg/core/validators"
 )
 
+// KumaALPNProtocol is negotiated on connections between proxies of the mesh, so on the inbound side
+// Kuma mTLS can be distinguished from application TLS. It can't be used as an application protocol.
+const KumaALPNProtocol = "kuma"
+
 // +kubebuilder:validation:Enum=TLSAuto;TLS10;TLS11;TLS12;TLS13
 type TlsVersion string
 
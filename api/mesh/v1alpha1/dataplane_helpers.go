@@ -97,6 +97,11 @@ const (
 	// ManagedByLabel is used when a MeshService is auto-generated
 	ManagedByLabel = "kuma.io/managed-by"
 
+	// FieldManagerLabel is set by server-side apply to the name of the manager which applied the resource last.
+	// The manager owns the whole resource, not single fields. Applying changes to a resource owned by another manager
+	// fails with a conflict unless the apply is forced.
+	FieldManagerLabel = "kuma.io/field-manager"
+
 	// DeletionGracePeriodStartedLabel is used when generating MeshServices on
 	// universal, it's here to avoid import cycles
 	DeletionGracePeriodStartedLabel string = "kuma.io/deletion-grace-period-started-at"
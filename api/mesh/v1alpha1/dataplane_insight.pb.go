@@ -35,6 +35,9 @@ type DataplaneInsight struct {
 	Metadata *structpb.Struct       `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	// Insights about OTel runtime resolution for this Dataplane.
 	OpenTelemetry *DataplaneInsight_OpenTelemetry `protobuf:"bytes,4,opt,name=openTelemetry,proto3" json:"openTelemetry,omitempty"`
+	// Results of the health checks the Dataplane runs on behalf of the control
+	// plane using the Health Discovery Service (HDS).
+	HealthChecks  *DataplaneInsight_HealthChecks `protobuf:"bytes,5,opt,name=healthChecks,proto3" json:"healthChecks,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -97,6 +100,13 @@ func (x *DataplaneInsight) GetOpenTelemetry() *DataplaneInsight_OpenTelemetry {
 	return nil
 }
 
+func (x *DataplaneInsight) GetHealthChecks() *DataplaneInsight_HealthChecks {
+	if x != nil {
+		return x.HealthChecks
+	}
+	return nil
+}
+
 // DiscoverySubscription describes a single ADS subscription
 // created by a Dataplane to the Control Plane.
 // Ideally, there should be only one such subscription per Dataplane lifecycle.
@@ -654,6 +664,60 @@ func (x *DataplaneInsight_MTLS) GetSupportedBackends() []string {
 	return nil
 }
 
+type DataplaneInsight_HealthChecks struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether Envoy itself is healthy. Envoy is unhealthy when it's draining.
+	EnvoyHealthy bool `protobuf:"varint,1,opt,name=envoyHealthy,proto3" json:"envoyHealthy,omitempty"`
+	// Results of the service probes defined on inbounds.
+	Inbounds      []*DataplaneInsight_HealthChecks_Inbound `protobuf:"bytes,2,rep,name=inbounds,proto3" json:"inbounds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DataplaneInsight_HealthChecks) Reset() {
+	*x = DataplaneInsight_HealthChecks{}
+	mi := &file_api_mesh_v1alpha1_dataplane_insight_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DataplaneInsight_HealthChecks) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataplaneInsight_HealthChecks) ProtoMessage() {}
+
+func (x *DataplaneInsight_HealthChecks) ProtoReflect() protoreflect.Message {
+	mi := &file_api_mesh_v1alpha1_dataplane_insight_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataplaneInsight_HealthChecks.ProtoReflect.Descriptor instead.
+func (*DataplaneInsight_HealthChecks) Descriptor() ([]byte, []int) {
+	return file_api_mesh_v1alpha1_dataplane_insight_proto_rawDescGZIP(), []int{0, 1}
+}
+
+func (x *DataplaneInsight_HealthChecks) GetEnvoyHealthy() bool {
+	if x != nil {
+		return x.EnvoyHealthy
+	}
+	return false
+}
+
+func (x *DataplaneInsight_HealthChecks) GetInbounds() []*DataplaneInsight_HealthChecks_Inbound {
+	if x != nil {
+		return x.Inbounds
+	}
+	return nil
+}
+
 type DataplaneInsight_OpenTelemetry struct {
 	state         protoimpl.MessageState                    `protogen:"open.v1"`
 	Backends      []*DataplaneInsight_OpenTelemetry_Backend `protobuf:"bytes,1,rep,name=backends,proto3" json:"backends,omitempty"`
@@ -663,7 +727,7 @@ type DataplaneInsight_OpenTelemetry struct {
 
 func (x *DataplaneInsight_OpenTelemetry) Reset() {
 	*x = DataplaneInsight_OpenTelemetry{}
-	mi := &file_api_mesh_v1alpha1_dataplane_insight_proto_msgTypes[8]
+	mi := &file_api_mesh_v1alpha1_dataplane_insight_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -675,7 +739,7 @@ func (x *DataplaneInsight_OpenTelemetry) String() string {
 func (*DataplaneInsight_OpenTelemetry) ProtoMessage() {}
 
 func (x *DataplaneInsight_OpenTelemetry) ProtoReflect() protoreflect.Message {
-	mi := &file_api_mesh_v1alpha1_dataplane_insight_proto_msgTypes[8]
+	mi := &file_api_mesh_v1alpha1_dataplane_insight_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -688,7 +752,7 @@ func (x *DataplaneInsight_OpenTelemetry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DataplaneInsight_OpenTelemetry.ProtoReflect.Descriptor instead.
 func (*DataplaneInsight_OpenTelemetry) Descriptor() ([]byte, []int) {
-	return file_api_mesh_v1alpha1_dataplane_insight_proto_rawDescGZIP(), []int{0, 1}
+	return file_api_mesh_v1alpha1_dataplane_insight_proto_rawDescGZIP(), []int{0, 2}
 }
 
 func (x *DataplaneInsight_OpenTelemetry) GetBackends() []*DataplaneInsight_OpenTelemetry_Backend {
@@ -698,6 +762,79 @@ func (x *DataplaneInsight_OpenTelemetry) GetBackends() []*DataplaneInsight_OpenT
 	return nil
 }
 
+type DataplaneInsight_HealthChecks_Inbound struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Port of the application that is probed.
+	WorkloadPort uint32 `protobuf:"varint,1,opt,name=workloadPort,proto3" json:"workloadPort,omitempty"`
+	// Type of the probe: tcp, http or grpc.
+	Probe string `protobuf:"bytes,2,opt,name=probe,proto3" json:"probe,omitempty"`
+	// Status reported by Envoy: Healthy, Unhealthy, Draining, Timeout,
+	// Degraded or Unknown.
+	Status string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	// Time when the status changed for the last time.
+	LastTransitionTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=lastTransitionTime,proto3" json:"lastTransitionTime,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *DataplaneInsight_HealthChecks_Inbound) Reset() {
+	*x = DataplaneInsight_HealthChecks_Inbound{}
+	mi := &file_api_mesh_v1alpha1_dataplane_insight_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DataplaneInsight_HealthChecks_Inbound) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataplaneInsight_HealthChecks_Inbound) ProtoMessage() {}
+
+func (x *DataplaneInsight_HealthChecks_Inbound) ProtoReflect() protoreflect.Message {
+	mi := &file_api_mesh_v1alpha1_dataplane_insight_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataplaneInsight_HealthChecks_Inbound.ProtoReflect.Descriptor instead.
+func (*DataplaneInsight_HealthChecks_Inbound) Descriptor() ([]byte, []int) {
+	return file_api_mesh_v1alpha1_dataplane_insight_proto_rawDescGZIP(), []int{0, 1, 0}
+}
+
+func (x *DataplaneInsight_HealthChecks_Inbound) GetWorkloadPort() uint32 {
+	if x != nil {
+		return x.WorkloadPort
+	}
+	return 0
+}
+
+func (x *DataplaneInsight_HealthChecks_Inbound) GetProbe() string {
+	if x != nil {
+		return x.Probe
+	}
+	return ""
+}
+
+func (x *DataplaneInsight_HealthChecks_Inbound) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DataplaneInsight_HealthChecks_Inbound) GetLastTransitionTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastTransitionTime
+	}
+	return nil
+}
+
 type DataplaneInsight_OpenTelemetry_Backend struct {
 	state         protoimpl.MessageState                 `protogen:"open.v1"`
 	Name          string                                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -710,7 +847,7 @@ type DataplaneInsight_OpenTelemetry_Backend struct {
 
 func (x *DataplaneInsight_OpenTelemetry_Backend) Reset() {
 	*x = DataplaneInsight_OpenTelemetry_Backend{}
-	mi := &file_api_mesh_v1alpha1_dataplane_insight_proto_msgTypes[9]
+	mi := &file_api_mesh_v1alpha1_dataplane_insight_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -722,7 +859,7 @@ func (x *DataplaneInsight_OpenTelemetry_Backend) String() string {
 func (*DataplaneInsight_OpenTelemetry_Backend) ProtoMessage() {}
 
 func (x *DataplaneInsight_OpenTelemetry_Backend) ProtoReflect() protoreflect.Message {
-	mi := &file_api_mesh_v1alpha1_dataplane_insight_proto_msgTypes[9]
+	mi := &file_api_mesh_v1alpha1_dataplane_insight_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -735,7 +872,7 @@ func (x *DataplaneInsight_OpenTelemetry_Backend) ProtoReflect() protoreflect.Mes
 
 // Deprecated: Use DataplaneInsight_OpenTelemetry_Backend.ProtoReflect.Descriptor instead.
 func (*DataplaneInsight_OpenTelemetry_Backend) Descriptor() ([]byte, []int) {
-	return file_api_mesh_v1alpha1_dataplane_insight_proto_rawDescGZIP(), []int{0, 1, 0}
+	return file_api_mesh_v1alpha1_dataplane_insight_proto_rawDescGZIP(), []int{0, 2, 0}
 }
 
 func (x *DataplaneInsight_OpenTelemetry_Backend) GetName() string {
@@ -781,7 +918,7 @@ type DataplaneInsight_OpenTelemetry_Signal struct {
 
 func (x *DataplaneInsight_OpenTelemetry_Signal) Reset() {
 	*x = DataplaneInsight_OpenTelemetry_Signal{}
-	mi := &file_api_mesh_v1alpha1_dataplane_insight_proto_msgTypes[10]
+	mi := &file_api_mesh_v1alpha1_dataplane_insight_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -793,7 +930,7 @@ func (x *DataplaneInsight_OpenTelemetry_Signal) String() string {
 func (*DataplaneInsight_OpenTelemetry_Signal) ProtoMessage() {}
 
 func (x *DataplaneInsight_OpenTelemetry_Signal) ProtoReflect() protoreflect.Message {
-	mi := &file_api_mesh_v1alpha1_dataplane_insight_proto_msgTypes[10]
+	mi := &file_api_mesh_v1alpha1_dataplane_insight_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -806,7 +943,7 @@ func (x *DataplaneInsight_OpenTelemetry_Signal) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use DataplaneInsight_OpenTelemetry_Signal.ProtoReflect.Descriptor instead.
 func (*DataplaneInsight_OpenTelemetry_Signal) Descriptor() ([]byte, []int) {
-	return file_api_mesh_v1alpha1_dataplane_insight_proto_rawDescGZIP(), []int{0, 1, 1}
+	return file_api_mesh_v1alpha1_dataplane_insight_proto_rawDescGZIP(), []int{0, 2, 1}
 }
 
 func (x *DataplaneInsight_OpenTelemetry_Signal) GetEnabled() bool {
@@ -862,19 +999,27 @@ var File_api_mesh_v1alpha1_dataplane_insight_proto protoreflect.FileDescriptor
 
 const file_api_mesh_v1alpha1_dataplane_insight_proto_rawDesc = "" +
 	"\n" +
-	")api/mesh/v1alpha1/dataplane_insight.proto\x12\x12kuma.mesh.v1alpha1\x1a\x16api/mesh/options.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17validate/validate.proto\"\xef\n" +
-	"\n" +
+	")api/mesh/v1alpha1/dataplane_insight.proto\x12\x12kuma.mesh.v1alpha1\x1a\x16api/mesh/options.proto\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17validate/validate.proto\"\xfc\r\n" +
 	"\x10DataplaneInsight\x12O\n" +
 	"\rsubscriptions\x18\x01 \x03(\v2).kuma.mesh.v1alpha1.DiscoverySubscriptionR\rsubscriptions\x12=\n" +
 	"\x04mTLS\x18\x02 \x01(\v2).kuma.mesh.v1alpha1.DataplaneInsight.MTLSR\x04mTLS\x123\n" +
 	"\bmetadata\x18\x03 \x01(\v2\x17.google.protobuf.StructR\bmetadata\x12X\n" +
-	"\ropenTelemetry\x18\x04 \x01(\v22.kuma.mesh.v1alpha1.DataplaneInsight.OpenTelemetryR\ropenTelemetry\x1a\xd3\x02\n" +
+	"\ropenTelemetry\x18\x04 \x01(\v22.kuma.mesh.v1alpha1.DataplaneInsight.OpenTelemetryR\ropenTelemetry\x12U\n" +
+	"\fhealthChecks\x18\x05 \x01(\v21.kuma.mesh.v1alpha1.DataplaneInsight.HealthChecksR\fhealthChecks\x1a\xd3\x02\n" +
 	"\x04MTLS\x12Z\n" +
 	"\x1bcertificate_expiration_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x19certificateExpirationTime\x12^\n" +
 	"\x1dlast_certificate_regeneration\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x1blastCertificateRegeneration\x12;\n" +
 	"\x19certificate_regenerations\x18\x03 \x01(\rR\x18certificateRegenerations\x12$\n" +
 	"\rissuedBackend\x18\x04 \x01(\tR\rissuedBackend\x12,\n" +
-	"\x11supportedBackends\x18\x05 \x03(\tR\x11supportedBackends\x1a\xf7\x04\n" +
+	"\x11supportedBackends\x18\x05 \x03(\tR\x11supportedBackends\x1a\xb3\x02\n" +
+	"\fHealthChecks\x12\"\n" +
+	"\fenvoyHealthy\x18\x01 \x01(\bR\fenvoyHealthy\x12U\n" +
+	"\binbounds\x18\x02 \x03(\v29.kuma.mesh.v1alpha1.DataplaneInsight.HealthChecks.InboundR\binbounds\x1a\xa7\x01\n" +
+	"\aInbound\x12\"\n" +
+	"\fworkloadPort\x18\x01 \x01(\rR\fworkloadPort\x12\x14\n" +
+	"\x05probe\x18\x02 \x01(\tR\x05probe\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12J\n" +
+	"\x12lastTransitionTime\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x12lastTransitionTime\x1a\xf7\x04\n" +
 	"\rOpenTelemetry\x12V\n" +
 	"\bbackends\x18\x01 \x03(\v2:.kuma.mesh.v1alpha1.DataplaneInsight.OpenTelemetry.BackendR\bbackends\x1a\x94\x02\n" +
 	"\aBackend\x12\x12\n" +
@@ -945,7 +1090,7 @@ func file_api_mesh_v1alpha1_dataplane_insight_proto_rawDescGZIP() []byte {
 	return file_api_mesh_v1alpha1_dataplane_insight_proto_rawDescData
 }
 
-var file_api_mesh_v1alpha1_dataplane_insight_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_api_mesh_v1alpha1_dataplane_insight_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_api_mesh_v1alpha1_dataplane_insight_proto_goTypes = []any{
 	(*DataplaneInsight)(nil),                       // 0: kuma.mesh.v1alpha1.DataplaneInsight
 	(*DiscoverySubscription)(nil),                  // 1: kuma.mesh.v1alpha1.DiscoverySubscription
@@ -955,42 +1100,47 @@ var file_api_mesh_v1alpha1_dataplane_insight_proto_goTypes = []any{
 	(*KumaDpVersion)(nil),                          // 5: kuma.mesh.v1alpha1.KumaDpVersion
 	(*EnvoyVersion)(nil),                           // 6: kuma.mesh.v1alpha1.EnvoyVersion
 	(*DataplaneInsight_MTLS)(nil),                  // 7: kuma.mesh.v1alpha1.DataplaneInsight.MTLS
-	(*DataplaneInsight_OpenTelemetry)(nil),         // 8: kuma.mesh.v1alpha1.DataplaneInsight.OpenTelemetry
-	(*DataplaneInsight_OpenTelemetry_Backend)(nil), // 9: kuma.mesh.v1alpha1.DataplaneInsight.OpenTelemetry.Backend
-	(*DataplaneInsight_OpenTelemetry_Signal)(nil),  // 10: kuma.mesh.v1alpha1.DataplaneInsight.OpenTelemetry.Signal
-	nil,                           // 11: kuma.mesh.v1alpha1.Version.DependenciesEntry
-	(*structpb.Struct)(nil),       // 12: google.protobuf.Struct
-	(*timestamppb.Timestamp)(nil), // 13: google.protobuf.Timestamp
+	(*DataplaneInsight_HealthChecks)(nil),          // 8: kuma.mesh.v1alpha1.DataplaneInsight.HealthChecks
+	(*DataplaneInsight_OpenTelemetry)(nil),         // 9: kuma.mesh.v1alpha1.DataplaneInsight.OpenTelemetry
+	(*DataplaneInsight_HealthChecks_Inbound)(nil),  // 10: kuma.mesh.v1alpha1.DataplaneInsight.HealthChecks.Inbound
+	(*DataplaneInsight_OpenTelemetry_Backend)(nil), // 11: kuma.mesh.v1alpha1.DataplaneInsight.OpenTelemetry.Backend
+	(*DataplaneInsight_OpenTelemetry_Signal)(nil),  // 12: kuma.mesh.v1alpha1.DataplaneInsight.OpenTelemetry.Signal
+	nil,                           // 13: kuma.mesh.v1alpha1.Version.DependenciesEntry
+	(*structpb.Struct)(nil),       // 14: google.protobuf.Struct
+	(*timestamppb.Timestamp)(nil), // 15: google.protobuf.Timestamp
 }
 var file_api_mesh_v1alpha1_dataplane_insight_proto_depIdxs = []int32{
 	1,  // 0: kuma.mesh.v1alpha1.DataplaneInsight.subscriptions:type_name -> kuma.mesh.v1alpha1.DiscoverySubscription
 	7,  // 1: kuma.mesh.v1alpha1.DataplaneInsight.mTLS:type_name -> kuma.mesh.v1alpha1.DataplaneInsight.MTLS
-	12, // 2: kuma.mesh.v1alpha1.DataplaneInsight.metadata:type_name -> google.protobuf.Struct
-	8,  // 3: kuma.mesh.v1alpha1.DataplaneInsight.openTelemetry:type_name -> kuma.mesh.v1alpha1.DataplaneInsight.OpenTelemetry
-	13, // 4: kuma.mesh.v1alpha1.DiscoverySubscription.connect_time:type_name -> google.protobuf.Timestamp
-	13, // 5: kuma.mesh.v1alpha1.DiscoverySubscription.disconnect_time:type_name -> google.protobuf.Timestamp
-	2,  // 6: kuma.mesh.v1alpha1.DiscoverySubscription.status:type_name -> kuma.mesh.v1alpha1.DiscoverySubscriptionStatus
-	4,  // 7: kuma.mesh.v1alpha1.DiscoverySubscription.version:type_name -> kuma.mesh.v1alpha1.Version
-	13, // 8: kuma.mesh.v1alpha1.DiscoverySubscriptionStatus.last_update_time:type_name -> google.protobuf.Timestamp
-	3,  // 9: kuma.mesh.v1alpha1.DiscoverySubscriptionStatus.total:type_name -> kuma.mesh.v1alpha1.DiscoveryServiceStats
-	3,  // 10: kuma.mesh.v1alpha1.DiscoverySubscriptionStatus.cds:type_name -> kuma.mesh.v1alpha1.DiscoveryServiceStats
-	3,  // 11: kuma.mesh.v1alpha1.DiscoverySubscriptionStatus.eds:type_name -> kuma.mesh.v1alpha1.DiscoveryServiceStats
-	3,  // 12: kuma.mesh.v1alpha1.DiscoverySubscriptionStatus.lds:type_name -> kuma.mesh.v1alpha1.DiscoveryServiceStats
-	3,  // 13: kuma.mesh.v1alpha1.DiscoverySubscriptionStatus.rds:type_name -> kuma.mesh.v1alpha1.DiscoveryServiceStats
-	5,  // 14: kuma.mesh.v1alpha1.Version.kumaDp:type_name -> kuma.mesh.v1alpha1.KumaDpVersion
-	6,  // 15: kuma.mesh.v1alpha1.Version.envoy:type_name -> kuma.mesh.v1alpha1.EnvoyVersion
-	11, // 16: kuma.mesh.v1alpha1.Version.dependencies:type_name -> kuma.mesh.v1alpha1.Version.DependenciesEntry
-	13, // 17: kuma.mesh.v1alpha1.DataplaneInsight.MTLS.certificate_expiration_time:type_name -> google.protobuf.Timestamp
-	13, // 18: kuma.mesh.v1alpha1.DataplaneInsight.MTLS.last_certificate_regeneration:type_name -> google.protobuf.Timestamp
-	9,  // 19: kuma.mesh.v1alpha1.DataplaneInsight.OpenTelemetry.backends:type_name -> kuma.mesh.v1alpha1.DataplaneInsight.OpenTelemetry.Backend
-	10, // 20: kuma.mesh.v1alpha1.DataplaneInsight.OpenTelemetry.Backend.traces:type_name -> kuma.mesh.v1alpha1.DataplaneInsight.OpenTelemetry.Signal
-	10, // 21: kuma.mesh.v1alpha1.DataplaneInsight.OpenTelemetry.Backend.logs:type_name -> kuma.mesh.v1alpha1.DataplaneInsight.OpenTelemetry.Signal
-	10, // 22: kuma.mesh.v1alpha1.DataplaneInsight.OpenTelemetry.Backend.metrics:type_name -> kuma.mesh.v1alpha1.DataplaneInsight.OpenTelemetry.Signal
-	23, // [23:23] is the sub-list for method output_type
-	23, // [23:23] is the sub-list for method input_type
-	23, // [23:23] is the sub-list for extension type_name
-	23, // [23:23] is the sub-list for extension extendee
-	0,  // [0:23] is the sub-list for field type_name
+	14, // 2: kuma.mesh.v1alpha1.DataplaneInsight.metadata:type_name -> google.protobuf.Struct
+	9,  // 3: kuma.mesh.v1alpha1.DataplaneInsight.openTelemetry:type_name -> kuma.mesh.v1alpha1.DataplaneInsight.OpenTelemetry
+	8,  // 4: kuma.mesh.v1alpha1.DataplaneInsight.healthChecks:type_name -> kuma.mesh.v1alpha1.DataplaneInsight.HealthChecks
+	15, // 5: kuma.mesh.v1alpha1.DiscoverySubscription.connect_time:type_name -> google.protobuf.Timestamp
+	15, // 6: kuma.mesh.v1alpha1.DiscoverySubscription.disconnect_time:type_name -> google.protobuf.Timestamp
+	2,  // 7: kuma.mesh.v1alpha1.DiscoverySubscription.status:type_name -> kuma.mesh.v1alpha1.DiscoverySubscriptionStatus
+	4,  // 8: kuma.mesh.v1alpha1.DiscoverySubscription.version:type_name -> kuma.mesh.v1alpha1.Version
+	15, // 9: kuma.mesh.v1alpha1.DiscoverySubscriptionStatus.last_update_time:type_name -> google.protobuf.Timestamp
+	3,  // 10: kuma.mesh.v1alpha1.DiscoverySubscriptionStatus.total:type_name -> kuma.mesh.v1alpha1.DiscoveryServiceStats
+	3,  // 11: kuma.mesh.v1alpha1.DiscoverySubscriptionStatus.cds:type_name -> kuma.mesh.v1alpha1.DiscoveryServiceStats
+	3,  // 12: kuma.mesh.v1alpha1.DiscoverySubscriptionStatus.eds:type_name -> kuma.mesh.v1alpha1.DiscoveryServiceStats
+	3,  // 13: kuma.mesh.v1alpha1.DiscoverySubscriptionStatus.lds:type_name -> kuma.mesh.v1alpha1.DiscoveryServiceStats
+	3,  // 14: kuma.mesh.v1alpha1.DiscoverySubscriptionStatus.rds:type_name -> kuma.mesh.v1alpha1.DiscoveryServiceStats
+	5,  // 15: kuma.mesh.v1alpha1.Version.kumaDp:type_name -> kuma.mesh.v1alpha1.KumaDpVersion
+	6,  // 16: kuma.mesh.v1alpha1.Version.envoy:type_name -> kuma.mesh.v1alpha1.EnvoyVersion
+	13, // 17: kuma.mesh.v1alpha1.Version.dependencies:type_name -> kuma.mesh.v1alpha1.Version.DependenciesEntry
+	15, // 18: kuma.mesh.v1alpha1.DataplaneInsight.MTLS.certificate_expiration_time:type_name -> google.protobuf.Timestamp
+	15, // 19: kuma.mesh.v1alpha1.DataplaneInsight.MTLS.last_certificate_regeneration:type_name -> google.protobuf.Timestamp
+	10, // 20: kuma.mesh.v1alpha1.DataplaneInsight.HealthChecks.inbounds:type_name -> kuma.mesh.v1alpha1.DataplaneInsight.HealthChecks.Inbound
+	11, // 21: kuma.mesh.v1alpha1.DataplaneInsight.OpenTelemetry.backends:type_name -> kuma.mesh.v1alpha1.DataplaneInsight.OpenTelemetry.Backend
+	15, // 22: kuma.mesh.v1alpha1.DataplaneInsight.HealthChecks.Inbound.lastTransitionTime:type_name -> google.protobuf.Timestamp
+	12, // 23: kuma.mesh.v1alpha1.DataplaneInsight.OpenTelemetry.Backend.traces:type_name -> kuma.mesh.v1alpha1.DataplaneInsight.OpenTelemetry.Signal
+	12, // 24: kuma.mesh.v1alpha1.DataplaneInsight.OpenTelemetry.Backend.logs:type_name -> kuma.mesh.v1alpha1.DataplaneInsight.OpenTelemetry.Signal
+	12, // 25: kuma.mesh.v1alpha1.DataplaneInsight.OpenTelemetry.Backend.metrics:type_name -> kuma.mesh.v1alpha1.DataplaneInsight.OpenTelemetry.Signal
+	26, // [26:26] is the sub-list for method output_type
+	26, // [26:26] is the sub-list for method input_type
+	26, // [26:26] is the sub-list for extension type_name
+	26, // [26:26] is the sub-list for extension extendee
+	0,  // [0:26] is the sub-list for field type_name
 }
 
 func init() { file_api_mesh_v1alpha1_dataplane_insight_proto_init() }
@@ -1004,7 +1154,7 @@ func file_api_mesh_v1alpha1_dataplane_insight_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_mesh_v1alpha1_dataplane_insight_proto_rawDesc), len(file_api_mesh_v1alpha1_dataplane_insight_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   12,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -87,6 +87,34 @@ func (x *DataplaneInsight) UpdateCert(generation time.Time, expiration time.Time
 	return nil
 }
 
+// UpdateHealthChecks replaces the results of the health checks reported over
+// HDS. The transition time of an inbound is kept when its status did not
+// change. It returns false when the results are the same as before.
+func (x *DataplaneInsight) UpdateHealthChecks(envoyHealthy bool, inbounds []*DataplaneInsight_HealthChecks_Inbound, now time.Time) bool {
+	previous := map[uint32]*DataplaneInsight_HealthChecks_Inbound{}
+	for _, inbound := range x.GetHealthChecks().GetInbounds() {
+		previous[inbound.WorkloadPort] = inbound
+	}
+	changed := x.HealthChecks == nil || x.HealthChecks.EnvoyHealthy != envoyHealthy || len(previous) != len(inbounds)
+	for _, inbound := range inbounds {
+		prev, ok := previous[inbound.WorkloadPort]
+		if ok && prev.Status == inbound.Status {
+			inbound.LastTransitionTime = prev.LastTransitionTime
+			changed = changed || prev.Probe != inbound.Probe
+			continue
+		}
+		inbound.LastTransitionTime = util_proto.MustTimestampProto(now)
+		changed = true
+	}
+	if changed {
+		x.HealthChecks = &DataplaneInsight_HealthChecks{
+			EnvoyHealthy: envoyHealthy,
+			Inbounds:     inbounds,
+		}
+	}
+	return changed
+}
+
 func (x *DataplaneInsight) UpdateSubscription(s generic.Subscription) error {
 	if x == nil {
 		return nil
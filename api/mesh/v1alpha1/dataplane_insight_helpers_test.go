@@ -152,6 +152,59 @@ var _ = Describe("DataplaneHelpers", func() {
 			})
 		})
 
+		Describe("UpdateHealthChecks()", func() {
+			It("should keep transition time of inbounds with unchanged status", func() {
+				// given
+				status.HealthChecks = nil
+				Expect(status.UpdateHealthChecks(true, []*DataplaneInsight_HealthChecks_Inbound{
+					{WorkloadPort: 8080, Probe: "http", Status: "Healthy"},
+					{WorkloadPort: 8081, Probe: "tcp", Status: "Healthy"},
+				}, t1)).To(BeTrue())
+
+				// when
+				changed := status.UpdateHealthChecks(true, []*DataplaneInsight_HealthChecks_Inbound{
+					{WorkloadPort: 8080, Probe: "http", Status: "Healthy"},
+					{WorkloadPort: 8081, Probe: "tcp", Status: "Unhealthy"},
+				}, t2)
+
+				// then
+				Expect(changed).To(BeTrue())
+				Expect(status.HealthChecks.Inbounds[0].LastTransitionTime.AsTime()).To(BeTemporally("==", t1))
+				Expect(status.HealthChecks.Inbounds[1].Status).To(Equal("Unhealthy"))
+				Expect(status.HealthChecks.Inbounds[1].LastTransitionTime.AsTime()).To(BeTemporally("==", t2))
+			})
+
+			It("should not report a change when results are the same", func() {
+				// given
+				status.HealthChecks = nil
+				Expect(status.UpdateHealthChecks(true, []*DataplaneInsight_HealthChecks_Inbound{
+					{WorkloadPort: 8080, Probe: "http", Status: "Healthy"},
+				}, t1)).To(BeTrue())
+
+				// when
+				changed := status.UpdateHealthChecks(true, []*DataplaneInsight_HealthChecks_Inbound{
+					{WorkloadPort: 8080, Probe: "http", Status: "Healthy"},
+				}, t2)
+
+				// then
+				Expect(changed).To(BeFalse())
+				Expect(status.HealthChecks.Inbounds[0].LastTransitionTime.AsTime()).To(BeTemporally("==", t1))
+			})
+
+			It("should report a change of Envoy health", func() {
+				// given
+				status.HealthChecks = nil
+				Expect(status.UpdateHealthChecks(true, nil, t1)).To(BeTrue())
+
+				// when
+				changed := status.UpdateHealthChecks(false, nil, t2)
+
+				// then
+				Expect(changed).To(BeTrue())
+				Expect(status.HealthChecks.EnvoyHealthy).To(BeFalse())
+			})
+		})
+
 		Describe("GetLastSubscription()", func() {
 			It("should return `nil` when there are no subscriptions", func() {
 				// given
@@ -12,6 +12,7 @@ import (
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	anypb "google.golang.org/protobuf/types/known/anypb"
 	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -131,7 +132,10 @@ func (x *KumaResource) GetStatus() *anypb.Any {
 }
 
 type ZoneHealthCheckRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// State of resources synced from the global control plane that the zone
+	// applied, by resource type.
+	ResourceTypes map[string]*ZoneResourceTypeState `protobuf:"bytes,1,rep,name=resource_types,json=resourceTypes,proto3" json:"resource_types,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -166,6 +170,81 @@ func (*ZoneHealthCheckRequest) Descriptor() ([]byte, []int) {
 	return file_api_mesh_v1alpha1_kds_proto_rawDescGZIP(), []int{1}
 }
 
+func (x *ZoneHealthCheckRequest) GetResourceTypes() map[string]*ZoneResourceTypeState {
+	if x != nil {
+		return x.ResourceTypes
+	}
+	return nil
+}
+
+// ZoneResourceTypeState summarizes resources of a single type that the zone
+// applied.
+type ZoneResourceTypeState struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Number of resources that the zone holds in its store with the spec sent by
+	// the global control plane.
+	Count uint32 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	// Hash of the versions of resources that the zone holds in its store with the
+	// spec sent by the global control plane.
+	Version string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// Time when the state of resources of this type in the zone store changed
+	// last.
+	AppliedTime   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=applied_time,json=appliedTime,proto3" json:"applied_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ZoneResourceTypeState) Reset() {
+	*x = ZoneResourceTypeState{}
+	mi := &file_api_mesh_v1alpha1_kds_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZoneResourceTypeState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZoneResourceTypeState) ProtoMessage() {}
+
+func (x *ZoneResourceTypeState) ProtoReflect() protoreflect.Message {
+	mi := &file_api_mesh_v1alpha1_kds_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZoneResourceTypeState.ProtoReflect.Descriptor instead.
+func (*ZoneResourceTypeState) Descriptor() ([]byte, []int) {
+	return file_api_mesh_v1alpha1_kds_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ZoneResourceTypeState) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *ZoneResourceTypeState) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *ZoneResourceTypeState) GetAppliedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AppliedTime
+	}
+	return nil
+}
+
 type ZoneHealthCheckResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The the interval that the global control plane
@@ -177,7 +256,7 @@ type ZoneHealthCheckResponse struct {
 
 func (x *ZoneHealthCheckResponse) Reset() {
 	*x = ZoneHealthCheckResponse{}
-	mi := &file_api_mesh_v1alpha1_kds_proto_msgTypes[2]
+	mi := &file_api_mesh_v1alpha1_kds_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -189,7 +268,7 @@ func (x *ZoneHealthCheckResponse) String() string {
 func (*ZoneHealthCheckResponse) ProtoMessage() {}
 
 func (x *ZoneHealthCheckResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_mesh_v1alpha1_kds_proto_msgTypes[2]
+	mi := &file_api_mesh_v1alpha1_kds_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -202,7 +281,7 @@ func (x *ZoneHealthCheckResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ZoneHealthCheckResponse.ProtoReflect.Descriptor instead.
 func (*ZoneHealthCheckResponse) Descriptor() ([]byte, []int) {
-	return file_api_mesh_v1alpha1_kds_proto_rawDescGZIP(), []int{2}
+	return file_api_mesh_v1alpha1_kds_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *ZoneHealthCheckResponse) GetInterval() *durationpb.Duration {
@@ -233,7 +312,7 @@ type XDSConfigRequest struct {
 
 func (x *XDSConfigRequest) Reset() {
 	*x = XDSConfigRequest{}
-	mi := &file_api_mesh_v1alpha1_kds_proto_msgTypes[3]
+	mi := &file_api_mesh_v1alpha1_kds_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -245,7 +324,7 @@ func (x *XDSConfigRequest) String() string {
 func (*XDSConfigRequest) ProtoMessage() {}
 
 func (x *XDSConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_mesh_v1alpha1_kds_proto_msgTypes[3]
+	mi := &file_api_mesh_v1alpha1_kds_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -258,7 +337,7 @@ func (x *XDSConfigRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use XDSConfigRequest.ProtoReflect.Descriptor instead.
 func (*XDSConfigRequest) Descriptor() ([]byte, []int) {
-	return file_api_mesh_v1alpha1_kds_proto_rawDescGZIP(), []int{3}
+	return file_api_mesh_v1alpha1_kds_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *XDSConfigRequest) GetRequestId() string {
@@ -313,7 +392,7 @@ type XDSConfigResponse struct {
 
 func (x *XDSConfigResponse) Reset() {
 	*x = XDSConfigResponse{}
-	mi := &file_api_mesh_v1alpha1_kds_proto_msgTypes[4]
+	mi := &file_api_mesh_v1alpha1_kds_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -325,7 +404,7 @@ func (x *XDSConfigResponse) String() string {
 func (*XDSConfigResponse) ProtoMessage() {}
 
 func (x *XDSConfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_mesh_v1alpha1_kds_proto_msgTypes[4]
+	mi := &file_api_mesh_v1alpha1_kds_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -338,7 +417,7 @@ func (x *XDSConfigResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use XDSConfigResponse.ProtoReflect.Descriptor instead.
 func (*XDSConfigResponse) Descriptor() ([]byte, []int) {
-	return file_api_mesh_v1alpha1_kds_proto_rawDescGZIP(), []int{4}
+	return file_api_mesh_v1alpha1_kds_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *XDSConfigResponse) GetRequestId() string {
@@ -416,7 +495,7 @@ type StatsRequest struct {
 
 func (x *StatsRequest) Reset() {
 	*x = StatsRequest{}
-	mi := &file_api_mesh_v1alpha1_kds_proto_msgTypes[5]
+	mi := &file_api_mesh_v1alpha1_kds_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -428,7 +507,7 @@ func (x *StatsRequest) String() string {
 func (*StatsRequest) ProtoMessage() {}
 
 func (x *StatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_mesh_v1alpha1_kds_proto_msgTypes[5]
+	mi := &file_api_mesh_v1alpha1_kds_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -441,7 +520,7 @@ func (x *StatsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StatsRequest.ProtoReflect.Descriptor instead.
 func (*StatsRequest) Descriptor() ([]byte, []int) {
-	return file_api_mesh_v1alpha1_kds_proto_rawDescGZIP(), []int{5}
+	return file_api_mesh_v1alpha1_kds_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *StatsRequest) GetRequestId() string {
@@ -503,7 +582,7 @@ type StatsResponse struct {
 
 func (x *StatsResponse) Reset() {
 	*x = StatsResponse{}
-	mi := &file_api_mesh_v1alpha1_kds_proto_msgTypes[6]
+	mi := &file_api_mesh_v1alpha1_kds_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -515,7 +594,7 @@ func (x *StatsResponse) String() string {
 func (*StatsResponse) ProtoMessage() {}
 
 func (x *StatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_mesh_v1alpha1_kds_proto_msgTypes[6]
+	mi := &file_api_mesh_v1alpha1_kds_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -528,7 +607,7 @@ func (x *StatsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StatsResponse.ProtoReflect.Descriptor instead.
 func (*StatsResponse) Descriptor() ([]byte, []int) {
-	return file_api_mesh_v1alpha1_kds_proto_rawDescGZIP(), []int{6}
+	return file_api_mesh_v1alpha1_kds_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *StatsResponse) GetRequestId() string {
@@ -603,7 +682,7 @@ type ClustersRequest struct {
 
 func (x *ClustersRequest) Reset() {
 	*x = ClustersRequest{}
-	mi := &file_api_mesh_v1alpha1_kds_proto_msgTypes[7]
+	mi := &file_api_mesh_v1alpha1_kds_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -615,7 +694,7 @@ func (x *ClustersRequest) String() string {
 func (*ClustersRequest) ProtoMessage() {}
 
 func (x *ClustersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_mesh_v1alpha1_kds_proto_msgTypes[7]
+	mi := &file_api_mesh_v1alpha1_kds_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -628,7 +707,7 @@ func (x *ClustersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClustersRequest.ProtoReflect.Descriptor instead.
 func (*ClustersRequest) Descriptor() ([]byte, []int) {
-	return file_api_mesh_v1alpha1_kds_proto_rawDescGZIP(), []int{7}
+	return file_api_mesh_v1alpha1_kds_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ClustersRequest) GetRequestId() string {
@@ -683,7 +762,7 @@ type ClustersResponse struct {
 
 func (x *ClustersResponse) Reset() {
 	*x = ClustersResponse{}
-	mi := &file_api_mesh_v1alpha1_kds_proto_msgTypes[8]
+	mi := &file_api_mesh_v1alpha1_kds_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -695,7 +774,7 @@ func (x *ClustersResponse) String() string {
 func (*ClustersResponse) ProtoMessage() {}
 
 func (x *ClustersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_mesh_v1alpha1_kds_proto_msgTypes[8]
+	mi := &file_api_mesh_v1alpha1_kds_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -708,7 +787,7 @@ func (x *ClustersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClustersResponse.ProtoReflect.Descriptor instead.
 func (*ClustersResponse) Descriptor() ([]byte, []int) {
-	return file_api_mesh_v1alpha1_kds_proto_rawDescGZIP(), []int{8}
+	return file_api_mesh_v1alpha1_kds_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ClustersResponse) GetRequestId() string {
@@ -775,7 +854,7 @@ type KumaResource_Meta struct {
 
 func (x *KumaResource_Meta) Reset() {
 	*x = KumaResource_Meta{}
-	mi := &file_api_mesh_v1alpha1_kds_proto_msgTypes[9]
+	mi := &file_api_mesh_v1alpha1_kds_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -787,7 +866,7 @@ func (x *KumaResource_Meta) String() string {
 func (*KumaResource_Meta) ProtoMessage() {}
 
 func (x *KumaResource_Meta) ProtoReflect() protoreflect.Message {
-	mi := &file_api_mesh_v1alpha1_kds_proto_msgTypes[9]
+	mi := &file_api_mesh_v1alpha1_kds_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -835,7 +914,7 @@ var File_api_mesh_v1alpha1_kds_proto protoreflect.FileDescriptor
 
 const file_api_mesh_v1alpha1_kds_proto_rawDesc = "" +
 	"\n" +
-	"\x1bapi/mesh/v1alpha1/kds.proto\x12\x12kuma.mesh.v1alpha1\x1a*envoy/service/discovery/v3/discovery.proto\x1a\x19google/protobuf/any.proto\x1a\x1egoogle/protobuf/duration.proto\"\xf2\x02\n" +
+	"\x1bapi/mesh/v1alpha1/kds.proto\x12\x12kuma.mesh.v1alpha1\x1a*envoy/service/discovery/v3/discovery.proto\x1a\x19google/protobuf/any.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xf2\x02\n" +
 	"\fKumaResource\x129\n" +
 	"\x04meta\x18\x01 \x01(\v2%.kuma.mesh.v1alpha1.KumaResource.MetaR\x04meta\x12(\n" +
 	"\x04spec\x18\x02 \x01(\v2\x14.google.protobuf.AnyR\x04spec\x12,\n" +
@@ -847,8 +926,16 @@ const file_api_mesh_v1alpha1_kds_proto_rawDesc = "" +
 	"\x06labels\x18\x06 \x03(\v21.kuma.mesh.v1alpha1.KumaResource.Meta.LabelsEntryR\x06labels\x1a9\n" +
 	"\vLabelsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x18\n" +
-	"\x16ZoneHealthCheckRequest\"P\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xeb\x01\n" +
+	"\x16ZoneHealthCheckRequest\x12d\n" +
+	"\x0eresource_types\x18\x01 \x03(\v2=.kuma.mesh.v1alpha1.ZoneHealthCheckRequest.ResourceTypesEntryR\rresourceTypes\x1ak\n" +
+	"\x12ResourceTypesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12?\n" +
+	"\x05value\x18\x02 \x01(\v2).kuma.mesh.v1alpha1.ZoneResourceTypeStateR\x05value:\x028\x01\"\x86\x01\n" +
+	"\x15ZoneResourceTypeState\x12\x14\n" +
+	"\x05count\x18\x01 \x01(\rR\x05count\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\tR\aversion\x12=\n" +
+	"\fapplied_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vappliedTime\"P\n" +
 	"\x17ZoneHealthCheckResponse\x125\n" +
 	"\binterval\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\binterval\"\xc1\x01\n" +
 	"\x10XDSConfigRequest\x12\x1d\n" +
@@ -917,50 +1004,56 @@ func file_api_mesh_v1alpha1_kds_proto_rawDescGZIP() []byte {
 }
 
 var file_api_mesh_v1alpha1_kds_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_api_mesh_v1alpha1_kds_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_api_mesh_v1alpha1_kds_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_api_mesh_v1alpha1_kds_proto_goTypes = []any{
 	(AdminOutputFormat)(0),            // 0: kuma.mesh.v1alpha1.AdminOutputFormat
 	(*KumaResource)(nil),              // 1: kuma.mesh.v1alpha1.KumaResource
 	(*ZoneHealthCheckRequest)(nil),    // 2: kuma.mesh.v1alpha1.ZoneHealthCheckRequest
-	(*ZoneHealthCheckResponse)(nil),   // 3: kuma.mesh.v1alpha1.ZoneHealthCheckResponse
-	(*XDSConfigRequest)(nil),          // 4: kuma.mesh.v1alpha1.XDSConfigRequest
-	(*XDSConfigResponse)(nil),         // 5: kuma.mesh.v1alpha1.XDSConfigResponse
-	(*StatsRequest)(nil),              // 6: kuma.mesh.v1alpha1.StatsRequest
-	(*StatsResponse)(nil),             // 7: kuma.mesh.v1alpha1.StatsResponse
-	(*ClustersRequest)(nil),           // 8: kuma.mesh.v1alpha1.ClustersRequest
-	(*ClustersResponse)(nil),          // 9: kuma.mesh.v1alpha1.ClustersResponse
-	(*KumaResource_Meta)(nil),         // 10: kuma.mesh.v1alpha1.KumaResource.Meta
-	nil,                               // 11: kuma.mesh.v1alpha1.KumaResource.Meta.LabelsEntry
-	(*anypb.Any)(nil),                 // 12: google.protobuf.Any
-	(*durationpb.Duration)(nil),       // 13: google.protobuf.Duration
-	(*v3.DeltaDiscoveryRequest)(nil),  // 14: envoy.service.discovery.v3.DeltaDiscoveryRequest
-	(*v3.DeltaDiscoveryResponse)(nil), // 15: envoy.service.discovery.v3.DeltaDiscoveryResponse
+	(*ZoneResourceTypeState)(nil),     // 3: kuma.mesh.v1alpha1.ZoneResourceTypeState
+	(*ZoneHealthCheckResponse)(nil),   // 4: kuma.mesh.v1alpha1.ZoneHealthCheckResponse
+	(*XDSConfigRequest)(nil),          // 5: kuma.mesh.v1alpha1.XDSConfigRequest
+	(*XDSConfigResponse)(nil),         // 6: kuma.mesh.v1alpha1.XDSConfigResponse
+	(*StatsRequest)(nil),              // 7: kuma.mesh.v1alpha1.StatsRequest
+	(*StatsResponse)(nil),             // 8: kuma.mesh.v1alpha1.StatsResponse
+	(*ClustersRequest)(nil),           // 9: kuma.mesh.v1alpha1.ClustersRequest
+	(*ClustersResponse)(nil),          // 10: kuma.mesh.v1alpha1.ClustersResponse
+	(*KumaResource_Meta)(nil),         // 11: kuma.mesh.v1alpha1.KumaResource.Meta
+	nil,                               // 12: kuma.mesh.v1alpha1.KumaResource.Meta.LabelsEntry
+	nil,                               // 13: kuma.mesh.v1alpha1.ZoneHealthCheckRequest.ResourceTypesEntry
+	(*anypb.Any)(nil),                 // 14: google.protobuf.Any
+	(*timestamppb.Timestamp)(nil),     // 15: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),       // 16: google.protobuf.Duration
+	(*v3.DeltaDiscoveryRequest)(nil),  // 17: envoy.service.discovery.v3.DeltaDiscoveryRequest
+	(*v3.DeltaDiscoveryResponse)(nil), // 18: envoy.service.discovery.v3.DeltaDiscoveryResponse
 }
 var file_api_mesh_v1alpha1_kds_proto_depIdxs = []int32{
-	10, // 0: kuma.mesh.v1alpha1.KumaResource.meta:type_name -> kuma.mesh.v1alpha1.KumaResource.Meta
-	12, // 1: kuma.mesh.v1alpha1.KumaResource.spec:type_name -> google.protobuf.Any
-	12, // 2: kuma.mesh.v1alpha1.KumaResource.status:type_name -> google.protobuf.Any
-	13, // 3: kuma.mesh.v1alpha1.ZoneHealthCheckResponse.interval:type_name -> google.protobuf.Duration
-	0,  // 4: kuma.mesh.v1alpha1.StatsRequest.format:type_name -> kuma.mesh.v1alpha1.AdminOutputFormat
-	0,  // 5: kuma.mesh.v1alpha1.ClustersRequest.format:type_name -> kuma.mesh.v1alpha1.AdminOutputFormat
-	11, // 6: kuma.mesh.v1alpha1.KumaResource.Meta.labels:type_name -> kuma.mesh.v1alpha1.KumaResource.Meta.LabelsEntry
-	5,  // 7: kuma.mesh.v1alpha1.GlobalKDSService.StreamXDSConfigs:input_type -> kuma.mesh.v1alpha1.XDSConfigResponse
-	7,  // 8: kuma.mesh.v1alpha1.GlobalKDSService.StreamStats:input_type -> kuma.mesh.v1alpha1.StatsResponse
-	9,  // 9: kuma.mesh.v1alpha1.GlobalKDSService.StreamClusters:input_type -> kuma.mesh.v1alpha1.ClustersResponse
-	2,  // 10: kuma.mesh.v1alpha1.GlobalKDSService.HealthCheck:input_type -> kuma.mesh.v1alpha1.ZoneHealthCheckRequest
-	14, // 11: kuma.mesh.v1alpha1.KDSSyncService.GlobalToZoneSync:input_type -> envoy.service.discovery.v3.DeltaDiscoveryRequest
-	15, // 12: kuma.mesh.v1alpha1.KDSSyncService.ZoneToGlobalSync:input_type -> envoy.service.discovery.v3.DeltaDiscoveryResponse
-	4,  // 13: kuma.mesh.v1alpha1.GlobalKDSService.StreamXDSConfigs:output_type -> kuma.mesh.v1alpha1.XDSConfigRequest
-	6,  // 14: kuma.mesh.v1alpha1.GlobalKDSService.StreamStats:output_type -> kuma.mesh.v1alpha1.StatsRequest
-	8,  // 15: kuma.mesh.v1alpha1.GlobalKDSService.StreamClusters:output_type -> kuma.mesh.v1alpha1.ClustersRequest
-	3,  // 16: kuma.mesh.v1alpha1.GlobalKDSService.HealthCheck:output_type -> kuma.mesh.v1alpha1.ZoneHealthCheckResponse
-	15, // 17: kuma.mesh.v1alpha1.KDSSyncService.GlobalToZoneSync:output_type -> envoy.service.discovery.v3.DeltaDiscoveryResponse
-	14, // 18: kuma.mesh.v1alpha1.KDSSyncService.ZoneToGlobalSync:output_type -> envoy.service.discovery.v3.DeltaDiscoveryRequest
-	13, // [13:19] is the sub-list for method output_type
-	7,  // [7:13] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	11, // 0: kuma.mesh.v1alpha1.KumaResource.meta:type_name -> kuma.mesh.v1alpha1.KumaResource.Meta
+	14, // 1: kuma.mesh.v1alpha1.KumaResource.spec:type_name -> google.protobuf.Any
+	14, // 2: kuma.mesh.v1alpha1.KumaResource.status:type_name -> google.protobuf.Any
+	13, // 3: kuma.mesh.v1alpha1.ZoneHealthCheckRequest.resource_types:type_name -> kuma.mesh.v1alpha1.ZoneHealthCheckRequest.ResourceTypesEntry
+	15, // 4: kuma.mesh.v1alpha1.ZoneResourceTypeState.applied_time:type_name -> google.protobuf.Timestamp
+	16, // 5: kuma.mesh.v1alpha1.ZoneHealthCheckResponse.interval:type_name -> google.protobuf.Duration
+	0,  // 6: kuma.mesh.v1alpha1.StatsRequest.format:type_name -> kuma.mesh.v1alpha1.AdminOutputFormat
+	0,  // 7: kuma.mesh.v1alpha1.ClustersRequest.format:type_name -> kuma.mesh.v1alpha1.AdminOutputFormat
+	12, // 8: kuma.mesh.v1alpha1.KumaResource.Meta.labels:type_name -> kuma.mesh.v1alpha1.KumaResource.Meta.LabelsEntry
+	3,  // 9: kuma.mesh.v1alpha1.ZoneHealthCheckRequest.ResourceTypesEntry.value:type_name -> kuma.mesh.v1alpha1.ZoneResourceTypeState
+	6,  // 10: kuma.mesh.v1alpha1.GlobalKDSService.StreamXDSConfigs:input_type -> kuma.mesh.v1alpha1.XDSConfigResponse
+	8,  // 11: kuma.mesh.v1alpha1.GlobalKDSService.StreamStats:input_type -> kuma.mesh.v1alpha1.StatsResponse
+	10, // 12: kuma.mesh.v1alpha1.GlobalKDSService.StreamClusters:input_type -> kuma.mesh.v1alpha1.ClustersResponse
+	2,  // 13: kuma.mesh.v1alpha1.GlobalKDSService.HealthCheck:input_type -> kuma.mesh.v1alpha1.ZoneHealthCheckRequest
+	17, // 14: kuma.mesh.v1alpha1.KDSSyncService.GlobalToZoneSync:input_type -> envoy.service.discovery.v3.DeltaDiscoveryRequest
+	18, // 15: kuma.mesh.v1alpha1.KDSSyncService.ZoneToGlobalSync:input_type -> envoy.service.discovery.v3.DeltaDiscoveryResponse
+	5,  // 16: kuma.mesh.v1alpha1.GlobalKDSService.StreamXDSConfigs:output_type -> kuma.mesh.v1alpha1.XDSConfigRequest
+	7,  // 17: kuma.mesh.v1alpha1.GlobalKDSService.StreamStats:output_type -> kuma.mesh.v1alpha1.StatsRequest
+	9,  // 18: kuma.mesh.v1alpha1.GlobalKDSService.StreamClusters:output_type -> kuma.mesh.v1alpha1.ClustersRequest
+	4,  // 19: kuma.mesh.v1alpha1.GlobalKDSService.HealthCheck:output_type -> kuma.mesh.v1alpha1.ZoneHealthCheckResponse
+	18, // 20: kuma.mesh.v1alpha1.KDSSyncService.GlobalToZoneSync:output_type -> envoy.service.discovery.v3.DeltaDiscoveryResponse
+	17, // 21: kuma.mesh.v1alpha1.KDSSyncService.ZoneToGlobalSync:output_type -> envoy.service.discovery.v3.DeltaDiscoveryRequest
+	16, // [16:22] is the sub-list for method output_type
+	10, // [10:16] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_api_mesh_v1alpha1_kds_proto_init() }
@@ -968,15 +1061,15 @@ func file_api_mesh_v1alpha1_kds_proto_init() {
 	if File_api_mesh_v1alpha1_kds_proto != nil {
 		return
 	}
-	file_api_mesh_v1alpha1_kds_proto_msgTypes[4].OneofWrappers = []any{
+	file_api_mesh_v1alpha1_kds_proto_msgTypes[5].OneofWrappers = []any{
 		(*XDSConfigResponse_Error)(nil),
 		(*XDSConfigResponse_Config)(nil),
 	}
-	file_api_mesh_v1alpha1_kds_proto_msgTypes[6].OneofWrappers = []any{
+	file_api_mesh_v1alpha1_kds_proto_msgTypes[7].OneofWrappers = []any{
 		(*StatsResponse_Error)(nil),
 		(*StatsResponse_Stats)(nil),
 	}
-	file_api_mesh_v1alpha1_kds_proto_msgTypes[8].OneofWrappers = []any{
+	file_api_mesh_v1alpha1_kds_proto_msgTypes[9].OneofWrappers = []any{
 		(*ClustersResponse_Error)(nil),
 		(*ClustersResponse_Clusters)(nil),
 	}
@@ -986,7 +1079,7 @@ func file_api_mesh_v1alpha1_kds_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_mesh_v1alpha1_kds_proto_rawDesc), len(file_api_mesh_v1alpha1_kds_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   11,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
@@ -29,7 +29,10 @@ type Zone struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// enable allows to turn the zone on/off and exclude the whole zone from
 	// balancing traffic on it
-	Enabled       *wrapperspb.BoolValue `protobuf:"bytes,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Enabled *wrapperspb.BoolValue `protobuf:"bytes,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// sync restricts resources that the global control plane syncs to the zone.
+	// When not set, the zone receives all resources.
+	Sync          *ZoneSync `protobuf:"bytes,2,opt,name=sync,proto3" json:"sync,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -71,15 +74,87 @@ func (x *Zone) GetEnabled() *wrapperspb.BoolValue {
 	return nil
 }
 
+func (x *Zone) GetSync() *ZoneSync {
+	if x != nil {
+		return x.Sync
+	}
+	return nil
+}
+
+// ZoneSync restricts resources that the global control plane syncs to a zone,
+// so the zone doesn't store and compute configuration for irrelevant meshes.
+type ZoneSync struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// meshes lists meshes which resources the zone receives. The zone receives
+	// resources of all meshes when the list is empty. Removing a mesh from the
+	// list deletes the mesh and its policies from the zone, so the change is
+	// rejected while the zone has online data plane proxies of the mesh.
+	Meshes []string `protobuf:"bytes,1,rep,name=meshes,proto3" json:"meshes,omitempty"`
+	// resource_types lists types of mesh-scoped resources the zone receives,
+	// e.g. "MeshTrafficPermission". The zone receives all types when the list is
+	// empty. Resources that are not scoped to a mesh are always synced.
+	ResourceTypes []string `protobuf:"bytes,2,rep,name=resource_types,json=resourceTypes,proto3" json:"resource_types,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ZoneSync) Reset() {
+	*x = ZoneSync{}
+	mi := &file_api_system_v1alpha1_zone_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ZoneSync) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ZoneSync) ProtoMessage() {}
+
+func (x *ZoneSync) ProtoReflect() protoreflect.Message {
+	mi := &file_api_system_v1alpha1_zone_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ZoneSync.ProtoReflect.Descriptor instead.
+func (*ZoneSync) Descriptor() ([]byte, []int) {
+	return file_api_system_v1alpha1_zone_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ZoneSync) GetMeshes() []string {
+	if x != nil {
+		return x.Meshes
+	}
+	return nil
+}
+
+func (x *ZoneSync) GetResourceTypes() []string {
+	if x != nil {
+		return x.ResourceTypes
+	}
+	return nil
+}
+
 var File_api_system_v1alpha1_zone_proto protoreflect.FileDescriptor
 
 const file_api_system_v1alpha1_zone_proto_rawDesc = "" +
 	"\n" +
-	"\x1eapi/system/v1alpha1/zone.proto\x12\x14kuma.system.v1alpha1\x1a\x16api/mesh/options.proto\x1a\x1egoogle/protobuf/wrappers.proto\"\xab\x01\n" +
+	"\x1eapi/system/v1alpha1/zone.proto\x12\x14kuma.system.v1alpha1\x1a\x16api/mesh/options.proto\x1a\x1egoogle/protobuf/wrappers.proto\"\xdf\x01\n" +
 	"\x04Zone\x124\n" +
-	"\aenabled\x18\x01 \x01(\v2\x1a.google.protobuf.BoolValueR\aenabled:m\xaa\x8c\x89\xa6\x01g\n" +
+	"\aenabled\x18\x01 \x01(\v2\x1a.google.protobuf.BoolValueR\aenabled\x122\n" +
+	"\x04sync\x18\x02 \x01(\v2\x1e.kuma.system.v1alpha1.ZoneSyncR\x04sync:m\xaa\x8c\x89\xa6\x01g\n" +
 	"\fZoneResource\x12\x04Zone\x18\x01\"\x06system:\x06\n" +
-	"\x04zoneR5model.ProvidedByGlobalFlag | model.ProvidedByZoneFlag\x90\x01\x01\x9a\x01\x01z\xa8\x01\x01B/Z-github.com/kumahq/kuma/v3/api/system/v1alpha1b\x06proto3"
+	"\x04zoneR5model.ProvidedByGlobalFlag | model.ProvidedByZoneFlag\x90\x01\x01\x9a\x01\x01z\xa8\x01\x01\"I\n" +
+	"\bZoneSync\x12\x16\n" +
+	"\x06meshes\x18\x01 \x03(\tR\x06meshes\x12%\n" +
+	"\x0eresource_types\x18\x02 \x03(\tR\rresourceTypesB/Z-github.com/kumahq/kuma/v3/api/system/v1alpha1b\x06proto3"
 
 var (
 	file_api_system_v1alpha1_zone_proto_rawDescOnce sync.Once
@@ -93,18 +168,20 @@ func file_api_system_v1alpha1_zone_proto_rawDescGZIP() []byte {
 	return file_api_system_v1alpha1_zone_proto_rawDescData
 }
 
-var file_api_system_v1alpha1_zone_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_api_system_v1alpha1_zone_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_api_system_v1alpha1_zone_proto_goTypes = []any{
 	(*Zone)(nil),                 // 0: kuma.system.v1alpha1.Zone
-	(*wrapperspb.BoolValue)(nil), // 1: google.protobuf.BoolValue
+	(*ZoneSync)(nil),             // 1: kuma.system.v1alpha1.ZoneSync
+	(*wrapperspb.BoolValue)(nil), // 2: google.protobuf.BoolValue
 }
 var file_api_system_v1alpha1_zone_proto_depIdxs = []int32{
-	1, // 0: kuma.system.v1alpha1.Zone.enabled:type_name -> google.protobuf.BoolValue
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	2, // 0: kuma.system.v1alpha1.Zone.enabled:type_name -> google.protobuf.BoolValue
+	1, // 1: kuma.system.v1alpha1.Zone.sync:type_name -> kuma.system.v1alpha1.ZoneSync
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_api_system_v1alpha1_zone_proto_init() }
@@ -118,7 +195,7 @@ func file_api_system_v1alpha1_zone_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_system_v1alpha1_zone_proto_rawDesc), len(file_api_system_v1alpha1_zone_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -11,6 +11,7 @@ import (
 	_ "github.com/kumahq/kuma/v3/api/mesh"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -34,7 +35,10 @@ type ZoneInsight struct {
 	EnvoyAdminStreams *EnvoyAdminStreams `protobuf:"bytes,2,opt,name=envoy_admin_streams,json=envoyAdminStreams,proto3" json:"envoy_admin_streams,omitempty"`
 	HealthCheck       *HealthCheck       `protobuf:"bytes,3,opt,name=health_check,json=healthCheck,proto3" json:"health_check,omitempty"`
 	// Information about kds streams that are estabilished between global and zone
-	KdsStreams    *KDSStreams `protobuf:"bytes,4,opt,name=kds_streams,json=kdsStreams,proto3" json:"kds_streams,omitempty"`
+	KdsStreams *KDSStreams `protobuf:"bytes,4,opt,name=kds_streams,json=kdsStreams,proto3" json:"kds_streams,omitempty"`
+	// Result of the last check whether the zone applied the same version of
+	// every resource that global sends to it.
+	ResourceSync  *KDSResourceSync `protobuf:"bytes,5,opt,name=resource_sync,json=resourceSync,proto3" json:"resource_sync,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -97,6 +101,189 @@ func (x *ZoneInsight) GetKdsStreams() *KDSStreams {
 	return nil
 }
 
+func (x *ZoneInsight) GetResourceSync() *KDSResourceSync {
+	if x != nil {
+		return x.ResourceSync
+	}
+	return nil
+}
+
+// KDSResourceSync compares resources sent by the Global CP with resources
+// applied by the Zone CP.
+type KDSResourceSync struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Time when the sync was last checked.
+	CheckTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=check_time,json=checkTime,proto3" json:"check_time,omitempty"`
+	// Global CP instance that checked the sync.
+	GlobalInstanceId string `protobuf:"bytes,2,opt,name=global_instance_id,json=globalInstanceId,proto3" json:"global_instance_id,omitempty"`
+	// Number of resource types that diverged between global and the zone.
+	DivergedTypes uint32 `protobuf:"varint,3,opt,name=diverged_types,json=divergedTypes,proto3" json:"diverged_types,omitempty"`
+	// Sync state by resource type.
+	Types         map[string]*KDSResourceTypeSync `protobuf:"bytes,4,rep,name=types,proto3" json:"types,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KDSResourceSync) Reset() {
+	*x = KDSResourceSync{}
+	mi := &file_api_system_v1alpha1_zone_insight_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KDSResourceSync) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KDSResourceSync) ProtoMessage() {}
+
+func (x *KDSResourceSync) ProtoReflect() protoreflect.Message {
+	mi := &file_api_system_v1alpha1_zone_insight_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KDSResourceSync.ProtoReflect.Descriptor instead.
+func (*KDSResourceSync) Descriptor() ([]byte, []int) {
+	return file_api_system_v1alpha1_zone_insight_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *KDSResourceSync) GetCheckTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CheckTime
+	}
+	return nil
+}
+
+func (x *KDSResourceSync) GetGlobalInstanceId() string {
+	if x != nil {
+		return x.GlobalInstanceId
+	}
+	return ""
+}
+
+func (x *KDSResourceSync) GetDivergedTypes() uint32 {
+	if x != nil {
+		return x.DivergedTypes
+	}
+	return 0
+}
+
+func (x *KDSResourceSync) GetTypes() map[string]*KDSResourceTypeSync {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+// KDSResourceTypeSync describes the sync state of a single resource type.
+type KDSResourceTypeSync struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Number of resources that global sends to the zone.
+	GlobalCount uint32 `protobuf:"varint,1,opt,name=global_count,json=globalCount,proto3" json:"global_count,omitempty"`
+	// Number of resources that the zone applied.
+	ZoneCount uint32 `protobuf:"varint,2,opt,name=zone_count,json=zoneCount,proto3" json:"zone_count,omitempty"`
+	// Hash of the versions of resources that global sends to the zone.
+	GlobalVersion string `protobuf:"bytes,3,opt,name=global_version,json=globalVersion,proto3" json:"global_version,omitempty"`
+	// Hash of the versions of resources that the zone applied last.
+	LastAppliedVersion string `protobuf:"bytes,4,opt,name=last_applied_version,json=lastAppliedVersion,proto3" json:"last_applied_version,omitempty"`
+	// Time when the zone applied resources of this type last.
+	LastAppliedTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_applied_time,json=lastAppliedTime,proto3" json:"last_applied_time,omitempty"`
+	// True if the zone applied the same version of every resource as global
+	// sends.
+	InSync bool `protobuf:"varint,6,opt,name=in_sync,json=inSync,proto3" json:"in_sync,omitempty"`
+	// Time since global changed resources of this type that the zone has not
+	// applied yet. Zero when in sync.
+	Lag           *durationpb.Duration `protobuf:"bytes,7,opt,name=lag,proto3" json:"lag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KDSResourceTypeSync) Reset() {
+	*x = KDSResourceTypeSync{}
+	mi := &file_api_system_v1alpha1_zone_insight_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KDSResourceTypeSync) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KDSResourceTypeSync) ProtoMessage() {}
+
+func (x *KDSResourceTypeSync) ProtoReflect() protoreflect.Message {
+	mi := &file_api_system_v1alpha1_zone_insight_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KDSResourceTypeSync.ProtoReflect.Descriptor instead.
+func (*KDSResourceTypeSync) Descriptor() ([]byte, []int) {
+	return file_api_system_v1alpha1_zone_insight_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *KDSResourceTypeSync) GetGlobalCount() uint32 {
+	if x != nil {
+		return x.GlobalCount
+	}
+	return 0
+}
+
+func (x *KDSResourceTypeSync) GetZoneCount() uint32 {
+	if x != nil {
+		return x.ZoneCount
+	}
+	return 0
+}
+
+func (x *KDSResourceTypeSync) GetGlobalVersion() string {
+	if x != nil {
+		return x.GlobalVersion
+	}
+	return ""
+}
+
+func (x *KDSResourceTypeSync) GetLastAppliedVersion() string {
+	if x != nil {
+		return x.LastAppliedVersion
+	}
+	return ""
+}
+
+func (x *KDSResourceTypeSync) GetLastAppliedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastAppliedTime
+	}
+	return nil
+}
+
+func (x *KDSResourceTypeSync) GetInSync() bool {
+	if x != nil {
+		return x.InSync
+	}
+	return false
+}
+
+func (x *KDSResourceTypeSync) GetLag() *durationpb.Duration {
+	if x != nil {
+		return x.Lag
+	}
+	return nil
+}
+
 type EnvoyAdminStreams struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Global instance ID that handles XDS Config Dump streams.
@@ -111,7 +298,7 @@ type EnvoyAdminStreams struct {
 
 func (x *EnvoyAdminStreams) Reset() {
 	*x = EnvoyAdminStreams{}
-	mi := &file_api_system_v1alpha1_zone_insight_proto_msgTypes[1]
+	mi := &file_api_system_v1alpha1_zone_insight_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -123,7 +310,7 @@ func (x *EnvoyAdminStreams) String() string {
 func (*EnvoyAdminStreams) ProtoMessage() {}
 
 func (x *EnvoyAdminStreams) ProtoReflect() protoreflect.Message {
-	mi := &file_api_system_v1alpha1_zone_insight_proto_msgTypes[1]
+	mi := &file_api_system_v1alpha1_zone_insight_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -136,7 +323,7 @@ func (x *EnvoyAdminStreams) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnvoyAdminStreams.ProtoReflect.Descriptor instead.
 func (*EnvoyAdminStreams) Descriptor() ([]byte, []int) {
-	return file_api_system_v1alpha1_zone_insight_proto_rawDescGZIP(), []int{1}
+	return file_api_system_v1alpha1_zone_insight_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *EnvoyAdminStreams) GetConfigDumpGlobalInstanceId() string {
@@ -178,7 +365,7 @@ type KDSStreams struct {
 
 func (x *KDSStreams) Reset() {
 	*x = KDSStreams{}
-	mi := &file_api_system_v1alpha1_zone_insight_proto_msgTypes[2]
+	mi := &file_api_system_v1alpha1_zone_insight_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -190,7 +377,7 @@ func (x *KDSStreams) String() string {
 func (*KDSStreams) ProtoMessage() {}
 
 func (x *KDSStreams) ProtoReflect() protoreflect.Message {
-	mi := &file_api_system_v1alpha1_zone_insight_proto_msgTypes[2]
+	mi := &file_api_system_v1alpha1_zone_insight_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -203,7 +390,7 @@ func (x *KDSStreams) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KDSStreams.ProtoReflect.Descriptor instead.
 func (*KDSStreams) Descriptor() ([]byte, []int) {
-	return file_api_system_v1alpha1_zone_insight_proto_rawDescGZIP(), []int{2}
+	return file_api_system_v1alpha1_zone_insight_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *KDSStreams) GetClusters() *KDSStream {
@@ -253,7 +440,7 @@ type KDSStream struct {
 
 func (x *KDSStream) Reset() {
 	*x = KDSStream{}
-	mi := &file_api_system_v1alpha1_zone_insight_proto_msgTypes[3]
+	mi := &file_api_system_v1alpha1_zone_insight_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -265,7 +452,7 @@ func (x *KDSStream) String() string {
 func (*KDSStream) ProtoMessage() {}
 
 func (x *KDSStream) ProtoReflect() protoreflect.Message {
-	mi := &file_api_system_v1alpha1_zone_insight_proto_msgTypes[3]
+	mi := &file_api_system_v1alpha1_zone_insight_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -278,7 +465,7 @@ func (x *KDSStream) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KDSStream.ProtoReflect.Descriptor instead.
 func (*KDSStream) Descriptor() ([]byte, []int) {
-	return file_api_system_v1alpha1_zone_insight_proto_rawDescGZIP(), []int{3}
+	return file_api_system_v1alpha1_zone_insight_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *KDSStream) GetGlobalInstanceId() string {
@@ -335,7 +522,7 @@ type KDSSubscription struct {
 
 func (x *KDSSubscription) Reset() {
 	*x = KDSSubscription{}
-	mi := &file_api_system_v1alpha1_zone_insight_proto_msgTypes[4]
+	mi := &file_api_system_v1alpha1_zone_insight_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -347,7 +534,7 @@ func (x *KDSSubscription) String() string {
 func (*KDSSubscription) ProtoMessage() {}
 
 func (x *KDSSubscription) ProtoReflect() protoreflect.Message {
-	mi := &file_api_system_v1alpha1_zone_insight_proto_msgTypes[4]
+	mi := &file_api_system_v1alpha1_zone_insight_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -360,7 +547,7 @@ func (x *KDSSubscription) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KDSSubscription.ProtoReflect.Descriptor instead.
 func (*KDSSubscription) Descriptor() ([]byte, []int) {
-	return file_api_system_v1alpha1_zone_insight_proto_rawDescGZIP(), []int{4}
+	return file_api_system_v1alpha1_zone_insight_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *KDSSubscription) GetId() string {
@@ -447,7 +634,7 @@ type KDSSubscriptionStatus struct {
 
 func (x *KDSSubscriptionStatus) Reset() {
 	*x = KDSSubscriptionStatus{}
-	mi := &file_api_system_v1alpha1_zone_insight_proto_msgTypes[5]
+	mi := &file_api_system_v1alpha1_zone_insight_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -459,7 +646,7 @@ func (x *KDSSubscriptionStatus) String() string {
 func (*KDSSubscriptionStatus) ProtoMessage() {}
 
 func (x *KDSSubscriptionStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_api_system_v1alpha1_zone_insight_proto_msgTypes[5]
+	mi := &file_api_system_v1alpha1_zone_insight_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -472,7 +659,7 @@ func (x *KDSSubscriptionStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KDSSubscriptionStatus.ProtoReflect.Descriptor instead.
 func (*KDSSubscriptionStatus) Descriptor() ([]byte, []int) {
-	return file_api_system_v1alpha1_zone_insight_proto_rawDescGZIP(), []int{5}
+	return file_api_system_v1alpha1_zone_insight_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *KDSSubscriptionStatus) GetLastUpdateTime() *timestamppb.Timestamp {
@@ -511,7 +698,7 @@ type KDSServiceStats struct {
 
 func (x *KDSServiceStats) Reset() {
 	*x = KDSServiceStats{}
-	mi := &file_api_system_v1alpha1_zone_insight_proto_msgTypes[6]
+	mi := &file_api_system_v1alpha1_zone_insight_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -523,7 +710,7 @@ func (x *KDSServiceStats) String() string {
 func (*KDSServiceStats) ProtoMessage() {}
 
 func (x *KDSServiceStats) ProtoReflect() protoreflect.Message {
-	mi := &file_api_system_v1alpha1_zone_insight_proto_msgTypes[6]
+	mi := &file_api_system_v1alpha1_zone_insight_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -536,7 +723,7 @@ func (x *KDSServiceStats) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KDSServiceStats.ProtoReflect.Descriptor instead.
 func (*KDSServiceStats) Descriptor() ([]byte, []int) {
-	return file_api_system_v1alpha1_zone_insight_proto_rawDescGZIP(), []int{6}
+	return file_api_system_v1alpha1_zone_insight_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *KDSServiceStats) GetResponsesSent() uint64 {
@@ -571,7 +758,7 @@ type Version struct {
 
 func (x *Version) Reset() {
 	*x = Version{}
-	mi := &file_api_system_v1alpha1_zone_insight_proto_msgTypes[7]
+	mi := &file_api_system_v1alpha1_zone_insight_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -583,7 +770,7 @@ func (x *Version) String() string {
 func (*Version) ProtoMessage() {}
 
 func (x *Version) ProtoReflect() protoreflect.Message {
-	mi := &file_api_system_v1alpha1_zone_insight_proto_msgTypes[7]
+	mi := &file_api_system_v1alpha1_zone_insight_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -596,7 +783,7 @@ func (x *Version) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Version.ProtoReflect.Descriptor instead.
 func (*Version) Descriptor() ([]byte, []int) {
-	return file_api_system_v1alpha1_zone_insight_proto_rawDescGZIP(), []int{7}
+	return file_api_system_v1alpha1_zone_insight_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *Version) GetKumaCp() *KumaCpVersion {
@@ -625,7 +812,7 @@ type KumaCpVersion struct {
 
 func (x *KumaCpVersion) Reset() {
 	*x = KumaCpVersion{}
-	mi := &file_api_system_v1alpha1_zone_insight_proto_msgTypes[8]
+	mi := &file_api_system_v1alpha1_zone_insight_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -637,7 +824,7 @@ func (x *KumaCpVersion) String() string {
 func (*KumaCpVersion) ProtoMessage() {}
 
 func (x *KumaCpVersion) ProtoReflect() protoreflect.Message {
-	mi := &file_api_system_v1alpha1_zone_insight_proto_msgTypes[8]
+	mi := &file_api_system_v1alpha1_zone_insight_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -650,7 +837,7 @@ func (x *KumaCpVersion) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KumaCpVersion.ProtoReflect.Descriptor instead.
 func (*KumaCpVersion) Descriptor() ([]byte, []int) {
-	return file_api_system_v1alpha1_zone_insight_proto_rawDescGZIP(), []int{8}
+	return file_api_system_v1alpha1_zone_insight_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *KumaCpVersion) GetVersion() string {
@@ -699,7 +886,7 @@ type HealthCheck struct {
 
 func (x *HealthCheck) Reset() {
 	*x = HealthCheck{}
-	mi := &file_api_system_v1alpha1_zone_insight_proto_msgTypes[9]
+	mi := &file_api_system_v1alpha1_zone_insight_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -711,7 +898,7 @@ func (x *HealthCheck) String() string {
 func (*HealthCheck) ProtoMessage() {}
 
 func (x *HealthCheck) ProtoReflect() protoreflect.Message {
-	mi := &file_api_system_v1alpha1_zone_insight_proto_msgTypes[9]
+	mi := &file_api_system_v1alpha1_zone_insight_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -724,7 +911,7 @@ func (x *HealthCheck) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HealthCheck.ProtoReflect.Descriptor instead.
 func (*HealthCheck) Descriptor() ([]byte, []int) {
-	return file_api_system_v1alpha1_zone_insight_proto_rawDescGZIP(), []int{9}
+	return file_api_system_v1alpha1_zone_insight_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *HealthCheck) GetTime() *timestamppb.Timestamp {
@@ -738,15 +925,35 @@ var File_api_system_v1alpha1_zone_insight_proto protoreflect.FileDescriptor
 
 const file_api_system_v1alpha1_zone_insight_proto_rawDesc = "" +
 	"\n" +
-	"&api/system/v1alpha1/zone_insight.proto\x12\x14kuma.system.v1alpha1\x1a\x16api/mesh/options.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17validate/validate.proto\"\xbc\x03\n" +
+	"&api/system/v1alpha1/zone_insight.proto\x12\x14kuma.system.v1alpha1\x1a\x16api/mesh/options.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17validate/validate.proto\"\x88\x04\n" +
 	"\vZoneInsight\x12K\n" +
 	"\rsubscriptions\x18\x01 \x03(\v2%.kuma.system.v1alpha1.KDSSubscriptionR\rsubscriptions\x12W\n" +
 	"\x13envoy_admin_streams\x18\x02 \x01(\v2'.kuma.system.v1alpha1.EnvoyAdminStreamsR\x11envoyAdminStreams\x12D\n" +
 	"\fhealth_check\x18\x03 \x01(\v2!.kuma.system.v1alpha1.HealthCheckR\vhealthCheck\x12A\n" +
 	"\vkds_streams\x18\x04 \x01(\v2 .kuma.system.v1alpha1.KDSStreamsR\n" +
-	"kdsStreams:~\xaa\x8c\x89\xa6\x01x\n" +
+	"kdsStreams\x12J\n" +
+	"\rresource_sync\x18\x05 \x01(\v2%.kuma.system.v1alpha1.KDSResourceSyncR\fresourceSync:~\xaa\x8c\x89\xa6\x01x\n" +
 	"\x13ZoneInsightResource\x12\vZoneInsight\x18\x01\"\x06system:\x10\n" +
-	"\fzone-insight\x18\x01R5model.ProvidedByGlobalFlag | model.ProvidedByZoneFlag\xa8\x01\x01\"\xcf\x01\n" +
+	"\fzone-insight\x18\x01R5model.ProvidedByGlobalFlag | model.ProvidedByZoneFlag\xa8\x01\x01\"\xce\x02\n" +
+	"\x0fKDSResourceSync\x129\n" +
+	"\n" +
+	"check_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tcheckTime\x12,\n" +
+	"\x12global_instance_id\x18\x02 \x01(\tR\x10globalInstanceId\x12%\n" +
+	"\x0ediverged_types\x18\x03 \x01(\rR\rdivergedTypes\x12F\n" +
+	"\x05types\x18\x04 \x03(\v20.kuma.system.v1alpha1.KDSResourceSync.TypesEntryR\x05types\x1ac\n" +
+	"\n" +
+	"TypesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12?\n" +
+	"\x05value\x18\x02 \x01(\v2).kuma.system.v1alpha1.KDSResourceTypeSyncR\x05value:\x028\x01\"\xbe\x02\n" +
+	"\x13KDSResourceTypeSync\x12!\n" +
+	"\fglobal_count\x18\x01 \x01(\rR\vglobalCount\x12\x1d\n" +
+	"\n" +
+	"zone_count\x18\x02 \x01(\rR\tzoneCount\x12%\n" +
+	"\x0eglobal_version\x18\x03 \x01(\tR\rglobalVersion\x120\n" +
+	"\x14last_applied_version\x18\x04 \x01(\tR\x12lastAppliedVersion\x12F\n" +
+	"\x11last_applied_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x0flastAppliedTime\x12\x17\n" +
+	"\ain_sync\x18\x06 \x01(\bR\x06inSync\x12+\n" +
+	"\x03lag\x18\a \x01(\v2\x19.google.protobuf.DurationR\x03lag\"\xcf\x01\n" +
 	"\x11EnvoyAdminStreams\x12B\n" +
 	"\x1econfig_dump_global_instance_id\x18\x01 \x01(\tR\x1aconfigDumpGlobalInstanceId\x127\n" +
 	"\x18stats_global_instance_id\x18\x02 \x01(\tR\x15statsGlobalInstanceId\x12=\n" +
@@ -810,47 +1017,57 @@ func file_api_system_v1alpha1_zone_insight_proto_rawDescGZIP() []byte {
 	return file_api_system_v1alpha1_zone_insight_proto_rawDescData
 }
 
-var file_api_system_v1alpha1_zone_insight_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_api_system_v1alpha1_zone_insight_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_api_system_v1alpha1_zone_insight_proto_goTypes = []any{
 	(*ZoneInsight)(nil),           // 0: kuma.system.v1alpha1.ZoneInsight
-	(*EnvoyAdminStreams)(nil),     // 1: kuma.system.v1alpha1.EnvoyAdminStreams
-	(*KDSStreams)(nil),            // 2: kuma.system.v1alpha1.KDSStreams
-	(*KDSStream)(nil),             // 3: kuma.system.v1alpha1.KDSStream
-	(*KDSSubscription)(nil),       // 4: kuma.system.v1alpha1.KDSSubscription
-	(*KDSSubscriptionStatus)(nil), // 5: kuma.system.v1alpha1.KDSSubscriptionStatus
-	(*KDSServiceStats)(nil),       // 6: kuma.system.v1alpha1.KDSServiceStats
-	(*Version)(nil),               // 7: kuma.system.v1alpha1.Version
-	(*KumaCpVersion)(nil),         // 8: kuma.system.v1alpha1.KumaCpVersion
-	(*HealthCheck)(nil),           // 9: kuma.system.v1alpha1.HealthCheck
-	nil,                           // 10: kuma.system.v1alpha1.KDSSubscriptionStatus.StatEntry
-	(*timestamppb.Timestamp)(nil), // 11: google.protobuf.Timestamp
+	(*KDSResourceSync)(nil),       // 1: kuma.system.v1alpha1.KDSResourceSync
+	(*KDSResourceTypeSync)(nil),   // 2: kuma.system.v1alpha1.KDSResourceTypeSync
+	(*EnvoyAdminStreams)(nil),     // 3: kuma.system.v1alpha1.EnvoyAdminStreams
+	(*KDSStreams)(nil),            // 4: kuma.system.v1alpha1.KDSStreams
+	(*KDSStream)(nil),             // 5: kuma.system.v1alpha1.KDSStream
+	(*KDSSubscription)(nil),       // 6: kuma.system.v1alpha1.KDSSubscription
+	(*KDSSubscriptionStatus)(nil), // 7: kuma.system.v1alpha1.KDSSubscriptionStatus
+	(*KDSServiceStats)(nil),       // 8: kuma.system.v1alpha1.KDSServiceStats
+	(*Version)(nil),               // 9: kuma.system.v1alpha1.Version
+	(*KumaCpVersion)(nil),         // 10: kuma.system.v1alpha1.KumaCpVersion
+	(*HealthCheck)(nil),           // 11: kuma.system.v1alpha1.HealthCheck
+	nil,                           // 12: kuma.system.v1alpha1.KDSResourceSync.TypesEntry
+	nil,                           // 13: kuma.system.v1alpha1.KDSSubscriptionStatus.StatEntry
+	(*timestamppb.Timestamp)(nil), // 14: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),   // 15: google.protobuf.Duration
 }
 var file_api_system_v1alpha1_zone_insight_proto_depIdxs = []int32{
-	4,  // 0: kuma.system.v1alpha1.ZoneInsight.subscriptions:type_name -> kuma.system.v1alpha1.KDSSubscription
-	1,  // 1: kuma.system.v1alpha1.ZoneInsight.envoy_admin_streams:type_name -> kuma.system.v1alpha1.EnvoyAdminStreams
-	9,  // 2: kuma.system.v1alpha1.ZoneInsight.health_check:type_name -> kuma.system.v1alpha1.HealthCheck
-	2,  // 3: kuma.system.v1alpha1.ZoneInsight.kds_streams:type_name -> kuma.system.v1alpha1.KDSStreams
-	3,  // 4: kuma.system.v1alpha1.KDSStreams.clusters:type_name -> kuma.system.v1alpha1.KDSStream
-	3,  // 5: kuma.system.v1alpha1.KDSStreams.config_dump:type_name -> kuma.system.v1alpha1.KDSStream
-	3,  // 6: kuma.system.v1alpha1.KDSStreams.stats:type_name -> kuma.system.v1alpha1.KDSStream
-	3,  // 7: kuma.system.v1alpha1.KDSStreams.global_to_zone:type_name -> kuma.system.v1alpha1.KDSStream
-	3,  // 8: kuma.system.v1alpha1.KDSStreams.zone_to_global:type_name -> kuma.system.v1alpha1.KDSStream
-	11, // 9: kuma.system.v1alpha1.KDSStream.connect_time:type_name -> google.protobuf.Timestamp
-	11, // 10: kuma.system.v1alpha1.KDSSubscription.connect_time:type_name -> google.protobuf.Timestamp
-	11, // 11: kuma.system.v1alpha1.KDSSubscription.disconnect_time:type_name -> google.protobuf.Timestamp
-	5,  // 12: kuma.system.v1alpha1.KDSSubscription.status:type_name -> kuma.system.v1alpha1.KDSSubscriptionStatus
-	7,  // 13: kuma.system.v1alpha1.KDSSubscription.version:type_name -> kuma.system.v1alpha1.Version
-	11, // 14: kuma.system.v1alpha1.KDSSubscriptionStatus.last_update_time:type_name -> google.protobuf.Timestamp
-	6,  // 15: kuma.system.v1alpha1.KDSSubscriptionStatus.total:type_name -> kuma.system.v1alpha1.KDSServiceStats
-	10, // 16: kuma.system.v1alpha1.KDSSubscriptionStatus.stat:type_name -> kuma.system.v1alpha1.KDSSubscriptionStatus.StatEntry
-	8,  // 17: kuma.system.v1alpha1.Version.kumaCp:type_name -> kuma.system.v1alpha1.KumaCpVersion
-	11, // 18: kuma.system.v1alpha1.HealthCheck.time:type_name -> google.protobuf.Timestamp
-	6,  // 19: kuma.system.v1alpha1.KDSSubscriptionStatus.StatEntry.value:type_name -> kuma.system.v1alpha1.KDSServiceStats
-	20, // [20:20] is the sub-list for method output_type
-	20, // [20:20] is the sub-list for method input_type
-	20, // [20:20] is the sub-list for extension type_name
-	20, // [20:20] is the sub-list for extension extendee
-	0,  // [0:20] is the sub-list for field type_name
+	6,  // 0: kuma.system.v1alpha1.ZoneInsight.subscriptions:type_name -> kuma.system.v1alpha1.KDSSubscription
+	3,  // 1: kuma.system.v1alpha1.ZoneInsight.envoy_admin_streams:type_name -> kuma.system.v1alpha1.EnvoyAdminStreams
+	11, // 2: kuma.system.v1alpha1.ZoneInsight.health_check:type_name -> kuma.system.v1alpha1.HealthCheck
+	4,  // 3: kuma.system.v1alpha1.ZoneInsight.kds_streams:type_name -> kuma.system.v1alpha1.KDSStreams
+	1,  // 4: kuma.system.v1alpha1.ZoneInsight.resource_sync:type_name -> kuma.system.v1alpha1.KDSResourceSync
+	14, // 5: kuma.system.v1alpha1.KDSResourceSync.check_time:type_name -> google.protobuf.Timestamp
+	12, // 6: kuma.system.v1alpha1.KDSResourceSync.types:type_name -> kuma.system.v1alpha1.KDSResourceSync.TypesEntry
+	14, // 7: kuma.system.v1alpha1.KDSResourceTypeSync.last_applied_time:type_name -> google.protobuf.Timestamp
+	15, // 8: kuma.system.v1alpha1.KDSResourceTypeSync.lag:type_name -> google.protobuf.Duration
+	5,  // 9: kuma.system.v1alpha1.KDSStreams.clusters:type_name -> kuma.system.v1alpha1.KDSStream
+	5,  // 10: kuma.system.v1alpha1.KDSStreams.config_dump:type_name -> kuma.system.v1alpha1.KDSStream
+	5,  // 11: kuma.system.v1alpha1.KDSStreams.stats:type_name -> kuma.system.v1alpha1.KDSStream
+	5,  // 12: kuma.system.v1alpha1.KDSStreams.global_to_zone:type_name -> kuma.system.v1alpha1.KDSStream
+	5,  // 13: kuma.system.v1alpha1.KDSStreams.zone_to_global:type_name -> kuma.system.v1alpha1.KDSStream
+	14, // 14: kuma.system.v1alpha1.KDSStream.connect_time:type_name -> google.protobuf.Timestamp
+	14, // 15: kuma.system.v1alpha1.KDSSubscription.connect_time:type_name -> google.protobuf.Timestamp
+	14, // 16: kuma.system.v1alpha1.KDSSubscription.disconnect_time:type_name -> google.protobuf.Timestamp
+	7,  // 17: kuma.system.v1alpha1.KDSSubscription.status:type_name -> kuma.system.v1alpha1.KDSSubscriptionStatus
+	9,  // 18: kuma.system.v1alpha1.KDSSubscription.version:type_name -> kuma.system.v1alpha1.Version
+	14, // 19: kuma.system.v1alpha1.KDSSubscriptionStatus.last_update_time:type_name -> google.protobuf.Timestamp
+	8,  // 20: kuma.system.v1alpha1.KDSSubscriptionStatus.total:type_name -> kuma.system.v1alpha1.KDSServiceStats
+	13, // 21: kuma.system.v1alpha1.KDSSubscriptionStatus.stat:type_name -> kuma.system.v1alpha1.KDSSubscriptionStatus.StatEntry
+	10, // 22: kuma.system.v1alpha1.Version.kumaCp:type_name -> kuma.system.v1alpha1.KumaCpVersion
+	14, // 23: kuma.system.v1alpha1.HealthCheck.time:type_name -> google.protobuf.Timestamp
+	2,  // 24: kuma.system.v1alpha1.KDSResourceSync.TypesEntry.value:type_name -> kuma.system.v1alpha1.KDSResourceTypeSync
+	8,  // 25: kuma.system.v1alpha1.KDSSubscriptionStatus.StatEntry.value:type_name -> kuma.system.v1alpha1.KDSServiceStats
+	26, // [26:26] is the sub-list for method output_type
+	26, // [26:26] is the sub-list for method input_type
+	26, // [26:26] is the sub-list for extension type_name
+	26, // [26:26] is the sub-list for extension extendee
+	0,  // [0:26] is the sub-list for field type_name
 }
 
 func init() { file_api_system_v1alpha1_zone_insight_proto_init() }
@@ -864,7 +1081,7 @@ func file_api_system_v1alpha1_zone_insight_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_system_v1alpha1_zone_insight_proto_rawDesc), len(file_api_system_v1alpha1_zone_insight_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -27,6 +27,7 @@ import (
 	kds_zone "github.com/kumahq/kuma/v3/pkg/kds/zone"
 	mads_server "github.com/kumahq/kuma/v3/pkg/mads/server"
 	metrics "github.com/kumahq/kuma/v3/pkg/metrics/components"
+	token_revocations "github.com/kumahq/kuma/v3/pkg/tokens/revocations"
 	"github.com/kumahq/kuma/v3/pkg/util/os"
 	kuma_version "github.com/kumahq/kuma/v3/pkg/version"
 	"github.com/kumahq/kuma/v3/pkg/xds"
@@ -157,6 +158,10 @@ func newRunCmdWithOpts(opts kuma_cmd.RunCmdOpts) *cobra.Command {
 				runLog.Error(err, "unable to set up MeshIdentity component")
 				return err
 			}
+			if err := token_revocations.Setup(rt); err != nil {
+				runLog.Error(err, "unable to set up token revocations pruner")
+				return err
+			}
 
 			runLog.Info("starting Control Plane runtime")
 			if err := rt.Start(gracefulCtx.Done()); err != nil {
@@ -0,0 +1,11 @@
+package accesslogs
+
+import (
+	"testing"
+
+	"github.com/kumahq/kuma/v3/pkg/test"
+)
+
+func TestAccessLogs(t *testing.T) {
+	test.RunSpecs(t, "Access Logs")
+}
@@ -0,0 +1,47 @@
+package accesslogs
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	dropReasonBufferFull = "buffer_full"
+	dropReasonSpillError = "spill_error"
+	dropReasonShutdown   = "shutdown"
+)
+
+type metrics struct {
+	SentTotal             *prometheus.CounterVec
+	DroppedTotal          *prometheus.CounterVec
+	SpilledTotal          *prometheus.CounterVec
+	ConnectionErrorsTotal *prometheus.CounterVec
+}
+
+func newMetrics(registerer prometheus.Registerer) *metrics {
+	sentTotal := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "kuma_dp_access_log_sent_total",
+		Help: "Total access log entries sent to a TCP logging backend.",
+	}, []string{"address"})
+	registerer.MustRegister(sentTotal)
+	droppedTotal := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "kuma_dp_access_log_dropped_total",
+		Help: "Total access log entries dropped before they were sent to a TCP logging backend, by reason.",
+	}, []string{"address", "reason"})
+	registerer.MustRegister(droppedTotal)
+	spilledTotal := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "kuma_dp_access_log_spilled_total",
+		Help: "Total access log entries written to disk because the buffer of a TCP logging backend was full.",
+	}, []string{"address"})
+	registerer.MustRegister(spilledTotal)
+	connectionErrorsTotal := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "kuma_dp_access_log_connection_errors_total",
+		Help: "Total failed attempts to connect or write to a TCP logging backend.",
+	}, []string{"address"})
+	registerer.MustRegister(connectionErrorsTotal)
+	return &metrics{
+		SentTotal:             sentTotal,
+		DroppedTotal:          droppedTotal,
+		SpilledTotal:          spilledTotal,
+		ConnectionErrorsTotal: connectionErrorsTotal,
+	}
+}
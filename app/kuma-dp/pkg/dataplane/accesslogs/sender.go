@@ -3,40 +3,276 @@ package accesslogs
 import (
 	"context"
 	"net"
+	"slices"
+	"sync"
 	"time"
 
+	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
+
+	kumadp "github.com/kumahq/kuma/v3/pkg/config/app/kuma-dp"
 )
 
 const (
-	defaultConnectTimeout = 5 * time.Second
+	defaultConnectTimeout       = 5 * time.Second
+	defaultWriteTimeout         = 5 * time.Second
+	defaultReconnectBaseBackoff = 1 * time.Second
+	defaultBatchMaxBytes        = 64 * 1024
 )
 
+// logSender forwards access log entries to a TCP logging backend.
+// Entries are buffered in memory and sent in the background, so an unavailable backend doesn't block reading the pipe.
+// Entries are sent in batches with a single write. A batch is sent once it reaches the maximum size
+// or the flush interval elapses since its first entry was taken from the buffer.
+// When the backend is unavailable, the sender reconnects with an exponential backoff.
+// Entries that don't fit in the buffer are spilled to disk if it's enabled, otherwise they are dropped.
 type logSender struct {
-	address string
+	address       string
+	baseBackoff   time.Duration
+	maxBackoff    time.Duration
+	batchMaxBytes int
+	flushInterval time.Duration
+	metrics       *metrics
+	log           logr.Logger
+
+	buffer  chan []byte
+	spill   *spillFile
+	spilled chan struct{}
+	stop    chan struct{}
+	done    chan struct{}
+	once    sync.Once
 	conn    net.Conn
+	// pending is the batch of entries from the buffer that was not sent when the sender stopped.
+	pending [][]byte
 }
 
-func (s *logSender) connect() error {
-	conn, err := (&net.Dialer{Timeout: defaultConnectTimeout}).DialContext(context.Background(), "tcp", s.address)
-	if err != nil {
-		return errors.Wrapf(err, "failed to connect to a TCP logging backend: %s", s.address)
+func newLogSender(address string, config kumadp.AccessLog, metrics *metrics) (*logSender, error) {
+	s := &logSender{
+		address:       address,
+		baseBackoff:   config.ReconnectBaseBackoff.Duration,
+		maxBackoff:    config.ReconnectMaxBackoff.Duration,
+		batchMaxBytes: int(config.BatchMaxBytes),
+		flushInterval: config.FlushInterval.Duration,
+		metrics:       metrics,
+		log:           logger.WithValues("address", address),
+		buffer:        make(chan []byte, config.BufferSize),
+		spilled:       make(chan struct{}, 1),
+		stop:          make(chan struct{}),
+		done:          make(chan struct{}),
 	}
-	s.conn = conn
-	return nil
+	if s.batchMaxBytes <= 0 {
+		s.batchMaxBytes = defaultBatchMaxBytes
+	}
+	if s.baseBackoff <= 0 {
+		s.baseBackoff = defaultReconnectBaseBackoff
+	}
+	if s.maxBackoff < s.baseBackoff {
+		s.maxBackoff = s.baseBackoff
+	}
+	if config.SpillDir != "" {
+		spill, err := openSpillFile(config.SpillDir, address, config.SpillMaxBytes)
+		if err != nil {
+			return nil, err
+		}
+		s.spill = spill
+	}
+	go s.run()
+	return s, nil
 }
 
-func (s *logSender) send(record []byte) error {
-	if s.conn == nil {
-		return errors.New("connection not initialized")
+// send enqueues the record without blocking.
+func (s *logSender) send(record []byte) {
+	// Once entries are spilled, new entries go to disk as well until the spill is sent, so the order is preserved.
+	if s.spill == nil || s.spill.empty() {
+		select {
+		case s.buffer <- record:
+			return
+		default:
+		}
 	}
-	_, err := s.conn.Write(record)
-	return errors.Wrapf(err, "failed to send a log entry to a TCP logging backend: %s", s.address)
+	s.spillOrDrop(record, dropReasonBufferFull)
 }
 
-func (s *logSender) close() error {
-	if s.conn != nil {
-		return s.conn.Close()
+func (s *logSender) spillOrDrop(record []byte, reason string) {
+	if s.spill == nil {
+		s.metrics.DroppedTotal.WithLabelValues(s.address, reason).Inc()
+		return
+	}
+	written, err := s.spill.write(record)
+	switch {
+	case err != nil:
+		s.log.Error(err, "could not spill the log to disk. Dropping the log")
+		s.metrics.DroppedTotal.WithLabelValues(s.address, dropReasonSpillError).Inc()
+	case !written:
+		s.metrics.DroppedTotal.WithLabelValues(s.address, reason).Inc()
+	default:
+		s.metrics.SpilledTotal.WithLabelValues(s.address).Inc()
+		select {
+		case s.spilled <- struct{}{}:
+		default:
+		}
+	}
+}
+
+func (s *logSender) run() {
+	defer close(s.done)
+	backoff := s.baseBackoff
+	for {
+		batch, fromSpill, ok := s.next()
+		if !ok {
+			return
+		}
+		for {
+			err := s.write(batch)
+			if err == nil {
+				break
+			}
+			s.metrics.ConnectionErrorsTotal.WithLabelValues(s.address).Inc()
+			s.log.Error(err, "could not send logs to TCP log destination. Retrying", "backoff", backoff)
+			select {
+			case <-s.stop:
+				if !fromSpill {
+					s.pending = batch
+				}
+				return
+			case <-time.After(backoff):
+			}
+			backoff = min(2*backoff, s.maxBackoff)
+		}
+		backoff = s.baseBackoff
+		s.metrics.SentTotal.WithLabelValues(s.address).Add(float64(len(batch)))
+		if fromSpill {
+			if err := s.spill.commit(batch); err != nil {
+				s.log.Error(err, "could not remove sent logs from the spill file")
+			}
+		}
+	}
+}
+
+// next returns the oldest batch of entries that were not sent yet. Entries from the buffer are older than the spilled ones.
+// Spilled entries are already late, so they are sent as soon as they are read.
+func (s *logSender) next() ([][]byte, bool, bool) {
+	for {
+		select {
+		case record := <-s.buffer:
+			return s.collect(record), false, true
+		default:
+		}
+		if s.spill != nil {
+			records, err := s.spill.next(s.batchMaxBytes)
+			if err != nil {
+				s.log.Error(err, "could not read the spill file. Dropping spilled logs")
+				s.metrics.DroppedTotal.WithLabelValues(s.address, dropReasonSpillError).Inc()
+				if err := s.spill.reset(); err != nil {
+					s.log.Error(err, "could not reset the spill file")
+				}
+			}
+			if len(records) > 0 {
+				return records, true, true
+			}
+		}
+		select {
+		case record := <-s.buffer:
+			return s.collect(record), false, true
+		case <-s.spilled:
+		case <-s.stop:
+			return nil, false, false
+		}
+	}
+}
+
+// collect adds entries from the buffer to the batch until it reaches the maximum size or the flush interval elapses.
+func (s *logSender) collect(first []byte) [][]byte {
+	batch := [][]byte{first}
+	size := len(first)
+	var flush <-chan time.Time
+	if s.flushInterval > 0 {
+		timer := time.NewTimer(s.flushInterval)
+		defer timer.Stop()
+		flush = timer.C
+	}
+	for size < s.batchMaxBytes {
+		if flush == nil {
+			// entries are sent as soon as possible, take only those that are already buffered
+			select {
+			case record := <-s.buffer:
+				batch = append(batch, record)
+				size += len(record)
+				continue
+			default:
+				return batch
+			}
+		}
+		select {
+		case record := <-s.buffer:
+			batch = append(batch, record)
+			size += len(record)
+		case <-flush:
+			return batch
+		case <-s.stop:
+			return batch
+		}
+	}
+	return batch
+}
+
+func (s *logSender) write(batch [][]byte) error {
+	if s.conn == nil {
+		conn, err := (&net.Dialer{Timeout: defaultConnectTimeout}).DialContext(context.Background(), "tcp", s.address)
+		if err != nil {
+			return errors.Wrapf(err, "failed to connect to a TCP logging backend: %s", s.address)
+		}
+		s.log.Info("connected to TCP log destination")
+		s.conn = conn
+	}
+	if err := s.conn.SetWriteDeadline(time.Now().Add(defaultWriteTimeout)); err != nil {
+		return s.reset(errors.Wrapf(err, "failed to set a write deadline: %s", s.address))
+	}
+	buffers := net.Buffers(slices.Clone(batch))
+	if _, err := buffers.WriteTo(s.conn); err != nil {
+		return s.reset(errors.Wrapf(err, "failed to send log entries to a TCP logging backend: %s", s.address))
 	}
 	return nil
 }
+
+func (s *logSender) reset(err error) error {
+	if closeErr := s.conn.Close(); closeErr != nil {
+		s.log.Error(closeErr, "could not close access log destination")
+	}
+	s.conn = nil
+	return err
+}
+
+// close stops sending. Entries that were not sent are kept on disk if spilling is enabled, otherwise they are dropped.
+func (s *logSender) close() error {
+	var err error
+	s.once.Do(func() {
+		close(s.stop)
+		<-s.done
+		unsent := s.pending
+		for drained := false; !drained; {
+			select {
+			case record := <-s.buffer:
+				unsent = append(unsent, record)
+			default:
+				drained = true
+			}
+		}
+		if s.conn != nil {
+			err = s.conn.Close()
+		}
+		if s.spill == nil {
+			s.metrics.DroppedTotal.WithLabelValues(s.address, dropReasonShutdown).Add(float64(len(unsent)))
+			return
+		}
+		if spillErr := s.spill.close(unsent); spillErr != nil {
+			s.metrics.DroppedTotal.WithLabelValues(s.address, dropReasonSpillError).Add(float64(len(unsent)))
+			if err == nil {
+				err = spillErr
+			}
+			return
+		}
+		s.metrics.SpilledTotal.WithLabelValues(s.address).Add(float64(len(unsent)))
+	})
+	return err
+}
@@ -0,0 +1,213 @@
+package accesslogs
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	kumadp "github.com/kumahq/kuma/v3/pkg/config/app/kuma-dp"
+	config_types "github.com/kumahq/kuma/v3/pkg/config/types"
+)
+
+var _ = Describe("logSender", func() {
+	var address string
+	var m *metrics
+	var cfg kumadp.AccessLog
+
+	BeforeEach(func() {
+		// reserve a port on which the backend is not listening yet
+		l, err := net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).ToNot(HaveOccurred())
+		address = l.Addr().String()
+		Expect(l.Close()).To(Succeed())
+
+		m = newMetrics(prometheus.NewRegistry())
+		cfg = kumadp.AccessLog{
+			BufferSize:           1,
+			ReconnectBaseBackoff: config_types.Duration{Duration: 10 * time.Millisecond},
+			ReconnectMaxBackoff:  config_types.Duration{Duration: 50 * time.Millisecond},
+		}
+	})
+
+	startBackend := func() <-chan string {
+		l, err := net.Listen("tcp", address)
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(l.Close)
+		lines := make(chan string, 100)
+		go func() {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			defer conn.Close()
+			scanner := bufio.NewScanner(conn)
+			for scanner.Scan() {
+				lines <- scanner.Text()
+			}
+		}()
+		return lines
+	}
+
+	sendAll := func(sender *logSender, n int) {
+		for i := 0; i < n; i++ {
+			sender.send([]byte(fmt.Sprintf("log-%d\n", i)))
+		}
+	}
+
+	expectLines := func(lines <-chan string, n int) {
+		for i := 0; i < n; i++ {
+			Eventually(lines, "5s").Should(Receive(Equal(fmt.Sprintf("log-%d", i))))
+		}
+	}
+
+	It("should deliver buffered logs once the backend is available", func() {
+		// given
+		cfg.BufferSize = 10
+		sender, err := newLogSender(address, cfg, m)
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(sender.close)
+
+		// when
+		sendAll(sender, 5)
+		Eventually(func() float64 {
+			return testutil.ToFloat64(m.ConnectionErrorsTotal.WithLabelValues(address))
+		}).Should(BeNumerically(">", 0))
+		lines := startBackend()
+
+		// then
+		expectLines(lines, 5)
+		Eventually(func() float64 {
+			return testutil.ToFloat64(m.SentTotal.WithLabelValues(address))
+		}).Should(Equal(5.0))
+	})
+
+	It("should send a batch once it reaches the maximum size", func() {
+		// given
+		cfg.BufferSize = 10
+		cfg.BatchMaxBytes = 12
+		cfg.FlushInterval = config_types.Duration{Duration: time.Hour}
+		lines := startBackend()
+		sender, err := newLogSender(address, cfg, m)
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(sender.close)
+
+		// when
+		sendAll(sender, 4)
+
+		// then every two logs fill the batch, so they are sent without waiting for the flush interval
+		expectLines(lines, 4)
+		Expect(testutil.ToFloat64(m.SentTotal.WithLabelValues(address))).To(Equal(4.0))
+	})
+
+	It("should send a batch once the flush interval elapses", func() {
+		// given
+		cfg.BufferSize = 10
+		cfg.FlushInterval = config_types.Duration{Duration: 300 * time.Millisecond}
+		lines := startBackend()
+		sender, err := newLogSender(address, cfg, m)
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(sender.close)
+
+		// when
+		sendAll(sender, 2)
+
+		// then
+		Consistently(lines, "200ms").ShouldNot(Receive())
+		expectLines(lines, 2)
+	})
+
+	It("should keep the batch that was not sent on close", func() {
+		// given
+		cfg.BufferSize = 10
+		cfg.SpillDir = GinkgoT().TempDir()
+		cfg.SpillMaxBytes = 1024
+		sender, err := newLogSender(address, cfg, m)
+		Expect(err).ToNot(HaveOccurred())
+		sendAll(sender, 3)
+		Eventually(func() float64 {
+			return testutil.ToFloat64(m.ConnectionErrorsTotal.WithLabelValues(address))
+		}).Should(BeNumerically(">", 0))
+
+		// when
+		Expect(sender.close()).To(Succeed())
+		sender, err = newLogSender(address, cfg, newMetrics(prometheus.NewRegistry()))
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(sender.close)
+		lines := startBackend()
+
+		// then
+		expectLines(lines, 3)
+	})
+
+	It("should drop logs that don't fit in the buffer", func() {
+		// given
+		sender, err := newLogSender(address, cfg, m)
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(sender.close)
+
+		// when
+		sendAll(sender, 5)
+
+		// then one log can be in flight and one in the buffer
+		Expect(testutil.ToFloat64(m.DroppedTotal.WithLabelValues(address, dropReasonBufferFull))).To(BeNumerically(">=", 3))
+	})
+
+	It("should spill logs that don't fit in the buffer to disk", func() {
+		// given
+		cfg.SpillDir = GinkgoT().TempDir()
+		cfg.SpillMaxBytes = 1024
+		sender, err := newLogSender(address, cfg, m)
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(sender.close)
+
+		// when
+		sendAll(sender, 5)
+		lines := startBackend()
+
+		// then
+		expectLines(lines, 5)
+		Expect(testutil.ToFloat64(m.SpilledTotal.WithLabelValues(address))).To(BeNumerically(">=", 3))
+		Expect(testutil.ToFloat64(m.DroppedTotal.WithLabelValues(address, dropReasonBufferFull))).To(Equal(0.0))
+	})
+
+	It("should drop logs when the spill file is full", func() {
+		// given
+		cfg.SpillDir = GinkgoT().TempDir()
+		cfg.SpillMaxBytes = 10
+		sender, err := newLogSender(address, cfg, m)
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(sender.close)
+
+		// when
+		sendAll(sender, 5)
+
+		// then
+		Expect(testutil.ToFloat64(m.SpilledTotal.WithLabelValues(address))).To(Equal(1.0))
+		Expect(testutil.ToFloat64(m.DroppedTotal.WithLabelValues(address, dropReasonBufferFull))).To(BeNumerically(">=", 2))
+	})
+
+	It("should send spilled logs after restart", func() {
+		// given
+		cfg.SpillDir = GinkgoT().TempDir()
+		cfg.SpillMaxBytes = 1024
+		sender, err := newLogSender(address, cfg, m)
+		Expect(err).ToNot(HaveOccurred())
+		sendAll(sender, 5)
+		Expect(sender.close()).To(Succeed())
+
+		// when
+		sender, err = newLogSender(address, cfg, newMetrics(prometheus.NewRegistry()))
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(sender.close)
+		lines := startBackend()
+
+		// then
+		expectLines(lines, 5)
+	})
+})
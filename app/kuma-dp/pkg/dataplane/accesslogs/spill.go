@@ -0,0 +1,174 @@
+package accesslogs
+
+import (
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+const frameHeaderSize = 4
+
+// spillFile stores access log entries on disk while a logging backend is
+// unavailable. Entries are written as length-prefixed frames and read back in
+// the same order. Once every entry is sent, the file is truncated. Sent entries
+// are removed from the beginning of the file when it would exceed its maximum
+// size, so the file doesn't grow when it's never fully sent.
+type spillFile struct {
+	maxBytes int64
+
+	sync.Mutex
+	file   *os.File
+	size   int64
+	offset int64
+}
+
+func openSpillFile(dir string, address string, maxBytes uint64) (*spillFile, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, errors.Wrapf(err, "could not create access log spill directory %s", dir)
+	}
+	name := strings.NewReplacer(":", "_", "/", "_", "[", "", "]", "").Replace(address) + ".spill"
+	path := filepath.Join(dir, name)
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0o600)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not open access log spill file %s", path)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return nil, errors.Wrapf(err, "could not stat access log spill file %s", path)
+	}
+	return &spillFile{
+		maxBytes: int64(maxBytes),
+		file:     file,
+		size:     info.Size(),
+	}, nil
+}
+
+// write appends the record. It returns false when the file would exceed its
+// maximum size.
+func (f *spillFile) write(record []byte) (bool, error) {
+	f.Lock()
+	defer f.Unlock()
+	frameSize := int64(frameHeaderSize + len(record))
+	if f.size-f.offset+frameSize > f.maxBytes {
+		return false, nil
+	}
+	if f.size+frameSize > f.maxBytes {
+		if err := f.compact(nil); err != nil {
+			return false, err
+		}
+	}
+	frame := make([]byte, frameSize)
+	binary.BigEndian.PutUint32(frame, uint32(len(record)))
+	copy(frame[frameHeaderSize:], record)
+	if _, err := f.file.WriteAt(frame, f.size); err != nil {
+		return false, err
+	}
+	f.size += frameSize
+	return true, nil
+}
+
+// next returns the oldest records without removing them, or nil when the file
+// is empty. At least one record is returned, more are added while they fit in
+// maxBytes. The records have to be removed with commit once they're sent.
+func (f *spillFile) next(maxBytes int) ([][]byte, error) {
+	f.Lock()
+	defer f.Unlock()
+	var records [][]byte
+	size := 0
+	for offset := f.offset; offset < f.size; {
+		header := make([]byte, frameHeaderSize)
+		if _, err := f.file.ReadAt(header, offset); err != nil {
+			return nil, err
+		}
+		recordSize := int(binary.BigEndian.Uint32(header))
+		// a frame that was torn by a crash can have any size, so it's not
+		// trusted before it's allocated
+		if int64(recordSize) > f.maxBytes || offset+frameHeaderSize+int64(recordSize) > f.size {
+			return nil, errors.Errorf("corrupted frame at offset %d of size %d", offset, recordSize)
+		}
+		if len(records) > 0 && size+recordSize > maxBytes {
+			break
+		}
+		record := make([]byte, recordSize)
+		if _, err := f.file.ReadAt(record, offset+frameHeaderSize); err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+		size += recordSize
+		offset += int64(frameHeaderSize + recordSize)
+	}
+	return records, nil
+}
+
+func (f *spillFile) commit(records [][]byte) error {
+	f.Lock()
+	defer f.Unlock()
+	for _, record := range records {
+		f.offset += int64(frameHeaderSize + len(record))
+	}
+	if f.offset < f.size {
+		return nil
+	}
+	return f.truncate()
+}
+
+// reset discards every record, e.g. when the file can't be read.
+func (f *spillFile) reset() error {
+	f.Lock()
+	defer f.Unlock()
+	return f.truncate()
+}
+
+func (f *spillFile) truncate() error {
+	f.offset = 0
+	f.size = 0
+	return f.file.Truncate(0)
+}
+
+func (f *spillFile) empty() bool {
+	f.Lock()
+	defer f.Unlock()
+	return f.offset >= f.size
+}
+
+// close keeps entries that were not sent yet, so they are sent after restart.
+// The head entries are older than the spilled ones, so they are stored first.
+func (f *spillFile) close(head [][]byte) error {
+	f.Lock()
+	defer f.Unlock()
+	if f.offset > 0 || len(head) > 0 {
+		if err := f.compact(head); err != nil {
+			return err
+		}
+	}
+	return f.file.Close()
+}
+
+// compact removes sent entries from the beginning of the file and stores the
+// head entries before the ones that were not sent yet.
+func (f *spillFile) compact(head [][]byte) error {
+	var frames []byte
+	for _, record := range head {
+		frames = binary.BigEndian.AppendUint32(frames, uint32(len(record)))
+		frames = append(frames, record...)
+	}
+	rest := make([]byte, f.size-f.offset)
+	if _, err := f.file.ReadAt(rest, f.offset); err != nil {
+		return err
+	}
+	frames = append(frames, rest...)
+	if _, err := f.file.WriteAt(frames, 0); err != nil {
+		return err
+	}
+	if err := f.file.Truncate(int64(len(frames))); err != nil {
+		return err
+	}
+	f.offset = 0
+	f.size = int64(len(frames))
+	return nil
+}
@@ -0,0 +1,71 @@
+package accesslogs
+
+import (
+	"encoding/binary"
+	"fmt"
+	"os"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("spillFile", func() {
+	var spill *spillFile
+
+	BeforeEach(func() {
+		var err error
+		spill, err = openSpillFile(GinkgoT().TempDir(), "127.0.0.1:1234", 32)
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(func() {
+			_ = spill.file.Close()
+		})
+	})
+
+	It("should not grow when it's never fully sent", func() {
+		// given
+		written, err := spill.write([]byte("log-0\n"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(written).To(BeTrue())
+
+		for i := 1; i <= 100; i++ {
+			// when a new entry is spilled before the oldest one is sent
+			written, err := spill.write(fmt.Appendf(nil, "log-%d\n", i))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(written).To(BeTrue())
+			records, err := spill.next(1)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(spill.commit(records[:1])).To(Succeed())
+
+			// then
+			info, err := spill.file.Stat()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(info.Size()).To(BeNumerically("<=", 32))
+		}
+
+		// and the entry that was not sent is kept
+		records, err := spill.next(32)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(records).To(HaveLen(1))
+		Expect(string(records[0])).To(Equal("log-100\n"))
+	})
+
+	It("should reject a frame larger than the file", func() {
+		// given a frame torn by a crash
+		header := binary.BigEndian.AppendUint32(nil, 1<<31)
+		_, err := spill.file.WriteAt(append(header, []byte("log")...), 0)
+		Expect(err).ToNot(HaveOccurred())
+		spill.size = int64(len(header) + 3)
+
+		// when
+		_, err = spill.next(32)
+
+		// then
+		Expect(err).To(MatchError(ContainSubstring("corrupted frame")))
+
+		// and the file can be reset
+		Expect(spill.reset()).To(Succeed())
+		info, err := os.Stat(spill.file.Name())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(info.Size()).To(BeZero())
+	})
+})
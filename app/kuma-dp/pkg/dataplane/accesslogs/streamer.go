@@ -10,7 +10,9 @@ import (
 	"syscall"
 
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
 
+	kumadp "github.com/kumahq/kuma/v3/pkg/config/app/kuma-dp"
 	"github.com/kumahq/kuma/v3/pkg/core"
 	"github.com/kumahq/kuma/v3/pkg/core/runtime/component"
 )
@@ -25,6 +27,8 @@ var _ component.Component = &accessLogStreamer{}
 // Streamer then reads logs from the pipe and passes it to a TCP destination.
 type accessLogStreamer struct {
 	address string
+	config  kumadp.AccessLog
+	metrics *metrics
 
 	sync.RWMutex
 	senders map[string]*logSender
@@ -34,9 +38,15 @@ func (s *accessLogStreamer) NeedLeaderElection() bool {
 	return false
 }
 
-func NewAccessLogStreamer(socketName string) component.Component {
+func NewAccessLogStreamer(socketName string, config kumadp.AccessLog) component.Component {
+	return newAccessLogStreamer(socketName, config, prometheus.DefaultRegisterer)
+}
+
+func newAccessLogStreamer(socketName string, config kumadp.AccessLog, registerer prometheus.Registerer) *accessLogStreamer {
 	return &accessLogStreamer{
 		address: socketName,
+		config:  config,
+		metrics: newMetrics(registerer),
 		senders: map[string]*logSender{},
 	}
 }
@@ -84,7 +94,7 @@ func (s *accessLogStreamer) cleanup() {
 	s.Lock()
 	defer s.Unlock()
 	for _, sender := range s.senders {
-		logger.Info("closing connection to the TCP log destination", "address", sender)
+		logger.Info("closing connection to the TCP log destination", "address", sender.address)
 		if err := sender.close(); err != nil {
 			logger.Error(err, "could not close access log destination")
 		}
@@ -141,32 +151,19 @@ func (s *accessLogStreamer) streamAccessLogs(reader *bufio.Reader) error {
 		log := logger.WithValues("address", address)
 
 		if !initialized {
-			sender = &logSender{address: address}
-			if err := sender.connect(); err != nil {
+			sender, err = newLogSender(address, s.config, s.metrics)
+			if err != nil {
 				// Drop log rather than return an error. Returning an error will cause reopening pipe which is unnecessary.
-				// Do not retry this operation. If we were to retry here, the fifo can quickly grow.
-				// Additionally, if TCP address is misconfigured, we would produce a lot of logs with misconfigured IP:port.
-				// If we add retry, we would retry connection for every misconfigured log as the TCP destination is added to every log.
-				// In this case, recovering logging to a proper configuration would take a lot of time.
-				log.Error(err, "could not connect to TCP log destination. Dropping the log")
+				log.Error(err, "could not create TCP log destination. Dropping the log")
 				continue
 			}
 			s.Lock()
 			s.senders[address] = sender
 			s.Unlock()
-			log.Info("connected to TCP log destination")
 		}
 
-		if err := sender.send(accessLogMsg); err != nil {
-			// If there is a problem on this connection, we need to reconnect.
-			// Drop log rather than return an error. Returning an error would cause reopening pipe which is unnecessary.
-			log.Error(err, "could not send the log to TCP log destination. Dropping the log", "address", address)
-			if err := sender.close(); err != nil {
-				log.Error(err, "could not close access log destination")
-			}
-			s.Lock()
-			delete(s.senders, address)
-			s.Unlock()
-		}
+		// The sender buffers the log and retries when the TCP destination is unavailable,
+		// so a slow or unavailable destination doesn't block reading the pipe.
+		sender.send(accessLogMsg)
 	}
 }
@@ -119,10 +119,10 @@ func (s *Server) Handler(res dns.ResponseWriter, req *dns.Msg) {
 		case dns.TypeA:
 			// lookup in our DNS map
 			dnsMap := s.dnsMap.Load()
-			dnsEntry = dnsMap.ARecords[req.Question[0].Name]
+			dnsEntry = lookup(dnsMap.ARecords, req.Question[0].Name)
 		case dns.TypeAAAA:
 			dnsMap := s.dnsMap.Load()
-			dnsEntry = dnsMap.AAAARecords[req.Question[0].Name]
+			dnsEntry = lookup(dnsMap.AAAARecords, req.Question[0].Name)
 		}
 		log.V(1).Info("got request", "type", req.Question[0].Qtype, "name", req.Question[0].Name, "entry", dnsEntry)
 	}
@@ -166,6 +166,28 @@ func (s *Server) Handler(res dns.ResponseWriter, req *dns.Msg) {
 	}
 }
 
+// lookup finds the entry of the name. When there is no exact entry, the closest wildcard entry (like *.example.com.)
+// is used and its records are answered with the queried name.
+func lookup(records map[string]*dnsEntry, name string) *dnsEntry {
+	if entry, ok := records[name]; ok {
+		return entry
+	}
+	for labels := dns.SplitDomainName(name); len(labels) > 1; labels = labels[1:] {
+		entry, ok := records["*."+dns.Fqdn(strings.Join(labels[1:], "."))]
+		if !ok {
+			continue
+		}
+		wildcardEntry := &dnsEntry{RCode: entry.RCode, RR: make([]dns.RR, 0, len(entry.RR))}
+		for _, rr := range entry.RR {
+			rr = dns.Copy(rr)
+			rr.Header().Name = name
+			wildcardEntry.RR = append(wildcardEntry.RR, rr)
+		}
+		return wildcardEntry
+	}
+	return nil
+}
+
 func (s *Server) Start(stop <-chan struct{}) error {
 	defer close(s.componentDone)
 
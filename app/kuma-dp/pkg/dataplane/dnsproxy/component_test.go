@@ -176,6 +176,45 @@ var _ = Describe("components", func() {
 		Expect(res).To(HaveField("Rcode", Equal(dns.RcodeSuccess)))
 		Expect(res.Answer[0].String()).To(Equal("foo.com.\t125\tIN\tA\t17.0.0.1"))
 	})
+	It("hit a wildcard in the map", func() {
+		f := func(req *dns.Msg) (*dns.Msg, error) {
+			response := new(dns.Msg)
+			response.SetRcode(req, dns.RcodeNameError)
+			return response, nil
+		}
+		mock.Store(&f)
+
+		Expect(server.ReloadMap(context.Background(), bytes.NewBuffer([]byte(`{
+"ttl": 123,
+"records": [
+  {"name": "*.s3.amazonaws.com", "ips": ["240.0.0.1"]},
+  {"name": "exact.s3.amazonaws.com", "ips": ["240.0.0.2"]}
+]
+}`)))).To(Succeed())
+		c := new(dns.Client)
+
+		By("subdomain of the wildcard")
+		msg := &dns.Msg{}
+		msg.SetQuestion("my-bucket.eu.s3.amazonaws.com.", dns.TypeA)
+		res, _, err := c.Exchange(msg, address)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res).To(HaveField("Rcode", Equal(dns.RcodeSuccess)))
+		Expect(res.Answer[0].String()).To(Equal("my-bucket.eu.s3.amazonaws.com.\t123\tIN\tA\t240.0.0.1"))
+
+		By("exact record takes precedence over the wildcard")
+		msg = &dns.Msg{}
+		msg.SetQuestion("exact.s3.amazonaws.com.", dns.TypeA)
+		res, _, err = c.Exchange(msg, address)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.Answer[0].String()).To(Equal("exact.s3.amazonaws.com.\t123\tIN\tA\t240.0.0.2"))
+
+		By("the wildcard does not match the parent domain")
+		msg = &dns.Msg{}
+		msg.SetQuestion("s3.amazonaws.com.", dns.TypeA)
+		res, _, err = c.Exchange(msg, address)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res).To(HaveField("Rcode", Equal(dns.RcodeNameError)))
+	})
 	It("metrics have extra labels after ReloadMap", func() {
 		f := func(req *dns.Msg) (*dns.Msg, error) {
 			return nil, fmt.Errorf("should not call upstream for local entry")
@@ -2,42 +2,51 @@ package apply
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
-	"net/url"
-	"os"
-	"path/filepath"
-	"sort"
-	"strings"
-	"time"
 
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 
+	api_common "github.com/kumahq/kuma/v3/api/openapi/types/common"
 	kumactl_cmd "github.com/kumahq/kuma/v3/app/kumactl/pkg/cmd"
+	"github.com/kumahq/kuma/v3/app/kumactl/pkg/manifests"
 	yaml_output "github.com/kumahq/kuma/v3/app/kumactl/pkg/output/yaml"
+	kumactl_resources "github.com/kumahq/kuma/v3/app/kumactl/pkg/resources"
+	api_server_types "github.com/kumahq/kuma/v3/pkg/api-server/types"
 	"github.com/kumahq/kuma/v3/pkg/core/resources/apis/mesh"
 	"github.com/kumahq/kuma/v3/pkg/core/resources/model"
 	rest_types "github.com/kumahq/kuma/v3/pkg/core/resources/model/rest"
 	"github.com/kumahq/kuma/v3/pkg/core/resources/registry"
 	"github.com/kumahq/kuma/v3/pkg/core/resources/store"
+	error_types "github.com/kumahq/kuma/v3/pkg/core/rest/errors/types"
 	"github.com/kumahq/kuma/v3/pkg/plugins/resources/remote"
 	"github.com/kumahq/kuma/v3/pkg/util/template"
 	"github.com/kumahq/kuma/v3/pkg/util/yaml"
 )
 
 const (
-	timeout = 10 * time.Second
+	dryRunNone   = "none"
+	dryRunClient = "client"
+	dryRunServer = "server"
 )
 
+const defaultFieldManager = "kumactl"
+
 type applyContext struct {
 	*kumactl_cmd.RootContext
 
 	args struct {
-		file   string
-		vars   map[string]string
-		dryRun bool
+		file            string
+		vars            map[string]string
+		dryRun          string
+		showImpact      bool
+		serverSide      bool
+		fieldManager    string
+		forceConflicts  bool
+		resourceVersion string
 	}
 }
 
@@ -62,12 +71,59 @@ $ kumactl apply -f https://example.com/resource.yaml
 
 Apply all resources from a directory
 $ kumactl apply -f resources/
+
+Show dataplanes affected by a policy without applying it
+$ kumactl apply -f policy.yaml --dry-run=server --show-impact
+
+Apply resources on the server as a field manager and print what changed
+$ kumactl apply -f resources/ --server-side --field-manager=team-a
+
+Show what a server side apply would change without applying it
+$ kumactl apply -f resources/ --server-side --dry-run=server
+
+Apply a resource only if it wasn't changed since the last apply
+$ kumactl apply -f resource.yaml --server-side --resource-version=2
 `,
-		Args: cobra.NoArgs,
+		Args: func(cmd *cobra.Command, args []string) error {
+			// --dry-run has an optional value, so "--dry-run server" is parsed as
+			// --dry-run=client followed by an argument "server"
+			if cmd.Flags().Changed("dry-run") && len(args) > 0 {
+				switch args[0] {
+				case "true", "false", dryRunNone, dryRunClient, dryRunServer:
+					return errors.Errorf("value of --dry-run has to be passed with \"=\", use --dry-run=%s", args[0])
+				}
+			}
+			return cobra.NoArgs(cmd, args)
+		},
 		RunE: func(cmd *cobra.Command, _ []string) error {
+			switch ctx.args.dryRun {
+			// --dry-run used to be a boolean flag, keep the old values working
+			case "true":
+				_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "Warning: --dry-run=true is deprecated, use --dry-run=%s\n", dryRunClient)
+				ctx.args.dryRun = dryRunClient
+			case "false":
+				_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "Warning: --dry-run=false is deprecated, use --dry-run=%s\n", dryRunNone)
+				ctx.args.dryRun = dryRunNone
+			case dryRunNone, dryRunClient, dryRunServer:
+			default:
+				return errors.Errorf("invalid --dry-run value %q, must be one of %q, %q or %q", ctx.args.dryRun, dryRunNone, dryRunClient, dryRunServer)
+			}
+			if ctx.args.showImpact && ctx.args.dryRun != dryRunServer {
+				return errors.New("--show-impact requires --dry-run=server")
+			}
+			if !ctx.args.serverSide && cmd.Flags().Changed("field-manager") {
+				return errors.New("--field-manager requires --server-side")
+			}
+			if !ctx.args.serverSide && ctx.args.resourceVersion != "" {
+				return errors.New("--resource-version requires --server-side")
+			}
+			if ctx.args.serverSide && ctx.args.showImpact {
+				return errors.New("--show-impact can't be used together with --server-side")
+			}
+
 			_ = kumactl_cmd.CheckCompatibility(pctx.FetchServerVersion, cmd.ErrOrStderr())
 
-			b, err := readContent(ctx.args.file, cmd)
+			b, err := manifests.Read(ctx.args.file, cmd.InOrStdin())
 			if err != nil {
 				return err
 			}
@@ -101,23 +157,32 @@ $ kumactl apply -f resources/
 			if hasErrors {
 				return errors.New("failed to validate some resources")
 			}
+			if ctx.args.resourceVersion != "" && len(resources) != 1 {
+				return errors.New("--resource-version requires a single resource")
+			}
+			p := yaml_output.NewPrinter()
+			if ctx.args.serverSide && ctx.args.dryRun != dryRunClient {
+				return ctx.serverSideApply(cmd, resources)
+			}
+			if ctx.args.dryRun == dryRunServer {
+				return ctx.simulate(cmd, resources)
+			}
 			var rs store.ResourceStore
-			if !ctx.args.dryRun {
+			if ctx.args.dryRun != dryRunClient {
 				rs, err = pctx.CurrentResourceStore()
 				if err != nil {
 					return err
 				}
 			}
-			p := yaml_output.NewPrinter()
 			for _, resource := range resources {
 				if rs == nil {
 					if err := p.Print(rest_types.From.Resource(resource), cmd.OutOrStdout()); err != nil {
 						return err
 					}
 				} else {
-					warnings, isUpdate, err := upsert(cmd.Context(), pctx.Runtime.Registry, rs, resource)
+					warnings, isUpdate, err := upsert(cmd.Context(), pctx.Runtime.Registry, rs, resource, ctx.args.forceConflicts)
 					if err != nil {
-						return fmt.Errorf("resource type=%q mesh=%q name=%q: failed server side: %s", resource.Descriptor().Name, resource.GetMeta().GetMesh(), resource.GetMeta().GetName(), err.Error())
+						return applyError(resource, err)
 					}
 					action := "Created"
 					if isUpdate {
@@ -137,107 +202,129 @@ $ kumactl apply -f resources/
 	cmd.Flags().StringVarP(&ctx.args.file, "file", "f", "", "Path to file or directory to apply. When a directory is provided, all .yaml, .yml, and .json files are applied. Pass `-` to read from stdin")
 	_ = cmd.MarkFlagRequired("file")
 	cmd.Flags().StringToStringVarP(&ctx.args.vars, "var", "v", map[string]string{}, "Variable to replace in configuration")
-	cmd.Flags().BoolVar(&ctx.args.dryRun, "dry-run", false, "Resolve variable and prints result out without actual applying")
+	cmd.Flags().StringVar(&ctx.args.dryRun, "dry-run", dryRunNone, `Must be "none", "client" or "server", passed as --dry-run=<value>. --dry-run without a value is the same as --dry-run=client. With "client" resolves variables and prints result out without actual applying. With "server" the control plane validates policies and computes their impact without applying them`)
+	cmd.Flags().Lookup("dry-run").NoOptDefVal = dryRunClient
+	cmd.Flags().BoolVar(&ctx.args.showImpact, "show-impact", false, "Print dataplanes affected by policies with the diff of their rules and XDS config. Requires --dry-run=server")
+	cmd.Flags().BoolVar(&ctx.args.serverSide, "server-side", false, "Apply resources on the control plane, which tracks the field manager of every resource and rejects changes of resources applied by other field managers. The field manager owns the whole resource, not single fields. Prints what changed in updated resources")
+	cmd.Flags().StringVar(&ctx.args.fieldManager, "field-manager", defaultFieldManager, "Name of the manager applying resources. Requires --server-side")
+	cmd.Flags().BoolVar(&ctx.args.forceConflicts, "force-conflicts", false, "Apply resources even if they were applied by other field managers. With --server-side it also takes them over")
+	cmd.Flags().StringVar(&ctx.args.resourceVersion, "resource-version", "", "Apply the resource only if it wasn't changed since the version printed by a previous server side apply. Requires --server-side and a single resource")
 	return cmd
 }
 
-var supportedExtensions = map[string]bool{
-	".yaml": true,
-	".yml":  true,
-	".json": true,
-}
-
-func readContent(file string, cmd *cobra.Command) ([]byte, error) {
-	if file == "-" {
-		return io.ReadAll(cmd.InOrStdin())
-	}
-	if strings.HasPrefix(file, "http://") || strings.HasPrefix(file, "https://") {
-		return readURL(file)
-	}
-	info, err := os.Stat(file)
+// simulate sends policies to the control plane which computes the impact of applying them.
+// Only targetRef policies can be simulated.
+func (c *applyContext) simulate(cmd *cobra.Command, resources []model.Resource) error {
+	client, err := c.CurrentPolicyInspectClient()
 	if err != nil {
-		return nil, errors.Wrap(err, "error while reading provided file")
+		return err
 	}
-	if info.IsDir() {
-		return readDirectory(file)
-	}
-	b, err := os.ReadFile(file)
-	if err != nil {
-		return nil, errors.Wrap(err, "error while reading provided file")
+	p := yaml_output.NewPrinter()
+	for _, resource := range resources {
+		desc := resource.Descriptor()
+		if !desc.IsPolicy || !desc.IsTargetRefBased {
+			return fmt.Errorf("resource type=%q mesh=%q name=%q: server side dry run is only supported for targetRef policies", desc.Name, resource.GetMeta().GetMesh(), resource.GetMeta().GetName())
+		}
+		simulation, err := client.Simulate(cmd.Context(), resource)
+		if err != nil {
+			return fmt.Errorf("resource type=%q mesh=%q name=%q: failed server side: %s", desc.Name, resource.GetMeta().GetMesh(), resource.GetMeta().GetName(), err.Error())
+		}
+		action := "Updated"
+		if simulation.Created {
+			action = "Created"
+		}
+		_, _ = fmt.Fprintf(cmd.OutOrStdout(), "resource type=%q mesh=%q name=%q %s (server dry run)\n", desc.Name, resource.GetMeta().GetMesh(), resource.GetMeta().GetName(), action)
+		if c.args.showImpact {
+			if err := p.Print(simulation, cmd.OutOrStdout()); err != nil {
+				return err
+			}
+		}
 	}
-	return b, nil
+	return nil
 }
 
-func readURL(file string) ([]byte, error) {
-	if _, err := url.ParseRequestURI(file); err != nil {
-		return nil, errors.Wrap(err, "invalid URL for --file")
-	}
-	client := &http.Client{
-		Timeout: timeout,
-	}
-	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, file, http.NoBody)
+// serverSideApply applies resources on the control plane. The control plane creates or updates resources and
+// returns the diff of the change, or fails with a conflict when a resource was applied by another field manager.
+func (c *applyContext) serverSideApply(cmd *cobra.Command, resources []model.Resource) error {
+	client, err := c.CurrentApplyClient()
 	if err != nil {
-		return nil, errors.Wrap(err, "error creating new http request")
+		return err
 	}
-	resp, err := client.Do(req) // #nosec G704 -- URL validated with ParseRequestURI above
-	if err != nil {
-		return nil, errors.Wrap(err, "error with GET http request")
-	}
-	defer resp.Body.Close()
-	if resp.StatusCode != http.StatusOK {
-		return nil, errors.New("error while retrieving URL")
+	opts := kumactl_resources.ApplyOptions{
+		FieldManager:    c.args.fieldManager,
+		Force:           c.args.forceConflicts,
+		DryRun:          c.args.dryRun == dryRunServer,
+		ResourceVersion: c.args.resourceVersion,
 	}
-	b, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, errors.Wrap(err, "error while reading provided file")
+	suffix := "(server side apply)"
+	if opts.DryRun {
+		suffix = "(server dry run)"
 	}
-	return b, nil
-}
-
-func readDirectory(dir string) ([]byte, error) {
-	entries, err := os.ReadDir(dir)
-	if err != nil {
-		return nil, errors.Wrap(err, "error reading directory")
-	}
-	var files []string
-	for _, entry := range entries {
-		if entry.IsDir() {
-			continue
-		}
-		// Skip symlinks that point to directories
-		if entry.Type()&os.ModeSymlink != 0 {
-			resolved, err := os.Stat(filepath.Join(dir, entry.Name()))
-			if err != nil {
-				continue
+	for _, resource := range resources {
+		desc := resource.Descriptor()
+		resp, err := client.Apply(cmd.Context(), resource, opts)
+		if err != nil {
+			var kumaErr *error_types.Error
+			if opts.ResourceVersion != "" && errors.As(err, &kumaErr) && kumaErr.Status == http.StatusConflict && len(kumaErr.InvalidParameters) == 0 {
+				return fmt.Errorf("resource type=%q mesh=%q name=%q: failed server side: the resource was changed since version %q", desc.Name, resource.GetMeta().GetMesh(), resource.GetMeta().GetName(), opts.ResourceVersion)
 			}
-			if resolved.IsDir() {
-				continue
+			return applyError(resource, err)
+		}
+		// the version can be passed to --resource-version of the next apply, a dry run doesn't store the resource
+		version := ""
+		if resp.ResourceVersion != "" {
+			version = fmt.Sprintf(" resourceVersion=%q", resp.ResourceVersion)
+		}
+		_, _ = fmt.Fprintf(cmd.OutOrStdout(), "resource type=%q mesh=%q name=%q %s %s%s\n", desc.Name, resource.GetMeta().GetMesh(), resource.GetMeta().GetName(), resp.Operation, suffix, version)
+		// the diff of a created resource is the whole resource, so it's only printed for updates
+		if resp.Operation == api_server_types.ApplyOperationUpdated {
+			for _, item := range resp.Diff {
+				if err := printDiffItem(cmd.OutOrStdout(), item); err != nil {
+					return err
+				}
 			}
 		}
-		ext := strings.ToLower(filepath.Ext(entry.Name()))
-		if supportedExtensions[ext] {
-			files = append(files, filepath.Join(dir, entry.Name()))
+		for _, w := range resp.Warnings {
+			if _, err := fmt.Fprintf(cmd.ErrOrStderr(), "Warning: %v\n", w); err != nil {
+				return err
+			}
 		}
 	}
-	sort.Strings(files)
-	if len(files) == 0 {
-		return nil, nil
+	return nil
+}
+
+// applyError returns the error of applying the resource. A conflict with a field manager suggests forcing it.
+func applyError(resource model.Resource, err error) error {
+	var kumaErr *error_types.Error
+	if errors.As(err, &kumaErr) && kumaErr.Status == http.StatusConflict && len(kumaErr.InvalidParameters) > 0 {
+		return fmt.Errorf("resource type=%q mesh=%q name=%q: failed server side: %s. Use --force-conflicts to apply it anyway", resource.Descriptor().Name, resource.GetMeta().GetMesh(), resource.GetMeta().GetName(), kumaErr.Detail)
+	}
+	return fmt.Errorf("resource type=%q mesh=%q name=%q: failed server side: %s", resource.Descriptor().Name, resource.GetMeta().GetMesh(), resource.GetMeta().GetName(), err.Error())
+}
+
+// printDiffItem prints a JSON patch operation of the apply diff as "+ /path: value" or "- /path: value".
+// Changed values are rendered as test, remove and add operations of the same path, so test operations are skipped.
+func printDiffItem(w io.Writer, item api_common.JsonPatchItem) error {
+	var sign string
+	switch item.Op {
+	case api_common.Test:
+		return nil
+	case api_common.Add:
+		sign = "+"
+	case api_common.Remove:
+		sign = "-"
+	default:
+		sign = string(item.Op)
 	}
-	var combined []byte
-	for _, f := range files {
-		b, err := os.ReadFile(f)
-		if err != nil {
-			return nil, errors.Wrapf(err, "error reading file %s", f)
-		}
-		if len(combined) > 0 {
-			combined = append(combined, []byte("\n---\n")...)
-		}
-		combined = append(combined, b...)
+	value, err := json.Marshal(item.Value)
+	if err != nil {
+		return err
 	}
-	return combined, nil
+	_, err = fmt.Fprintf(w, "  %s %s: %s\n", sign, item.Path, value)
+	return err
 }
 
-func upsert(ctx context.Context, typeRegistry registry.TypeRegistry, rs store.ResourceStore, res model.Resource) ([]string, bool, error) {
+func upsert(ctx context.Context, typeRegistry registry.TypeRegistry, rs store.ResourceStore, res model.Resource, force bool) ([]string, bool, error) {
 	newRes, err := typeRegistry.NewObject(res.Descriptor().Name)
 	if err != nil {
 		return nil, false, err
@@ -247,6 +334,7 @@ func upsert(ctx context.Context, typeRegistry registry.TypeRegistry, rs store.Re
 	warnContext := context.WithValue(ctx, remote.WarningsCallback, func(s []string) {
 		warnings = s
 	})
+	warnContext = context.WithValue(warnContext, remote.ForceConflicts, force)
 
 	meta := res.GetMeta()
 	if err := rs.Get(ctx, newRes, store.GetByKey(meta.GetName(), meta.GetMesh())); err != nil {
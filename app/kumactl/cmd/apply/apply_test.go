@@ -16,15 +16,19 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/kumahq/kuma/v3/api/mesh/v1alpha1"
+	api_common "github.com/kumahq/kuma/v3/api/openapi/types/common"
 	"github.com/kumahq/kuma/v3/app/kumactl/cmd"
 	kumactl_cmd "github.com/kumahq/kuma/v3/app/kumactl/pkg/cmd"
+	"github.com/kumahq/kuma/v3/app/kumactl/pkg/resources"
 	test_kumactl "github.com/kumahq/kuma/v3/app/kumactl/pkg/test"
+	api_server_types "github.com/kumahq/kuma/v3/pkg/api-server/types"
 	"github.com/kumahq/kuma/v3/pkg/core/resources/apis/mesh"
 	core_model "github.com/kumahq/kuma/v3/pkg/core/resources/model"
 	"github.com/kumahq/kuma/v3/pkg/core/resources/registry"
 	core_store "github.com/kumahq/kuma/v3/pkg/core/resources/store"
 	"github.com/kumahq/kuma/v3/pkg/core/rest/errors/types"
 	memory_resources "github.com/kumahq/kuma/v3/pkg/plugins/resources/memory"
+	"github.com/kumahq/kuma/v3/pkg/plugins/resources/remote"
 	"github.com/kumahq/kuma/v3/pkg/test"
 	"github.com/kumahq/kuma/v3/pkg/test/matchers"
 	"github.com/kumahq/kuma/v3/pkg/test/resources/model"
@@ -358,6 +362,71 @@ networking:
 		Expect(buf.String()).To(matchers.MatchGoldenEqual(filepath.Join("testdata", "apply-many-dataplane-template.golden.yaml")))
 	})
 
+	It("should accept deprecated --dry-run=true as client dry run", func() {
+		// given
+		rootCmd.SetArgs([]string{
+			"apply", "-f", filepath.Join("testdata", "apply-many-dataplane-template.yaml"),
+			"-v", "address=2.2.2.2", "--dry-run=true",
+		})
+		stdout := &bytes.Buffer{}
+		stderr := &bytes.Buffer{}
+		rootCmd.SetOut(stdout)
+		rootCmd.SetErr(stderr)
+
+		// when
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(stdout.String()).To(matchers.MatchGoldenEqual(filepath.Join("testdata", "apply-many-dataplane-template.golden.yaml")))
+		Expect(stderr.String()).To(Equal("Warning: --dry-run=true is deprecated, use --dry-run=client\n"))
+	})
+
+	DescribeTable("should reject a value of --dry-run passed without \"=\"",
+		func(value string) {
+			// given
+			rootCmd.SetArgs([]string{
+				"apply", "-f", filepath.Join("testdata", "apply-dataplane-template.yaml"),
+				"-v", "address=2.2.2.2", "--dry-run", value,
+			})
+			rootCmd.SetOut(&bytes.Buffer{})
+			rootCmd.SetErr(&bytes.Buffer{})
+
+			// when
+			err := rootCmd.Execute()
+
+			// then
+			Expect(err).To(MatchError(fmt.Sprintf(`value of --dry-run has to be passed with "=", use --dry-run=%s`, value)))
+			resource := mesh.NewDataplaneResource()
+			err = store.Get(context.Background(), resource, core_store.GetByKey("sample", "default"))
+			Expect(core_store.IsNotFound(err)).To(BeTrue())
+		},
+		Entry("true", "true"),
+		Entry("false", "false"),
+		Entry("server", "server"),
+	)
+
+	It("should accept deprecated --dry-run=false as no dry run", func() {
+		// given
+		rootCmd.SetArgs([]string{
+			"apply", "-f", filepath.Join("testdata", "apply-dataplane-template.yaml"),
+			"-v", "address=2.2.2.2", "--dry-run=false",
+		})
+		stderr := &bytes.Buffer{}
+		rootCmd.SetOut(&bytes.Buffer{})
+		rootCmd.SetErr(stderr)
+
+		// when
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(stderr.String()).To(Equal("Warning: --dry-run=false is deprecated, use --dry-run=none\n"))
+		resource := mesh.NewDataplaneResource()
+		Expect(store.Get(context.Background(), resource, core_store.GetByKey("sample", "default"))).To(Succeed())
+		Expect(resource.Spec.Networking.Address).To(Equal("2.2.2.2"))
+	})
+
 	type testCase struct {
 		resource string
 		err      string
@@ -525,4 +594,317 @@ spec:
 		},
 		test.EntriesForFolder("golden"),
 	)
+
+	Describe("server dry run", func() {
+		var client *testPolicyInspectClient
+		BeforeEach(func() {
+			client = &testPolicyInspectClient{
+				simulation: &api_server_types.PolicySimulation{
+					Created: true,
+					Dataplanes: []api_server_types.PolicySimulationDataplane{
+						{
+							Dataplane: api_server_types.ResourceKeyEntry{Mesh: "default", Name: "dp-1"},
+							Match:     api_server_types.PolicySimulationMatchAdded,
+							RulesDiff: []api_common.JsonPatchItem{
+								{Op: api_common.Add, Path: "/proxyRule", Value: map[string]any{"conf": map[string]any{}}},
+							},
+						},
+					},
+				},
+			}
+			rootCtx.Runtime.NewPolicyInspectClient = func(util_http.Client) resources.PolicyInspectClient {
+				return client
+			}
+		})
+
+		const policy = `
+type: MeshTimeout
+mesh: default
+name: timeout
+spec:
+  targetRef:
+    kind: Mesh
+  rules:
+    - default:
+        idleTimeout: 10s
+`
+
+		It("should print the impact of a policy without applying it", func() {
+			// given
+			rootCmd.SetArgs([]string{"apply", "-f", "-", "--dry-run=server", "--show-impact"})
+			rootCmd.SetIn(strings.NewReader(policy))
+			buf := &bytes.Buffer{}
+			rootCmd.SetOut(buf)
+
+			// when
+			err := rootCmd.Execute()
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(client.simulated).To(HaveLen(1))
+			Expect(client.simulated[0].GetMeta().GetName()).To(Equal("timeout"))
+			Expect(buf.String()).To(matchers.MatchGoldenEqual(filepath.Join("testdata", "apply-dry-run-server.golden.txt")))
+			// and
+			list := &mesh.DataplaneResourceList{}
+			Expect(store.List(context.Background(), list)).To(Succeed())
+			Expect(list.Items).To(BeEmpty())
+		})
+
+		It("should reject resources that are not targetRef policies", func() {
+			// given
+			rootCmd.SetArgs([]string{"apply", "-f", "-", "--dry-run=server"})
+			rootCmd.SetIn(strings.NewReader(`
+type: Mesh
+name: default
+`))
+
+			// when
+			err := rootCmd.Execute()
+
+			// then
+			Expect(err).To(MatchError(ContainSubstring("server side dry run is only supported for targetRef policies")))
+			Expect(client.simulated).To(BeEmpty())
+		})
+
+		It("should require server dry run to show impact", func() {
+			// given
+			rootCmd.SetArgs([]string{"apply", "-f", "-", "--show-impact"})
+			rootCmd.SetIn(strings.NewReader(policy))
+
+			// when
+			err := rootCmd.Execute()
+
+			// then
+			Expect(err).To(MatchError("--show-impact requires --dry-run=server"))
+		})
+	})
+
+	Describe("server side apply", func() {
+		var client *testApplyClient
+		BeforeEach(func() {
+			client = &testApplyClient{
+				response: api_server_types.ApplyResponse{
+					Operation:       api_server_types.ApplyOperationUpdated,
+					FieldManager:    "team-a",
+					ResourceVersion: "2",
+					Diff: []api_common.JsonPatchItem{
+						{Op: api_common.Add, Path: "/labels/team", Value: "a"},
+						{Op: api_common.Test, Path: "/spec/rules/0/default/idleTimeout", Value: "20s"},
+						{Op: api_common.Remove, Path: "/spec/rules/0/default/idleTimeout", Value: "20s"},
+						{Op: api_common.Add, Path: "/spec/rules/0/default/idleTimeout", Value: "10s"},
+					},
+				},
+			}
+			rootCtx.Runtime.NewApplyClient = func(util_http.Client) resources.ApplyClient {
+				return client
+			}
+		})
+
+		const policy = `
+type: MeshTimeout
+mesh: default
+name: timeout
+labels:
+  team: a
+spec:
+  targetRef:
+    kind: Mesh
+  rules:
+    - default:
+        idleTimeout: 10s
+`
+
+		It("should apply resources on the server and print the diff", func() {
+			// given
+			rootCmd.SetArgs([]string{"apply", "-f", "-", "--server-side", "--field-manager", "team-a", "--force-conflicts"})
+			rootCmd.SetIn(strings.NewReader(policy))
+			buf := &bytes.Buffer{}
+			rootCmd.SetOut(buf)
+
+			// when
+			err := rootCmd.Execute()
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(client.applied).To(HaveLen(1))
+			Expect(client.applied[0].GetMeta().GetName()).To(Equal("timeout"))
+			Expect(client.opts).To(Equal(resources.ApplyOptions{FieldManager: "team-a", Force: true}))
+			Expect(buf.String()).To(matchers.MatchGoldenEqual(filepath.Join("testdata", "apply-server-side.golden.txt")))
+			// and
+			list := &mesh.DataplaneResourceList{}
+			Expect(store.List(context.Background(), list)).To(Succeed())
+			Expect(list.Items).To(BeEmpty())
+		})
+
+		It("should apply resources as a server dry run", func() {
+			// given
+			client.response.DryRun = true
+			client.response.ResourceVersion = ""
+			rootCmd.SetArgs([]string{"apply", "-f", "-", "--server-side", "--dry-run=server"})
+			rootCmd.SetIn(strings.NewReader(policy))
+			buf := &bytes.Buffer{}
+			rootCmd.SetOut(buf)
+
+			// when
+			err := rootCmd.Execute()
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(client.opts).To(Equal(resources.ApplyOptions{FieldManager: "kumactl", DryRun: true}))
+			Expect(buf.String()).To(HavePrefix(`resource type="MeshTimeout" mesh="default" name="timeout" Updated (server dry run)` + "\n"))
+		})
+
+		It("should suggest forcing conflicts", func() {
+			// given
+			client.err = &types.Error{
+				Status: http.StatusConflict,
+				Title:  "Conflict",
+				Detail: `resource is managed by field manager "team-b", the change would modify: /spec/rules/0/default/idleTimeout`,
+				InvalidParameters: []types.InvalidParameter{
+					{Field: "/spec/rules/0/default/idleTimeout", Reason: `modified in a resource managed by field manager "team-b"`},
+				},
+			}
+			rootCmd.SetArgs([]string{"apply", "-f", "-", "--server-side"})
+			rootCmd.SetIn(strings.NewReader(policy))
+
+			// when
+			err := rootCmd.Execute()
+
+			// then
+			Expect(err).To(MatchError(`resource type="MeshTimeout" mesh="default" name="timeout": failed server side: ` +
+				`resource is managed by field manager "team-b", the change would modify: /spec/rules/0/default/idleTimeout. Use --force-conflicts to apply it anyway`))
+		})
+
+		It("should apply a resource of the given version", func() {
+			// given
+			client.err = &types.Error{
+				Status: http.StatusConflict,
+				Title:  "Conflict",
+				Detail: `resource conflict: type="MeshTimeout" name="timeout" mesh="default"`,
+			}
+			rootCmd.SetArgs([]string{"apply", "-f", "-", "--server-side", "--resource-version", "1"})
+			rootCmd.SetIn(strings.NewReader(policy))
+
+			// when
+			err := rootCmd.Execute()
+
+			// then
+			Expect(client.opts).To(Equal(resources.ApplyOptions{FieldManager: "kumactl", ResourceVersion: "1"}))
+			Expect(err).To(MatchError(`resource type="MeshTimeout" mesh="default" name="timeout": failed server side: the resource was changed since version "1"`))
+		})
+
+		It("should require server side apply and a single resource to set the resource version", func() {
+			// given
+			rootCmd.SetArgs([]string{"apply", "-f", "-", "--resource-version", "1"})
+			rootCmd.SetIn(strings.NewReader(policy))
+
+			// when
+			err := rootCmd.Execute()
+
+			// then
+			Expect(err).To(MatchError("--resource-version requires --server-side"))
+
+			// given
+			rootCmd.SetArgs([]string{"apply", "-f", "-", "--server-side", "--resource-version", "1"})
+			rootCmd.SetIn(strings.NewReader(policy + "---" + policy))
+
+			// when
+			err = rootCmd.Execute()
+
+			// then
+			Expect(err).To(MatchError("--resource-version requires a single resource"))
+			Expect(client.applied).To(BeEmpty())
+		})
+
+		It("should require server side apply to set the field manager", func() {
+			// given
+			rootCmd.SetArgs([]string{"apply", "-f", "-", "--field-manager", "team-a"})
+			rootCmd.SetIn(strings.NewReader(policy))
+
+			// when
+			err := rootCmd.Execute()
+
+			// then
+			Expect(err).To(MatchError("--field-manager requires --server-side"))
+			Expect(client.applied).To(BeEmpty())
+		})
+
+		It("should force conflicts of client side apply", func() {
+			// given
+			forceStore := &forceConflictsStore{ResourceStore: store}
+			rootCtx.Runtime.NewResourceStore = func(util_http.Client) core_store.ResourceStore {
+				return forceStore
+			}
+			rootCmd.SetArgs([]string{"apply", "-f", "-", "--force-conflicts"})
+			rootCmd.SetIn(strings.NewReader(policy))
+
+			// when
+			err := rootCmd.Execute()
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(forceStore.forced).To(Equal([]bool{true}))
+			Expect(client.applied).To(BeEmpty())
+		})
+
+		It("should suggest forcing conflicts of client side apply", func() {
+			// given
+			conflict := &types.Error{
+				Status: http.StatusConflict,
+				Title:  "Conflict",
+				Detail: `resource is managed by field manager "team-b", the change would modify: /spec/rules/0/default/idleTimeout`,
+				InvalidParameters: []types.InvalidParameter{
+					{Field: "/spec/rules/0/default/idleTimeout", Reason: `modified in a resource managed by field manager "team-b"`},
+				},
+			}
+			rootCtx.Runtime.NewResourceStore = func(util_http.Client) core_store.ResourceStore {
+				return &test_store.FailingStore{Err: conflict}
+			}
+			rootCmd.SetArgs([]string{"apply", "-f", "-"})
+			rootCmd.SetIn(strings.NewReader(policy))
+
+			// when
+			err := rootCmd.Execute()
+
+			// then
+			Expect(err).To(MatchError(`resource type="MeshTimeout" mesh="default" name="timeout": failed server side: ` +
+				`resource is managed by field manager "team-b", the change would modify: /spec/rules/0/default/idleTimeout. Use --force-conflicts to apply it anyway`))
+		})
+	})
 })
+
+type testPolicyInspectClient struct {
+	resources.PolicyInspectClient
+	simulation *api_server_types.PolicySimulation
+	simulated  []core_model.Resource
+}
+
+func (t *testPolicyInspectClient) Simulate(_ context.Context, policy core_model.Resource) (*api_server_types.PolicySimulation, error) {
+	t.simulated = append(t.simulated, policy)
+	return t.simulation, nil
+}
+
+type testApplyClient struct {
+	response api_server_types.ApplyResponse
+	err      error
+	applied  []core_model.Resource
+	opts     resources.ApplyOptions
+}
+
+func (t *testApplyClient) Apply(_ context.Context, res core_model.Resource, opts resources.ApplyOptions) (api_server_types.ApplyResponse, error) {
+	t.applied = append(t.applied, res)
+	t.opts = opts
+	return t.response, t.err
+}
+
+// forceConflictsStore records if conflicts with field managers were forced by client side apply.
+type forceConflictsStore struct {
+	core_store.ResourceStore
+	forced []bool
+}
+
+func (f *forceConflictsStore) Create(ctx context.Context, res core_model.Resource, fs ...core_store.CreateOptionsFunc) error {
+	force, _ := ctx.Value(remote.ForceConflicts).(bool)
+	f.forced = append(f.forced, force)
+	return f.ResourceStore.Create(ctx, res, fs...)
+}
@@ -9,11 +9,22 @@ import (
 	"github.com/spf13/cobra"
 
 	kumactl_cmd "github.com/kumahq/kuma/v3/app/kumactl/pkg/cmd"
+	kumactl_resources "github.com/kumahq/kuma/v3/app/kumactl/pkg/resources"
 	"github.com/kumahq/kuma/v3/pkg/core/resources/model"
 	"github.com/kumahq/kuma/v3/pkg/core/resources/store"
 )
 
+type deleteContext struct {
+	args struct {
+		labelSelector string
+		fieldSelector string
+		all           bool
+		dryRun        bool
+	}
+}
+
 func NewDeleteCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
+	ctx := &deleteContext{}
 	byName := map[string]model.ResourceTypeDescriptor{}
 	allNames := []string{}
 	for _, desc := range pctx.Runtime.Registry.ObjectDescriptors(model.HasKumactlEnabled()) {
@@ -26,15 +37,36 @@ func NewDeleteCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
 	}
 	sort.Strings(allNames)
 	cmd := &cobra.Command{
-		Use:   "delete TYPE NAME",
+		Use:   "delete TYPE [NAME | -l SELECTOR | --field-selector SELECTOR | --all]",
 		Short: "Delete Kuma resources",
-		Long:  `Delete Kuma resources.`,
-		Args:  cobra.ExactArgs(2),
+		Long: `Delete Kuma resources.
+
+Resources can be deleted by name or all at once by selectors. When deleting by selectors,
+the control plane deletes either all matching resources or none of them.`,
+		Example: `# Delete a Dataplane by name
+kumactl delete dataplane web
+
+# Delete all Dataplanes of the zone east
+kumactl delete dataplane -l kuma.io/zone=east
+
+# List all MeshTimeouts which would be deleted without deleting them
+kumactl delete meshtimeout --all --dry-run`,
+		Args: cobra.RangeArgs(1, 2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			_ = kumactl_cmd.CheckCompatibility(pctx.FetchServerVersion, cmd.ErrOrStderr())
 
 			resourceTypeArg := args[0]
-			name := args[1]
+			var name string
+			if len(args) > 1 {
+				name = args[1]
+			}
+			bySelector := ctx.args.labelSelector != "" || ctx.args.fieldSelector != ""
+			switch {
+			case name != "" && (bySelector || ctx.args.all):
+				return errors.New("NAME can't be used together with selectors or --all")
+			case name == "" && !bySelector && !ctx.args.all:
+				return errors.New("either NAME, selectors or --all is required")
+			}
 
 			desc, ok := byName[resourceTypeArg]
 			if !ok {
@@ -44,28 +76,85 @@ func NewDeleteCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
 				return errors.Errorf("TYPE: %s is readOnly, can't use it for write action", resourceTypeArg)
 			}
 
-			rs, err := pctx.CurrentResourceStore()
-			if err != nil {
-				return err
-			}
-
 			mesh := model.NoMesh
 			if desc.Scope == model.ScopeMesh {
 				mesh = pctx.CurrentMesh()
 			}
 
-			if err := deleteResource(name, mesh, desc, rs); err != nil {
+			if name == "" {
+				client, err := pctx.CurrentDeleteCollectionClient()
+				if err != nil {
+					return err
+				}
+				return ctx.deleteResources(cmd, client, mesh, desc)
+			}
+
+			rs, err := pctx.CurrentResourceStore()
+			if err != nil {
+				return err
+			}
+			if ctx.args.dryRun {
+				if err := getResource(name, mesh, desc, rs); err != nil {
+					return err
+				}
+			} else if err := deleteResource(name, mesh, desc, rs); err != nil {
 				return err
 			}
 
-			cmd.Printf("deleted %s %q\n", desc.Name, name)
+			cmd.Printf("deleted %s %q%s\n", desc.Name, name, ctx.dryRunSuffix())
 			return nil
 		},
 	}
 	cmd.PersistentFlags().StringVarP(&pctx.Args.Mesh, "mesh", "m", "default", "mesh to use")
+	cmd.Flags().StringVarP(&ctx.args.labelSelector, "selector", "l", "", "delete resources matching the label selector, supports '=', '==', '!=', 'in', 'notin' and existence of a label (e.g. -l kuma.io/zone=east)")
+	cmd.Flags().StringVarP(&ctx.args.fieldSelector, "field-selector", "", "", "delete resources matching the field selector, supports '=', '==' and '!=' on 'name', 'mesh' and paths in the spec")
+	cmd.Flags().BoolVar(&ctx.args.all, "all", false, "delete all resources of the type in the mesh")
+	cmd.Flags().BoolVar(&ctx.args.dryRun, "dry-run", false, "only print resources which would be deleted")
 	return cmd
 }
 
+func (c *deleteContext) deleteResources(
+	cmd *cobra.Command,
+	client kumactl_resources.DeleteCollectionClient,
+	mesh string,
+	desc model.ResourceTypeDescriptor,
+) error {
+	resp, err := client.DeleteCollection(cmd.Context(), desc, mesh, kumactl_resources.DeleteCollectionRequest{
+		LabelSelector: c.args.labelSelector,
+		FieldSelector: c.args.fieldSelector,
+		All:           c.args.all,
+		DryRun:        c.args.dryRun,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to delete %s", desc.Name)
+	}
+	if len(resp.Items) == 0 {
+		cmd.Printf("no %s found\n", desc.Name)
+		return nil
+	}
+	for _, item := range resp.Items {
+		cmd.Printf("deleted %s %q%s\n", item.Type, item.Name, c.dryRunSuffix())
+	}
+	return nil
+}
+
+func (c *deleteContext) dryRunSuffix() string {
+	if c.args.dryRun {
+		return " (dry run)"
+	}
+	return ""
+}
+
+func getResource(name string, mesh string, desc model.ResourceTypeDescriptor, rs store.ResourceStore) error {
+	if err := rs.Get(context.Background(), desc.NewObject(), store.GetBy(model.ResourceKey{Mesh: mesh, Name: name})); err != nil {
+		if store.IsNotFound(err) {
+			return errors.Errorf("there is no %s with name %q", desc.Name, name)
+		}
+		return errors.Wrapf(err, "failed to get %s with the name %q", desc.Name, name)
+	}
+	return nil
+}
+
 func deleteResource(name string, mesh string, desc model.ResourceTypeDescriptor, rs store.ResourceStore) error {
 	resource := desc.NewObject()
 	deleteOptions := store.DeleteBy(model.ResourceKey{Mesh: mesh, Name: name})
@@ -87,9 +87,9 @@ var _ = Describe("kumactl delete mesh", func() {
 			// then
 			Expect(err).To(HaveOccurred())
 			// and
-			Expect(err.Error()).To(Equal("accepts 2 arg(s), received 1"))
+			Expect(err.Error()).To(Equal("either NAME, selectors or --all is required"))
 			// and
-			Expect(outbuf.String()).To(MatchRegexp(`Error: accepts 2 arg\(s\), received 1`))
+			Expect(outbuf.String()).To(ContainSubstring("Error: either NAME, selectors or --all is required"))
 		})
 
 		It("should throw an error in case of a non existing mesh", func() {
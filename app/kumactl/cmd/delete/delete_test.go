@@ -13,6 +13,7 @@ import (
 	kumactl_cmd "github.com/kumahq/kuma/v3/app/kumactl/pkg/cmd"
 	"github.com/kumahq/kuma/v3/app/kumactl/pkg/resources"
 	"github.com/kumahq/kuma/v3/app/kumactl/pkg/test"
+	api_server_types "github.com/kumahq/kuma/v3/pkg/api-server/types"
 	core_mesh "github.com/kumahq/kuma/v3/pkg/core/resources/apis/mesh"
 	"github.com/kumahq/kuma/v3/pkg/core/resources/apis/system"
 	core_model "github.com/kumahq/kuma/v3/pkg/core/resources/model"
@@ -21,6 +22,25 @@ import (
 	util_http "github.com/kumahq/kuma/v3/pkg/util/http"
 )
 
+type fakeDeleteCollectionClient struct {
+	response api_server_types.DeleteCollectionResponse
+	desc     core_model.ResourceTypeDescriptor
+	mesh     string
+	request  resources.DeleteCollectionRequest
+}
+
+func (c *fakeDeleteCollectionClient) DeleteCollection(
+	_ context.Context,
+	desc core_model.ResourceTypeDescriptor,
+	mesh string,
+	request resources.DeleteCollectionRequest,
+) (api_server_types.DeleteCollectionResponse, error) {
+	c.desc = desc
+	c.mesh = mesh
+	c.request = request
+	return c.response, nil
+}
+
 var _ = Describe("kumactl delete ", func() {
 	Describe("Delete Command", func() {
 		var rootCtx *kumactl_cmd.RootContext
@@ -64,9 +84,9 @@ var _ = Describe("kumactl delete ", func() {
 			// then
 			Expect(err).To(HaveOccurred())
 			// and
-			Expect(err.Error()).To(Equal("accepts 2 arg(s), received 0"))
+			Expect(err.Error()).To(Equal("accepts between 1 and 2 arg(s), received 0"))
 			// and
-			Expect(outbuf.String()).To(MatchRegexp(`Error: accepts 2 arg\(s\), received 0`))
+			Expect(outbuf.String()).To(MatchRegexp(`Error: accepts between 1 and 2 arg\(s\), received 0`))
 		})
 
 		It("should throw an error in case of unsupported resource type", func() {
@@ -238,5 +258,119 @@ var _ = Describe("kumactl delete ", func() {
 				}),
 			)
 		})
+
+		It("should not delete resource by name with --dry-run", func() {
+			// given
+			key := core_model.ResourceKey{Mesh: "demo", Name: "web"}
+			Expect(store.Create(context.Background(), core_mesh.NewDataplaneResource(), core_store.CreateBy(key))).To(Succeed())
+			rootCmd.SetArgs([]string{
+				"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+				"delete", "dataplane", "web", "--mesh", "demo", "--dry-run",
+			})
+
+			// when
+			err := rootCmd.Execute()
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(outbuf.String()).To(Equal("deleted Dataplane \"web\" (dry run)\n"))
+			Expect(store.Get(context.Background(), core_mesh.NewDataplaneResource(), core_store.GetBy(key))).To(Succeed())
+		})
+
+		Describe("kumactl delete TYPE with selectors", func() {
+			var deleteClient *fakeDeleteCollectionClient
+
+			BeforeEach(func() {
+				deleteClient = &fakeDeleteCollectionClient{}
+				rootCtx.Runtime.NewDeleteCollectionClient = func(util_http.Client) resources.DeleteCollectionClient {
+					return deleteClient
+				}
+			})
+
+			It("should delete resources matching selectors", func() {
+				// given
+				deleteClient.response = api_server_types.DeleteCollectionResponse{
+					Total: 2,
+					Items: []api_server_types.DeletedResource{
+						{Type: "Dataplane", Mesh: "demo", Name: "web-1"},
+						{Type: "Dataplane", Mesh: "demo", Name: "web-2"},
+					},
+				}
+				rootCmd.SetArgs([]string{
+					"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+					"delete", "dataplane", "--mesh", "demo", "-l", "kuma.io/zone=east", "--field-selector", "spec.networking.address=127.0.0.1",
+				})
+
+				// when
+				err := rootCmd.Execute()
+
+				// then
+				Expect(err).ToNot(HaveOccurred())
+				Expect(outbuf.String()).To(Equal("deleted Dataplane \"web-1\"\ndeleted Dataplane \"web-2\"\n"))
+				Expect(deleteClient.desc.Name).To(Equal(core_mesh.DataplaneType))
+				Expect(deleteClient.mesh).To(Equal("demo"))
+				Expect(deleteClient.request).To(Equal(resources.DeleteCollectionRequest{
+					LabelSelector: "kuma.io/zone=east",
+					FieldSelector: "spec.networking.address=127.0.0.1",
+				}))
+			})
+
+			It("should list resources which would be deleted with --all --dry-run", func() {
+				// given
+				deleteClient.response = api_server_types.DeleteCollectionResponse{
+					DryRun: true,
+					Total:  1,
+					Items:  []api_server_types.DeletedResource{{Type: "Mesh", Name: "demo"}},
+				}
+				rootCmd.SetArgs([]string{
+					"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+					"delete", "mesh", "--all", "--dry-run",
+				})
+
+				// when
+				err := rootCmd.Execute()
+
+				// then
+				Expect(err).ToNot(HaveOccurred())
+				Expect(outbuf.String()).To(Equal("deleted Mesh \"demo\" (dry run)\n"))
+				Expect(deleteClient.mesh).To(Equal(core_model.NoMesh))
+				Expect(deleteClient.request).To(Equal(resources.DeleteCollectionRequest{All: true, DryRun: true}))
+			})
+
+			It("should print when no resources match", func() {
+				// given
+				rootCmd.SetArgs([]string{
+					"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+					"delete", "dataplane", "-l", "kuma.io/zone=west",
+				})
+
+				// when
+				err := rootCmd.Execute()
+
+				// then
+				Expect(err).ToNot(HaveOccurred())
+				Expect(outbuf.String()).To(Equal("no Dataplane found\n"))
+			})
+
+			DescribeTable("should fail on invalid combination of arguments",
+				func(args []string, expectedErr string) {
+					// given
+					rootCmd.SetArgs(append([]string{
+						"--config-file", filepath.Join("..", "testdata", "sample-kumactl.config.yaml"),
+						"delete",
+					}, args...))
+
+					// when
+					err := rootCmd.Execute()
+
+					// then
+					Expect(err).To(MatchError(expectedErr))
+					Expect(deleteClient.request).To(BeZero())
+				},
+				Entry("no name nor selectors", []string{"dataplane"}, "either NAME, selectors or --all is required"),
+				Entry("name and selector", []string{"dataplane", "web", "-l", "kuma.io/zone=east"}, "NAME can't be used together with selectors or --all"),
+				Entry("name and --all", []string{"dataplane", "web", "--all"}, "NAME can't be used together with selectors or --all"),
+			)
+		})
 	})
 })
@@ -0,0 +1,476 @@
+package diff
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/josephburnett/jd/v2"
+	"github.com/pkg/errors"
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/spf13/cobra"
+	"sigs.k8s.io/yaml"
+
+	mesh_proto "github.com/kumahq/kuma/v3/api/mesh/v1alpha1"
+	api_common "github.com/kumahq/kuma/v3/api/openapi/types/common"
+	kumactl_cmd "github.com/kumahq/kuma/v3/app/kumactl/pkg/cmd"
+	kumactl_errors "github.com/kumahq/kuma/v3/app/kumactl/pkg/errors"
+	"github.com/kumahq/kuma/v3/app/kumactl/pkg/manifests"
+	json_output "github.com/kumahq/kuma/v3/app/kumactl/pkg/output/json"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/apis/mesh"
+	resource_labels "github.com/kumahq/kuma/v3/pkg/core/resources/labels"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/model"
+	rest_types "github.com/kumahq/kuma/v3/pkg/core/resources/model/rest"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/store"
+	"github.com/kumahq/kuma/v3/pkg/plugins/runtime/k8s/metadata"
+	"github.com/kumahq/kuma/v3/pkg/util/template"
+	util_yaml "github.com/kumahq/kuma/v3/pkg/util/yaml"
+)
+
+const (
+	outputUnified = "unified"
+	outputJSON    = "json"
+)
+
+// exit codes of the command are the same as of kubectl diff
+const (
+	exitCodeDiffer = 1
+	exitCodeError  = 2
+)
+
+type Operation string
+
+const (
+	OperationAdded     Operation = "Added"
+	OperationModified  Operation = "Modified"
+	OperationUnchanged Operation = "Unchanged"
+)
+
+// ResourceDiff is printed for every resource of manifests with the JSON output.
+type ResourceDiff struct {
+	Type      string    `json:"type"`
+	Mesh      string    `json:"mesh,omitempty"`
+	Name      string    `json:"name"`
+	Operation Operation `json:"operation"`
+	// Diff is a diff in JSONPatch format from the resource in the control plane to the resource in manifests.
+	Diff []api_common.JsonPatchItem `json:"diff,omitempty"`
+}
+
+// universalServerFields are set by the control plane on resources in the Universal format.
+var universalServerFields = []string{"creationTime", "modificationTime", "kri", "snis", "status"}
+
+// kubernetesServerMetadata are set by the control plane on metadata of resources in the Kubernetes format.
+var kubernetesServerMetadata = []string{"creationTimestamp", "resourceVersion", "uid", "generation", "managedFields", "ownerReferences"}
+
+type diffContext struct {
+	*kumactl_cmd.RootContext
+
+	args struct {
+		file   string
+		vars   map[string]string
+		output string
+	}
+}
+
+// manifest is a resource read from manifests with the resource stored in the control plane.
+type manifest struct {
+	desc  model.ResourceTypeDescriptor
+	mesh  string
+	name  string
+	local map[string]any
+	// live is nil when the resource doesn't exist in the control plane
+	live map[string]any
+}
+
+func NewDiffCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
+	ctx := &diffContext{RootContext: pctx}
+	cmd := &cobra.Command{
+		Use:   "diff",
+		Short: "Diff Kuma resources with the control plane",
+		Long: `Diff Kuma resources in files with resources in the control plane.
+
+Resources can be in the Universal or in the Kubernetes format. Fields set by the control plane,
+like the creation time, the status or labels computed by the control plane and KDS, are ignored
+unless they are set in files.
+
+Exits with status 1 when resources differ from the control plane and with status 2 when
+the diff fails.`,
+		Example: `
+Diff a resource from file
+$ kumactl diff -f resource.yaml
+
+Diff all resources from a directory
+$ kumactl diff -f resources/
+
+Print the diff as JSON patches
+$ kumactl diff -f resources/ -o json
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			differ, err := ctx.run(cmd)
+			if err != nil {
+				return &kumactl_errors.ExitError{Code: exitCodeError, Err: err}
+			}
+			if differ > 0 {
+				return &kumactl_errors.ExitError{Code: exitCodeDiffer, Err: errors.Errorf("%d resource(s) differ from the control plane", differ)}
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringVarP(&ctx.args.file, "file", "f", "", "Path to file or directory to diff. When a directory is provided, all .yaml, .yml, and .json files are compared. Pass `-` to read from stdin")
+	_ = cmd.MarkFlagRequired("file")
+	cmd.Flags().StringToStringVarP(&ctx.args.vars, "var", "v", map[string]string{}, "Variable to replace in configuration")
+	cmd.Flags().StringVarP(&ctx.args.output, "output", "o", outputUnified, `Output format. Must be "unified" or "json"`)
+	return cmd
+}
+
+// run diffs resources in manifests with the control plane and returns the number of resources which differ.
+func (c *diffContext) run(cmd *cobra.Command) (int, error) {
+	if c.args.output != outputUnified && c.args.output != outputJSON {
+		return 0, errors.Errorf("invalid --output value %q, must be one of %q or %q", c.args.output, outputUnified, outputJSON)
+	}
+
+	b, err := manifests.Read(c.args.file, cmd.InOrStdin())
+	if err != nil {
+		return 0, err
+	}
+	if len(b) == 0 {
+		return 0, fmt.Errorf("no resource(s) passed to diff")
+	}
+	var resources []*manifest
+	var hasErrors bool
+	for i, rawResource := range util_yaml.SplitYAML(string(b)) {
+		if rawResource == "" {
+			continue
+		}
+		bytes := []byte(rawResource)
+		if len(c.args.vars) > 0 {
+			bytes = template.Render(rawResource, c.args.vars)
+		}
+		res, pErr := c.parse(bytes)
+		if pErr != nil {
+			_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "resource[%d]: %v\n", i, pErr)
+			hasErrors = true
+			continue
+		}
+		resources = append(resources, res)
+	}
+	if hasErrors {
+		return 0, errors.New("failed to validate some resources")
+	}
+
+	if err := c.fetch(cmd, resources); err != nil {
+		return 0, err
+	}
+
+	var diffs []ResourceDiff
+	var differ int
+	for _, res := range resources {
+		normalize(res)
+		resDiff, err := c.diff(cmd, res)
+		if err != nil {
+			return 0, err
+		}
+		if resDiff.Operation != OperationUnchanged {
+			differ++
+		}
+		diffs = append(diffs, resDiff)
+	}
+	if c.args.output == outputJSON {
+		if err := json_output.NewPrinter().Print(diffs, cmd.OutOrStdout()); err != nil {
+			return 0, err
+		}
+	}
+	return differ, nil
+}
+
+// parse reads a resource in the Kubernetes format when it has an apiVersion and a kind, otherwise in the Universal format.
+func (c *diffContext) parse(b []byte) (*manifest, error) {
+	jsonBytes, err := yaml.YAMLToJSON(b)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse resource")
+	}
+	obj := map[string]any{}
+	if err := json.Unmarshal(jsonBytes, &obj); err != nil {
+		return nil, errors.Wrap(err, "failed to parse resource")
+	}
+	if _, ok := obj["apiVersion"]; !ok {
+		return parseUniversal(b)
+	}
+
+	kind, _ := obj["kind"].(string)
+	desc, err := c.Runtime.Registry.DescriptorFor(model.ResourceType(kind))
+	if err != nil {
+		return nil, errors.Errorf("unknown kind %q", kind)
+	}
+	meta, _ := obj["metadata"].(map[string]any)
+	name, _ := meta["name"].(string)
+	if name == "" {
+		return nil, errors.New("metadata.name is required")
+	}
+	// names of namespaced resources are suffixed with the namespace on Kubernetes
+	if namespace, _ := meta["namespace"].(string); namespace != "" {
+		name = name + "." + namespace
+	}
+	var meshName string
+	if desc.Scope == model.ScopeMesh {
+		meshName = model.DefaultMesh
+		if labels, ok := meta["labels"].(map[string]any); ok {
+			if m, ok := labels[metadata.KumaMeshLabel].(string); ok {
+				meshName = m
+			}
+		}
+		// old resources have the mesh in the top level field
+		if m, ok := obj["mesh"].(string); ok {
+			meshName = m
+		}
+	}
+	return &manifest{desc: desc, mesh: meshName, name: name, local: obj}, nil
+}
+
+func parseUniversal(b []byte) (*manifest, error) {
+	res, err := rest_types.YAML.UnmarshalCore(b)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse resource")
+	}
+	if vErr := mesh.ValidateMeta(res.GetMeta(), res.Descriptor().Scope); vErr.HasViolations() {
+		return nil, errors.Wrap(vErr.OrNil(), "failed to read meta")
+	}
+	local, err := toMap(rest_types.From.Resource(res))
+	if err != nil {
+		return nil, err
+	}
+	return &manifest{desc: res.Descriptor(), mesh: res.GetMeta().GetMesh(), name: res.GetMeta().GetName(), local: local}, nil
+}
+
+// fetch gets resources from the control plane in the same format as they are in manifests.
+func (c *diffContext) fetch(cmd *cobra.Command, resources []*manifest) error {
+	for _, res := range resources {
+		var err error
+		if isKubernetes(res.local) {
+			res.live, err = c.fetchKubernetes(cmd, res)
+		} else {
+			res.live, err = c.fetchUniversal(cmd, res)
+		}
+		if err != nil {
+			if store.IsNotFound(err) {
+				continue
+			}
+			return errors.Wrapf(err, "failed to get %s %q", res.desc.Name, res.name)
+		}
+	}
+	return nil
+}
+
+func (c *diffContext) fetchUniversal(cmd *cobra.Command, res *manifest) (map[string]any, error) {
+	rs, err := c.CurrentResourceStore()
+	if err != nil {
+		return nil, err
+	}
+	live := res.desc.NewObject()
+	if err := rs.Get(cmd.Context(), live, store.GetByKey(res.name, res.mesh)); err != nil {
+		return nil, err
+	}
+	return toMap(rest_types.From.Resource(live))
+}
+
+func (c *diffContext) fetchKubernetes(cmd *cobra.Command, res *manifest) (map[string]any, error) {
+	client, err := c.CurrentKubernetesResourcesClient()
+	if err != nil {
+		return nil, err
+	}
+	return client.Get(cmd.Context(), res.desc, res.name, res.mesh)
+}
+
+func (c *diffContext) diff(cmd *cobra.Command, res *manifest) (ResourceDiff, error) {
+	resDiff := ResourceDiff{
+		Type:      string(res.desc.Name),
+		Mesh:      res.mesh,
+		Name:      res.name,
+		Operation: OperationModified,
+	}
+	var live any = map[string]any{}
+	if res.live == nil {
+		resDiff.Operation = OperationAdded
+	} else {
+		live = res.live
+	}
+	patch, err := jsonPatch(live, res.local)
+	if err != nil {
+		return resDiff, err
+	}
+	if len(patch) == 0 {
+		resDiff.Operation = OperationUnchanged
+		return resDiff, nil
+	}
+	resDiff.Diff = patch
+	if c.args.output != outputUnified {
+		return resDiff, nil
+	}
+
+	path := resDiff.Type + "/" + resDiff.Name
+	if resDiff.Mesh != "" {
+		path = resDiff.Type + "/" + resDiff.Mesh + "/" + resDiff.Name
+	}
+	fromFile := "live/" + path
+	var liveLines []string
+	if res.live == nil {
+		fromFile = "/dev/null"
+	} else {
+		liveLines, err = yamlLines(res.live)
+		if err != nil {
+			return resDiff, err
+		}
+	}
+	localLines, err := yamlLines(res.local)
+	if err != nil {
+		return resDiff, err
+	}
+	unified, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        liveLines,
+		B:        localLines,
+		FromFile: fromFile,
+		ToFile:   "local/" + path,
+		Context:  3,
+	})
+	if err != nil {
+		return resDiff, err
+	}
+	_, err = fmt.Fprint(cmd.OutOrStdout(), unified)
+	return resDiff, err
+}
+
+// normalize removes fields set by the control plane, so they are not reported as differences.
+// Labels computed by the control plane or synced by KDS are only compared when they are set in the manifest.
+func normalize(res *manifest) {
+	for _, obj := range []map[string]any{res.local, res.live} {
+		if obj == nil {
+			continue
+		}
+		delete(obj, "status")
+		if isKubernetes(obj) {
+			if meta, ok := obj["metadata"].(map[string]any); ok {
+				for _, field := range kubernetesServerMetadata {
+					delete(meta, field)
+				}
+			}
+		} else {
+			for _, field := range universalServerFields {
+				delete(obj, field)
+			}
+		}
+	}
+	if res.live == nil {
+		return
+	}
+	localLabels := labelsOf(res.local)
+	liveLabels := labelsOf(res.live)
+	for label := range liveLabels {
+		_, computed := resource_labels.AllComputedLabels[label]
+		if _, ok := localLabels[label]; !ok && (computed || label == mesh_proto.FieldManagerLabel) {
+			delete(liveLabels, label)
+		}
+	}
+	if len(liveLabels) == 0 {
+		deleteLabels(res.live)
+	}
+	if isKubernetes(res.live) {
+		normalizeKubernetesMetadata(res.local, res.live)
+	}
+}
+
+// normalizeKubernetesMetadata removes annotations and finalizers of the live object which are not set in the manifest,
+// like the last applied configuration of kubectl or finalizers added by controllers.
+func normalizeKubernetesMetadata(local, live map[string]any) {
+	localMeta, _ := local["metadata"].(map[string]any)
+	liveMeta, ok := live["metadata"].(map[string]any)
+	if !ok {
+		return
+	}
+	localAnnotations, _ := localMeta["annotations"].(map[string]any)
+	liveAnnotations, _ := liveMeta["annotations"].(map[string]any)
+	for annotation := range liveAnnotations {
+		if _, ok := localAnnotations[annotation]; !ok {
+			delete(liveAnnotations, annotation)
+		}
+	}
+	if len(liveAnnotations) == 0 {
+		delete(liveMeta, "annotations")
+	}
+	if _, ok := localMeta["finalizers"]; !ok {
+		delete(liveMeta, "finalizers")
+	}
+}
+
+func isKubernetes(obj map[string]any) bool {
+	_, ok := obj["apiVersion"]
+	return ok
+}
+
+func labelsOf(obj map[string]any) map[string]any {
+	if isKubernetes(obj) {
+		meta, _ := obj["metadata"].(map[string]any)
+		labels, _ := meta["labels"].(map[string]any)
+		return labels
+	}
+	labels, _ := obj["labels"].(map[string]any)
+	return labels
+}
+
+func deleteLabels(obj map[string]any) {
+	if isKubernetes(obj) {
+		if meta, ok := obj["metadata"].(map[string]any); ok {
+			delete(meta, "labels")
+		}
+		return
+	}
+	delete(obj, "labels")
+}
+
+func yamlLines(obj map[string]any) ([]string, error) {
+	b, err := yaml.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	// SplitLines terminates the last line, so the trailing newline would become an empty line
+	return difflib.SplitLines(strings.TrimSuffix(string(b), "\n")), nil
+}
+
+func toMap(obj any) (map[string]any, error) {
+	b, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	m := map[string]any{}
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func jsonPatch(before, after any) ([]api_common.JsonPatchItem, error) {
+	toNode := func(obj any) (jd.JsonNode, error) {
+		b, err := json.Marshal(obj)
+		if err != nil {
+			return nil, err
+		}
+		return jd.ReadJsonString(string(b))
+	}
+	beforeNode, err := toNode(before)
+	if err != nil {
+		return nil, err
+	}
+	afterNode, err := toNode(after)
+	if err != nil {
+		return nil, err
+	}
+	patch, err := beforeNode.Diff(afterNode).RenderPatch()
+	if err != nil {
+		return nil, err
+	}
+	var items []api_common.JsonPatchItem
+	if err := json.Unmarshal([]byte(patch), &items); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,11 @@
+package diff_test
+
+import (
+	"testing"
+
+	"github.com/kumahq/kuma/v3/pkg/test"
+)
+
+func TestDiffCmd(t *testing.T) {
+	test.RunSpecs(t, "Diff Cmd Suite")
+}
@@ -0,0 +1,180 @@
+package diff_test
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+
+	"github.com/kumahq/kuma/v3/app/kumactl/cmd"
+	kumactl_client "github.com/kumahq/kuma/v3/app/kumactl/pkg/client"
+	kumactl_cmd "github.com/kumahq/kuma/v3/app/kumactl/pkg/cmd"
+	kumactl_errors "github.com/kumahq/kuma/v3/app/kumactl/pkg/errors"
+	test_kumactl "github.com/kumahq/kuma/v3/app/kumactl/pkg/test"
+	core_model "github.com/kumahq/kuma/v3/pkg/core/resources/model"
+	rest_types "github.com/kumahq/kuma/v3/pkg/core/resources/model/rest"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/registry"
+	core_store "github.com/kumahq/kuma/v3/pkg/core/resources/store"
+	memory_resources "github.com/kumahq/kuma/v3/pkg/plugins/resources/memory"
+	"github.com/kumahq/kuma/v3/pkg/test/matchers"
+	util_http "github.com/kumahq/kuma/v3/pkg/util/http"
+)
+
+var _ = Describe("kumactl diff", func() {
+	var rootCtx *kumactl_cmd.RootContext
+	var rootCmd *cobra.Command
+	var store core_store.ResourceStore
+	var buf *bytes.Buffer
+
+	create := func(raw string, labels map[string]string) {
+		res, err := rest_types.YAML.UnmarshalCore([]byte(raw))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(store.Create(context.Background(), res,
+			core_store.CreateByKey(res.GetMeta().GetName(), res.GetMeta().GetMesh()),
+			core_store.CreateWithLabels(labels),
+		)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		rootCtx = test_kumactl.MakeMinimalRootContext()
+		rootCtx.Runtime.Registry = registry.Global()
+		store = core_store.NewPaginationStore(memory_resources.NewStore())
+		rootCtx.Runtime.NewResourceStore = func(util_http.Client) core_store.ResourceStore {
+			return store
+		}
+		rootCmd = cmd.NewRootCmd(rootCtx)
+		buf = &bytes.Buffer{}
+		rootCmd.SetOut(buf)
+
+		create(`
+type: Mesh
+name: default
+`, map[string]string{"kuma.io/origin": "zone", "kuma.io/display-name": "default"})
+		create(`
+type: MeshTimeout
+mesh: default
+name: timeout
+spec:
+  targetRef:
+    kind: Mesh
+  rules:
+    - default:
+        idleTimeout: 20s
+        connectionTimeout: 5s
+`, map[string]string{
+			"team":                  "a",
+			"kuma.io/mesh":          "default",
+			"kuma.io/origin":        "zone",
+			"kuma.io/display-name":  "timeout",
+			"kuma.io/field-manager": "team-a",
+		})
+	})
+
+	It("should print unified diff of resources", func() {
+		// given
+		rootCmd.SetArgs([]string{"diff", "-f", filepath.Join("testdata", "universal")})
+
+		// when
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).To(MatchError("2 resource(s) differ from the control plane"))
+		Expect(kumactl_errors.ExitCode(err)).To(Equal(1))
+		Expect(buf.String()).To(matchers.MatchGoldenEqual(filepath.Join("testdata", "diff-unified.golden.txt")))
+	})
+
+	It("should print JSON diff of resources", func() {
+		// given
+		rootCmd.SetArgs([]string{"diff", "-f", filepath.Join("testdata", "universal"), "-o", "json"})
+
+		// when
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).To(MatchError("2 resource(s) differ from the control plane"))
+		Expect(buf.String()).To(matchers.MatchGoldenJSON(filepath.Join("testdata", "diff-json.golden.json")))
+	})
+
+	It("should not fail when resources are the same", func() {
+		// given
+		rootCmd.SetArgs([]string{"diff", "-f", filepath.Join("testdata", "universal", "01-mesh.yaml")})
+
+		// when
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(BeEmpty())
+	})
+
+	It("should exit with a status other than for differences when the diff fails", func() {
+		// given
+		rootCmd.SetArgs([]string{"diff", "-f", filepath.Join("testdata", "universal"), "-o", "yaml"})
+
+		// when
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).To(MatchError(`invalid --output value "yaml", must be one of "unified" or "json"`))
+		Expect(kumactl_errors.ExitCode(err)).To(Equal(2))
+	})
+
+	It("should diff resources in the Kubernetes format", func() {
+		// given
+		client := &testKubernetesResourcesClient{
+			obj: map[string]any{
+				"apiVersion": "kuma.io/v1alpha1",
+				"kind":       "MeshTimeout",
+				"metadata": map[string]any{
+					"name":              "timeout",
+					"namespace":         "kuma-system",
+					"creationTimestamp": "2024-01-03T15:33:33Z",
+					"generation":        2,
+					"resourceVersion":   "1234",
+					"uid":               "d8a5e4c4-2b7a-4a0f-9d47-3c1f1f2b8a11",
+					"annotations": map[string]any{
+						"kubectl.kubernetes.io/last-applied-configuration": `{"apiVersion":"kuma.io/v1alpha1","kind":"MeshTimeout"}`,
+						"team.example.com/owner":                           "team-a",
+					},
+					"finalizers": []any{"kuma.io/finalizer"},
+					"labels": map[string]any{
+						"kuma.io/mesh":          "default",
+						"kuma.io/origin":        "zone",
+						"k8s.kuma.io/namespace": "kuma-system",
+					},
+				},
+				"spec": map[string]any{
+					"targetRef": map[string]any{"kind": "Mesh"},
+					"rules": []any{
+						map[string]any{"default": map[string]any{"idleTimeout": "20s"}},
+					},
+				},
+			},
+		}
+		rootCtx.Runtime.NewKubernetesResourcesClient = func(util_http.Client) kumactl_client.KubernetesResourcesClient {
+			return client
+		}
+		rootCmd.SetArgs([]string{"diff", "-f", filepath.Join("testdata", "kubernetes")})
+
+		// when
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).To(MatchError("1 resource(s) differ from the control plane"))
+		Expect(client.requested).To(Equal([]core_model.ResourceKey{{Mesh: "default", Name: "timeout.kuma-system"}}))
+		Expect(buf.String()).To(matchers.MatchGoldenEqual(filepath.Join("testdata", "diff-kubernetes.golden.txt")))
+	})
+})
+
+type testKubernetesResourcesClient struct {
+	obj       map[string]any
+	requested []core_model.ResourceKey
+}
+
+func (t *testKubernetesResourcesClient) Get(_ context.Context, _ core_model.ResourceTypeDescriptor, name, mesh string) (map[string]any, error) {
+	t.requested = append(t.requested, core_model.ResourceKey{Mesh: mesh, Name: name})
+	return t.obj, nil
+}
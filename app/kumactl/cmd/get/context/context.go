@@ -8,7 +8,10 @@ type GetContext struct {
 
 type ListContext struct {
 	Args struct {
-		Size   int
-		Offset string
+		Size          int
+		Offset        string
+		LabelSelector string
+		FieldSelector string
+		Watch         bool
 	}
 }
@@ -26,9 +26,10 @@ func NewGetCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
 	// flags
 	getCmd.PersistentFlags().StringVarP(&pctx.GetContext.Args.OutputFormat, "output", "o", string(output.TableFormat), kuma_cmd.UsageOptions("output format", output.TableFormat, output.YAMLFormat, output.JSONFormat))
 	for _, cmdInst := range pctx.Runtime.Registry.ObjectDescriptors(model.HasKumactlEnabled()) {
-		getCmd.AddCommand(WithPaginationArgs(NewGetResourcesCmd(pctx, cmdInst), &pctx.ListContext))
+		getCmd.AddCommand(WithSelectorArgs(WithPaginationArgs(NewGetResourcesCmd(pctx, cmdInst), &pctx.ListContext), &pctx.ListContext))
 		getCmd.AddCommand(NewGetResourceCmd(pctx, cmdInst))
 	}
+	getCmd.AddCommand(NewGetRevocationsCmd(pctx))
 	return getCmd
 }
 
@@ -37,3 +38,9 @@ func WithPaginationArgs(cmd *cobra.Command, ctx *get_context.ListContext) *cobra
 	cmd.PersistentFlags().StringVarP(&ctx.Args.Offset, "offset", "", "", "the offset that indicates starting element of the resources list to retrieve")
 	return cmd
 }
+
+func WithSelectorArgs(cmd *cobra.Command, ctx *get_context.ListContext) *cobra.Command {
+	cmd.PersistentFlags().StringVarP(&ctx.Args.LabelSelector, "selector", "l", "", "label selector to filter on, supports '=', '==', '!=', 'in', 'notin' and existence of a label (e.g. -l kuma.io/zone=east,kuma.io/origin!=global)")
+	cmd.PersistentFlags().StringVarP(&ctx.Args.FieldSelector, "field-selector", "", "", "field selector to filter on, supports '=', '==' and '!=' on 'name', 'mesh' and paths in the spec (e.g. --field-selector spec.networking.address=10.0.0.1)")
+	return cmd
+}
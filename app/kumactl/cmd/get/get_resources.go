@@ -1,7 +1,6 @@
 package get
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/pkg/errors"
@@ -10,6 +9,7 @@ import (
 	kumactl_cmd "github.com/kumahq/kuma/v3/app/kumactl/pkg/cmd"
 	"github.com/kumahq/kuma/v3/app/kumactl/pkg/output"
 	"github.com/kumahq/kuma/v3/app/kumactl/pkg/output/printers"
+	kumactl_resources "github.com/kumahq/kuma/v3/app/kumactl/pkg/resources"
 	"github.com/kumahq/kuma/v3/pkg/core/resources/model"
 	core_store "github.com/kumahq/kuma/v3/pkg/core/resources/store"
 )
@@ -33,14 +33,48 @@ func NewGetResourcesCmd(pctx *kumactl_cmd.RootContext, desc model.ResourceTypeDe
 			if resource.Descriptor().Scope == model.ScopeGlobal {
 				currentMesh = ""
 			}
-			if err := rs.List(context.Background(), resources, core_store.ListByMesh(currentMesh), core_store.ListByPage(pctx.ListContext.Args.Size, pctx.ListContext.Args.Offset)); err != nil {
+			args := pctx.ListContext.Args
+			format := output.Format(pctx.GetContext.Args.OutputFormat)
+			printer := ResolvePrinter(desc.Name, resource.Descriptor().Scope, pctx.Now())
+			if args.Watch && (args.Size != 0 || args.Offset != "") {
+				// only the first page would be printed, but changes of all resources would be watched
+				return errors.New("--watch cannot be used together with --size or --offset")
+			}
+
+			var stream kumactl_resources.EventStream
+			if args.Watch {
+				eventsClient, err := pctx.CurrentEventsClient()
+				if err != nil {
+					return err
+				}
+				// subscribe before listing resources, so changes made in the meantime are not missed
+				stream, err = eventsClient.Subscribe(cmd.Context(), desc.Name, currentMesh)
+				if err != nil {
+					return err
+				}
+				defer stream.Close()
+			}
+
+			if err := rs.List(cmd.Context(), resources,
+				core_store.ListByMesh(currentMesh),
+				core_store.ListByPage(args.Size, args.Offset),
+				core_store.ListByLabelSelector(args.LabelSelector),
+				core_store.ListByFieldSelector(args.FieldSelector),
+			); err != nil {
 				return errors.Wrap(err, "failed to list "+string(desc.Name))
 			}
 
-			format := output.Format(pctx.GetContext.Args.OutputFormat)
-			return printers.GenericPrint(format, resources, ResolvePrinter(desc.Name, resource.Descriptor().Scope, pctx.Now()), cmd.OutOrStdout())
+			if args.Watch {
+				watcher, err := newResourceWatcher(rs, desc, currentMesh, args.LabelSelector, args.FieldSelector, format, printer, cmd.OutOrStdout())
+				if err != nil {
+					return err
+				}
+				return watcher.Watch(cmd.Context(), stream, resources)
+			}
+			return printers.GenericPrint(format, resources, printer, cmd.OutOrStdout())
 		},
 	}
 	cmd.PersistentFlags().StringVarP(&pctx.Args.Mesh, "mesh", "m", "default", "mesh to use")
+	cmd.PersistentFlags().BoolVarP(&pctx.ListContext.Args.Watch, "watch", "w", false, "after listing the resources, watch for changes and print them")
 	return cmd
 }
@@ -0,0 +1,236 @@
+package get_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+
+	mesh_proto "github.com/kumahq/kuma/v3/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/v3/app/kumactl/cmd"
+	kumactl_resources "github.com/kumahq/kuma/v3/app/kumactl/pkg/resources"
+	test_kumactl "github.com/kumahq/kuma/v3/app/kumactl/pkg/test"
+	api_server_types "github.com/kumahq/kuma/v3/pkg/api-server/types"
+	core_mesh "github.com/kumahq/kuma/v3/pkg/core/resources/apis/mesh"
+	core_model "github.com/kumahq/kuma/v3/pkg/core/resources/model"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/registry"
+	core_store "github.com/kumahq/kuma/v3/pkg/core/resources/store"
+	memory_resources "github.com/kumahq/kuma/v3/pkg/plugins/resources/memory"
+	. "github.com/kumahq/kuma/v3/pkg/test/matchers"
+	util_http "github.com/kumahq/kuma/v3/pkg/util/http"
+)
+
+// recordingStore records options of every list request, selectors are evaluated by the API server
+// so they are ignored by the in-memory store
+type recordingStore struct {
+	core_store.ResourceStore
+	listOptions []*core_store.ListOptions
+}
+
+func (s *recordingStore) List(ctx context.Context, rs core_model.ResourceList, fs ...core_store.ListOptionsFunc) error {
+	s.listOptions = append(s.listOptions, core_store.NewListOptions(fs...))
+	return s.ResourceStore.List(ctx, rs, fs...)
+}
+
+// fakeEventStream runs every step before returning the event it produced, so the change is already in the store
+type fakeEventStream struct {
+	steps []func() (api_server_types.ResourceChangedEvent, error)
+}
+
+func (s *fakeEventStream) Recv() (api_server_types.ResourceChangedEvent, error) {
+	if len(s.steps) == 0 {
+		return api_server_types.ResourceChangedEvent{}, io.EOF
+	}
+	step := s.steps[0]
+	s.steps = s.steps[1:]
+	return step()
+}
+
+func (s *fakeEventStream) Close() error {
+	return nil
+}
+
+type fakeEventsClient struct {
+	stream       *fakeEventStream
+	resourceType core_model.ResourceType
+	mesh         string
+}
+
+func (c *fakeEventsClient) Subscribe(_ context.Context, resourceType core_model.ResourceType, mesh string) (kumactl_resources.EventStream, error) {
+	c.resourceType = resourceType
+	c.mesh = mesh
+	return c.stream, nil
+}
+
+var _ = Describe("kumactl get [resources] with selectors and --watch", func() {
+	var rootCmd *cobra.Command
+	var outbuf *bytes.Buffer
+	var store *recordingStore
+	var eventsClient *fakeEventsClient
+	rootTime, _ := time.Parse(time.RFC3339, "2008-04-01T16:05:36.995Z")
+
+	BeforeEach(func() {
+		store = &recordingStore{ResourceStore: core_store.NewPaginationStore(memory_resources.NewStore())}
+		eventsClient = &fakeEventsClient{stream: &fakeEventStream{}}
+		rootCtx, _ := test_kumactl.MakeRootContext(rootTime, store)
+		rootCtx.Runtime.Registry = registry.Global()
+		rootCtx.Runtime.NewEventsClient = func(util_http.Client) kumactl_resources.EventsClient {
+			return eventsClient
+		}
+		rootCmd = cmd.NewRootCmd(rootCtx)
+		outbuf = &bytes.Buffer{}
+		rootCmd.SetOut(outbuf)
+		rootCmd.SetErr(outbuf)
+
+		rootCmd.SetArgs([]string{"apply", "-f", filepath.Join("testdata", "list", "get-dataplanes.input.yaml")})
+		Expect(rootCmd.Execute()).To(Succeed())
+		outbuf.Reset()
+	})
+
+	It("should pass selectors to the control plane", func() {
+		// when
+		rootCmd.SetArgs([]string{"get", "dataplanes", "-l", "kuma.io/zone=east,kuma.io/origin!=global", "--field-selector", "spec.networking.address=127.0.0.1"})
+		Expect(rootCmd.Execute()).To(Succeed())
+
+		// then
+		Expect(store.listOptions).To(HaveLen(1))
+		Expect(store.listOptions[0].Mesh).To(Equal("default"))
+		Expect(store.listOptions[0].LabelSelector).To(Equal("kuma.io/zone=east,kuma.io/origin!=global"))
+		Expect(store.listOptions[0].FieldSelector).To(Equal("spec.networking.address=127.0.0.1"))
+	})
+
+	DescribeTable("should print changes of resources",
+		func(format string, goldenFile string) {
+			// given
+			ctx := context.Background()
+			eventsClient.stream.steps = []func() (api_server_types.ResourceChangedEvent, error){
+				func() (api_server_types.ResourceChangedEvent, error) {
+					dp := &core_mesh.DataplaneResource{
+						Spec: &mesh_proto.Dataplane{
+							Networking: &mesh_proto.Dataplane_Networking{
+								Address: "127.0.0.3",
+								Inbound: []*mesh_proto.Dataplane_Networking_Inbound{{
+									Port: 8080,
+								}},
+							},
+						},
+					}
+					Expect(store.Create(ctx, dp, core_store.CreateByKey("third", "default"), core_store.CreatedAt(rootTime))).To(Succeed())
+					return api_server_types.ResourceChangedEvent{Operation: "Create", Type: "Dataplane", Mesh: "default", Name: "third"}, nil
+				},
+				func() (api_server_types.ResourceChangedEvent, error) {
+					dp := core_mesh.NewDataplaneResource()
+					Expect(store.Get(ctx, dp, core_store.GetByKey("experiment", "default"))).To(Succeed())
+					dp.Spec.Networking.Address = "127.0.0.4"
+					Expect(store.Update(ctx, dp, core_store.ModifiedAt(rootTime))).To(Succeed())
+					return api_server_types.ResourceChangedEvent{Operation: "Update", Type: "Dataplane", Mesh: "default", Name: "experiment"}, nil
+				},
+				func() (api_server_types.ResourceChangedEvent, error) {
+					return api_server_types.ResourceChangedEvent{Operation: "Update", Type: "DataplaneInsight", Mesh: "default", Name: "experiment"}, nil
+				},
+				func() (api_server_types.ResourceChangedEvent, error) {
+					Expect(store.Delete(ctx, core_mesh.NewDataplaneResource(), core_store.DeleteByKey("example", "default"))).To(Succeed())
+					return api_server_types.ResourceChangedEvent{Operation: "Delete", Type: "Dataplane", Mesh: "default", Name: "example"}, nil
+				},
+			}
+
+			// when
+			rootCmd.SetArgs([]string{"get", "dataplanes", "--watch", "-o", format})
+			Expect(rootCmd.Execute()).To(Succeed())
+
+			// then
+			Expect(eventsClient.resourceType).To(Equal(core_mesh.DataplaneType))
+			Expect(eventsClient.mesh).To(Equal("default"))
+			Expect(outbuf.String()).To(MatchGoldenEqual("testdata", "watch", goldenFile))
+		},
+		Entry("table", "table", "get-dataplanes.watch.golden.txt"),
+		Entry("json", "json", "get-dataplanes.watch.golden.json"),
+		Entry("yaml", "yaml", "get-dataplanes.watch.golden.yaml"),
+	)
+
+	It("should report resources that stop matching the selectors as deleted", func() {
+		// given
+		ctx := context.Background()
+		eventsClient.stream.steps = []func() (api_server_types.ResourceChangedEvent, error){
+			func() (api_server_types.ResourceChangedEvent, error) {
+				dp := &core_mesh.DataplaneResource{
+					Spec: &mesh_proto.Dataplane{
+						Networking: &mesh_proto.Dataplane_Networking{
+							Address: "127.0.0.4",
+							Inbound: []*mesh_proto.Dataplane_Networking_Inbound{{
+								Port: 8080,
+							}},
+						},
+					},
+				}
+				Expect(store.Create(ctx, dp, core_store.CreateByKey("third", "default"), core_store.CreatedAt(rootTime))).To(Succeed())
+				return api_server_types.ResourceChangedEvent{Operation: "Create", Type: "Dataplane", Mesh: "default", Name: "third"}, nil
+			},
+			func() (api_server_types.ResourceChangedEvent, error) {
+				dp := core_mesh.NewDataplaneResource()
+				Expect(store.Get(ctx, dp, core_store.GetByKey("experiment", "default"))).To(Succeed())
+				dp.Spec.Networking.Address = "127.0.0.4"
+				Expect(store.Update(ctx, dp, core_store.ModifiedAt(rootTime))).To(Succeed())
+				return api_server_types.ResourceChangedEvent{Operation: "Update", Type: "Dataplane", Mesh: "default", Name: "experiment"}, nil
+			},
+		}
+
+		// when
+		rootCmd.SetArgs([]string{"get", "dataplanes", "--watch", "--field-selector", "spec.networking.address!=127.0.0.4"})
+		Expect(rootCmd.Execute()).To(Succeed())
+
+		// then changed resources are not listed again
+		Expect(store.listOptions).To(HaveLen(1))
+		Expect(outbuf.String()).To(MatchGoldenEqual("testdata", "watch", "get-dataplanes.watch-selector.golden.txt"))
+	})
+
+	It("should list resources again when resync is required", func() {
+		// given
+		ctx := context.Background()
+		eventsClient.stream.steps = []func() (api_server_types.ResourceChangedEvent, error){
+			func() (api_server_types.ResourceChangedEvent, error) {
+				// changes made while the stream was resumed on another instance of the control plane
+				dp := &core_mesh.DataplaneResource{
+					Spec: &mesh_proto.Dataplane{
+						Networking: &mesh_proto.Dataplane_Networking{
+							Address: "127.0.0.3",
+							Inbound: []*mesh_proto.Dataplane_Networking_Inbound{{
+								Port: 8080,
+							}},
+						},
+					},
+				}
+				Expect(store.Create(ctx, dp, core_store.CreateByKey("third", "default"), core_store.CreatedAt(rootTime))).To(Succeed())
+				existing := core_mesh.NewDataplaneResource()
+				Expect(store.Get(ctx, existing, core_store.GetByKey("experiment", "default"))).To(Succeed())
+				existing.Spec.Networking.Address = "127.0.0.4"
+				Expect(store.Update(ctx, existing, core_store.ModifiedAt(rootTime))).To(Succeed())
+				Expect(store.Delete(ctx, core_mesh.NewDataplaneResource(), core_store.DeleteByKey("example", "default"))).To(Succeed())
+				return api_server_types.ResourceChangedEvent{}, kumactl_resources.ErrResyncRequired
+			},
+		}
+
+		// when
+		rootCmd.SetArgs([]string{"get", "dataplanes", "--watch"})
+		Expect(rootCmd.Execute()).To(Succeed())
+
+		// then
+		Expect(store.listOptions).To(HaveLen(2))
+		Expect(outbuf.String()).To(MatchGoldenEqual("testdata", "watch", "get-dataplanes.watch-resync.golden.txt"))
+	})
+
+	It("should reject --watch with pagination", func() {
+		// when
+		rootCmd.SetArgs([]string{"get", "dataplanes", "--watch", "--size", "1"})
+		err := rootCmd.Execute()
+
+		// then
+		Expect(err).To(MatchError("--watch cannot be used together with --size or --offset"))
+		Expect(store.listOptions).To(BeEmpty())
+	})
+})
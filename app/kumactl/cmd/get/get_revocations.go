@@ -0,0 +1,73 @@
+package get
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	kumactl_cmd "github.com/kumahq/kuma/v3/app/kumactl/pkg/cmd"
+	"github.com/kumahq/kuma/v3/app/kumactl/pkg/output"
+	"github.com/kumahq/kuma/v3/app/kumactl/pkg/output/printers"
+	"github.com/kumahq/kuma/v3/app/kumactl/pkg/output/table"
+	"github.com/kumahq/kuma/v3/pkg/tokens/builtin"
+	"github.com/kumahq/kuma/v3/pkg/tokens/builtin/server/types"
+)
+
+func NewGetRevocationsCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
+	args := struct {
+		tokenType string
+		mesh      string
+	}{}
+	cmd := &cobra.Command{
+		Use:   "revocations",
+		Short: "Show revoked tokens",
+		Long:  `Show revoked dataplane, zone or user tokens.`,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			client, err := pctx.CurrentRevocationClient()
+			if err != nil {
+				return errors.Wrap(err, "failed to create a revocation client")
+			}
+			revocations, err := client.List(cmd.Context(), args.tokenType, args.mesh)
+			if err != nil {
+				return errors.Wrap(err, "failed to list revocations")
+			}
+			format := output.Format(pctx.GetContext.Args.OutputFormat)
+			return printers.GenericPrint(format, revocations, revocationsTable(pctx.Now()), cmd.OutOrStdout())
+		},
+	}
+	cmd.Flags().StringVar(&args.tokenType, "type", "", fmt.Sprintf("type of the token, one of: %s", strings.Join(builtin.TokenTypes, ", ")))
+	cmd.Flags().StringVarP(&args.mesh, "mesh", "m", "", "mesh of the dataplane tokens")
+	_ = cmd.MarkFlagRequired("type")
+	return cmd
+}
+
+func revocationsTable(now time.Time) printers.Table {
+	return printers.Table{
+		Headers: []string{"ID", "REASON", "REVOKED", "EXPIRES"},
+		RowForItem: func(i int, container any) ([]string, error) {
+			list := container.(types.TokenRevocationList)
+			if len(list.Items) <= i {
+				return nil, nil
+			}
+			revocation := list.Items[i]
+			revoked := "-"
+			if revocation.RevokedAt != nil {
+				revoked = table.TimeSince(*revocation.RevokedAt, now) + " ago"
+			}
+			expires := "never"
+			if revocation.ExpiresAt != nil {
+				expires = revocation.ExpiresAt.UTC().Format(time.RFC3339)
+			}
+			return []string{
+				revocation.ID,     // ID
+				revocation.Reason, // REASON
+				revoked,           // REVOKED
+				expires,           // EXPIRES
+			}, nil
+		},
+	}
+}
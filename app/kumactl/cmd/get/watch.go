@@ -0,0 +1,275 @@
+package get
+
+import (
+	"cmp"
+	"context"
+	"io"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+
+	"github.com/kumahq/kuma/v3/app/kumactl/pkg/output"
+	"github.com/kumahq/kuma/v3/app/kumactl/pkg/output/json"
+	"github.com/kumahq/kuma/v3/app/kumactl/pkg/output/printers"
+	"github.com/kumahq/kuma/v3/app/kumactl/pkg/output/yaml"
+	kumactl_resources "github.com/kumahq/kuma/v3/app/kumactl/pkg/resources"
+	"github.com/kumahq/kuma/v3/pkg/api-server/filters"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/model"
+	rest_types "github.com/kumahq/kuma/v3/pkg/core/resources/model/rest"
+	core_store "github.com/kumahq/kuma/v3/pkg/core/resources/store"
+	"github.com/kumahq/kuma/v3/pkg/core/validators"
+)
+
+// columnPadding is the same as the padding of tables printed with table.NewWriter
+const columnPadding = 3
+
+type WatchEventType string
+
+const (
+	WatchEventAdded    WatchEventType = "ADDED"
+	WatchEventModified WatchEventType = "MODIFIED"
+	WatchEventDeleted  WatchEventType = "DELETED"
+)
+
+// WatchEvent is printed for every change of a watched resource in JSON and YAML formats.
+// It follows the format of watch events of Kubernetes, so existing tooling can consume it.
+type WatchEvent struct {
+	Type   WatchEventType      `json:"type"`
+	Object rest_types.Resource `json:"object"`
+}
+
+// resourceWatcher prints resources matching the selectors and then every change of them.
+// Changed resources are fetched one by one and matched against the selectors the same way as the API server does,
+// so resources which stop matching them are reported as deleted, the same way as in kubectl.
+// When changes are lost, i.e. the stream was resumed on another instance of the control plane,
+// resources are listed again and the differences are printed.
+type resourceWatcher struct {
+	store    core_store.ResourceStore
+	desc     model.ResourceTypeDescriptor
+	listOpts []core_store.ListOptionsFunc
+	filter   core_store.ListFilterFunc
+	format   output.Format
+	table    printers.Table
+	printer  output.Printer
+	out      io.Writer
+	known    map[model.ResourceKey]model.Resource
+	widths   []int
+}
+
+func newResourceWatcher(
+	store core_store.ResourceStore,
+	desc model.ResourceTypeDescriptor,
+	mesh string,
+	labelSelector string,
+	fieldSelector string,
+	format output.Format,
+	table printers.Table,
+	out io.Writer,
+) (*resourceWatcher, error) {
+	labelFilter, err := filters.LabelSelectorFilter(validators.RootedAt("selector"), labelSelector)
+	if err != nil {
+		return nil, err
+	}
+	fieldFilter, err := filters.FieldSelectorFilter(validators.RootedAt("field-selector"), fieldSelector)
+	if err != nil {
+		return nil, err
+	}
+	w := &resourceWatcher{
+		store: store,
+		desc:  desc,
+		listOpts: []core_store.ListOptionsFunc{
+			core_store.ListByMesh(mesh),
+			core_store.ListByLabelSelector(labelSelector),
+			core_store.ListByFieldSelector(fieldSelector),
+		},
+		filter: filters.AllOf(labelFilter, fieldFilter),
+		format: format,
+		table:  table,
+		out:    out,
+		known:  map[model.ResourceKey]model.Resource{},
+	}
+	switch format {
+	case output.JSONFormat:
+		w.printer = json.NewPrinter()
+	case output.YAMLFormat:
+		w.printer = yaml.NewPrinter()
+	case output.TableFormat:
+		// the event column is as wide as the widest event, so it doesn't grow when resources are modified
+		w.widths = []int{len(WatchEventModified)}
+	default:
+		return nil, errors.Errorf("unknown output format %q", format)
+	}
+	return w, nil
+}
+
+func (w *resourceWatcher) Watch(ctx context.Context, stream kumactl_resources.EventStream, resources model.ResourceList) error {
+	var rows [][]string
+	if w.format == output.TableFormat {
+		rows = append(rows, append([]string{"EVENT"}, w.table.Headers...))
+	}
+	for _, item := range resources.GetItems() {
+		w.known[model.MetaToResourceKey(item.GetMeta())] = item
+		if w.format != output.TableFormat {
+			if err := w.print(WatchEventAdded, item); err != nil {
+				return err
+			}
+			continue
+		}
+		row, err := w.row(WatchEventAdded, item)
+		if err != nil {
+			return err
+		}
+		rows = append(rows, row)
+	}
+	if err := w.printRows(rows...); err != nil {
+		return err
+	}
+
+	for {
+		event, err := stream.Recv()
+		if errors.Is(err, kumactl_resources.ErrResyncRequired) {
+			if err := w.resync(ctx); err != nil {
+				return err
+			}
+			continue
+		}
+		if err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, context.Canceled) {
+				return nil
+			}
+			return err
+		}
+		if event.Type != string(w.desc.Name) {
+			continue
+		}
+		key := model.ResourceKey{Mesh: event.Mesh, Name: event.Name}
+		if err := w.handle(ctx, key, event.Operation == "Delete"); err != nil {
+			return err
+		}
+	}
+}
+
+func (w *resourceWatcher) handle(ctx context.Context, key model.ResourceKey, deleted bool) error {
+	previous, wasKnown := w.known[key]
+	var current model.Resource
+	if !deleted {
+		resource := w.desc.NewObject()
+		err := w.store.Get(ctx, resource, core_store.GetBy(key))
+		switch {
+		case core_store.IsNotFound(err):
+			// the resource was deleted before we fetched it, the delete event follows
+		case err != nil:
+			return errors.Wrapf(err, "failed to get %s %q", w.desc.Name, key.Name)
+		case w.filter == nil || w.filter(resource):
+			current = resource
+		}
+	}
+
+	switch {
+	case current != nil:
+		w.known[key] = current
+		if wasKnown {
+			return w.print(WatchEventModified, current)
+		}
+		return w.print(WatchEventAdded, current)
+	case wasKnown:
+		// the resource was deleted or doesn't match the selectors anymore
+		delete(w.known, key)
+		return w.print(WatchEventDeleted, previous)
+	default:
+		return nil
+	}
+}
+
+// resync lists resources again and prints the differences to the known resources
+func (w *resourceWatcher) resync(ctx context.Context) error {
+	list := w.desc.NewList()
+	if err := w.store.List(ctx, list, w.listOpts...); err != nil {
+		return errors.Wrap(err, "failed to list "+string(w.desc.Name))
+	}
+	current := map[model.ResourceKey]struct{}{}
+	for _, item := range list.GetItems() {
+		key := model.MetaToResourceKey(item.GetMeta())
+		current[key] = struct{}{}
+		previous, wasKnown := w.known[key]
+		w.known[key] = item
+		switch {
+		case !wasKnown:
+			if err := w.print(WatchEventAdded, item); err != nil {
+				return err
+			}
+		case previous.GetMeta().GetVersion() != item.GetMeta().GetVersion():
+			if err := w.print(WatchEventModified, item); err != nil {
+				return err
+			}
+		}
+	}
+	var deleted []model.ResourceKey
+	for key := range w.known {
+		if _, ok := current[key]; !ok {
+			deleted = append(deleted, key)
+		}
+	}
+	slices.SortFunc(deleted, func(a, b model.ResourceKey) int {
+		return cmp.Or(cmp.Compare(a.Mesh, b.Mesh), cmp.Compare(a.Name, b.Name))
+	})
+	for _, key := range deleted {
+		previous := w.known[key]
+		delete(w.known, key)
+		if err := w.print(WatchEventDeleted, previous); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (w *resourceWatcher) print(eventType WatchEventType, resource model.Resource) error {
+	if w.format != output.TableFormat {
+		return w.printer.Print(WatchEvent{
+			Type:   eventType,
+			Object: rest_types.From.Resource(resource),
+		}, w.out)
+	}
+	row, err := w.row(eventType, resource)
+	if err != nil {
+		return err
+	}
+	return w.printRows(row)
+}
+
+func (w *resourceWatcher) row(eventType WatchEventType, resource model.Resource) ([]string, error) {
+	row, err := w.table.RowForItem(0, resource)
+	if err != nil {
+		return nil, err
+	}
+	return append([]string{string(eventType)}, row...), nil
+}
+
+// printRows prints rows aligned to the widest cells printed so far. Rows are printed as soon as
+// the change is received, so they can't be aligned to the rows printed later like with a tabwriter.
+func (w *resourceWatcher) printRows(rows ...[]string) error {
+	for _, row := range rows {
+		for i, cell := range row {
+			if i == len(w.widths) {
+				w.widths = append(w.widths, 0)
+			}
+			w.widths[i] = max(w.widths[i], utf8.RuneCountInString(cell))
+		}
+	}
+	for _, row := range rows {
+		var line strings.Builder
+		for i, cell := range row {
+			line.WriteString(cell)
+			if i < len(row)-1 {
+				line.WriteString(strings.Repeat(" ", w.widths[i]-utf8.RuneCountInString(cell)+columnPadding))
+			}
+		}
+		line.WriteString("\n")
+		if _, err := io.WriteString(w.out, line.String()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"os"
 	"path"
 	"path/filepath"
@@ -42,6 +43,10 @@ func (t *testPolicyInspectClient) Inspect(ctx context.Context, policyDesc model.
 	return t.response, nil
 }
 
+func (t *testPolicyInspectClient) Simulate(context.Context, model.Resource) (*api_server_types.PolicySimulation, error) {
+	return nil, errors.New("not implemented")
+}
+
 var _ resources.PolicyInspectClient = &testPolicyInspectClient{}
 
 var _ = Describe("kumactl inspect POLICY", func() {
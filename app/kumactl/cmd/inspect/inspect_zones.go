@@ -2,6 +2,7 @@ package inspect
 
 import (
 	"encoding/json"
+	"fmt"
 	"time"
 
 	"github.com/pkg/errors"
@@ -39,7 +40,7 @@ func newInspectZonesCmd(pctx *cmd.RootContext) *cobra.Command {
 
 func zoneOverviewTable(now time.Time) printers.Table {
 	return printers.Table{
-		Headers: []string{"NAME", "STATUS", "LAST CONNECTED AGO", "LAST UPDATED AGO", "TOTAL UPDATES", "TOTAL ERRORS", "ZONE-CP VERSION", "BACKEND"},
+		Headers: []string{"NAME", "STATUS", "LAST CONNECTED AGO", "LAST UPDATED AGO", "TOTAL UPDATES", "TOTAL ERRORS", "ZONE-CP VERSION", "BACKEND", "RESOURCE SYNC", "SYNC LAG"},
 		RowForItem: func(i int, container any) ([]string, error) {
 			zoneOverviews := container.(*system.ZoneOverviewResourceList)
 			if len(zoneOverviews.Items) <= i {
@@ -85,6 +86,8 @@ func zoneOverviewTable(now time.Time) printers.Table {
 				}
 			}
 
+			resourceSync, syncLag := zoneResourceSync(zoneInsight.GetResourceSync())
+
 			return []string{
 				meta.GetName(),                       // NAME,
 				onlineStatus,                         // STATUS
@@ -94,7 +97,24 @@ func zoneOverviewTable(now time.Time) printers.Table {
 				table.Number(totalResponsesRejected), // TOTAL ERRORS
 				zoneCPVersion,                        // ZONE-CP VERSION
 				backend,                              // BACKEND
+				resourceSync,                         // RESOURCE SYNC
+				syncLag,                              // SYNC LAG
 			}, nil
 		},
 	}
 }
+
+// zoneResourceSync returns the state of resources synced from global to the zone and the longest lag among resource types.
+func zoneResourceSync(sync *system_proto.KDSResourceSync) (string, string) {
+	if sync == nil {
+		return "unknown", "-"
+	}
+	var lag time.Duration
+	for _, typeSync := range sync.GetTypes() {
+		lag = max(lag, typeSync.GetLag().AsDuration())
+	}
+	if sync.GetDivergedTypes() == 0 {
+		return "in sync", table.Duration(lag)
+	}
+	return fmt.Sprintf("%d diverged", sync.GetDivergedTypes()), table.Duration(lag)
+}
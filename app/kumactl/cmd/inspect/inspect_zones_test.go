@@ -10,6 +10,7 @@ import (
 	. "github.com/onsi/gomega"
 	gomega_types "github.com/onsi/gomega/types"
 	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/durationpb"
 
 	system_proto "github.com/kumahq/kuma/v3/api/system/v1alpha1"
 	"github.com/kumahq/kuma/v3/app/kumactl/cmd"
@@ -58,6 +59,22 @@ var _ = Describe("kumactl inspect zones", func() {
 				Spec: &system_proto.ZoneOverview{
 					Zone: &system_proto.Zone{Enabled: util_proto.Bool(true)},
 					ZoneInsight: &system_proto.ZoneInsight{
+						ResourceSync: &system_proto.KDSResourceSync{
+							DivergedTypes: 1,
+							Types: map[string]*system_proto.KDSResourceTypeSync{
+								"MeshTimeout": {
+									GlobalCount: 2,
+									ZoneCount:   1,
+									Lag:         durationpb.New(90 * time.Second),
+								},
+								"MeshTrafficPermission": {
+									GlobalCount: 1,
+									ZoneCount:   1,
+									InSync:      true,
+									Lag:         durationpb.New(0),
+								},
+							},
+						},
 						Subscriptions: []*system_proto.KDSSubscription{
 							{
 								Id:               "1",
@@ -183,6 +200,16 @@ var _ = Describe("kumactl inspect zones", func() {
 				Spec: &system_proto.ZoneOverview{
 					Zone: &system_proto.Zone{Enabled: util_proto.Bool(false)},
 					ZoneInsight: &system_proto.ZoneInsight{
+						ResourceSync: &system_proto.KDSResourceSync{
+							Types: map[string]*system_proto.KDSResourceTypeSync{
+								"MeshTimeout": {
+									GlobalCount: 2,
+									ZoneCount:   2,
+									InSync:      true,
+									Lag:         durationpb.New(0),
+								},
+							},
+						},
 						Subscriptions: []*system_proto.KDSSubscription{
 							{
 								Id:               "1",
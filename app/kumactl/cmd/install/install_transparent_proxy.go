@@ -170,6 +170,10 @@ runuser -u kuma-dp -- \
 				cfg.Redirect.DNS.Enabled = true
 			}
 
+			if cfg.StoreFirewalld && cfg.Backend == config.BackendNftables {
+				return errors.Errorf("storing rules with firewalld is supported only with the '%s' backend", config.BackendIptables)
+			}
+
 			// Bound the total setup time so that a hung iptables binary
 			// causes a clean failure (and pod restart) instead of a
 			// permanent hang that blocks the pod in init phase forever.
@@ -208,6 +212,7 @@ runuser -u kuma-dp -- \
 	cmd.Flags().BoolVar(&cfg.DryRun, "dry-run", cfg.DryRun, "dry run")
 	cmd.Flags().BoolVar(&cfg.Verbose, "verbose", cfg.Verbose, "verbose")
 	cmd.Flags().Var(&cfg.IPFamilyMode, "ip-family-mode", "The IP family mode to enable traffic redirection for. Can be 'dualstack' or 'ipv4'")
+	cmd.Flags().Var(&cfg.Backend, "backend", fmt.Sprintf("The backend used to configure traffic redirection. Can be %s. The 'nftables' backend applies a native nftables ruleset with nft instead of using iptables, which is needed on systems without iptables", config.AllowedBackends()))
 	cmd.Flags().Var(&cfg.Redirect.Outbound.Port, "redirect-outbound-port", `outbound port redirected to Envoy, as specified in dataplane's "networking.transparentProxying.redirectPortOutbound"`)
 	cmd.Flags().BoolVar(&cfg.Redirect.Inbound.Enabled, "redirect-inbound", cfg.Redirect.Inbound.Enabled, "redirect the inbound traffic to the Envoy. Should be disabled for Gateway data plane proxies.")
 	cmd.Flags().Var(&cfg.Redirect.Inbound.Port, "redirect-inbound-port", `inbound port redirected to Envoy, as specified in dataplane's "networking.transparentProxying.redirectPortInbound"`)
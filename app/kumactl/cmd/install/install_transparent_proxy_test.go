@@ -30,6 +30,7 @@ var _ = Context("kumactl install transparent proxy", func() {
 				strings.Split(stderr.String(), "\n"),
 				func(line string) bool {
 					return strings.Contains(line, config.WarningDryRunNoValidIptablesFound) ||
+						strings.Contains(line, config.WarningDryRunNoValidNftFound) ||
 						strings.Contains(line, install.WarningDryRunRunningAsNonRoot)
 				},
 			),
@@ -67,6 +68,9 @@ var _ = Context("kumactl install transparent proxy", func() {
 				out = regexp.MustCompile(`-m comment --comment ".*?" `).ReplaceAllString(out, "")
 				out = regexp.MustCompile(`(?m)^-I OUTPUT (\d+) -p udp --dport 53 -m owner --uid-owner (\d+) -j (\w+)$`).
 					ReplaceAllString(out, "-I OUTPUT $1 -p udp --dport 53 -m owner --uid-owner $2 -j dnsJumpTargetPlaceholder")
+				// nftables
+				out = regexp.MustCompile(`oifname "[^"]+"`).ReplaceAllString(out, `oifname "ifPlaceholder"`)
+				out = regexp.MustCompile(`(?m)^\t+# .*\n`).ReplaceAllString(out, "")
 				return out
 			}, matchers.MatchGoldenEqual("testdata", given.goldenFile)))
 		},
@@ -180,6 +184,16 @@ var _ = Context("kumactl install transparent proxy", func() {
 			},
 			goldenFile: "install-transparent-proxy.excludedports_simpler.txt",
 		}),
+		Entry("should generate nftables ruleset", testCase{
+			extraArgs: []string{
+				"--kuma-dp-user", "root",
+				"--backend", "nftables",
+				"--exclude-inbound-ports", "1000,1001",
+				"--exclude-outbound-ports-for-uids", "tcp:1900,1902,1000-2000:106-108",
+				"--vnet", "docker0:172.17.0.0/16",
+			},
+			goldenFile: "install-transparent-proxy.nftables.golden.txt",
+		}),
 	)
 
 	DescribeTable("should return error",
@@ -240,5 +254,20 @@ var _ = Context("kumactl install transparent proxy", func() {
 			},
 			errorMatcher: ContainSubstring("parsing excluded outbound ports for uids failed: invalid or unsupported protocol: 'http'\n"),
 		}),
+		Entry("should error out on unknown backend", testCase{
+			extraArgs: []string{
+				"--kuma-dp-user", "root",
+				"--backend", "bpf",
+			},
+			errorMatcher: ContainSubstring("must be one of 'iptables' or 'nftables'"),
+		}),
+		Entry("should error out when storing nftables rules with firewalld", testCase{
+			extraArgs: []string{
+				"--kuma-dp-user", "root",
+				"--backend", "nftables",
+				"--store-firewalld",
+			},
+			errorMatcher: ContainSubstring("storing rules with firewalld is supported only with the 'iptables' backend"),
+		}),
 	)
 })
@@ -0,0 +1,25 @@
+package revoke
+
+import (
+	"github.com/spf13/cobra"
+
+	kumactl_cmd "github.com/kumahq/kuma/v3/app/kumactl/pkg/cmd"
+)
+
+func NewRevokeCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
+	revokeCmd := &cobra.Command{
+		Use:   "revoke",
+		Short: "Revoke tokens",
+		Long:  `Revoke tokens.`,
+	}
+	revokeCmd.PersistentPreRunE = func(cmd *cobra.Command, args []string) error {
+		if err := kumactl_cmd.RunParentPreRunE(revokeCmd, args); err != nil {
+			return err
+		}
+		_ = kumactl_cmd.CheckCompatibility(pctx.FetchServerVersion, cmd.ErrOrStderr())
+		return nil
+	}
+	// sub-commands
+	revokeCmd.AddCommand(NewRevokeTokenCmd(pctx))
+	return revokeCmd
+}
@@ -0,0 +1,81 @@
+package revoke
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	kumactl_cmd "github.com/kumahq/kuma/v3/app/kumactl/pkg/cmd"
+	"github.com/kumahq/kuma/v3/pkg/tokens/builtin"
+	"github.com/kumahq/kuma/v3/pkg/tokens/builtin/server/types"
+)
+
+type revokeTokenArgs struct {
+	tokenType string
+	mesh      string
+	token     string
+	tokenFile string
+	id        string
+	reason    string
+}
+
+func NewRevokeTokenCmd(pctx *kumactl_cmd.RootContext) *cobra.Command {
+	args := &revokeTokenArgs{}
+	cmd := &cobra.Command{
+		Use:   "token",
+		Short: "Revoke a token",
+		Long: `Revoke a dataplane, zone or user token.
+The token is added to the revocation list and it's removed from the list automatically once it expires.`,
+		Example: `
+Revoke a user token
+$ kumactl revoke token --type user --token-file /tmp/token --reason "leaked in CI logs"
+
+Revoke a dataplane token by its ID
+$ kumactl revoke token --type dataplane --mesh default --id 1d0c2d6e-5b0e-4f34-9b3e-3a4e1b1a2c3d`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if args.token != "" && args.tokenFile != "" {
+				return errors.New("--token and --token-file cannot be used together")
+			}
+			token := args.token
+			if args.tokenFile != "" {
+				content, err := os.ReadFile(args.tokenFile)
+				if err != nil {
+					return errors.Wrap(err, "could not read the token file")
+				}
+				token = strings.TrimSpace(string(content))
+			}
+			if token == "" && args.id == "" {
+				return errors.New("one of --token, --token-file or --id has to be defined")
+			}
+
+			client, err := pctx.CurrentRevocationClient()
+			if err != nil {
+				return errors.Wrap(err, "failed to create a revocation client")
+			}
+			revocation, err := client.Revoke(cmd.Context(), types.TokenRevocationRequest{
+				Type:   args.tokenType,
+				Mesh:   args.mesh,
+				Token:  token,
+				ID:     args.id,
+				Reason: args.reason,
+			})
+			if err != nil {
+				return errors.Wrap(err, "failed to revoke a token")
+			}
+			_, err = fmt.Fprintf(cmd.OutOrStdout(), "token %q revoked\n", revocation.ID)
+			return err
+		},
+	}
+	cmd.Flags().StringVar(&args.tokenType, "type", "", fmt.Sprintf("type of the token, one of: %s", strings.Join(builtin.TokenTypes, ", ")))
+	cmd.Flags().StringVarP(&args.mesh, "mesh", "m", "", "mesh of the dataplane token")
+	cmd.Flags().StringVar(&args.token, "token", "", "token to revoke")
+	cmd.Flags().StringVar(&args.tokenFile, "token-file", "", "path to a file with the token to revoke")
+	cmd.Flags().StringVar(&args.id, "id", "", "ID of the token to revoke")
+	cmd.Flags().StringVar(&args.reason, "reason", "", "reason of the revocation")
+	_ = cmd.MarkFlagRequired("type")
+	return cmd
+}
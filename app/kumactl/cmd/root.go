@@ -11,11 +11,13 @@ import (
 	"github.com/kumahq/kuma/v3/app/kumactl/cmd/completion"
 	"github.com/kumahq/kuma/v3/app/kumactl/cmd/config"
 	"github.com/kumahq/kuma/v3/app/kumactl/cmd/delete"
+	"github.com/kumahq/kuma/v3/app/kumactl/cmd/diff"
 	"github.com/kumahq/kuma/v3/app/kumactl/cmd/export"
 	"github.com/kumahq/kuma/v3/app/kumactl/cmd/generate"
 	"github.com/kumahq/kuma/v3/app/kumactl/cmd/get"
 	"github.com/kumahq/kuma/v3/app/kumactl/cmd/inspect"
 	"github.com/kumahq/kuma/v3/app/kumactl/cmd/install"
+	"github.com/kumahq/kuma/v3/app/kumactl/cmd/revoke"
 	"github.com/kumahq/kuma/v3/app/kumactl/cmd/uninstall"
 	"github.com/kumahq/kuma/v3/app/kumactl/cmd/version"
 	kumactl_cmd "github.com/kumahq/kuma/v3/app/kumactl/pkg/cmd"
@@ -103,10 +105,12 @@ func NewRootCmd(root *kumactl_cmd.RootContext) *cobra.Command {
 	cmd.AddCommand(completion.NewCompletionCommand())
 	cmd.AddCommand(config.NewConfigCmd(root))
 	cmd.AddCommand(delete.NewDeleteCmd(root))
+	cmd.AddCommand(diff.NewDiffCmd(root))
 	cmd.AddCommand(generate.NewGenerateCmd(root))
 	cmd.AddCommand(get.NewGetCmd(root))
 	cmd.AddCommand(inspect.NewInspectCmd(root))
 	cmd.AddCommand(install.NewInstallCmd(root))
+	cmd.AddCommand(revoke.NewRevokeCmd(root))
 	cmd.AddCommand(uninstall.NewUninstallCmd())
 	cmd.AddCommand(version.NewCmd(root))
 
@@ -122,7 +126,7 @@ func DefaultRootCmd() *cobra.Command {
 // This is called by main.main(). It only needs to happen once to the rootCmd.
 func Execute() {
 	if err := DefaultRootCmd().Execute(); err != nil {
-		os.Exit(1)
+		os.Exit(kumactl_errors.ExitCode(err))
 	}
 }
 
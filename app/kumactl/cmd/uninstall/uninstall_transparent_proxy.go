@@ -19,7 +19,7 @@ func newUninstallTransparentProxy() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "transparent-proxy",
 		Short: "Uninstall Transparent Proxy pre-requisites on the host",
-		Long:  "Uninstall Transparent Proxy by restoring the hosts iptables and /etc/resolv.conf",
+		Long:  "Uninstall Transparent Proxy by restoring the hosts iptables (or removing the nftables tables when it was installed with '--backend nftables') and /etc/resolv.conf",
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			resolvConf := filepath.Clean("/etc/resolv.conf")
 			resolvConfBackup := filepath.Clean("/etc/resolv.conf.kuma-backup")
@@ -27,6 +27,13 @@ func newUninstallTransparentProxy() *cobra.Command {
 			cfg.RuntimeStdout = cmd.OutOrStdout()
 			cfg.RuntimeStderr = cmd.ErrOrStderr()
 
+			if !cmd.Flags().Changed("backend") {
+				cfg.Backend = config.DetectInstalledBackend(cmd.Context())
+				if cfg.Verbose {
+					fmt.Fprintf(cfg.RuntimeStdout, "# detected %s backend of the installed transparent proxy\n", cfg.Backend)
+				}
+			}
+
 			switch {
 			case runtime.GOOS != "linux" && !cfg.DryRun:
 				return errors.New("transparent proxy is supported only on Linux systems")
@@ -71,6 +78,7 @@ func newUninstallTransparentProxy() *cobra.Command {
 
 	cmd.Flags().BoolVar(&cfg.DryRun, "dry-run", cfg.DryRun, "dry run")
 	cmd.Flags().BoolVar(&cfg.Verbose, "verbose", cfg.Verbose, "verbose")
+	cmd.Flags().Var(&cfg.Backend, "backend", fmt.Sprintf("The backend used to configure traffic redirection during the installation. Can be %s. By default, it's detected by the presence of the nftables tables of the transparent proxy", config.AllowedBackends()))
 
 	return cmd
 }
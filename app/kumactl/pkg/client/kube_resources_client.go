@@ -10,10 +10,12 @@ import (
 
 	"github.com/kumahq/kuma/v3/pkg/core/resources/model"
 	"github.com/kumahq/kuma/v3/pkg/core/resources/model/rest"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/store"
 	util_http "github.com/kumahq/kuma/v3/pkg/util/http"
 )
 
 type KubernetesResourcesClient interface {
+	// Get returns the resource in the Kubernetes format. It returns a not found error when the resource doesn't exist.
 	Get(ctx context.Context, descriptor model.ResourceTypeDescriptor, name, mesh string) (map[string]any, error)
 }
 
@@ -54,6 +56,9 @@ func (k *HTTPKubernetesResourcesClient) Get(ctx context.Context, descriptor mode
 	if err != nil {
 		return nil, err
 	}
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, store.ErrorResourceNotFound(descriptor.Name, name, mesh)
+	}
 	if resp.StatusCode != 200 {
 		return nil, errors.Errorf("unexpected status code: %d %s", resp.StatusCode, b)
 	}
@@ -12,6 +12,7 @@ import (
 	"github.com/kumahq/kuma/v3/pkg/core/resources/apis/system"
 	"github.com/kumahq/kuma/v3/pkg/core/resources/model"
 	"github.com/kumahq/kuma/v3/pkg/core/resources/registry"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/store"
 	util_http "github.com/kumahq/kuma/v3/pkg/util/http"
 )
 
@@ -58,4 +59,23 @@ var _ = Describe("Kube Resources Client", func() {
 		Expect(err).ToNot(HaveOccurred())
 		Expect(obj["kind"]).To(Equal("Secret"))
 	})
+
+	It("should return not found error", func() {
+		// given
+		server := httptest.NewServer(http.NotFoundHandler())
+		defer server.Close()
+		serverURL, err := url.Parse(server.URL)
+		Expect(err).ToNot(HaveOccurred())
+
+		kubeResClient := NewHTTPKubernetesResourcesClient(
+			util_http.ClientWithBaseURL(http.DefaultClient, serverURL, nil),
+			registry.Global().ObjectDescriptors(),
+		)
+
+		// when
+		_, err = kubeResClient.Get(context.Background(), system.NewGlobalSecretResource().Descriptor(), "zone-token-signing-key-1", model.NoMesh)
+
+		// then
+		Expect(store.IsNotFound(err)).To(BeTrue())
+	})
 })
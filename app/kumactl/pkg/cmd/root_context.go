@@ -56,9 +56,13 @@ type RootRuntime struct {
 	NewZoneOverviewClient        func(util_http.Client) kumactl_resources.ZoneOverviewClient
 	NewDataplaneTokenClient      func(util_http.Client) tokens.DataplaneTokenClient
 	NewZoneTokenClient           func(util_http.Client) tokens.ZoneTokenClient
+	NewRevocationClient          func(util_http.Client) tokens.RevocationClient
 	NewAPIServerClient           func(util_http.Client) kumactl_resources.ApiServerClient
 	NewKubernetesResourcesClient func(util_http.Client) client.KubernetesResourcesClient
 	NewResourcesListClient       func(util_http.Client) client.ResourcesListClient
+	NewEventsClient              func(util_http.Client) kumactl_resources.EventsClient
+	NewDeleteCollectionClient    func(util_http.Client) kumactl_resources.DeleteCollectionClient
+	NewApplyClient               func(util_http.Client) kumactl_resources.ApplyClient
 	Registry                     registry.TypeRegistry
 }
 
@@ -103,11 +107,15 @@ func DefaultRootContext() *RootContext {
 			NewZoneOverviewClient:      kumactl_resources.NewZoneOverviewClient,
 			NewDataplaneTokenClient:    tokens.NewDataplaneTokenClient,
 			NewZoneTokenClient:         tokens.NewZoneTokenClient,
+			NewRevocationClient:        tokens.NewRevocationClient,
 			NewAPIServerClient:         kumactl_resources.NewAPIServerClient,
 			NewKubernetesResourcesClient: func(c util_http.Client) client.KubernetesResourcesClient {
 				return client.NewHTTPKubernetesResourcesClient(c, registry.Global().ObjectDescriptors())
 			},
-			NewResourcesListClient: client.NewHTTPResourcesListClient,
+			NewResourcesListClient:    client.NewHTTPResourcesListClient,
+			NewEventsClient:           kumactl_resources.NewEventsClient,
+			NewDeleteCollectionClient: kumactl_resources.NewDeleteCollectionClient,
+			NewApplyClient:            kumactl_resources.NewApplyClient,
 		},
 		InstallCpContext:                    install_context.DefaultInstallCpContext(),
 		InstallCRDContext:                   install_context.DefaultInstallCrdsContext(),
@@ -172,11 +180,15 @@ func (rc *RootContext) Now() time.Time {
 }
 
 func (rc *RootContext) BaseAPIServerClient() (util_http.Client, error) {
+	return rc.baseAPIServerClient(rc.Args.ApiTimeout)
+}
+
+func (rc *RootContext) baseAPIServerClient(timeout time.Duration) (util_http.Client, error) {
 	controlPlane, err := rc.CurrentControlPlane()
 	if err != nil {
 		return nil, err
 	}
-	client, err := rc.Runtime.NewBaseAPIServerClient(controlPlane.Coordinates.ApiServer, rc.Args.ApiTimeout)
+	client, err := rc.Runtime.NewBaseAPIServerClient(controlPlane.Coordinates.ApiServer, timeout)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to create a client for Control Plane %q", controlPlane.Name)
 	}
@@ -203,6 +215,32 @@ func (rc *RootContext) CurrentResourceStore() (core_store.ResourceStore, error)
 	return rc.Runtime.NewResourceStore(client), nil
 }
 
+// CurrentEventsClient returns a client of the event stream. The stream is open for as long as the client
+// is interested in changes, so unlike other clients it is not bound by the API timeout.
+func (rc *RootContext) CurrentEventsClient() (kumactl_resources.EventsClient, error) {
+	client, err := rc.baseAPIServerClient(0)
+	if err != nil {
+		return nil, err
+	}
+	return rc.Runtime.NewEventsClient(client), nil
+}
+
+func (rc *RootContext) CurrentDeleteCollectionClient() (kumactl_resources.DeleteCollectionClient, error) {
+	client, err := rc.BaseAPIServerClient()
+	if err != nil {
+		return nil, err
+	}
+	return rc.Runtime.NewDeleteCollectionClient(client), nil
+}
+
+func (rc *RootContext) CurrentApplyClient() (kumactl_resources.ApplyClient, error) {
+	client, err := rc.BaseAPIServerClient()
+	if err != nil {
+		return nil, err
+	}
+	return rc.Runtime.NewApplyClient(client), nil
+}
+
 func (rc *RootContext) CurrentKubernetesResourcesClient() (client.KubernetesResourcesClient, error) {
 	client, err := rc.BaseAPIServerClient()
 	if err != nil {
@@ -275,6 +313,14 @@ func (rc *RootContext) CurrentZoneTokenClient() (tokens.ZoneTokenClient, error)
 	return rc.Runtime.NewZoneTokenClient(client), nil
 }
 
+func (rc *RootContext) CurrentRevocationClient() (tokens.RevocationClient, error) {
+	client, err := rc.BaseAPIServerClient()
+	if err != nil {
+		return nil, err
+	}
+	return rc.Runtime.NewRevocationClient(client), nil
+}
+
 func (rc *RootContext) IsFirstTimeUsage() bool {
 	if rc.Args.ConfigFile != "" {
 		return !util_files.FileExists(rc.Args.ConfigFile)
@@ -0,0 +1,28 @@
+package errors
+
+import (
+	"github.com/pkg/errors"
+)
+
+// ExitError is returned by commands which exit with a status other than 1, like kumactl diff.
+type ExitError struct {
+	Code int
+	Err  error
+}
+
+func (e *ExitError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *ExitError) Unwrap() error {
+	return e.Err
+}
+
+// ExitCode returns the status kumactl exits with when the command fails with the error.
+func ExitCode(err error) int {
+	var exitErr *ExitError
+	if errors.As(err, &exitErr) {
+		return exitErr.Code
+	}
+	return 1
+}
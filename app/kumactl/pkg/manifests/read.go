@@ -0,0 +1,117 @@
+package manifests
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	timeout = 10 * time.Second
+)
+
+var supportedExtensions = map[string]bool{
+	".yaml": true,
+	".yml":  true,
+	".json": true,
+}
+
+// Read reads manifests from a file, a directory, an http(s) URL or from stdin when file is `-`.
+// Files of a directory are read in the lexical order and joined into a multi document YAML.
+func Read(file string, stdin io.Reader) ([]byte, error) {
+	if file == "-" {
+		return io.ReadAll(stdin)
+	}
+	if strings.HasPrefix(file, "http://") || strings.HasPrefix(file, "https://") {
+		return readURL(file)
+	}
+	info, err := os.Stat(file)
+	if err != nil {
+		return nil, errors.Wrap(err, "error while reading provided file")
+	}
+	if info.IsDir() {
+		return readDirectory(file)
+	}
+	b, err := os.ReadFile(file)
+	if err != nil {
+		return nil, errors.Wrap(err, "error while reading provided file")
+	}
+	return b, nil
+}
+
+func readURL(file string) ([]byte, error) {
+	if _, err := url.ParseRequestURI(file); err != nil {
+		return nil, errors.Wrap(err, "invalid URL for --file")
+	}
+	client := &http.Client{
+		Timeout: timeout,
+	}
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, file, http.NoBody)
+	if err != nil {
+		return nil, errors.Wrap(err, "error creating new http request")
+	}
+	resp, err := client.Do(req) // #nosec G704 -- URL validated with ParseRequestURI above
+	if err != nil {
+		return nil, errors.Wrap(err, "error with GET http request")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.New("error while retrieving URL")
+	}
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "error while reading provided file")
+	}
+	return b, nil
+}
+
+func readDirectory(dir string) ([]byte, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, errors.Wrap(err, "error reading directory")
+	}
+	var files []string
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		// Skip symlinks that point to directories
+		if entry.Type()&os.ModeSymlink != 0 {
+			resolved, err := os.Stat(filepath.Join(dir, entry.Name()))
+			if err != nil {
+				continue
+			}
+			if resolved.IsDir() {
+				continue
+			}
+		}
+		ext := strings.ToLower(filepath.Ext(entry.Name()))
+		if supportedExtensions[ext] {
+			files = append(files, filepath.Join(dir, entry.Name()))
+		}
+	}
+	sort.Strings(files)
+	if len(files) == 0 {
+		return nil, nil
+	}
+	var combined []byte
+	for _, f := range files {
+		b, err := os.ReadFile(f)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error reading file %s", f)
+		}
+		if len(combined) > 0 {
+			combined = append(combined, []byte("\n---\n")...)
+		}
+		combined = append(combined, b...)
+	}
+	return combined, nil
+}
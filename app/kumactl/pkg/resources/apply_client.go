@@ -0,0 +1,84 @@
+package resources
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/pkg/errors"
+
+	api_server_types "github.com/kumahq/kuma/v3/pkg/api-server/types"
+	core_model "github.com/kumahq/kuma/v3/pkg/core/resources/model"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/model/rest"
+	util_http "github.com/kumahq/kuma/v3/pkg/util/http"
+)
+
+type ApplyOptions struct {
+	// FieldManager is the name of the manager which applies the resource.
+	FieldManager string
+	// Force takes over the resource applied by another field manager instead of failing with a conflict.
+	Force bool
+	// DryRun returns the result of the apply without applying it.
+	DryRun bool
+	// ResourceVersion fails the apply with a conflict when the resource was changed since the version.
+	ResourceVersion string
+}
+
+type ApplyClient interface {
+	// Apply creates or updates the resource on the control plane. The control plane fails with a conflict
+	// when the resource was applied by another field manager, unless the apply is forced.
+	Apply(ctx context.Context, res core_model.Resource, opts ApplyOptions) (api_server_types.ApplyResponse, error)
+}
+
+func NewApplyClient(client util_http.Client) ApplyClient {
+	return &httpApplyClient{
+		Client: client,
+	}
+}
+
+type httpApplyClient struct {
+	Client util_http.Client
+}
+
+func (c *httpApplyClient) Apply(ctx context.Context, res core_model.Resource, opts ApplyOptions) (api_server_types.ApplyResponse, error) {
+	resp := api_server_types.ApplyResponse{}
+	desc := res.Descriptor()
+	path := "/" + desc.WsPath + "/" + res.GetMeta().GetName()
+	if desc.Scope == core_model.ScopeMesh {
+		path = "/meshes/" + res.GetMeta().GetMesh() + path
+	}
+	query := url.Values{}
+	query.Add("fieldManager", opts.FieldManager)
+	if opts.Force {
+		query.Add("force", strconv.FormatBool(opts.Force))
+	}
+	if opts.DryRun {
+		query.Add("dryRun", strconv.FormatBool(opts.DryRun))
+	}
+	if opts.ResourceVersion != "" {
+		query.Add("resourceVersion", opts.ResourceVersion)
+	}
+	body, err := json.Marshal(rest.From.Resource(res))
+	if err != nil {
+		return resp, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPatch, (&url.URL{Path: path, RawQuery: query.Encode()}).String(), bytes.NewReader(body))
+	if err != nil {
+		return resp, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	statusCode, b, err := doRequest(c.Client, ctx, req)
+	if err != nil {
+		return resp, err
+	}
+	if statusCode != http.StatusOK && statusCode != http.StatusCreated {
+		return resp, errors.Errorf("(%d): %s", statusCode, string(b))
+	}
+	if err := json.Unmarshal(b, &resp); err != nil {
+		return resp, errors.Wrap(err, "could not parse the response")
+	}
+	return resp, nil
+}
@@ -0,0 +1,85 @@
+package resources_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	api_common "github.com/kumahq/kuma/v3/api/openapi/types/common"
+	"github.com/kumahq/kuma/v3/app/kumactl/pkg/resources"
+	api_server_types "github.com/kumahq/kuma/v3/pkg/api-server/types"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/apis/mesh"
+	rest_types "github.com/kumahq/kuma/v3/pkg/core/rest/errors/types"
+	test_model "github.com/kumahq/kuma/v3/pkg/test/resources/model"
+)
+
+var _ = Describe("httpApplyClient", func() {
+	Describe("Apply()", func() {
+		It("should send the resource and parse response", func() {
+			// given
+			client := resources.NewApplyClient(&http.Client{
+				Transport: resources.RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+					Expect(req.Method).To(Equal(http.MethodPatch))
+					Expect(req.URL.Path).To(Equal("/meshes/demo/dataplanes/web"))
+					Expect(req.URL.Query().Get("fieldManager")).To(Equal("team-a"))
+					Expect(req.URL.Query().Get("force")).To(Equal("true"))
+					Expect(req.URL.Query().Get("dryRun")).To(Equal("true"))
+					Expect(req.URL.Query().Get("resourceVersion")).To(Equal("3"))
+					body, err := io.ReadAll(req.Body)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(string(body)).To(ContainSubstring(`"type":"Dataplane","mesh":"demo","name":"web"`))
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       io.NopCloser(strings.NewReader(`{"operation":"Updated","dryRun":true,"fieldManager":"team-a","diff":[{"op":"add","path":"/labels/team","value":"a"}]}`)),
+					}, nil
+				}),
+			})
+			res := mesh.NewDataplaneResource()
+			res.SetMeta(&test_model.ResourceMeta{Mesh: "demo", Name: "web"})
+
+			// when
+			resp, err := client.Apply(context.Background(), res, resources.ApplyOptions{
+				FieldManager:    "team-a",
+				Force:           true,
+				DryRun:          true,
+				ResourceVersion: "3",
+			})
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp).To(Equal(api_server_types.ApplyResponse{
+				Operation:    api_server_types.ApplyOperationUpdated,
+				DryRun:       true,
+				FieldManager: "team-a",
+				Diff:         []api_common.JsonPatchItem{{Op: api_common.Add, Path: "/labels/team", Value: "a"}},
+			}))
+		})
+
+		It("should return conflict from the server", func() {
+			// given
+			client := resources.NewApplyClient(&http.Client{
+				Transport: resources.RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+					Expect(req.URL.String()).To(Equal("/meshes/default?fieldManager=kumactl"))
+					return &http.Response{
+						StatusCode: http.StatusConflict,
+						Body:       io.NopCloser(strings.NewReader(`{"title":"Conflict","detail":"resource is managed by field manager \"team-a\", the change would modify: /spec/mtls","status":409}`)),
+					}, nil
+				}),
+			})
+			res := mesh.NewMeshResource()
+			res.SetMeta(&test_model.ResourceMeta{Name: "default"})
+
+			// when
+			_, err := client.Apply(context.Background(), res, resources.ApplyOptions{FieldManager: "kumactl"})
+
+			// then
+			var kumaErr *rest_types.Error
+			Expect(err).To(BeAssignableToTypeOf(kumaErr))
+			Expect(err).To(MatchError(ContainSubstring(`managed by field manager "team-a"`)))
+		})
+	})
+})
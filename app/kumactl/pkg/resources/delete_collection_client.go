@@ -0,0 +1,81 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/pkg/errors"
+
+	api_server_types "github.com/kumahq/kuma/v3/pkg/api-server/types"
+	core_model "github.com/kumahq/kuma/v3/pkg/core/resources/model"
+	util_http "github.com/kumahq/kuma/v3/pkg/util/http"
+)
+
+type DeleteCollectionRequest struct {
+	LabelSelector string
+	FieldSelector string
+	// All has to be set to delete resources without any selector.
+	All bool
+	// DryRun returns resources which would be deleted without deleting them.
+	DryRun bool
+}
+
+type DeleteCollectionClient interface {
+	// DeleteCollection deletes all resources of the type in the mesh matching the selectors.
+	// The control plane deletes either all of them or none of them.
+	DeleteCollection(ctx context.Context, desc core_model.ResourceTypeDescriptor, mesh string, request DeleteCollectionRequest) (api_server_types.DeleteCollectionResponse, error)
+}
+
+func NewDeleteCollectionClient(client util_http.Client) DeleteCollectionClient {
+	return &httpDeleteCollectionClient{
+		Client: client,
+	}
+}
+
+type httpDeleteCollectionClient struct {
+	Client util_http.Client
+}
+
+func (c *httpDeleteCollectionClient) DeleteCollection(
+	ctx context.Context,
+	desc core_model.ResourceTypeDescriptor,
+	mesh string,
+	request DeleteCollectionRequest,
+) (api_server_types.DeleteCollectionResponse, error) {
+	resp := api_server_types.DeleteCollectionResponse{}
+	path := "/" + desc.WsPath
+	if desc.Scope == core_model.ScopeMesh {
+		path = "/meshes/" + mesh + path
+	}
+	query := url.Values{}
+	if request.LabelSelector != "" {
+		query.Add("labelSelector", request.LabelSelector)
+	}
+	if request.FieldSelector != "" {
+		query.Add("fieldSelector", request.FieldSelector)
+	}
+	if request.All {
+		query.Add("all", strconv.FormatBool(request.All))
+	}
+	if request.DryRun {
+		query.Add("dryRun", strconv.FormatBool(request.DryRun))
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, (&url.URL{Path: path, RawQuery: query.Encode()}).String(), http.NoBody)
+	if err != nil {
+		return resp, err
+	}
+	statusCode, b, err := doRequest(c.Client, ctx, req)
+	if err != nil {
+		return resp, err
+	}
+	if statusCode != http.StatusOK {
+		return resp, errors.Errorf("(%d): %s", statusCode, string(b))
+	}
+	if err := json.Unmarshal(b, &resp); err != nil {
+		return resp, errors.Wrap(err, "could not parse the response")
+	}
+	return resp, nil
+}
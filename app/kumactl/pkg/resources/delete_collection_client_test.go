@@ -0,0 +1,90 @@
+package resources_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/kumahq/kuma/v3/app/kumactl/pkg/resources"
+	api_server_types "github.com/kumahq/kuma/v3/pkg/api-server/types"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/apis/mesh"
+)
+
+var _ = Describe("httpDeleteCollectionClient", func() {
+	Describe("DeleteCollection()", func() {
+		It("should send selectors and parse response", func() {
+			// given
+			client := resources.NewDeleteCollectionClient(&http.Client{
+				Transport: resources.RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+					Expect(req.Method).To(Equal(http.MethodDelete))
+					Expect(req.URL.Path).To(Equal("/meshes/demo/dataplanes"))
+					Expect(req.URL.Query().Get("labelSelector")).To(Equal("kuma.io/zone=east"))
+					Expect(req.URL.Query().Get("fieldSelector")).To(Equal("spec.networking.address=127.0.0.1"))
+					Expect(req.URL.Query().Get("dryRun")).To(Equal("true"))
+					Expect(req.URL.Query().Has("all")).To(BeFalse())
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       io.NopCloser(strings.NewReader(`{"dryRun":true,"total":1,"items":[{"type":"Dataplane","mesh":"demo","name":"web"}]}`)),
+					}, nil
+				}),
+			})
+
+			// when
+			resp, err := client.DeleteCollection(context.Background(), mesh.DataplaneResourceTypeDescriptor, "demo", resources.DeleteCollectionRequest{
+				LabelSelector: "kuma.io/zone=east",
+				FieldSelector: "spec.networking.address=127.0.0.1",
+				DryRun:        true,
+			})
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp).To(Equal(api_server_types.DeleteCollectionResponse{
+				DryRun: true,
+				Total:  1,
+				Items:  []api_server_types.DeletedResource{{Type: "Dataplane", Mesh: "demo", Name: "web"}},
+			}))
+		})
+
+		It("should delete all resources of a global type", func() {
+			// given
+			client := resources.NewDeleteCollectionClient(&http.Client{
+				Transport: resources.RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+					Expect(req.URL.String()).To(Equal("/meshes?all=true"))
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       io.NopCloser(strings.NewReader(`{"total":0,"items":[]}`)),
+					}, nil
+				}),
+			})
+
+			// when
+			resp, err := client.DeleteCollection(context.Background(), mesh.MeshResourceTypeDescriptor, "default", resources.DeleteCollectionRequest{All: true})
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Items).To(BeEmpty())
+		})
+
+		It("should return error from the server", func() {
+			// given
+			client := resources.NewDeleteCollectionClient(&http.Client{
+				Transport: resources.RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+					return &http.Response{
+						StatusCode: http.StatusBadRequest,
+						Body:       io.NopCloser(strings.NewReader(`{"title":"Could not delete resources","detail":"Bad Request"}`)),
+					}, nil
+				}),
+			})
+
+			// when
+			_, err := client.DeleteCollection(context.Background(), mesh.DataplaneResourceTypeDescriptor, "default", resources.DeleteCollectionRequest{All: true})
+
+			// then
+			Expect(err).To(MatchError(ContainSubstring("Could not delete resources")))
+		})
+	})
+})
@@ -0,0 +1,156 @@
+package resources
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	api_server_types "github.com/kumahq/kuma/v3/pkg/api-server/types"
+	core_model "github.com/kumahq/kuma/v3/pkg/core/resources/model"
+	util_http "github.com/kumahq/kuma/v3/pkg/util/http"
+)
+
+type EventsClient interface {
+	// Subscribe opens a stream of changes of resources of the type in the mesh.
+	// It returns once the control plane accepted the subscription, so no changes made afterwards are missed.
+	Subscribe(ctx context.Context, resourceType core_model.ResourceType, mesh string) (EventStream, error)
+}
+
+type EventStream interface {
+	// Recv blocks until the next event is received. When the control plane closes the stream
+	// it is resumed from the last received event. When the stream can't be resumed, it's opened again
+	// and ErrResyncRequired is returned.
+	Recv() (api_server_types.ResourceChangedEvent, error)
+	Close() error
+}
+
+// ErrResyncRequired is returned by EventStream.Recv when changes since the last received event are not available,
+// i.e. the stream was resumed on another instance of the control plane. The stream continues with new changes,
+// but the changes in between are lost, so resources have to be listed again.
+var ErrResyncRequired = errors.New("changes since the last received event are not available, resync required")
+
+func NewEventsClient(client util_http.Client) EventsClient {
+	return &httpEventsClient{
+		Client: client,
+	}
+}
+
+type httpEventsClient struct {
+	Client util_http.Client
+}
+
+func (c *httpEventsClient) Subscribe(ctx context.Context, resourceType core_model.ResourceType, mesh string) (EventStream, error) {
+	query := url.Values{}
+	query.Add("type", string(resourceType))
+	if mesh != "" {
+		query.Add("mesh", mesh)
+	}
+	stream := &httpEventStream{
+		ctx:    ctx,
+		client: c.Client,
+		url:    (&url.URL{Path: "/_events", RawQuery: query.Encode()}).String(),
+	}
+	if err := stream.connect(); err != nil {
+		return nil, err
+	}
+	return stream, nil
+}
+
+type httpEventStream struct {
+	ctx         context.Context
+	client      util_http.Client
+	url         string
+	lastEventID string
+	body        io.ReadCloser
+	reader      *bufio.Reader
+}
+
+func (s *httpEventStream) connect() error {
+	req, err := http.NewRequestWithContext(s.ctx, http.MethodGet, s.url, http.NoBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "text/event-stream")
+	if s.lastEventID != "" {
+		req.Header.Set("Last-Event-ID", s.lastEventID)
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "could not subscribe to events")
+	}
+	if resp.StatusCode == http.StatusGone {
+		_ = resp.Body.Close()
+		return ErrResyncRequired
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		b, _ := io.ReadAll(resp.Body)
+		return errors.Errorf("could not subscribe to events (%d): %s", resp.StatusCode, string(b))
+	}
+	s.body = resp.Body
+	s.reader = bufio.NewReader(resp.Body)
+	return nil
+}
+
+func (s *httpEventStream) Recv() (api_server_types.ResourceChangedEvent, error) {
+	var id string
+	var data strings.Builder
+	for {
+		line, err := s.reader.ReadString('\n')
+		if err != nil {
+			if s.ctx.Err() != nil {
+				return api_server_types.ResourceChangedEvent{}, s.ctx.Err()
+			}
+			if !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+				return api_server_types.ResourceChangedEvent{}, errors.Wrap(err, "could not read events")
+			}
+			// the control plane closes the stream when the client falls behind, continue after the last received event
+			_ = s.body.Close()
+			if err := s.connect(); err != nil {
+				if !errors.Is(err, ErrResyncRequired) {
+					return api_server_types.ResourceChangedEvent{}, err
+				}
+				// subscribe from now on, the caller lists resources again to catch up
+				s.lastEventID = ""
+				if err := s.connect(); err != nil {
+					return api_server_types.ResourceChangedEvent{}, err
+				}
+				return api_server_types.ResourceChangedEvent{}, ErrResyncRequired
+			}
+			id = ""
+			data.Reset()
+			continue
+		}
+		line = strings.TrimRight(line, "\r\n")
+		switch {
+		case line == "":
+			if data.Len() == 0 {
+				continue
+			}
+			event := api_server_types.ResourceChangedEvent{}
+			if err := json.Unmarshal([]byte(data.String()), &event); err != nil {
+				return api_server_types.ResourceChangedEvent{}, errors.Wrap(err, "could not parse event")
+			}
+			if id != "" {
+				s.lastEventID = id
+			}
+			return event, nil
+		case strings.HasPrefix(line, ":"):
+			// comment used as keep-alive
+		case strings.HasPrefix(line, "id:"):
+			id = strings.TrimSpace(strings.TrimPrefix(line, "id:"))
+		case strings.HasPrefix(line, "data:"):
+			data.WriteString(strings.TrimPrefix(strings.TrimPrefix(line, "data:"), " "))
+		}
+	}
+}
+
+func (s *httpEventStream) Close() error {
+	return s.body.Close()
+}
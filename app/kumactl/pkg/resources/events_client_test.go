@@ -0,0 +1,124 @@
+package resources_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/kumahq/kuma/v3/app/kumactl/pkg/resources"
+	api_server_types "github.com/kumahq/kuma/v3/pkg/api-server/types"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/apis/mesh"
+)
+
+var _ = Describe("httpEventsClient", func() {
+	Describe("Subscribe()", func() {
+		It("should receive events and resume the stream after the last received event", func() {
+			// given
+			var lastEventIDs []string
+			bodies := []string{
+				": keep-alive\n\n" +
+					"id: i1:1\nevent: ResourceChanged\ndata: {\"operation\":\"Create\",\"type\":\"Dataplane\",\"mesh\":\"default\",\"name\":\"dp-1\"}\n\n" +
+					"id: i1:2\nevent: ResourceChanged\ndata: {\"operation\":\"Update\",\"type\":\"Dataplane\",\"mesh\":\"default\",\"name\":\"dp-1\"}\n\n",
+				"id: i1:5\nevent: ResourceChanged\ndata: {\"operation\":\"Delete\",\"type\":\"Dataplane\",\"mesh\":\"default\",\"name\":\"dp-1\"}\n\n",
+			}
+			client := resources.NewEventsClient(&http.Client{
+				Transport: resources.RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+					Expect(req.URL.Path).To(Equal("/_events"))
+					Expect(req.URL.Query().Get("type")).To(Equal("Dataplane"))
+					Expect(req.URL.Query().Get("mesh")).To(Equal("default"))
+					lastEventIDs = append(lastEventIDs, req.Header.Get("Last-Event-ID"))
+					body := bodies[0]
+					bodies = bodies[1:]
+					return &http.Response{
+						StatusCode: http.StatusOK,
+						Body:       io.NopCloser(strings.NewReader(body)),
+					}, nil
+				}),
+			})
+
+			// when
+			stream, err := client.Subscribe(context.Background(), mesh.DataplaneType, "default")
+			Expect(err).ToNot(HaveOccurred())
+			var received []api_server_types.ResourceChangedEvent
+			for range 3 {
+				event, err := stream.Recv()
+				Expect(err).ToNot(HaveOccurred())
+				received = append(received, event)
+			}
+
+			// then
+			Expect(received).To(Equal([]api_server_types.ResourceChangedEvent{
+				{Operation: "Create", Type: "Dataplane", Mesh: "default", Name: "dp-1"},
+				{Operation: "Update", Type: "Dataplane", Mesh: "default", Name: "dp-1"},
+				{Operation: "Delete", Type: "Dataplane", Mesh: "default", Name: "dp-1"},
+			}))
+			Expect(lastEventIDs).To(Equal([]string{"", "i1:2"}))
+			Expect(stream.Close()).To(Succeed())
+		})
+
+		It("should require resync when the stream can't be resumed", func() {
+			// given
+			var lastEventIDs []string
+			responses := []*http.Response{
+				{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(strings.NewReader("id: i1:1\nevent: ResourceChanged\ndata: {\"operation\":\"Create\",\"type\":\"Dataplane\",\"mesh\":\"default\",\"name\":\"dp-1\"}\n\n")),
+				},
+				{
+					StatusCode: http.StatusGone,
+					Body:       io.NopCloser(strings.NewReader("resync required")),
+				},
+				{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(strings.NewReader("id: i2:1\nevent: ResourceChanged\ndata: {\"operation\":\"Delete\",\"type\":\"Dataplane\",\"mesh\":\"default\",\"name\":\"dp-1\"}\n\n")),
+				},
+			}
+			client := resources.NewEventsClient(&http.Client{
+				Transport: resources.RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+					lastEventIDs = append(lastEventIDs, req.Header.Get("Last-Event-ID"))
+					resp := responses[0]
+					responses = responses[1:]
+					return resp, nil
+				}),
+			})
+			stream, err := client.Subscribe(context.Background(), mesh.DataplaneType, "default")
+			Expect(err).ToNot(HaveOccurred())
+			_, err = stream.Recv()
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			_, err = stream.Recv()
+
+			// then
+			Expect(err).To(MatchError(resources.ErrResyncRequired))
+			Expect(lastEventIDs).To(Equal([]string{"", "i1:1", ""}))
+
+			// and the stream continues with new changes
+			event, err := stream.Recv()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(event).To(Equal(api_server_types.ResourceChangedEvent{Operation: "Delete", Type: "Dataplane", Mesh: "default", Name: "dp-1"}))
+		})
+
+		It("should return error from the server", func() {
+			// given
+			client := resources.NewEventsClient(&http.Client{
+				Transport: resources.RoundTripperFunc(func(req *http.Request) (*http.Response, error) {
+					return &http.Response{
+						StatusCode: http.StatusBadRequest,
+						Body:       io.NopCloser(strings.NewReader("unknown resource type")),
+					}, nil
+				}),
+			})
+
+			// when
+			_, err := client.Subscribe(context.Background(), "Unknown", "")
+
+			// then
+			Expect(err).To(MatchError("could not subscribe to events (400): unknown resource type"))
+		})
+	})
+})
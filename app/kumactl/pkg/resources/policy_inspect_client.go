@@ -1,6 +1,7 @@
 package resources
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -12,12 +13,15 @@ import (
 	"github.com/kumahq/kuma/v3/api/openapi/types"
 	api_server_types "github.com/kumahq/kuma/v3/pkg/api-server/types"
 	core_model "github.com/kumahq/kuma/v3/pkg/core/resources/model"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/model/rest"
 	util_http "github.com/kumahq/kuma/v3/pkg/util/http"
 )
 
 type PolicyInspectClient interface {
 	Inspect(ctx context.Context, policyDesc core_model.ResourceTypeDescriptor, mesh, name string) (*api_server_types.PolicyInspectEntryList, error)
 	DataplanesForPolicy(ctx context.Context, desc core_model.ResourceTypeDescriptor, mesh string, name string) (types.InspectDataplanesForPolicyResponse, error)
+	// Simulate returns the impact of applying the policy without applying it.
+	Simulate(ctx context.Context, policy core_model.Resource) (*api_server_types.PolicySimulation, error)
 }
 
 func NewPolicyInspectClient(client util_http.Client) PolicyInspectClient {
@@ -77,3 +81,32 @@ func (h *httpPolicyInspectClient) Inspect(ctx context.Context, policyDesc core_m
 	}
 	return entryList, nil
 }
+
+func (h *httpPolicyInspectClient) Simulate(ctx context.Context, policy core_model.Resource) (*api_server_types.PolicySimulation, error) {
+	desc := policy.Descriptor()
+	resUrl, err := url.Parse(fmt.Sprintf("/meshes/%s/%s/%s/_simulate", policy.GetMeta().GetMesh(), desc.WsPath, policy.GetMeta().GetName()))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not construct the url")
+	}
+	body, err := json.Marshal(rest.From.Resource(policy))
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, resUrl.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	statusCode, b, err := doRequest(h.Client, ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if statusCode != 200 {
+		return nil, errors.Errorf("(%d): %s", statusCode, string(b))
+	}
+	simulation := &api_server_types.PolicySimulation{}
+	if err := json.Unmarshal(b, simulation); err != nil {
+		return nil, err
+	}
+	return simulation, nil
+}
@@ -0,0 +1,90 @@
+package tokens
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/pkg/errors"
+
+	error_types "github.com/kumahq/kuma/v3/pkg/core/rest/errors/types"
+	"github.com/kumahq/kuma/v3/pkg/core/tokens"
+	"github.com/kumahq/kuma/v3/pkg/tokens/builtin/server/types"
+	util_http "github.com/kumahq/kuma/v3/pkg/util/http"
+)
+
+func NewRevocationClient(client util_http.Client) RevocationClient {
+	return &httpRevocationClient{
+		client: client,
+	}
+}
+
+type RevocationClient interface {
+	Revoke(ctx context.Context, req types.TokenRevocationRequest) (tokens.Revocation, error)
+	List(ctx context.Context, tokenType string, mesh string) (types.TokenRevocationList, error)
+}
+
+type httpRevocationClient struct {
+	client util_http.Client
+}
+
+var _ RevocationClient = &httpRevocationClient{}
+
+func (h *httpRevocationClient) Revoke(ctx context.Context, revReq types.TokenRevocationRequest) (tokens.Revocation, error) {
+	reqBytes, err := json.Marshal(revReq)
+	if err != nil {
+		return tokens.Revocation{}, errors.Wrap(err, "could not marshal revocation request to json")
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "/tokens/revocations", bytes.NewReader(reqBytes))
+	if err != nil {
+		return tokens.Revocation{}, errors.Wrap(err, "could not construct the request")
+	}
+	req.Header.Set("content-type", "application/json")
+	revocation := tokens.Revocation{}
+	if err := h.do(req, &revocation); err != nil {
+		return tokens.Revocation{}, err
+	}
+	return revocation, nil
+}
+
+func (h *httpRevocationClient) List(ctx context.Context, tokenType string, mesh string) (types.TokenRevocationList, error) {
+	query := url.Values{}
+	query.Set("type", tokenType)
+	if mesh != "" {
+		query.Set("mesh", mesh)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "/tokens/revocations?"+query.Encode(), nil)
+	if err != nil {
+		return types.TokenRevocationList{}, errors.Wrap(err, "could not construct the request")
+	}
+	list := types.TokenRevocationList{}
+	if err := h.do(req, &list); err != nil {
+		return types.TokenRevocationList{}, err
+	}
+	return list, nil
+}
+
+func (h *httpRevocationClient) do(req *http.Request, out any) error {
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "could not execute the request")
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return errors.Wrap(err, "could not read a body of the request")
+	}
+	if resp.StatusCode != http.StatusOK {
+		var kumaErr error_types.Error
+		if err := json.Unmarshal(body, &kumaErr); err == nil {
+			if kumaErr.Title != "" {
+				return &kumaErr
+			}
+		}
+		return errors.Errorf("(%d): %s", resp.StatusCode, body)
+	}
+	return json.Unmarshal(body, out)
+}
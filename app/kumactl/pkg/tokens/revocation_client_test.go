@@ -0,0 +1,102 @@
+package tokens_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/emicklei/go-restful/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	kumactl_client "github.com/kumahq/kuma/v3/app/kumactl/pkg/client"
+	"github.com/kumahq/kuma/v3/app/kumactl/pkg/tokens"
+	config_access "github.com/kumahq/kuma/v3/pkg/config/access"
+	config_kumactl "github.com/kumahq/kuma/v3/pkg/config/app/kumactl/v1alpha1"
+	config_store "github.com/kumahq/kuma/v3/pkg/config/core/resources/store"
+	resources_access "github.com/kumahq/kuma/v3/pkg/core/resources/access"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/v3/pkg/core/user"
+	"github.com/kumahq/kuma/v3/pkg/plugins/resources/memory"
+	tokens_server "github.com/kumahq/kuma/v3/pkg/tokens/builtin/server"
+	"github.com/kumahq/kuma/v3/pkg/tokens/builtin/server/types"
+)
+
+var _ = Describe("Revocation Client", func() {
+	var server *httptest.Server
+	var client tokens.RevocationClient
+
+	BeforeEach(func() {
+		container := restful.NewContainer()
+		container.Add(tokens_server.NewRevocationsWebservice(
+			"/tokens",
+			manager.NewResourceManager(memory.NewStore()),
+			resources_access.NewAdminResourceAccess(config_access.AdminResourcesStaticAccessConfig{
+				Groups: user.Anonymous.Groups,
+			}),
+			config_store.DefaultUpsertConfig(),
+		))
+		server = httptest.NewServer(container.ServeMux)
+
+		baseClient, err := kumactl_client.ApiServerClient(&config_kumactl.ControlPlaneCoordinates_ApiServer{
+			Url: server.URL,
+		}, time.Second)
+		Expect(err).ToNot(HaveOccurred())
+		client = tokens.NewRevocationClient(baseClient)
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("should revoke and list tokens", func() {
+		// when
+		revocation, err := client.Revoke(context.Background(), types.TokenRevocationRequest{
+			Type:   "zone",
+			ID:     "token-1",
+			Reason: "zone decommissioned",
+		})
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(revocation.ID).To(Equal("token-1"))
+
+		// when
+		list, err := client.List(context.Background(), "zone", "")
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(list.Total).To(Equal(1))
+		Expect(list.Items[0].Reason).To(Equal("zone decommissioned"))
+	})
+
+	It("should return an error when request is invalid", func() {
+		// when
+		_, err := client.List(context.Background(), "unknown", "")
+
+		// then
+		Expect(err).To(MatchError(ContainSubstring("Invalid request")))
+	})
+
+	It("should return an error when status code is different than 200", func() {
+		// given
+		mux := http.NewServeMux()
+		srv := httptest.NewServer(mux)
+		defer srv.Close()
+		mux.HandleFunc("/tokens/revocations", func(writer http.ResponseWriter, req *http.Request) {
+			writer.WriteHeader(500)
+			_, _ = writer.Write([]byte("Internal Server Error"))
+		})
+		baseClient, err := kumactl_client.ApiServerClient(&config_kumactl.ControlPlaneCoordinates_ApiServer{
+			Url: srv.URL,
+		}, time.Second)
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		_, err = tokens.NewRevocationClient(baseClient).List(context.Background(), "zone", "")
+
+		// then
+		Expect(err).To(MatchError("(500): Internal Server Error"))
+	})
+})
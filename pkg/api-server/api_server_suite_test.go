@@ -26,6 +26,7 @@ import (
 	kuma_cp "github.com/kumahq/kuma/v3/pkg/config/app/kuma-cp"
 	config_core "github.com/kumahq/kuma/v3/pkg/config/core"
 	"github.com/kumahq/kuma/v3/pkg/core/access"
+	"github.com/kumahq/kuma/v3/pkg/core/audit"
 	resources_access "github.com/kumahq/kuma/v3/pkg/core/resources/access"
 	"github.com/kumahq/kuma/v3/pkg/core/resources/manager"
 	"github.com/kumahq/kuma/v3/pkg/core/resources/model"
@@ -33,6 +34,7 @@ import (
 	"github.com/kumahq/kuma/v3/pkg/core/resources/store"
 	"github.com/kumahq/kuma/v3/pkg/core/runtime"
 	envoyadmin_access "github.com/kumahq/kuma/v3/pkg/envoy/admin/access"
+	"github.com/kumahq/kuma/v3/pkg/events"
 	"github.com/kumahq/kuma/v3/pkg/insights/globalinsight"
 	core_metrics "github.com/kumahq/kuma/v3/pkg/metrics"
 	"github.com/kumahq/kuma/v3/pkg/plugins/resources/memory"
@@ -57,6 +59,8 @@ type testApiServerConfigurer struct {
 	global                       bool
 	disableOriginLabelValidation bool
 	accessConfigMutator          func(config *config_access.AccessConfig)
+	eventBus                     events.EventBus
+	auditLog                     audit.Log
 }
 
 func NewTestApiServerConfigurer() *testApiServerConfigurer {
@@ -107,6 +111,17 @@ func (t *testApiServerConfigurer) WithConfigMutator(fn func(*config_api_server.A
 	return t
 }
 
+func (t *testApiServerConfigurer) WithEventBus(eventBus events.EventBus) *testApiServerConfigurer {
+	t.eventBus = eventBus
+	return t
+}
+
+// WithAuditLog enables the audit log and records changes of resources made through the API server.
+func (t *testApiServerConfigurer) WithAuditLog(auditLog audit.Log) *testApiServerConfigurer {
+	t.auditLog = auditLog
+	return t
+}
+
 func (t *testApiServerConfigurer) WithAccessConfigMutator(fn func(config *config_access.AccessConfig)) *testApiServerConfigurer {
 	t.accessConfigMutator = fn
 	return t
@@ -165,7 +180,12 @@ func tryStartApiServer(t *testApiServerConfigurer) (*api_server.ApiServer, kuma_
 
 	cfg.Multizone.Zone.DisableOriginLabelValidation = t.disableOriginLabelValidation
 
-	resManager := manager.NewResourceManager(t.store)
+	resourceStore := t.store
+	if t.auditLog != nil {
+		cfg.Audit.Enabled = true
+		resourceStore = audit.NewStore(resourceStore, t.auditLog)
+	}
+	resManager := manager.NewResourceManager(resourceStore)
 	apiServer, err := api_server.NewApiServer(
 		test_runtime.NewTestRuntime(
 			resManager,
@@ -187,7 +207,7 @@ func tryStartApiServer(t *testApiServerConfigurer) (*api_server.ApiServer, kuma_
 				ZoneToken:      builtin.NewZoneTokenIssuer(resManager),
 			},
 			globalinsight.NewDefaultGlobalInsightService(t.store),
-		),
+		).WithEventBus(t.eventBus).WithAuditLog(t.auditLog),
 		xds_context.NewMeshContextBuilder(
 			resManager,
 			server.MeshResourceTypes(),
@@ -303,7 +323,7 @@ func apiTest(inputResourceFile string, apiServer *api_server.ApiServer, resource
 		// Given
 		url := fmt.Sprintf("http://%s%s", apiServer.Address(), act.path)
 		var body io.Reader
-		if act.method == http.MethodPut || act.method == http.MethodPost {
+		if act.method == http.MethodPut || act.method == http.MethodPost || act.method == http.MethodPatch {
 			requestFile := strings.ReplaceAll(inputResourceFile, ".input.yaml", ".request.json")
 			if len(actions) > 1 {
 				requestFile = strings.ReplaceAll(requestFile, ".request.json", fmt.Sprintf("_%02d.request.json", i))
@@ -0,0 +1,76 @@
+package api_server
+
+import (
+	"net/http"
+
+	"github.com/emicklei/go-restful/v3"
+
+	api_server_types "github.com/kumahq/kuma/v3/pkg/api-server/types"
+	"github.com/kumahq/kuma/v3/pkg/core/audit"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/access"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/model"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/registry"
+	rest_errors "github.com/kumahq/kuma/v3/pkg/core/rest/errors"
+	"github.com/kumahq/kuma/v3/pkg/core/user"
+)
+
+type auditEndpoint struct {
+	auditLog       audit.Log
+	resourceAccess access.ResourceAccess
+}
+
+func addAuditEndpoints(ws *restful.WebService, auditLog audit.Log, resourceAccess access.ResourceAccess) {
+	e := auditEndpoint{
+		auditLog:       auditLog,
+		resourceAccess: resourceAccess,
+	}
+	ws.Route(
+		ws.GET("/_audit").To(e.listEntries).
+			Doc("List the most recent entries of the audit log, the newest first").
+			Param(ws.QueryParameter("type", "Type of a resource").DataType("string")).
+			Param(ws.QueryParameter("mesh", "Name of a mesh").DataType("string")).
+			Param(ws.QueryParameter("name", "Name of a resource").DataType("string")).
+			Param(ws.QueryParameter("user", "Name of a user that made the change").DataType("string")).
+			Param(ws.QueryParameter("source", "Source of the change: api-server, k8s-admission (admission attempts) or kds").DataType("string")).
+			Param(ws.QueryParameter("size", "Maximum number of entries").DataType("int")).
+			Returns(http.StatusOK, "OK", api_server_types.AuditEntryList{}),
+	)
+}
+
+func (e *auditEndpoint) listEntries(request *restful.Request, response *restful.Response) {
+	ctx := request.Request.Context()
+	page, err := pagination(request)
+	if err != nil {
+		rest_errors.HandleError(ctx, response, err, "Could not retrieve audit entries")
+		return
+	}
+	query := audit.Query{
+		Type:   model.ResourceType(request.QueryParameter("type")),
+		Mesh:   request.QueryParameter("mesh"),
+		Name:   request.QueryParameter("name"),
+		User:   request.QueryParameter("user"),
+		Source: audit.Source(request.QueryParameter("source")),
+	}
+
+	out := api_server_types.AuditEntryList{
+		Items: []audit.Entry{},
+	}
+	for _, entry := range e.auditLog.Recent(query) {
+		// entries are only visible to users who can read the resource
+		desc, err := registry.Global().DescriptorFor(entry.Type)
+		if err != nil {
+			continue
+		}
+		if err := e.resourceAccess.ValidateGet(ctx, model.ResourceKey{Mesh: entry.Mesh, Name: entry.Name}, desc, user.FromCtx(ctx)); err != nil {
+			continue
+		}
+		out.Items = append(out.Items, entry)
+		if len(out.Items) == page.size {
+			break
+		}
+	}
+
+	if err := response.WriteAsJson(out); err != nil {
+		rest_errors.HandleError(ctx, response, err, "Could not write response")
+	}
+}
@@ -0,0 +1,89 @@
+package api_server_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+
+	api_server "github.com/kumahq/kuma/v3/pkg/api-server"
+	api_server_types "github.com/kumahq/kuma/v3/pkg/api-server/types"
+	"github.com/kumahq/kuma/v3/pkg/core/audit"
+	core_mesh "github.com/kumahq/kuma/v3/pkg/core/resources/apis/mesh"
+	core_metrics "github.com/kumahq/kuma/v3/pkg/metrics"
+)
+
+var _ = Describe("Audit Endpoint", func() {
+	var apiServer *api_server.ApiServer
+	var stop func()
+
+	BeforeEach(func() {
+		metrics, err := core_metrics.NewMetrics("")
+		Expect(err).ToNot(HaveOccurred())
+		recorder, err := audit.NewRecorder(10, 10, nil, metrics)
+		Expect(err).ToNot(HaveOccurred())
+		apiServer, _, stop = StartApiServer(NewTestApiServerConfigurer().WithAuditLog(recorder))
+	})
+
+	AfterEach(func() {
+		stop()
+	})
+
+	do := func(method, path, body string) *http.Response {
+		req, err := http.NewRequestWithContext(context.Background(), method, fmt.Sprintf("http://%s%s", apiServer.Address(), path), strings.NewReader(body))
+		Expect(err).ToNot(HaveOccurred())
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := http.DefaultClient.Do(req)
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(resp.Body.Close)
+		return resp
+	}
+
+	It("should list changes of resources made through the API server", func() {
+		// given
+		Expect(do(http.MethodPut, "/meshes/mesh-1", `{"type": "Mesh", "name": "mesh-1"}`).StatusCode).To(Equal(http.StatusCreated))
+		Expect(do(http.MethodPut, "/meshes/mesh-2", `{"type": "Mesh", "name": "mesh-2"}`).StatusCode).To(Equal(http.StatusCreated))
+		Expect(do(http.MethodDelete, "/meshes/mesh-1", "").StatusCode).To(Equal(http.StatusOK))
+
+		// when
+		resp := do(http.MethodGet, "/_audit?type=Mesh&name=mesh-1", "")
+
+		// then
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		list := api_server_types.AuditEntryList{}
+		Expect(json.NewDecoder(resp.Body).Decode(&list)).To(Succeed())
+		Expect(list.Items).To(HaveLen(2))
+		Expect(list.Items[0]).To(MatchFields(IgnoreExtras, Fields{
+			"User":       Equal("mesh-system:admin"),
+			"Operation":  Equal(audit.OperationDelete),
+			"Source":     Equal(audit.SourceAPIServer),
+			"Type":       Equal(core_mesh.MeshType),
+			"Name":       Equal("mesh-1"),
+			"BeforeHash": Not(BeEmpty()),
+			"AfterHash":  BeEmpty(),
+		}))
+		Expect(list.Items[1].Operation).To(Equal(audit.OperationCreate))
+		Expect(list.Items[1].AfterHash).To(Equal(list.Items[0].BeforeHash))
+	})
+
+	It("should limit the number of entries", func() {
+		// given
+		Expect(do(http.MethodPut, "/meshes/mesh-1", `{"type": "Mesh", "name": "mesh-1"}`).StatusCode).To(Equal(http.StatusCreated))
+		Expect(do(http.MethodPut, "/meshes/mesh-2", `{"type": "Mesh", "name": "mesh-2"}`).StatusCode).To(Equal(http.StatusCreated))
+
+		// when
+		resp := do(http.MethodGet, "/_audit?size=1", "")
+
+		// then
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		list := api_server_types.AuditEntryList{}
+		Expect(json.NewDecoder(resp.Body).Decode(&list)).To(Succeed())
+		Expect(list.Items).To(HaveLen(1))
+		Expect(list.Items[0].Name).To(Equal("mesh-2"))
+	})
+})
@@ -10,6 +10,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	api_server "github.com/kumahq/kuma/v3/pkg/api-server"
+	config_api_server "github.com/kumahq/kuma/v3/pkg/config/api-server"
 	"github.com/kumahq/kuma/v3/pkg/core/resources/store"
 	"github.com/kumahq/kuma/v3/pkg/plugins/resources/memory"
 	"github.com/kumahq/kuma/v3/pkg/test"
@@ -33,6 +34,10 @@ var _ = Describe("Endpoints", func() {
 		apiTest(inputFile, apiServer, resourceStore)
 	}, test.EntriesForFolder("resources/inspect/policies/_resources/dataplanes"))
 
+	DescribeTable("simulate policy /meshes/{mesh}/{policyType}/{policyName}/_simulate", func(inputFile string) {
+		apiTest(inputFile, apiServer, resourceStore)
+	}, test.EntriesForFolder("resources/inspect/policies/_simulate"))
+
 	DescribeTable("inspect for policies /meshes/{mesh}/{serviceType}/{policyName}/_hostnames", func(inputFile string) {
 		apiTest(inputFile, apiServer, resourceStore)
 	}, test.EntriesForFolder("resources/inspect/services/_resources/hostnames/zone"))
@@ -49,6 +54,10 @@ var _ = Describe("Endpoints", func() {
 		apiTest(inputFile, apiServer, resourceStore)
 	}, test.EntriesForFolder("resources/inspect/dataplanes/_layout"))
 
+	DescribeTable("preview proxy patch /meshes/{mesh}/dataplanes/{dpName}/_proxypatch", func(inputFile string) {
+		apiTest(inputFile, apiServer, resourceStore)
+	}, test.EntriesForFolder("resources/inspect/dataplanes/_proxypatch"))
+
 	DescribeTable("resources CRUD", func(inputFile string) {
 		apiTest(inputFile, apiServer, resourceStore)
 	}, test.EntriesForFolder("resources/crud"))
@@ -97,6 +106,29 @@ var _ = Describe("Endpoints", func() {
 		Entry(nil, "/who-am-i"),
 	)
 
+	Describe("field manager conflicts on PUT", func() {
+		var conflictsApiServer *api_server.ApiServer
+		var conflictsResourceStore store.ResourceStore
+		stopConflicts := func() {}
+
+		BeforeAll(func() {
+			conflictsResourceStore = memory.NewStore()
+			conflictsApiServer, _, stopConflicts = StartApiServer(NewTestApiServerConfigurer().
+				WithStore(store.NewPaginationStore(conflictsResourceStore)).
+				WithConfigMutator(func(config *config_api_server.ApiServerConfig) {
+					config.FieldManagerConflictsOnPut = true
+				}))
+		})
+
+		AfterAll(func() {
+			stopConflicts()
+		})
+
+		DescribeTable("resources CRUD", func(inputFile string) {
+			apiTest(inputFile, conflictsApiServer, conflictsResourceStore)
+		}, test.EntriesForFolder("resources/crud_field_manager_conflicts"))
+	})
+
 	Describe("global mode", func() {
 		var globalApiServer *api_server.ApiServer
 		var globalResourceStore store.ResourceStore
@@ -114,5 +146,9 @@ var _ = Describe("Endpoints", func() {
 		DescribeTable("inspect for services /meshes/{mesh}/{serviceType}/{policyName}/_hostnames", func(inputFile string) {
 			apiTest(inputFile, globalApiServer, globalResourceStore)
 		}, test.EntriesForFolder("resources/inspect/services/_resources/hostnames/global"))
+
+		DescribeTable("zone sync /zones/{name}/_sync", func(inputFile string) {
+			apiTest(inputFile, globalApiServer, globalResourceStore)
+		}, test.EntriesForFolder("zones/_sync"))
 	})
 }, Ordered)
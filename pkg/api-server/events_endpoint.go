@@ -0,0 +1,195 @@
+package api_server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/emicklei/go-restful/v3"
+	"github.com/pkg/errors"
+
+	api_server_types "github.com/kumahq/kuma/v3/pkg/api-server/types"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/access"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/model"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/registry"
+	rest_errors "github.com/kumahq/kuma/v3/pkg/core/rest/errors"
+	"github.com/kumahq/kuma/v3/pkg/core/user"
+	"github.com/kumahq/kuma/v3/pkg/events"
+	"github.com/kumahq/kuma/v3/pkg/multitenant"
+)
+
+const (
+	eventsSubscriptionName = "api-server-events"
+	eventsKeepAlive        = 15 * time.Second
+	lastEventIDHeader      = "Last-Event-ID"
+)
+
+// eventsEndpoint streams resource change events as Server-Sent Events.
+// Every event has the instance of the control plane and the sequence number of its event bus as its id,
+// so a client can resume the stream with the Last-Event-ID header after it was disconnected.
+// When the stream can't be resumed, i.e. the client reconnected to another instance, the endpoint returns 410 Gone
+// and the client has to subscribe again without the id and list resources again.
+type eventsEndpoint struct {
+	eventBus       events.SequencedListenerFactory
+	resourceAccess access.ResourceAccess
+}
+
+func addEventsEndpoints(ws *restful.WebService, eventBus events.SequencedListenerFactory, resourceAccess access.ResourceAccess) {
+	e := eventsEndpoint{
+		eventBus:       eventBus,
+		resourceAccess: resourceAccess,
+	}
+	ws.Route(
+		ws.GET("/_events").To(e.streamEvents).
+			Doc("Stream resource change events as Server-Sent Events").
+			Param(ws.QueryParameter("type", "Type of resources, can be repeated or comma separated").DataType("string")).
+			Param(ws.QueryParameter("mesh", "Name of a mesh, can be repeated or comma separated").DataType("string")).
+			Param(ws.QueryParameter("since", "ID of the last received event, the Last-Event-ID header takes precedence").DataType("string")).
+			Produces("text/event-stream").
+			Returns(http.StatusOK, "OK", nil).
+			Returns(http.StatusGone, "Events since the event are not available, resync required", nil),
+	)
+}
+
+func (e *eventsEndpoint) streamEvents(request *restful.Request, response *restful.Response) {
+	ctx := request.Request.Context()
+
+	filter, err := eventsFilter(request)
+	if err != nil {
+		rest_errors.HandleError(ctx, response, err, "Invalid filter")
+		return
+	}
+	if tenantID, ok := multitenant.TenantFromCtx(ctx); ok {
+		filter.TenantIDs = []string{tenantID}
+	}
+	since, err := eventsSince(request)
+	if err != nil {
+		rest_errors.HandleError(ctx, response, err, "Invalid event id")
+		return
+	}
+
+	listener, err := e.eventBus.SubscribeSequenced(events.Subscription{
+		Name:   eventsSubscriptionName,
+		Filter: filter,
+		Predicates: []events.Predicate{func(event events.Event) bool {
+			_, ok := event.(events.ResourceChangedEvent)
+			return ok
+		}},
+		Since: since,
+	})
+	if err != nil {
+		if errors.Is(err, events.ResyncRequiredErr) {
+			err = rest_errors.NewGoneError("resync required: events since the event are not available on this instance, subscribe without it and list resources again")
+		}
+		rest_errors.HandleError(ctx, response, err, "Could not subscribe to events")
+		return
+	}
+	defer listener.Close()
+
+	controller := http.NewResponseController(response.ResponseWriter)
+	// the stream is open until the client disconnects, so it can't be bound by the write timeout of the server
+	if err := controller.SetWriteDeadline(time.Time{}); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		log.Error(err, "could not clear write deadline of the event stream")
+	}
+	response.Header().Set("Content-Type", "text/event-stream")
+	response.Header().Set("Cache-Control", "no-cache")
+	response.Header().Set("X-Accel-Buffering", "no")
+	response.WriteHeader(http.StatusOK)
+	if err := controller.Flush(); err != nil {
+		return
+	}
+
+	keepAlive := time.NewTicker(eventsKeepAlive)
+	defer keepAlive.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-keepAlive.C:
+			if _, err := fmt.Fprint(response, ": keep-alive\n\n"); err != nil {
+				return
+			}
+		case event, ok := <-listener.Recv():
+			if !ok {
+				// the client fell behind, it has to reconnect with the id of the last received event
+				return
+			}
+			if !e.canRead(request, event.Event.(events.ResourceChangedEvent)) {
+				continue
+			}
+			if err := writeEvent(response, event); err != nil {
+				return
+			}
+		}
+		if err := controller.Flush(); err != nil {
+			return
+		}
+	}
+}
+
+func (e *eventsEndpoint) canRead(request *restful.Request, event events.ResourceChangedEvent) bool {
+	ctx := request.Request.Context()
+	desc, err := registry.Global().DescriptorFor(event.Type)
+	if err != nil {
+		return false
+	}
+	return e.resourceAccess.ValidateGet(ctx, event.Key, desc, user.FromCtx(ctx)) == nil
+}
+
+func writeEvent(response *restful.Response, event events.SequencedEvent) error {
+	changed := event.Event.(events.ResourceChangedEvent)
+	data, err := json.Marshal(api_server_types.ResourceChangedEvent{
+		Operation: changed.Operation.String(),
+		Type:      string(changed.Type),
+		Mesh:      changed.Key.Mesh,
+		Name:      changed.Key.Name,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(response, "id: %s\nevent: ResourceChanged\ndata: %s\n\n", event.ID(), data)
+	return err
+}
+
+func eventsFilter(request *restful.Request) (events.Filter, error) {
+	filter := events.Filter{
+		Meshes: splitQueryParameters(request, "mesh"),
+	}
+	for _, typ := range splitQueryParameters(request, "type") {
+		desc, err := registry.Global().DescriptorFor(model.ResourceType(typ))
+		if err != nil {
+			return events.Filter{}, rest_errors.NewBadRequestError(fmt.Sprintf("unknown resource type %q", typ))
+		}
+		filter.ResourceTypes = append(filter.ResourceTypes, desc.Name)
+	}
+	return filter, nil
+}
+
+func eventsSince(request *restful.Request) (*events.EventID, error) {
+	value := request.HeaderParameter(lastEventIDHeader)
+	if value == "" {
+		value = request.QueryParameter("since")
+	}
+	if value == "" {
+		return nil, nil
+	}
+	id, err := events.ParseEventID(value)
+	if err != nil {
+		return nil, rest_errors.NewBadRequestError(err.Error())
+	}
+	return &id, nil
+}
+
+func splitQueryParameters(request *restful.Request, name string) []string {
+	var values []string
+	for _, param := range request.QueryParameters(name) {
+		for value := range strings.SplitSeq(param, ",") {
+			if value = strings.TrimSpace(value); value != "" {
+				values = append(values, value)
+			}
+		}
+	}
+	return values
+}
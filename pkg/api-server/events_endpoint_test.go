@@ -0,0 +1,156 @@
+package api_server_test
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	api_server "github.com/kumahq/kuma/v3/pkg/api-server"
+	core_mesh "github.com/kumahq/kuma/v3/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/model"
+	"github.com/kumahq/kuma/v3/pkg/events"
+	core_metrics "github.com/kumahq/kuma/v3/pkg/metrics"
+)
+
+var _ = Describe("Events Endpoint", func() {
+	var apiServer *api_server.ApiServer
+	var eventBus events.EventBus
+	var sent events.SequencedListener
+	var stop func()
+
+	BeforeEach(func() {
+		metrics, err := core_metrics.NewMetrics("")
+		Expect(err).ToNot(HaveOccurred())
+		eventBus, err = events.NewEventBus(10, metrics, events.WithHistorySize(3))
+		Expect(err).ToNot(HaveOccurred())
+		sent, err = eventBus.SubscribeSequenced(events.Subscription{})
+		Expect(err).ToNot(HaveOccurred())
+		apiServer, _, stop = StartApiServer(NewTestApiServerConfigurer().WithEventBus(eventBus))
+	})
+
+	AfterEach(func() {
+		sent.Close()
+		stop()
+	})
+
+	// send sends the event and returns its id assigned by the event bus
+	send := func(event events.Event) events.EventID {
+		eventBus.Send(event)
+		return (<-sent.Recv()).ID()
+	}
+
+	changed := func(op events.Op, typ model.ResourceType, mesh, name string) events.ResourceChangedEvent {
+		return events.ResourceChangedEvent{
+			Operation: op,
+			Type:      typ,
+			Key:       model.ResourceKey{Mesh: mesh, Name: name},
+		}
+	}
+
+	subscribe := func(query string, header http.Header) *http.Response {
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, fmt.Sprintf("http://%s/_events%s", apiServer.Address(), query), http.NoBody)
+		Expect(err).ToNot(HaveOccurred())
+		for k, v := range header {
+			req.Header[k] = v
+		}
+		resp, err := http.DefaultClient.Do(req)
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(resp.Body.Close)
+		return resp
+	}
+
+	readEvent := func(reader *bufio.Reader) string {
+		var lines []string
+		for {
+			line, err := reader.ReadString('\n')
+			Expect(err).ToNot(HaveOccurred())
+			if line == "\n" {
+				return strings.Join(lines, "")
+			}
+			lines = append(lines, line)
+		}
+	}
+
+	It("should stream filtered resource changed events", func() {
+		// given
+		resp := subscribe("?type=Dataplane&mesh=mesh-1", nil)
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		Expect(resp.Header.Get("Content-Type")).To(Equal("text/event-stream"))
+
+		// when
+		send(changed(events.Create, core_mesh.DataplaneType, "mesh-2", "dp-1"))
+		send(changed(events.Create, core_mesh.MeshType, "", "mesh-1"))
+		send(events.TriggerInsightsComputationEvent{})
+		id := send(changed(events.Update, core_mesh.DataplaneType, "mesh-1", "dp-2"))
+
+		// then
+		reader := bufio.NewReader(resp.Body)
+		Expect(id.Seq).To(Equal(uint64(4)))
+		Expect(readEvent(reader)).To(Equal(fmt.Sprintf(`id: %s
+event: ResourceChanged
+data: {"operation":"Update","type":"Dataplane","mesh":"mesh-1","name":"dp-2"}
+`, id)))
+	})
+
+	It("should resume the stream from the last event id", func() {
+		// given
+		since := send(changed(events.Create, core_mesh.DataplaneType, "mesh-1", "dp-1"))
+		id := send(changed(events.Delete, core_mesh.DataplaneType, "mesh-1", "dp-2"))
+
+		// when
+		resp := subscribe("", http.Header{"Last-Event-ID": []string{since.String()}})
+
+		// then
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		reader := bufio.NewReader(resp.Body)
+		Expect(readEvent(reader)).To(Equal(fmt.Sprintf(`id: %s
+event: ResourceChanged
+data: {"operation":"Delete","type":"Dataplane","mesh":"mesh-1","name":"dp-2"}
+`, id)))
+	})
+
+	It("should return gone when events since the event are not in the history", func() {
+		// given
+		since := send(changed(events.Create, core_mesh.DataplaneType, "mesh-1", "dp-0"))
+		for i := range 4 {
+			send(changed(events.Create, core_mesh.DataplaneType, "mesh-1", fmt.Sprintf("dp-%d", i+1)))
+		}
+
+		// when
+		resp := subscribe("?since="+since.String(), nil)
+
+		// then
+		Expect(resp.StatusCode).To(Equal(http.StatusGone))
+	})
+
+	It("should return gone when the event was issued by another instance", func() {
+		// given
+		id := send(changed(events.Create, core_mesh.DataplaneType, "mesh-1", "dp-1"))
+
+		// when
+		resp := subscribe("", http.Header{"Last-Event-ID": []string{events.EventID{InstanceID: "other-instance", Seq: id.Seq}.String()}})
+
+		// then
+		Expect(resp.StatusCode).To(Equal(http.StatusGone))
+		body, err := io.ReadAll(resp.Body)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(body)).To(ContainSubstring("resync required"))
+	})
+
+	It("should reject unknown resource types", func() {
+		// when
+		resp := subscribe("?type=NotExisting", nil)
+
+		// then
+		Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+		body, err := io.ReadAll(resp.Body)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(body)).To(ContainSubstring(`unknown resource type \"NotExisting\"`))
+	})
+})
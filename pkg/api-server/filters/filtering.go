@@ -81,10 +81,19 @@ func labelFilter(request *restful.Request) (store.ListFilterFunc, error) {
 // For example we could make a filter that works on top level targetRef by looking at the descriptor info
 func Resource(resDescriptor core_model.ResourceTypeDescriptor) func(request *restful.Request) (store.ListFilterFunc, error) {
 	return func(request *restful.Request) (store.ListFilterFunc, error) {
-		genericFilter, err := labelFilter(request)
+		labels, err := labelFilter(request)
 		if err != nil {
 			return nil, err
 		}
+		labelSelector, err := labelSelectorFilter(request)
+		if err != nil {
+			return nil, err
+		}
+		fieldSelector, err := fieldSelectorFilter(request)
+		if err != nil {
+			return nil, err
+		}
+		genericFilter := AllOf(labels, labelSelector, fieldSelector)
 		switch resDescriptor.Name {
 		case mesh.DataplaneType:
 			gatewayFilter, err := gatewayModeFilterFromParameter(request)
@@ -0,0 +1,187 @@
+package filters
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/emicklei/go-restful/v3"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+
+	core_model "github.com/kumahq/kuma/v3/pkg/core/resources/model"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/store"
+	"github.com/kumahq/kuma/v3/pkg/core/validators"
+)
+
+const (
+	LabelSelectorParam = "labelSelector"
+	FieldSelectorParam = "fieldSelector"
+
+	fieldName       = "name"
+	fieldMesh       = "mesh"
+	fieldSpecPrefix = "spec."
+)
+
+// labelSelectorFilter returns a store filter that matches labels of resources against a selector
+// in the same format as Kubernetes label selectors, i.e. `kuma.io/zone=east,kuma.io/origin!=global,env in (dev,test)`
+func labelSelectorFilter(request *restful.Request) (store.ListFilterFunc, error) {
+	return LabelSelectorFilter(validators.RootedAt(request.SelectedRoutePath()).Field(LabelSelectorParam), request.QueryParameter(LabelSelectorParam))
+}
+
+// LabelSelectorFilter returns a store filter for the label selector, violations are reported at the path.
+// It returns nil filter for an empty selector.
+func LabelSelectorFilter(path validators.PathBuilder, value string) (store.ListFilterFunc, error) {
+	if value == "" {
+		return nil, nil
+	}
+	selector, err := labels.Parse(value)
+	if err != nil {
+		verr := validators.ValidationError{}
+		verr.AddViolationAt(path, err.Error())
+		return nil, &verr
+	}
+	return func(rs core_model.Resource) bool {
+		return selector.Matches(labels.Set(rs.GetMeta().GetLabels()))
+	}, nil
+}
+
+// fieldSelectorFilter returns a store filter that matches fields of resources against a selector
+// in the same format as Kubernetes field selectors, i.e. `mesh=default,spec.networking.address!=10.0.0.1`.
+// Supported fields are `name`, `mesh` and paths to scalar values in the spec prefixed with `spec.`,
+// using the same field names as the JSON representation of the resource. Elements of lists are selected by their index.
+func fieldSelectorFilter(request *restful.Request) (store.ListFilterFunc, error) {
+	return FieldSelectorFilter(validators.RootedAt(request.SelectedRoutePath()).Field(FieldSelectorParam), request.QueryParameter(FieldSelectorParam))
+}
+
+// FieldSelectorFilter returns a store filter for the field selector, violations are reported at the path.
+// It returns nil filter for an empty selector.
+func FieldSelectorFilter(path validators.PathBuilder, value string) (store.ListFilterFunc, error) {
+	if value == "" {
+		return nil, nil
+	}
+	verr := validators.ValidationError{}
+	selector, err := fields.ParseSelector(value)
+	if err != nil {
+		verr.AddViolationAt(path, err.Error())
+		return nil, &verr
+	}
+	requirements := selector.Requirements()
+	inspectsSpec := false
+	for _, requirement := range requirements {
+		switch {
+		case requirement.Field == fieldName, requirement.Field == fieldMesh:
+		case strings.HasPrefix(requirement.Field, fieldSpecPrefix) && len(requirement.Field) > len(fieldSpecPrefix):
+			inspectsSpec = true
+		default:
+			verr.AddViolationAt(path, fmt.Sprintf("field %q is not supported, use %q, %q or a path starting with %q", requirement.Field, fieldName, fieldMesh, fieldSpecPrefix))
+		}
+	}
+	if verr.HasViolations() {
+		return nil, &verr
+	}
+	return func(rs core_model.Resource) bool {
+		var spec any
+		if inspectsSpec {
+			bytes, err := core_model.ToJSON(rs.GetSpec())
+			if err != nil {
+				return false
+			}
+			if err := json.Unmarshal(bytes, &spec); err != nil {
+				return false
+			}
+		}
+		for _, requirement := range requirements {
+			var actual string
+			switch requirement.Field {
+			case fieldName:
+				actual = rs.GetMeta().GetName()
+			case fieldMesh:
+				actual = rs.GetMeta().GetMesh()
+			default:
+				actual = specFieldValue(spec, strings.Split(strings.TrimPrefix(requirement.Field, fieldSpecPrefix), "."))
+			}
+			switch requirement.Operator {
+			case selection.Equals, selection.DoubleEquals:
+				if actual != requirement.Value {
+					return false
+				}
+			case selection.NotEquals:
+				if actual == requirement.Value {
+					return false
+				}
+			default:
+				return false
+			}
+		}
+		return true
+	}, nil
+}
+
+// specFieldValue returns the value of a scalar under the path in a decoded JSON document.
+// Missing fields and fields that are not scalars are returned as an empty string.
+func specFieldValue(value any, path []string) string {
+	for _, segment := range path {
+		switch v := value.(type) {
+		case map[string]any:
+			value = v[segment]
+		case []any:
+			idx, err := strconv.Atoi(segment)
+			if err != nil || idx < 0 || idx >= len(v) {
+				return ""
+			}
+			value = v[idx]
+		default:
+			return ""
+		}
+	}
+	switch v := value.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		return ""
+	}
+}
+
+// AllOf returns a store filter that matches when all non nil filters match
+func AllOf(filters ...store.ListFilterFunc) store.ListFilterFunc {
+	var nonNil []store.ListFilterFunc
+	for _, filter := range filters {
+		if filter != nil {
+			nonNil = append(nonNil, filter)
+		}
+	}
+	switch len(nonNil) {
+	case 0:
+		return nil
+	case 1:
+		return nonNil[0]
+	}
+	return func(rs core_model.Resource) bool {
+		for _, filter := range nonNil {
+			if !filter(rs) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// HasSelector returns true when the request restricts resources with a label or field selector
+func HasSelector(request *restful.Request) bool {
+	if request.QueryParameter(LabelSelectorParam) != "" || request.QueryParameter(FieldSelectorParam) != "" {
+		return true
+	}
+	for k := range request.Request.URL.Query() {
+		if strings.HasPrefix(k, "filter[") {
+			return true
+		}
+	}
+	return false
+}
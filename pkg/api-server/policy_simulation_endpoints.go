@@ -0,0 +1,355 @@
+package api_server
+
+import (
+	"context"
+	"io"
+	"slices"
+
+	"github.com/emicklei/go-restful/v3"
+	"github.com/pkg/errors"
+
+	api_types "github.com/kumahq/kuma/v3/api/openapi/types"
+	api_common "github.com/kumahq/kuma/v3/api/openapi/types/common"
+	api_server_types "github.com/kumahq/kuma/v3/pkg/api-server/types"
+	config_core "github.com/kumahq/kuma/v3/pkg/config/core"
+	core_plugins "github.com/kumahq/kuma/v3/pkg/core/plugins"
+	core_mesh "github.com/kumahq/kuma/v3/pkg/core/resources/apis/mesh"
+	core_model "github.com/kumahq/kuma/v3/pkg/core/resources/model"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/model/rest"
+	rest_v1alpha1 "github.com/kumahq/kuma/v3/pkg/core/resources/model/rest/v1alpha1"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/registry"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/store"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/validator"
+	rest_errors "github.com/kumahq/kuma/v3/pkg/core/rest/errors"
+	"github.com/kumahq/kuma/v3/pkg/core/user"
+	core_xds "github.com/kumahq/kuma/v3/pkg/core/xds"
+	"github.com/kumahq/kuma/v3/pkg/core/xds/inspect"
+	"github.com/kumahq/kuma/v3/pkg/plugins/policies/core/matchers"
+	meshproxypatch_api "github.com/kumahq/kuma/v3/pkg/plugins/policies/meshproxypatch/api/v1alpha1"
+	xds_context "github.com/kumahq/kuma/v3/pkg/xds/context"
+)
+
+// simulatePolicy computes the impact of creating or updating the policy from the request body without persisting it.
+// It returns dataplanes matched by the policy before or after the change with a diff of rules of the policy type
+// and, on a zone control plane, a diff of the XDS config.
+func (r *resourceEndpoints) simulatePolicy(request *restful.Request, response *restful.Response) {
+	ctx := request.Request.Context()
+	name := request.PathParameter("name")
+	meshName, err := r.meshFromRequest(request)
+	if err != nil {
+		rest_errors.HandleError(ctx, response, err, "Failed to retrieve Mesh")
+		return
+	}
+
+	if err := r.resourceAccess.ValidateGet(
+		ctx,
+		core_model.ResourceKey{Mesh: meshName, Name: name},
+		r.descriptor,
+		user.FromCtx(ctx),
+	); err != nil {
+		rest_errors.HandleError(ctx, response, err, "Access Denied")
+		return
+	}
+
+	bodyBytes, err := io.ReadAll(request.Request.Body)
+	if err != nil {
+		rest_errors.HandleError(ctx, response, err, "Could not process a resource")
+		return
+	}
+	resourceRest, err := rest.JSON.Unmarshal(bodyBytes, r.descriptor)
+	if err != nil {
+		rest_errors.HandleError(ctx, response, err, "Could not process a resource")
+		return
+	}
+	if err := r.validateResourceRequest(name, meshName, resourceRest); err != nil {
+		rest_errors.HandleError(ctx, response, err, "Could not process a resource")
+		return
+	}
+
+	current := r.descriptor.NewObject()
+	created := false
+	if err := r.resManager.Get(ctx, current, store.GetByKey(name, meshName)); err != nil {
+		if !store.IsNotFound(err) {
+			rest_errors.HandleError(ctx, response, err, "Failed to find a resource")
+			return
+		}
+		current = nil
+		created = true
+	}
+
+	simulated := r.descriptor.NewObject()
+	if err := simulated.SetSpec(resourceRest.GetSpec()); err != nil {
+		rest_errors.HandleError(ctx, response, err, "Could not process a resource")
+		return
+	}
+	labels, err := r.computeLabels(r.descriptor, simulated.GetSpec(), resourceRest.GetMeta(), meshName, name)
+	if err != nil {
+		rest_errors.HandleError(ctx, response, err, "Could not compute labels for a resource")
+		return
+	}
+	meta := rest_v1alpha1.ResourceMeta{
+		Type:   string(r.descriptor.Name),
+		Mesh:   meshName,
+		Name:   name,
+		Labels: labels,
+	}
+	if current != nil {
+		meta.CreationTime = current.GetMeta().GetCreationTime()
+	}
+	simulated.SetMeta(meta)
+	if err := validator.Validate(simulated); err != nil {
+		rest_errors.HandleError(ctx, response, err, "Could not process a resource")
+		return
+	}
+
+	baseMeshContext, err := r.inspect.meshContextBuilder.BuildBaseMeshContextIfChanged(ctx, meshName, nil)
+	if err != nil {
+		rest_errors.HandleError(ctx, response, err, "Failed to build Mesh context")
+		return
+	}
+	resources := baseMeshContext.Resources()
+	simulatedResources := withResource(resources, r.descriptor, simulated)
+
+	dataplanes := &core_mesh.DataplaneResourceList{}
+	if err := r.resManager.List(ctx, dataplanes, store.ListByMesh(meshName)); err != nil {
+		rest_errors.HandleError(ctx, response, err, "Failed to list dataplanes")
+		return
+	}
+
+	out := api_server_types.PolicySimulation{
+		Created:    created,
+		Dataplanes: []api_server_types.PolicySimulationDataplane{},
+	}
+	var affected []*core_mesh.DataplaneResource
+	for _, dp := range dataplanes.Items {
+		matchedBefore := false
+		if current != nil {
+			if matchedBefore, err = matchers.PolicyMatches(current, dp, resources); err != nil {
+				rest_errors.HandleError(ctx, response, err, "Failed to match policy")
+				return
+			}
+		}
+		matchedAfter, err := matchers.PolicyMatches(simulated, dp, simulatedResources)
+		if err != nil {
+			rest_errors.HandleError(ctx, response, err, "Failed to match policy")
+			return
+		}
+		if !matchedBefore && !matchedAfter {
+			continue
+		}
+		entry := api_server_types.PolicySimulationDataplane{
+			Dataplane: api_server_types.ResourceKeyEntryFromModelKey(core_model.MetaToResourceKey(dp.GetMeta())),
+			Match:     api_server_types.PolicySimulationMatchUnchanged,
+		}
+		switch {
+		case !matchedBefore:
+			entry.Match = api_server_types.PolicySimulationMatchAdded
+		case !matchedAfter:
+			entry.Match = api_server_types.PolicySimulationMatchRemoved
+		}
+		if entry.RulesDiff, err = r.rulesDiff(dp, resources, simulatedResources); err != nil {
+			rest_errors.HandleError(ctx, response, err, "Failed to compute rules")
+			return
+		}
+		out.Dataplanes = append(out.Dataplanes, entry)
+		affected = append(affected, dp)
+	}
+
+	if r.mode != config_core.Global && len(affected) > 0 {
+		mc, err := r.inspect.meshContextBuilder.Build(ctx, meshName)
+		if err != nil {
+			rest_errors.HandleError(ctx, response, err, "Failed to build mesh context")
+			return
+		}
+		simulatedMc := withSimulatedResource(mc, r.descriptor, simulated)
+		for i, dp := range affected {
+			diff, err := r.xdsDiff(ctx, dp, mc, simulatedMc)
+			if err != nil {
+				out.Dataplanes[i].XdsError = err.Error()
+				continue
+			}
+			out.Dataplanes[i].XdsDiff = &diff
+		}
+	}
+
+	if err := response.WriteAsJson(out); err != nil {
+		rest_errors.HandleError(ctx, response, err, "Failed writing response")
+	}
+}
+
+// previewProxyPatch returns the proxy config of the dataplane with the MeshProxyPatch from the request body applied
+// and the diff against the current proxy config. The policy is not persisted. It replaces the MeshProxyPatch with the
+// same name, so it's matched by its targetRef and ordered with other MeshProxyPatches as if it was stored.
+func (r *resourceEndpoints) previewProxyPatch(request *restful.Request, response *restful.Response) {
+	ctx := request.Request.Context()
+	name := request.PathParameter("name")
+	meshName, err := r.meshFromRequest(request)
+	if err != nil {
+		rest_errors.HandleError(ctx, response, err, "Failed to retrieve Mesh")
+		return
+	}
+
+	if err := r.resourceAccess.ValidateGet(
+		ctx,
+		core_model.ResourceKey{Mesh: meshName, Name: name},
+		r.descriptor,
+		user.FromCtx(ctx),
+	); err != nil {
+		rest_errors.HandleError(ctx, response, err, "Access Denied")
+		return
+	}
+
+	bodyBytes, err := io.ReadAll(request.Request.Body)
+	if err != nil {
+		rest_errors.HandleError(ctx, response, err, "Could not process a resource")
+		return
+	}
+	desc := meshproxypatch_api.MeshProxyPatchResourceTypeDescriptor
+	resourceRest, err := rest.JSON.Unmarshal(bodyBytes, desc)
+	if err != nil {
+		rest_errors.HandleError(ctx, response, err, "Could not process a resource")
+		return
+	}
+	patchName := resourceRest.GetMeta().GetName()
+	if patchName == "" {
+		rest_errors.HandleError(ctx, response, rest_errors.NewBadRequestError("name of the MeshProxyPatch is required"), "Could not process a resource")
+		return
+	}
+	patch := meshproxypatch_api.NewMeshProxyPatchResource()
+	if err := patch.SetSpec(resourceRest.GetSpec()); err != nil {
+		rest_errors.HandleError(ctx, response, err, "Could not process a resource")
+		return
+	}
+	labels, err := r.computeLabels(desc, patch.GetSpec(), resourceRest.GetMeta(), meshName, patchName)
+	if err != nil {
+		rest_errors.HandleError(ctx, response, err, "Could not compute labels for a resource")
+		return
+	}
+	patch.SetMeta(rest_v1alpha1.ResourceMeta{
+		Type:   string(desc.Name),
+		Mesh:   meshName,
+		Name:   patchName,
+		Labels: labels,
+	})
+	if err := validator.Validate(patch); err != nil {
+		rest_errors.HandleError(ctx, response, err, "Could not process a resource")
+		return
+	}
+
+	mc, err := r.inspect.meshContextBuilder.Build(ctx, meshName)
+	if err != nil {
+		rest_errors.HandleError(ctx, response, err, "Failed to build mesh context")
+		return
+	}
+	currentConfig, config, err := r.xdsConfigs(ctx, core_model.ResourceKey{Mesh: meshName, Name: name}, mc, withSimulatedResource(mc, desc, patch))
+	if err != nil {
+		rest_errors.HandleError(ctx, response, err, "Failed to inspect proxy config")
+		return
+	}
+	diff, err := inspect.Diff(currentConfig, config)
+	if err != nil {
+		rest_errors.HandleError(ctx, response, err, "Failed to compute diff")
+		return
+	}
+
+	out := &api_types.GetDataplaneXDSConfigResponse{
+		Xds:  config,
+		Diff: &diff,
+	}
+	if err := response.WriteAsJson(out); err != nil {
+		rest_errors.HandleError(ctx, response, err, "Failed writing response")
+	}
+}
+
+// rulesDiff computes a diff between rules of the policy type for the dataplane before and after the change.
+func (r *resourceEndpoints) rulesDiff(dp *core_mesh.DataplaneResource, before, after xds_context.Resources) ([]api_common.JsonPatchItem, error) {
+	idx := slices.IndexFunc(core_plugins.Plugins().PolicyPlugins(), func(p core_plugins.RegisteredPolicyPlugin) bool {
+		return p.Name == core_plugins.PluginName(r.descriptor.KumactlArg)
+	})
+	if idx < 0 {
+		return nil, errors.Errorf("policy plugin for %s is not registered", r.descriptor.Name)
+	}
+	plugin := core_plugins.Plugins().PolicyPlugins()[idx].Plugin
+	rule := func(resources xds_context.Resources) (*api_common.InspectRule, error) {
+		res, err := plugin.MatchedPolicies(dp, resources)
+		if err != nil {
+			return nil, err
+		}
+		return toInspectRule(dp, res), nil
+	}
+	beforeRule, err := rule(before)
+	if err != nil {
+		return nil, err
+	}
+	afterRule, err := rule(after)
+	if err != nil {
+		return nil, err
+	}
+	return inspect.DiffJSON(beforeRule, afterRule)
+}
+
+func (r *resourceEndpoints) xdsDiff(ctx context.Context, dp *core_mesh.DataplaneResource, before, after xds_context.MeshContext) ([]api_common.JsonPatchItem, error) {
+	beforeConfig, afterConfig, err := r.xdsConfigs(ctx, core_model.MetaToResourceKey(dp.GetMeta()), before, after)
+	if err != nil {
+		return nil, err
+	}
+	return inspect.Diff(beforeConfig, afterConfig)
+}
+
+// xdsConfigs returns the XDS config of the dataplane generated from the mesh context before and after the change.
+func (r *resourceEndpoints) xdsConfigs(ctx context.Context, dpKey core_model.ResourceKey, before, after xds_context.MeshContext) (inspect.ProxyConfig, inspect.ProxyConfig, error) {
+	dataplaneInsight := core_mesh.NewDataplaneInsightResource()
+	if err := r.resManager.Get(ctx, dataplaneInsight, store.GetByKey(dpKey.Name, dpKey.Mesh)); err != nil && !store.IsNotFound(err) {
+		return nil, nil, err
+	}
+	metadata := core_xds.DataplaneMetadataFromXdsMetadata(dataplaneInsight.Spec.GetMetadata())
+	config := func(mc xds_context.MeshContext) (inspect.ProxyConfig, error) {
+		inspector, err := inspect.NewProxyConfigInspector(mc, metadata, r.zoneName, r.inspect.knownInternalAddresses, r.inspect.xdsHooks...)
+		if err != nil {
+			return nil, err
+		}
+		return inspector.Get(ctx, dpKey.Name, false)
+	}
+	beforeConfig, err := config(before)
+	if err != nil {
+		return nil, nil, err
+	}
+	afterConfig, err := config(after)
+	if err != nil {
+		return nil, nil, err
+	}
+	return beforeConfig, afterConfig, nil
+}
+
+// withSimulatedResource returns a copy of the mesh context in which the resource replaces the one with the same name
+// or is added, so policies are matched and applied as if the resource was stored.
+func withSimulatedResource(mc xds_context.MeshContext, desc core_model.ResourceTypeDescriptor, resource core_model.Resource) xds_context.MeshContext {
+	simulated := mc
+	simulated.Hash = ""
+	simulated.PolicyMatchingHash = ""
+	simulated.Resources = withResource(mc.Resources, desc, resource)
+	if mc.BaseMeshContext != nil {
+		simulatedBase := *mc.BaseMeshContext
+		simulatedBase.ResourceMap = withResource(simulatedBase.Resources(), desc, resource).MeshLocalResources
+		simulated.BaseMeshContext = &simulatedBase
+	}
+	return simulated
+}
+
+// withResource returns a copy of resources in which the resource replaces the one with the same name or is added.
+func withResource(resources xds_context.Resources, desc core_model.ResourceTypeDescriptor, resource core_model.Resource) xds_context.Resources {
+	out := xds_context.NewResources()
+	for typ, list := range resources.MeshLocalResources {
+		out.MeshLocalResources[typ] = list
+	}
+	list := registry.Global().MustNewList(desc.Name)
+	for _, item := range resources.ListOrEmpty(desc.Name).GetItems() {
+		if core_model.MetaToResourceKey(item.GetMeta()) == core_model.MetaToResourceKey(resource.GetMeta()) {
+			continue
+		}
+		_ = list.AddItem(item)
+	}
+	_ = list.AddItem(resource)
+	out.MeshLocalResources[desc.Name] = list
+	return out
+}
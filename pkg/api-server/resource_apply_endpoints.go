@@ -0,0 +1,330 @@
+package api_server
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"maps"
+	"net/http"
+	"slices"
+
+	"github.com/emicklei/go-restful/v3"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	mesh_proto "github.com/kumahq/kuma/v3/api/mesh/v1alpha1"
+	api_common "github.com/kumahq/kuma/v3/api/openapi/types/common"
+	api_server_types "github.com/kumahq/kuma/v3/pkg/api-server/types"
+	"github.com/kumahq/kuma/v3/pkg/core/audit"
+	core_mesh "github.com/kumahq/kuma/v3/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/manager"
+	core_model "github.com/kumahq/kuma/v3/pkg/core/resources/model"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/model/rest"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/store"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/validator"
+	rest_errors "github.com/kumahq/kuma/v3/pkg/core/rest/errors"
+	"github.com/kumahq/kuma/v3/pkg/core/user"
+	"github.com/kumahq/kuma/v3/pkg/core/validators"
+	"github.com/kumahq/kuma/v3/pkg/core/xds/inspect"
+)
+
+// applyParams are query parameters of the server-side apply.
+type applyParams struct {
+	fieldManager    string
+	force           bool
+	dryRun          bool
+	resourceVersion string
+}
+
+func applyParamsFromRequest(request *restful.Request) (applyParams, error) {
+	var verr validators.ValidationError
+	path := validators.RootedAt(request.SelectedRoutePath())
+	params := applyParams{
+		fieldManager:    request.QueryParameter("fieldManager"),
+		force:           boolQueryParameter(request, "force", path, &verr),
+		dryRun:          boolQueryParameter(request, "dryRun", path, &verr),
+		resourceVersion: request.QueryParameter("resourceVersion"),
+	}
+	if params.fieldManager == "" {
+		verr.AddViolationAt(path.Field("fieldManager"), validators.MustBeDefined)
+	}
+	// the manager is stored in a label, so it has to be a valid label value
+	for _, msg := range validation.IsValidLabelValue(params.fieldManager) {
+		verr.AddViolationAt(path.Field("fieldManager"), msg)
+	}
+	return params, verr.OrNil()
+}
+
+// applyView is the part of a resource set by the apply, the diff of the apply is computed between views
+// of the resource before and after it. The field manager label is left out as it's not set by the user.
+type applyView struct {
+	Labels map[string]string `json:"labels,omitempty"`
+	Spec   json.RawMessage   `json:"spec,omitempty"`
+}
+
+func newApplyView(labels map[string]string, spec core_model.ResourceSpec) (applyView, error) {
+	specJSON, err := core_model.ToJSON(spec)
+	if err != nil {
+		return applyView{}, err
+	}
+	labels = maps.Clone(labels)
+	delete(labels, mesh_proto.FieldManagerLabel)
+	return applyView{Labels: labels, Spec: specJSON}, nil
+}
+
+// applyResource is the server-side apply of a resource. Like PUT, the applied resource replaces labels and spec of
+// the existing one, but the manager which applied it is stored in the kuma.io/field-manager label. Ownership is
+// tracked for the whole resource, so changing any field of a resource applied by another manager is a conflict
+// unless the apply is forced. Fields which are not changed by the apply are not conflicts. PUT which changes the
+// resource removes the manager, or fails with the same conflict if configured, see updateResource.
+func (r *resourceCrudHandler) applyResource(request *restful.Request, response *restful.Response) {
+	ctx := request.Request.Context()
+	name := request.PathParameter("name")
+	meshName, err := r.meshFromRequest(request)
+	if err != nil {
+		rest_errors.HandleError(ctx, response, err, "Failed to retrieve Mesh")
+		return
+	}
+
+	params, err := applyParamsFromRequest(request)
+	if err != nil {
+		rest_errors.HandleError(ctx, response, err, "Could not apply a resource")
+		return
+	}
+
+	bodyBytes, err := io.ReadAll(request.Request.Body)
+	if err != nil {
+		rest_errors.HandleError(ctx, response, err, "Could not process a resource")
+		return
+	}
+	resourceRest, err := rest.JSON.Unmarshal(bodyBytes, r.descriptor)
+	if err != nil {
+		rest_errors.HandleError(ctx, response, err, "Could not process a resource")
+		return
+	}
+	if err := r.validateResourceRequest(name, meshName, resourceRest); err != nil {
+		rest_errors.HandleError(ctx, response, err, "Could not process a resource")
+		return
+	}
+
+	current := r.descriptor.NewObject()
+	exists := true
+	if err := r.resManager.Get(ctx, current, store.GetByKey(name, meshName)); err != nil {
+		if !store.IsNotFound(err) {
+			rest_errors.HandleError(ctx, response, err, "Failed to find a resource")
+			return
+		}
+		exists = false
+	}
+	if params.resourceVersion != "" && (!exists || current.GetMeta().GetVersion() != params.resourceVersion) {
+		rest_errors.HandleError(ctx, response, store.ErrorResourceConflict(r.descriptor.Name, name, meshName), "Could not apply a resource")
+		return
+	}
+
+	if exists {
+		r.applyUpdate(ctx, params, current, resourceRest, meshName, response)
+	} else {
+		r.applyCreate(ctx, params, name, meshName, resourceRest, response)
+	}
+}
+
+func (r *resourceCrudHandler) applyCreate(
+	ctx context.Context,
+	params applyParams,
+	name string,
+	meshName string,
+	resRest rest.Resource,
+	response *restful.Response,
+) {
+	if err := r.resourceAccess.ValidateCreate(
+		ctx,
+		core_model.ResourceKey{Mesh: meshName, Name: name},
+		resRest.GetSpec(),
+		r.descriptor,
+		user.FromCtx(ctx),
+	); err != nil {
+		rest_errors.HandleError(ctx, response, err, "Access Denied")
+		return
+	}
+
+	r.clearMeshTrustOrigin(resRest, meshName, name)
+
+	res := r.descriptor.NewObject()
+	_ = res.SetSpec(resRest.GetSpec())
+	res.SetMeta(resRest.GetMeta())
+	if err := r.validateWorkloadLabel(res); err != nil {
+		rest_errors.HandleError(ctx, response, err, "Invalid workload label")
+		return
+	}
+
+	labels, err := r.computeLabels(res.Descriptor(), res.GetSpec(), res.GetMeta(), meshName, name)
+	if err != nil {
+		rest_errors.HandleError(ctx, response, err, "Could not compute labels for a resource")
+		return
+	}
+	labels[mesh_proto.FieldManagerLabel] = params.fieldManager
+
+	after, err := newApplyView(labels, res.GetSpec())
+	if err != nil {
+		rest_errors.HandleError(ctx, response, err, "Could not apply a resource")
+		return
+	}
+	diff, err := inspect.DiffJSON(applyView{}, after)
+	if err != nil {
+		rest_errors.HandleError(ctx, response, err, "Could not apply a resource")
+		return
+	}
+
+	resp := api_server_types.ApplyResponse{
+		Operation:    api_server_types.ApplyOperationCreated,
+		DryRun:       params.dryRun,
+		FieldManager: params.fieldManager,
+		Diff:         diff,
+		Warnings:     core_model.Deprecations(res),
+	}
+	if params.dryRun {
+		if err := r.validateDryRunCreate(ctx, res, meshName); err != nil {
+			rest_errors.HandleError(ctx, response, err, "Failed to create a resource")
+			return
+		}
+	} else {
+		if err := r.resManager.Create(ctx, res, store.CreateByKey(name, meshName), store.CreateWithLabels(labels)); err != nil {
+			rest_errors.HandleError(ctx, response, err, "Failed to create a resource")
+			return
+		}
+		resp.ResourceVersion = res.GetMeta().GetVersion()
+	}
+	if err := response.WriteHeaderAndJson(http.StatusCreated, resp, "application/json"); err != nil {
+		log.Error(err, "Could not write the apply response")
+	}
+}
+
+func (r *resourceCrudHandler) applyUpdate(
+	ctx context.Context,
+	params applyParams,
+	current core_model.Resource,
+	resRest rest.Resource,
+	meshName string,
+	response *restful.Response,
+) {
+	name := current.GetMeta().GetName()
+	if err := r.resourceAccess.ValidateUpdate(
+		ctx,
+		core_model.ResourceKey{Mesh: current.GetMeta().GetMesh(), Name: name},
+		current.GetSpec(),
+		resRest.GetSpec(),
+		r.descriptor,
+		user.FromCtx(ctx),
+	); err != nil {
+		rest_errors.HandleError(ctx, response, err, "Access Denied")
+		return
+	}
+
+	r.clearMeshTrustOrigin(resRest, meshName, name)
+
+	currentLabels, err := r.computeLabels(current.Descriptor(), current.GetSpec(), current.GetMeta(), meshName, name)
+	if err != nil {
+		rest_errors.HandleError(ctx, response, err, "Could not compute current labels")
+		return
+	}
+	labels, err := r.computeLabels(current.Descriptor(), resRest.GetSpec(), resRest.GetMeta(), meshName, name)
+	if err != nil {
+		rest_errors.HandleError(ctx, response, err, "Could not compute labels for a resource")
+		return
+	}
+	if validationErr := r.validateImmutableLabels(currentLabels, labels); validationErr.HasViolations() {
+		var err validators.ValidationError
+		err.AddError("labels", validationErr)
+		rest_errors.HandleError(ctx, response, &err, "Could not apply a resource")
+		return
+	}
+	labels[mesh_proto.FieldManagerLabel] = params.fieldManager
+
+	before, err := newApplyView(current.GetMeta().GetLabels(), current.GetSpec())
+	if err != nil {
+		rest_errors.HandleError(ctx, response, err, "Could not apply a resource")
+		return
+	}
+	after, err := newApplyView(labels, resRest.GetSpec())
+	if err != nil {
+		rest_errors.HandleError(ctx, response, err, "Could not apply a resource")
+		return
+	}
+	diff, err := inspect.DiffJSON(before, after)
+	if err != nil {
+		rest_errors.HandleError(ctx, response, err, "Could not apply a resource")
+		return
+	}
+
+	owner := current.GetMeta().GetLabels()[mesh_proto.FieldManagerLabel]
+	resp := api_server_types.ApplyResponse{
+		Operation:       api_server_types.ApplyOperationUnchanged,
+		DryRun:          params.dryRun,
+		FieldManager:    owner,
+		ResourceVersion: current.GetMeta().GetVersion(),
+		Diff:            diff,
+	}
+	if len(diff) > 0 {
+		if owner != "" && owner != params.fieldManager && !params.force {
+			rest_errors.HandleError(ctx, response, fieldManagerConflict(owner, diff), "Could not apply a resource")
+			return
+		}
+
+		ctx = audit.WithSpecBefore(ctx, current.GetSpec())
+		_ = current.SetSpec(resRest.GetSpec())
+		resp.Operation = api_server_types.ApplyOperationUpdated
+		resp.FieldManager = params.fieldManager
+		resp.Warnings = core_model.Deprecations(current)
+		if params.dryRun {
+			resp.ResourceVersion = ""
+			if err := validateDryRun(current); err != nil {
+				rest_errors.HandleError(ctx, response, err, "Failed to update a resource")
+				return
+			}
+		} else {
+			// the store rejects the update with a conflict when the resource was changed since it was fetched
+			if err := r.resManager.Update(ctx, current, store.UpdateWithLabels(labels)); err != nil {
+				rest_errors.HandleError(ctx, response, err, "Failed to update a resource")
+				return
+			}
+			resp.ResourceVersion = current.GetMeta().GetVersion()
+		}
+	}
+	if err := response.WriteHeaderAndJson(http.StatusOK, resp, "application/json"); err != nil {
+		log.Error(err, "Could not write the apply response")
+	}
+}
+
+// fieldManagerConflict returns the conflict of changing a resource owned by the field manager.
+func fieldManagerConflict(owner string, diff []api_common.JsonPatchItem) *rest_errors.ApplyConflict {
+	conflict := &rest_errors.ApplyConflict{Manager: owner}
+	for _, item := range diff {
+		// changed values are rendered as test, remove and add operations of the same path
+		if item.Op != api_common.Test && !slices.Contains(conflict.Fields, item.Path) {
+			conflict.Fields = append(conflict.Fields, item.Path)
+		}
+	}
+	return conflict
+}
+
+// validateDryRunCreate runs the checks done by the resource manager when the resource is created.
+func (r *resourceCrudHandler) validateDryRunCreate(ctx context.Context, res core_model.Resource, meshName string) error {
+	if r.descriptor.Scope == core_model.ScopeMesh {
+		if err := r.resManager.Get(ctx, core_mesh.NewMeshResource(), store.GetByKey(meshName, core_model.NoMesh)); err != nil {
+			if store.IsNotFound(err) {
+				return manager.MeshNotFound(meshName)
+			}
+			return err
+		}
+	}
+	return validateDryRun(res)
+}
+
+// validateDryRun runs the validation done by the resource manager when the resource is stored.
+func validateDryRun(res core_model.Resource) error {
+	if defaulter, ok := res.(core_model.Defaulter); ok {
+		if err := defaulter.Default(); err != nil {
+			return err
+		}
+	}
+	return validator.Validate(res)
+}
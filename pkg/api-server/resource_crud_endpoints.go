@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/emicklei/go-restful/v3"
@@ -12,8 +13,10 @@ import (
 	"k8s.io/apimachinery/pkg/util/validation"
 
 	mesh_proto "github.com/kumahq/kuma/v3/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/v3/pkg/api-server/filters"
 	api_server_types "github.com/kumahq/kuma/v3/pkg/api-server/types"
 	config_core "github.com/kumahq/kuma/v3/pkg/config/core"
+	"github.com/kumahq/kuma/v3/pkg/core/audit"
 	core_mesh "github.com/kumahq/kuma/v3/pkg/core/resources/apis/mesh"
 	meshtrust_api "github.com/kumahq/kuma/v3/pkg/core/resources/apis/meshtrust/api/v1alpha1"
 	resource_labels "github.com/kumahq/kuma/v3/pkg/core/resources/labels"
@@ -23,6 +26,7 @@ import (
 	rest_errors "github.com/kumahq/kuma/v3/pkg/core/rest/errors"
 	"github.com/kumahq/kuma/v3/pkg/core/user"
 	"github.com/kumahq/kuma/v3/pkg/core/validators"
+	"github.com/kumahq/kuma/v3/pkg/core/xds/inspect"
 	"github.com/kumahq/kuma/v3/pkg/plugins/resources/k8s"
 	"github.com/kumahq/kuma/v3/pkg/plugins/runtime/k8s/metadata"
 	"github.com/kumahq/kuma/v3/pkg/util/maps"
@@ -35,10 +39,12 @@ type resourceCrudHandler struct {
 	federatedZone   bool
 	k8sMapper       k8s.ResourceMapperFunc
 	filter          func(request *restful.Request) (store.ListFilterFunc, error)
+	transactions    store.Transactions
 	systemNamespace string
 	isK8s           bool
 
 	disableOriginLabelValidation bool
+	fieldManagerConflictsOnPut   bool
 }
 
 func (r *resourceCrudHandler) findResource(withInsight bool) func(request *restful.Request, response *restful.Response) {
@@ -231,10 +237,17 @@ func (r *resourceCrudHandler) createOrUpdateResource(request *restful.Request, r
 		return
 	}
 
+	var verr validators.ValidationError
+	force := boolQueryParameter(request, "force", validators.RootedAt(request.SelectedRoutePath()), &verr)
+	if err := verr.OrNil(); err != nil {
+		rest_errors.HandleError(request.Request.Context(), response, err, "Could not process a resource")
+		return
+	}
+
 	if create {
 		r.createResource(request.Request.Context(), name, meshName, resourceRest, response)
 	} else {
-		r.updateResource(request.Request.Context(), resource, resourceRest, response, meshName)
+		r.updateResource(request.Request.Context(), resource, resourceRest, response, meshName, force)
 	}
 }
 
@@ -297,22 +310,9 @@ func (r *resourceCrudHandler) createResource(
 	_ = res.SetSpec(resRest.GetSpec())
 	res.SetMeta(resRest.GetMeta())
 
-	// Validate workload label on Universal Zone dataplanes
-	if r.descriptor.Name == core_mesh.DataplaneType && !r.isK8s {
-		if resLabels := res.GetMeta().GetLabels(); resLabels != nil {
-			if workloadName, ok := resLabels[metadata.KumaWorkload]; ok && workloadName != "" {
-				if r.mode == config_core.Global {
-					err := rest_errors.NewBadRequestError("labels[\"kuma.io/workload\"]: not allowed on Global control plane")
-					rest_errors.HandleError(ctx, response, err, "Invalid workload label")
-					return
-				}
-				if validationErrs := apimachineryvalidation.NameIsDNS1035Label(workloadName, false); len(validationErrs) != 0 {
-					err := rest_errors.NewBadRequestError(fmt.Sprintf("labels[\"kuma.io/workload\"]: must be a valid DNS-1035 label (at most 63 characters, matching regex [a-z]([-a-z0-9]*[a-z0-9])?): %s", strings.Join(validationErrs, "; ")))
-					rest_errors.HandleError(ctx, response, err, "Invalid workload label")
-					return
-				}
-			}
-		}
+	if err := r.validateWorkloadLabel(res); err != nil {
+		rest_errors.HandleError(ctx, response, err, "Invalid workload label")
+		return
 	}
 
 	labels, err := r.computeLabels(res.Descriptor(), res.GetSpec(), res.GetMeta(), meshName, name)
@@ -320,6 +320,8 @@ func (r *resourceCrudHandler) createResource(
 		rest_errors.HandleError(ctx, response, err, "Could not compute labels for a resource")
 		return
 	}
+	// the field manager is only set by the server-side apply
+	delete(labels, mesh_proto.FieldManagerLabel)
 
 	if err := r.resManager.Create(ctx, res, store.CreateByKey(name, meshName), store.CreateWithLabels(labels)); err != nil {
 		rest_errors.HandleError(ctx, response, err, "Failed to create a resource")
@@ -332,12 +334,31 @@ func (r *resourceCrudHandler) createResource(
 	}
 }
 
+// validateWorkloadLabel validates the workload label on Universal Zone dataplanes
+func (r *resourceCrudHandler) validateWorkloadLabel(res core_model.Resource) error {
+	if r.descriptor.Name != core_mesh.DataplaneType || r.isK8s {
+		return nil
+	}
+	workloadName, ok := res.GetMeta().GetLabels()[metadata.KumaWorkload]
+	if !ok || workloadName == "" {
+		return nil
+	}
+	if r.mode == config_core.Global {
+		return rest_errors.NewBadRequestError("labels[\"kuma.io/workload\"]: not allowed on Global control plane")
+	}
+	if validationErrs := apimachineryvalidation.NameIsDNS1035Label(workloadName, false); len(validationErrs) != 0 {
+		return rest_errors.NewBadRequestError(fmt.Sprintf("labels[\"kuma.io/workload\"]: must be a valid DNS-1035 label (at most 63 characters, matching regex [a-z]([-a-z0-9]*[a-z0-9])?): %s", strings.Join(validationErrs, "; ")))
+	}
+	return nil
+}
+
 func (r *resourceCrudHandler) updateResource(
 	ctx context.Context,
 	currentRes core_model.Resource,
 	newResRest rest.Resource,
 	response *restful.Response,
 	meshName string,
+	force bool,
 ) {
 	if err := r.resourceAccess.ValidateUpdate(
 		ctx,
@@ -360,6 +381,16 @@ func (r *resourceCrudHandler) updateResource(
 		return
 	}
 
+	owner := currentRes.GetMeta().GetLabels()[mesh_proto.FieldManagerLabel]
+	var before applyView
+	if owner != "" {
+		if before, err = newApplyView(currentRes.GetMeta().GetLabels(), currentRes.GetSpec()); err != nil {
+			rest_errors.HandleError(ctx, response, err, "Could not update a resource")
+			return
+		}
+	}
+
+	ctx = audit.WithSpecBefore(ctx, currentRes.GetSpec())
 	_ = currentRes.SetSpec(newResRest.GetSpec())
 
 	// Compute labels for new request
@@ -377,6 +408,30 @@ func (r *resourceCrudHandler) updateResource(
 		return
 	}
 
+	// The field manager is only set by the server-side apply. A resource applied by a manager keeps it when PUT
+	// doesn't change the resource. When PUT changes it, the manager is removed, or the change is a conflict unless
+	// it's forced if the API server is configured to report field manager conflicts on PUT.
+	delete(labels, mesh_proto.FieldManagerLabel)
+	if owner != "" {
+		after, err := newApplyView(labels, currentRes.GetSpec())
+		if err != nil {
+			rest_errors.HandleError(ctx, response, err, "Could not update a resource")
+			return
+		}
+		diff, err := inspect.DiffJSON(before, after)
+		if err != nil {
+			rest_errors.HandleError(ctx, response, err, "Could not update a resource")
+			return
+		}
+		switch {
+		case len(diff) == 0, r.fieldManagerConflictsOnPut && force:
+			labels[mesh_proto.FieldManagerLabel] = owner
+		case r.fieldManagerConflictsOnPut:
+			rest_errors.HandleError(ctx, response, fieldManagerConflict(owner, diff), "Could not update a resource")
+			return
+		}
+	}
+
 	if err := r.resManager.Update(ctx, currentRes, store.UpdateWithLabels(labels)); err != nil {
 		rest_errors.HandleError(ctx, response, err, "Failed to update a resource")
 		return
@@ -430,6 +485,114 @@ func (r *resourceCrudHandler) deleteResource(request *restful.Request, response
 	}
 }
 
+// deleteResources deletes all resources matching the selectors of the request. Every resource is validated
+// before any of them is deleted, so the request either deletes all matching resources or none of them.
+// Resources are deleted in a transaction when the store supports it.
+func (r *resourceCrudHandler) deleteResources(request *restful.Request, response *restful.Response) {
+	ctx := request.Request.Context()
+	meshName, err := r.meshFromRequest(request)
+	if err != nil {
+		rest_errors.HandleError(ctx, response, err, "Failed to retrieve Mesh")
+		return
+	}
+
+	dryRun, err := deleteCollectionParams(request)
+	if err != nil {
+		rest_errors.HandleError(ctx, response, err, "Could not delete resources")
+		return
+	}
+	filter, err := r.filter(request)
+	if err != nil {
+		rest_errors.HandleError(ctx, response, err, "Could not delete resources")
+		return
+	}
+
+	if err := r.resourceAccess.ValidateList(ctx, meshName, r.descriptor, user.FromCtx(ctx)); err != nil {
+		rest_errors.HandleError(ctx, response, err, "Access Denied")
+		return
+	}
+	list := r.descriptor.NewList()
+	if err := r.resManager.List(ctx, list, store.ListByMesh(meshName), store.ListByFilterFunc(filter)); err != nil {
+		rest_errors.HandleError(ctx, response, err, "Could not delete resources")
+		return
+	}
+
+	var verr validators.ValidationError
+	resp := api_server_types.DeleteCollectionResponse{
+		DryRun: dryRun,
+		Items:  []api_server_types.DeletedResource{},
+	}
+	for _, item := range list.GetItems() {
+		verr.AddErrorAt(validators.RootedAt("items").Key(item.GetMeta().GetName()), r.validateOriginForWrite(item.GetMeta()))
+		if err := r.resourceAccess.ValidateDelete(
+			ctx,
+			core_model.MetaToResourceKey(item.GetMeta()),
+			item.GetSpec(),
+			item.Descriptor(),
+			user.FromCtx(ctx),
+		); err != nil {
+			rest_errors.HandleError(ctx, response, err, "Access Denied")
+			return
+		}
+		resp.Items = append(resp.Items, api_server_types.DeletedResource{
+			Type: string(item.Descriptor().Name),
+			Mesh: item.GetMeta().GetMesh(),
+			Name: item.GetMeta().GetName(),
+		})
+	}
+	if verr.HasViolations() {
+		rest_errors.HandleError(ctx, response, verr.OrNil(), "Could not delete resources")
+		return
+	}
+	resp.Total = len(resp.Items)
+
+	if !dryRun {
+		if err := store.InTx(ctx, r.transactions, func(ctx context.Context) error {
+			for _, item := range list.GetItems() {
+				err := r.resManager.Delete(ctx, item, store.DeleteBy(core_model.MetaToResourceKey(item.GetMeta())))
+				// the resource could be deleted in the meantime, which is the outcome we want anyway
+				if err != nil && !store.IsNotFound(err) {
+					return err
+				}
+			}
+			return nil
+		}); err != nil {
+			rest_errors.HandleError(ctx, response, err, "Could not delete resources")
+			return
+		}
+	}
+
+	if err := response.WriteHeaderAndJson(http.StatusOK, resp, "application/json"); err != nil {
+		log.Error(err, "Could not write the delete response")
+	}
+}
+
+// deleteCollectionParams returns whether the request is a dry run. Deleting all resources has to be
+// requested explicitly with all=true, so a request without selectors doesn't remove everything by mistake.
+func deleteCollectionParams(request *restful.Request) (bool, error) {
+	var verr validators.ValidationError
+	path := validators.RootedAt(request.SelectedRoutePath())
+	dryRun := boolQueryParameter(request, "dryRun", path, &verr)
+	all := boolQueryParameter(request, "all", path, &verr)
+	if !all && !filters.HasSelector(request) {
+		verr.AddViolationAt(path.Field("all"), fmt.Sprintf("either %s, %s or all=true is required", filters.LabelSelectorParam, filters.FieldSelectorParam))
+	}
+	return dryRun, verr.OrNil()
+}
+
+// boolQueryParameter returns the value of an optional boolean query parameter, an invalid value is added to verr.
+func boolQueryParameter(request *restful.Request, name string, path validators.PathBuilder, verr *validators.ValidationError) bool {
+	value := request.QueryParameter(name)
+	if value == "" {
+		return false
+	}
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		verr.AddViolationAt(path.Field(name), "must be a boolean")
+	}
+	return b
+}
+
 func (r *resourceCrudHandler) validateResourceRequest(name string, meshName string, resource rest.Resource) error {
 	var err validators.ValidationError
 	if name != resource.GetMeta().Name {
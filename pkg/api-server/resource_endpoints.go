@@ -8,6 +8,8 @@ import (
 	"github.com/emicklei/go-restful/v3"
 
 	"github.com/kumahq/kuma/v3/pkg/api-server/authn"
+	"github.com/kumahq/kuma/v3/pkg/api-server/filters"
+	api_server_types "github.com/kumahq/kuma/v3/pkg/api-server/types"
 	config_core "github.com/kumahq/kuma/v3/pkg/config/core"
 	core_plugins "github.com/kumahq/kuma/v3/pkg/core/plugins"
 	"github.com/kumahq/kuma/v3/pkg/core/resources/access"
@@ -80,6 +82,8 @@ func (r *resourceEndpoints) route(ws *restful.WebService, method, path string) *
 		rb = ws.GET(path)
 	case http.MethodPut:
 		rb = ws.PUT(path)
+	case http.MethodPatch:
+		rb = ws.PATCH(path)
 	case http.MethodDelete:
 		rb = ws.DELETE(path)
 	default:
@@ -126,6 +130,13 @@ func (r *resourceEndpoints) addFindEndpoint(ws *restful.WebService, pathPrefix s
 			Param(ws.PathParameter("name", fmt.Sprintf("Name of a %s", r.descriptor.Name)).DataType("string")).
 			Returns(200, "OK", nil).
 			Returns(404, "Not found", nil))
+		if r.descriptor.IsTargetRefBased {
+			ws.Route(ws.POST(pathPrefix+"/{name}/_simulate").To(r.simulatePolicy).
+				Doc(fmt.Sprintf("Simulate creating or updating a %s without applying it", r.descriptor.Name)).
+				Param(ws.PathParameter("name", fmt.Sprintf("Name of a %s", r.descriptor.Name)).DataType("string")).
+				Returns(200, "OK", nil).
+				Returns(400, "Bad request", nil))
+		}
 	}
 	if r.descriptor.Name == core_mesh.DataplaneType {
 		ws.Route(ws.GET(pathPrefix+"/{name}/_rules").To(r.inspect.rulesForResource()).
@@ -138,12 +149,21 @@ func (r *resourceEndpoints) addFindEndpoint(ws *restful.WebService, pathPrefix s
 			ws.Route(ws.GET(pathPrefix+"/{name}/_config").To(r.methodNotAllowed(msg)).
 				Doc(msg).
 				Returns(http.StatusMethodNotAllowed, msg, restful.ServiceError{}))
+			ws.Route(ws.POST(pathPrefix+"/{name}/_proxypatch").To(r.methodNotAllowed(msg)).
+				Doc(msg).
+				Returns(http.StatusMethodNotAllowed, msg, restful.ServiceError{}))
 		} else {
 			ws.Route(ws.GET(pathPrefix+"/{name}/_config").To(r.inspect.configForProxy()).
 				Doc(fmt.Sprintf("Get proxy config%s", r.descriptor.Name)).
 				Param(ws.PathParameter("name", fmt.Sprintf("Name of a %s", r.descriptor.Name)).DataType("string")).
 				Returns(200, "OK", nil).
 				Returns(404, "Not found", nil))
+			ws.Route(ws.POST(pathPrefix+"/{name}/_proxypatch").To(r.previewProxyPatch).
+				Doc(fmt.Sprintf("Preview proxy config of a %s with a MeshProxyPatch applied", r.descriptor.Name)).
+				Param(ws.PathParameter("name", fmt.Sprintf("Name of a %s", r.descriptor.Name)).DataType("string")).
+				Returns(200, "OK", nil).
+				Returns(400, "Bad request", nil).
+				Returns(404, "Not found", nil))
 		}
 	}
 	if r.descriptor.Name == core_mesh.DataplaneType {
@@ -207,6 +227,8 @@ func (r *resourceEndpoints) addListEndpoint(ws *restful.WebService, pathPrefix s
 		Param(ws.QueryParameter("size", "size of page").DataType("int")).
 		Param(ws.QueryParameter("offset", "offset of page to list").DataType("string")).
 		Param(ws.QueryParameter("name", "a pattern to select only resources that contain these characters").DataType("string")).
+		Param(ws.QueryParameter(filters.LabelSelectorParam, "a selector to restrict the list of returned resources by their labels, i.e. `kuma.io/zone=east,kuma.io/origin!=global`").DataType("string")).
+		Param(ws.QueryParameter(filters.FieldSelectorParam, "a selector to restrict the list of returned resources by their fields: `name`, `mesh` or paths in the spec, i.e. `spec.networking.address=10.0.0.1`").DataType("string")).
 		Returns(200, "OK", nil))
 	if r.descriptor.HasInsights() {
 		route := r.listResources(true)
@@ -215,6 +237,8 @@ func (r *resourceEndpoints) addListEndpoint(ws *restful.WebService, pathPrefix s
 			Param(ws.QueryParameter("size", "size of page").DataType("int")).
 			Param(ws.QueryParameter("offset", "offset of page to list").DataType("string")).
 			Param(ws.PathParameter("name", "a pattern to select only resources that contain these characters").DataType("string")).
+			Param(ws.QueryParameter(filters.LabelSelectorParam, "a selector to restrict the list of returned resources by their labels, i.e. `kuma.io/zone=east,kuma.io/origin!=global`").DataType("string")).
+			Param(ws.QueryParameter(filters.FieldSelectorParam, "a selector to restrict the list of returned resources by their fields: `name`, `mesh` or paths in the spec").DataType("string")).
 			Returns(200, "OK", nil).
 			Returns(404, "Not found", nil))
 		// Backward compatibility with previous path for overviews
@@ -233,12 +257,25 @@ func (r *resourceEndpoints) addCreateOrUpdateEndpoint(ws *restful.WebService, pa
 		ws.Route(r.route(ws, http.MethodPut, pathPrefix+"/{name}").To(r.methodNotAllowed(r.readOnlyMessage())).
 			Doc("Not allowed in read-only mode.").
 			Returns(http.StatusMethodNotAllowed, "Not allowed in read-only mode.", restful.ServiceError{}))
+		ws.Route(r.route(ws, http.MethodPatch, pathPrefix+"/{name}").To(r.methodNotAllowed(r.readOnlyMessage())).
+			Doc("Not allowed in read-only mode.").
+			Returns(http.StatusMethodNotAllowed, "Not allowed in read-only mode.", restful.ServiceError{}))
 	} else {
 		ws.Route(r.route(ws, http.MethodPut, pathPrefix+"/{name}").To(r.createOrUpdateResource).
 			Doc(fmt.Sprintf("Updates a %s", r.descriptor.WsPath)).
 			Param(ws.PathParameter("name", fmt.Sprintf("Name of the %s", r.descriptor.WsPath)).DataType("string")).
 			Returns(200, "OK", nil).
 			Returns(201, "Created", nil))
+		ws.Route(r.route(ws, http.MethodPatch, pathPrefix+"/{name}").To(r.applyResource).
+			Doc(fmt.Sprintf("Server-side apply of a %s", r.descriptor.Name)).
+			Param(ws.PathParameter("name", fmt.Sprintf("Name of the %s", r.descriptor.WsPath)).DataType("string")).
+			Param(ws.QueryParameter("fieldManager", "name of the manager applying the resource").DataType("string").Required(true)).
+			Param(ws.QueryParameter("force", "take over the resource when it's owned by another field manager").DataType("boolean")).
+			Param(ws.QueryParameter("dryRun", "only validate the resource and compute the diff without storing it").DataType("boolean")).
+			Param(ws.QueryParameter("resourceVersion", "apply only when the stored resource has this version").DataType("string")).
+			Returns(200, "OK", api_server_types.ApplyResponse{}).
+			Returns(201, "Created", api_server_types.ApplyResponse{}).
+			Returns(409, "Conflict", nil))
 	}
 }
 
@@ -247,11 +284,21 @@ func (r *resourceEndpoints) addDeleteEndpoint(ws *restful.WebService, pathPrefix
 		ws.Route(r.route(ws, http.MethodDelete, pathPrefix+"/{name}").To(r.methodNotAllowed(r.readOnlyMessage())).
 			Doc("Not allowed in read-only mode.").
 			Returns(http.StatusMethodNotAllowed, "Not allowed in read-only mode.", restful.ServiceError{}))
+		ws.Route(r.route(ws, http.MethodDelete, pathPrefix).To(r.methodNotAllowed(r.readOnlyMessage())).
+			Doc("Not allowed in read-only mode.").
+			Returns(http.StatusMethodNotAllowed, "Not allowed in read-only mode.", restful.ServiceError{}))
 	} else {
 		ws.Route(r.route(ws, http.MethodDelete, pathPrefix+"/{name}").To(r.deleteResource).
 			Doc(fmt.Sprintf("Deletes a %s", r.descriptor.Name)).
 			Param(ws.PathParameter("name", fmt.Sprintf("Name of a %s", r.descriptor.Name)).DataType("string")).
 			Returns(200, "OK", nil))
+		ws.Route(r.route(ws, http.MethodDelete, pathPrefix).To(r.deleteResources).
+			Doc(fmt.Sprintf("Deletes all %s matching the selectors", r.descriptor.Name)).
+			Param(ws.QueryParameter(filters.LabelSelectorParam, "a selector to restrict deleted resources by their labels, i.e. `kuma.io/zone=east`").DataType("string")).
+			Param(ws.QueryParameter(filters.FieldSelectorParam, "a selector to restrict deleted resources by their fields: `name`, `mesh` or paths in the spec").DataType("string")).
+			Param(ws.QueryParameter("all", "delete all resources, required when no selector is set").DataType("boolean")).
+			Param(ws.QueryParameter("dryRun", "only return resources that would be deleted").DataType("boolean")).
+			Returns(200, "OK", api_server_types.DeleteCollectionResponse{}))
 	}
 }
 
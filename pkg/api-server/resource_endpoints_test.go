@@ -296,6 +296,58 @@ var _ = Describe("Resource Endpoints on Zone, label origin", func() {
 		Entry("federated zone", true),
 	)
 
+	It("should not delete any resource when one of the matching resources can't be deleted", func() {
+		// given
+		apiServer, store, stop := createServer(true, true)
+		defer stop()
+		createMesh(store)
+		for name, origin := range map[string]mesh_proto.ResourceOrigin{
+			"mtp-zone":   mesh_proto.ZoneResourceOrigin,
+			"mtp-global": mesh_proto.GlobalResourceOrigin,
+		} {
+			Expect(store.Create(
+				context.Background(),
+				builders.MeshTrafficPermission().WithTargetRef(builders.TargetRefMesh()).AddRule(v1alpha1.Allow).Build(),
+				core_store.CreateByKey(name, mesh),
+				core_store.CreateWithLabels(map[string]string{mesh_proto.ResourceOriginLabel: string(origin)}),
+			)).To(Succeed())
+		}
+		deleteCollection := func(query string) *http.Response {
+			request, err := http.NewRequestWithContext(
+				context.Background(),
+				http.MethodDelete,
+				fmt.Sprintf("http://%s/meshes/%s/meshtrafficpermissions?%s", apiServer.Address(), mesh, query),
+				http.NoBody,
+			)
+			Expect(err).ToNot(HaveOccurred())
+			resp, err := http.DefaultClient.Do(request)
+			Expect(err).ToNot(HaveOccurred())
+			return resp
+		}
+
+		// when
+		resp := deleteCollection("all=true")
+
+		// then
+		Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+		bytes, err := io.ReadAll(resp.Body)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(bytes).To(matchers.MatchGoldenJSON(path.Join("testdata", "resource_400onDeleteCollectionOrigin.golden.json")))
+		list := &v1alpha1.MeshTrafficPermissionResourceList{}
+		Expect(store.List(context.Background(), list, core_store.ListByMesh(mesh))).To(Succeed())
+		Expect(list.Items).To(HaveLen(2))
+
+		// when
+		resp = deleteCollection("labelSelector=kuma.io/origin%3Dzone")
+
+		// then
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		list = &v1alpha1.MeshTrafficPermissionResourceList{}
+		Expect(store.List(context.Background(), list, core_store.ListByMesh(mesh))).To(Succeed())
+		Expect(list.Items).To(HaveLen(1))
+		Expect(list.Items[0].GetMeta().GetName()).To(Equal("mtp-global"))
+	})
+
 	It("should set origin label automatically for DPPs", func() {
 		// given
 		apiServer, store, stop := createServer(false, false)
@@ -548,82 +548,9 @@ func (r *resourceInspectHandler) rulesForResource() restful.RouteFunction {
 				}
 			}
 
-			if len(res.ToRules.ResourceRules) == 0 && len(res.FromRules.InboundRules) == 0 && res.ProxyConf == nil {
-				continue
+			if rule := toInspectRule(dp, res); rule != nil {
+				rules = append(rules, *rule)
 			}
-			var proxyRule *api_common.ProxyRule
-			if res.ProxyConf != nil {
-				proxyRule = &api_common.ProxyRule{
-					Conf:   res.ProxyConf.Conf,
-					Origin: oapi_helpers.ResourceMetaListToMetaList(res.Type, res.ProxyConf.Origin),
-				}
-			}
-
-			getInboundPortName := func(port uint32) *string {
-				if name := dp.Spec.GetNetworking().GetInboundForPort(port).GetName(); name != "" {
-					return &name
-				}
-				return nil
-			}
-
-			inboundRules := []api_common.InboundRulesEntry{}
-			for inbound, rulesForInbound := range res.FromRules.InboundRules {
-				if len(rulesForInbound) == 0 {
-					continue
-				}
-				rs := make([]api_common.InboundRule, len(rulesForInbound))
-				for i := range rulesForInbound {
-					rs[i] = api_common.InboundRule{
-						Conf:   []any{rulesForInbound[i].Conf},
-						Match:  rulesForInbound[i].Match,
-						Origin: oapi_helpers.OriginListToResourceRuleOrigin(res.Type, []common.Origin{rulesForInbound[i].Origin}),
-					}
-				}
-				var tags map[string]string
-				if dp.Spec.IsDelegatedGateway() {
-					tags = dp.Spec.Networking.Gateway.Tags
-				}
-				inboundRules = append(inboundRules, api_common.InboundRulesEntry{
-					Inbound: api_common.Inbound{
-						Name: getInboundPortName(inbound.Port),
-						Port: int(inbound.Port),
-						Tags: tags,
-					},
-					Rules: rs,
-				})
-			}
-			sort.SliceStable(inboundRules, func(i, j int) bool {
-				return inboundRules[i].Inbound.Port < inboundRules[j].Inbound.Port
-			})
-
-			toResourceRules := []api_common.ResourceRule{}
-			for itemIdentifier, resourceRuleItem := range res.ToRules.ResourceRules {
-				toResourceRules = append(toResourceRules, api_common.ResourceRule{
-					Conf:                resourceRuleItem.Conf,
-					Origin:              oapi_helpers.OriginListToResourceRuleOrigin(res.Type, resourceRuleItem.Origin),
-					ResourceMeta:        oapi_helpers.ResourceMetaToMeta(itemIdentifier.ResourceType, resourceRuleItem.Resource),
-					ResourceSectionName: &resourceRuleItem.ResourceSectionName,
-				})
-			}
-			sort.Slice(toResourceRules, func(i, j int) bool {
-				return toResourceRules[i].ResourceMeta.Name < toResourceRules[j].ResourceMeta.Name
-			})
-
-			if proxyRule == nil && len(toResourceRules) == 0 && len(inboundRules) == 0 && len(res.Warnings) == 0 {
-				// No matches for this policy, keep going...
-				continue
-			}
-			warnings := res.Warnings
-			if warnings == nil {
-				warnings = []string{}
-			}
-			rules = append(rules, api_common.InspectRule{
-				Type:            string(res.Type),
-				ToResourceRules: &toResourceRules,
-				InboundRules:    &inboundRules,
-				ProxyRule:       proxyRule,
-				Warnings:        &warnings,
-			})
 		}
 		httpMatches := []api_common.HttpMatch{}
 		for k, v := range matchesByHash {
@@ -645,3 +572,84 @@ func (r *resourceInspectHandler) rulesForResource() restful.RouteFunction {
 		}
 	}
 }
+
+// toInspectRule converts policies of one type matched for the dataplane to the rule returned by the inspect API.
+// It returns nil when no policy of the type matches the dataplane.
+func toInspectRule(dp *core_mesh.DataplaneResource, res core_xds.TypedMatchingPolicies) *api_common.InspectRule {
+	if len(res.ToRules.ResourceRules) == 0 && len(res.FromRules.InboundRules) == 0 && res.ProxyConf == nil {
+		return nil
+	}
+	var proxyRule *api_common.ProxyRule
+	if res.ProxyConf != nil {
+		proxyRule = &api_common.ProxyRule{
+			Conf:   res.ProxyConf.Conf,
+			Origin: oapi_helpers.ResourceMetaListToMetaList(res.Type, res.ProxyConf.Origin),
+		}
+	}
+
+	getInboundPortName := func(port uint32) *string {
+		if name := dp.Spec.GetNetworking().GetInboundForPort(port).GetName(); name != "" {
+			return &name
+		}
+		return nil
+	}
+
+	inboundRules := []api_common.InboundRulesEntry{}
+	for inbound, rulesForInbound := range res.FromRules.InboundRules {
+		if len(rulesForInbound) == 0 {
+			continue
+		}
+		rs := make([]api_common.InboundRule, len(rulesForInbound))
+		for i := range rulesForInbound {
+			rs[i] = api_common.InboundRule{
+				Conf:   []any{rulesForInbound[i].Conf},
+				Match:  rulesForInbound[i].Match,
+				Origin: oapi_helpers.OriginListToResourceRuleOrigin(res.Type, []common.Origin{rulesForInbound[i].Origin}),
+			}
+		}
+		var tags map[string]string
+		if dp.Spec.IsDelegatedGateway() {
+			tags = dp.Spec.Networking.Gateway.Tags
+		}
+		inboundRules = append(inboundRules, api_common.InboundRulesEntry{
+			Inbound: api_common.Inbound{
+				Name: getInboundPortName(inbound.Port),
+				Port: int(inbound.Port),
+				Tags: tags,
+			},
+			Rules: rs,
+		})
+	}
+	sort.SliceStable(inboundRules, func(i, j int) bool {
+		return inboundRules[i].Inbound.Port < inboundRules[j].Inbound.Port
+	})
+
+	toResourceRules := []api_common.ResourceRule{}
+	for itemIdentifier, resourceRuleItem := range res.ToRules.ResourceRules {
+		toResourceRules = append(toResourceRules, api_common.ResourceRule{
+			Conf:                resourceRuleItem.Conf,
+			Origin:              oapi_helpers.OriginListToResourceRuleOrigin(res.Type, resourceRuleItem.Origin),
+			ResourceMeta:        oapi_helpers.ResourceMetaToMeta(itemIdentifier.ResourceType, resourceRuleItem.Resource),
+			ResourceSectionName: &resourceRuleItem.ResourceSectionName,
+		})
+	}
+	sort.Slice(toResourceRules, func(i, j int) bool {
+		return toResourceRules[i].ResourceMeta.Name < toResourceRules[j].ResourceMeta.Name
+	})
+
+	if proxyRule == nil && len(toResourceRules) == 0 && len(inboundRules) == 0 && len(res.Warnings) == 0 {
+		// No matches for this policy
+		return nil
+	}
+	warnings := res.Warnings
+	if warnings == nil {
+		warnings = []string{}
+	}
+	return &api_common.InspectRule{
+		Type:            string(res.Type),
+		ToResourceRules: &toResourceRules,
+		InboundRules:    &inboundRules,
+		ProxyRule:       proxyRule,
+		Warnings:        &warnings,
+	}
+}
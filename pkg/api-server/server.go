@@ -33,12 +33,14 @@ import (
 	config_store "github.com/kumahq/kuma/v3/pkg/config/core/resources/store"
 	config_types "github.com/kumahq/kuma/v3/pkg/config/types"
 	"github.com/kumahq/kuma/v3/pkg/core"
+	"github.com/kumahq/kuma/v3/pkg/core/audit"
 	resources_access "github.com/kumahq/kuma/v3/pkg/core/resources/access"
 	"github.com/kumahq/kuma/v3/pkg/core/resources/apis/mesh"
 	"github.com/kumahq/kuma/v3/pkg/core/resources/apis/system"
 	"github.com/kumahq/kuma/v3/pkg/core/resources/manager"
 	"github.com/kumahq/kuma/v3/pkg/core/resources/model"
 	"github.com/kumahq/kuma/v3/pkg/core/resources/registry"
+	core_store "github.com/kumahq/kuma/v3/pkg/core/resources/store"
 	"github.com/kumahq/kuma/v3/pkg/core/runtime"
 	"github.com/kumahq/kuma/v3/pkg/insights/globalinsight"
 	kuma_log "github.com/kumahq/kuma/v3/pkg/log"
@@ -157,6 +159,7 @@ func NewApiServer(
 		meshContextBuilder,
 		xdsHooks,
 		rt.RouteMetadataProvider(),
+		rt.Transactions(),
 	)
 	addPoliciesWsEndpoints(ws, cfg.Mode == config_core.Global, cfg.IsFederatedZoneCP(), cfg.ApiServer.ReadOnly, defs)
 	addInspectEndpoints(ws, cfg, meshContextBuilder, rt.ResourceManager(), rt.Access().ResourceAccess)
@@ -182,6 +185,10 @@ func NewApiServer(
 		return nil, errors.Wrap(err, "could not create index webservice")
 	}
 	addWhoamiEndpoints(ws)
+	addEventsEndpoints(ws, rt.EventBus(), rt.Access().ResourceAccess)
+	if cfg.Audit.Enabled {
+		addAuditEndpoints(ws, rt.AuditLog(), rt.Access().ResourceAccess)
+	}
 
 	ws.SetDynamicRoutes(true)
 	if err := rt.APIWebServiceCustomize()(ws); err != nil {
@@ -201,6 +208,15 @@ func NewApiServer(
 		rt.Access().DataplaneTokenAccess,
 		rt.Access().ZoneTokenAccess,
 	))
+	if !cfg.ApiServer.ReadOnly && !cfg.IsFederatedZoneCP() {
+		// revocations are written to secrets, which are managed on global in multizone
+		container.Add(tokens_server.NewRevocationsWebservice(
+			path+"tokens",
+			rt.ResourceManager(),
+			rt.Access().ResourceAccess,
+			cfg.Store.Upsert,
+		))
+	}
 	guiPath := cfg.ApiServer.GUI.BasePath
 	if !strings.HasSuffix(guiPath, "/") {
 		guiPath += "/"
@@ -236,6 +252,12 @@ func NewApiServer(
 		certWatchers: rt.CertWatchers(),
 	}
 
+	container.Filter(func(request *restful.Request, response *restful.Response, chain *restful.FilterChain) {
+		// resources changed through the API server are recorded in the audit log
+		request.Request = request.Request.WithContext(audit.WithSource(request.Request.Context(), audit.SourceAPIServer))
+		chain.ProcessFilter(request, response)
+	})
+
 	container.Filter(func(request *restful.Request, response *restful.Response, chain *restful.FilterChain) {
 		request.Request = request.Request.WithContext(logr.NewContext(
 			request.Request.Context(),
@@ -264,6 +286,7 @@ func addResourcesEndpoints(
 	meshContextBuilder xds_context.MeshContextBuilder,
 	xdsHooks []hooks.ResourceSetHook,
 	routeMetadataProvider runtime.RouteMetadataProvider,
+	transactions core_store.Transactions,
 ) {
 	globalInsightsEndpoints := globalInsightsEndpoints{
 		resManager:     resManager,
@@ -278,6 +301,14 @@ func addResourcesEndpoints(
 
 	newDataplaneLayoutEndpoint(resManager, meshContextBuilder, resourceAccess, cfg.Multizone.Zone.Name, cfg.Environment).addEndpoint(ws)
 
+	if cfg.Mode == config_core.Global {
+		zoneSyncEndpoint := zoneSyncEndpoint{
+			resManager:     resManager,
+			resourceAccess: resourceAccess,
+		}
+		zoneSyncEndpoint.addEndpoint(ws)
+	}
+
 	var k8sMapper k8s.ResourceMapperFunc
 	var k8sSecretMapper k8s.ResourceMapperFunc
 	switch cfg.Store.Type {
@@ -313,7 +344,9 @@ func addResourcesEndpoints(
 				k8sMapper:                    k8sMapper,
 				federatedZone:                cfg.IsFederatedZoneCP(),
 				filter:                       filters.Resource(definition),
+				transactions:                 transactions,
 				disableOriginLabelValidation: cfg.Multizone.Zone.DisableOriginLabelValidation,
+				fieldManagerConflictsOnPut:   cfg.ApiServer.FieldManagerConflictsOnPut,
 				systemNamespace:              cfg.Store.Kubernetes.SystemNamespace,
 				isK8s:                        cfg.Environment == config_core.KubernetesEnvironment,
 			},
@@ -0,0 +1,34 @@
+package api_server_test
+
+import (
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	config_api_server "github.com/kumahq/kuma/v3/pkg/config/api-server"
+)
+
+var _ = Describe("Token revocations endpoints", func() {
+	DescribeTable("should be exposed only when resources can be modified",
+		func(configurer *testApiServerConfigurer, status int) {
+			// given
+			apiServer, _, stop := StartApiServer(configurer)
+			defer stop()
+
+			// when
+			resp, err := http.Get("http://" + apiServer.Address() + "/tokens/revocations?type=user")
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Body.Close()).To(Succeed())
+			Expect(resp.StatusCode).To(Equal(status))
+		},
+		Entry("standalone", NewTestApiServerConfigurer(), http.StatusOK),
+		Entry("global", NewTestApiServerConfigurer().WithGlobal(), http.StatusOK),
+		Entry("read only", NewTestApiServerConfigurer().WithConfigMutator(func(config *config_api_server.ApiServerConfig) {
+			config.ReadOnly = true
+		}), http.StatusNotFound),
+		Entry("federated zone", NewTestApiServerConfigurer().WithZone("zone-1"), http.StatusNotFound),
+	)
+})
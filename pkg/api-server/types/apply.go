@@ -0,0 +1,29 @@
+package types
+
+import (
+	api_common "github.com/kumahq/kuma/v3/api/openapi/types/common"
+)
+
+// ApplyOperation is the outcome of the server-side apply of a resource.
+type ApplyOperation string
+
+const (
+	ApplyOperationCreated   ApplyOperation = "Created"
+	ApplyOperationUpdated   ApplyOperation = "Updated"
+	ApplyOperationUnchanged ApplyOperation = "Unchanged"
+)
+
+// ApplyResponse is returned by the server-side apply of a resource.
+type ApplyResponse struct {
+	Operation ApplyOperation `json:"operation"`
+	// DryRun is true when the resource was only validated without being stored.
+	DryRun bool `json:"dryRun,omitempty"`
+	// FieldManager is the manager owning the resource after the apply.
+	FieldManager string `json:"fieldManager"`
+	// ResourceVersion is the version of the stored resource, it can be passed to the next apply
+	// to make sure the resource was not changed in the meantime. It's empty on dry run.
+	ResourceVersion string `json:"resourceVersion,omitempty"`
+	// Diff is a diff in JSONPatch format between labels and spec of the resource before and after the apply.
+	Diff     []api_common.JsonPatchItem `json:"diff"`
+	Warnings []string                   `json:"warnings,omitempty"`
+}
@@ -0,0 +1,10 @@
+package types
+
+import (
+	"github.com/kumahq/kuma/v3/pkg/core/audit"
+)
+
+// AuditEntryList is a list of the most recent entries of the audit log, the newest first.
+type AuditEntryList struct {
+	Items []audit.Entry `json:"items"`
+}
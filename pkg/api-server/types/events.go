@@ -0,0 +1,10 @@
+package types
+
+// ResourceChangedEvent is the data of an event streamed by the events endpoint when a resource is created, updated or deleted.
+type ResourceChangedEvent struct {
+	// Operation is one of Create, Update or Delete.
+	Operation string `json:"operation"`
+	Type      string `json:"type"`
+	Mesh      string `json:"mesh,omitempty"`
+	Name      string `json:"name"`
+}
@@ -5,3 +5,17 @@ type CreateOrUpdateSuccessResponse struct {
 }
 
 type DeleteSuccessResponse struct{}
+
+// DeleteCollectionResponse lists resources deleted by the bulk delete endpoint,
+// or the resources that would be deleted when it's called with dryRun.
+type DeleteCollectionResponse struct {
+	DryRun bool              `json:"dryRun,omitempty"`
+	Total  int               `json:"total"`
+	Items  []DeletedResource `json:"items"`
+}
+
+type DeletedResource struct {
+	Type string `json:"type"`
+	Mesh string `json:"mesh,omitempty"`
+	Name string `json:"name"`
+}
@@ -0,0 +1,37 @@
+package types
+
+import (
+	api_common "github.com/kumahq/kuma/v3/api/openapi/types/common"
+)
+
+// PolicySimulationMatch describes how a simulated policy changes the matching of a dataplane.
+type PolicySimulationMatch string
+
+const (
+	// PolicySimulationMatchAdded means that the dataplane is matched only after the policy is applied.
+	PolicySimulationMatchAdded PolicySimulationMatch = "Added"
+	// PolicySimulationMatchRemoved means that the dataplane is no longer matched after the policy is applied.
+	PolicySimulationMatchRemoved PolicySimulationMatch = "Removed"
+	// PolicySimulationMatchUnchanged means that the dataplane is matched both before and after the policy is applied.
+	PolicySimulationMatchUnchanged PolicySimulationMatch = "Unchanged"
+)
+
+// PolicySimulation is the impact of applying a policy on dataplanes of the mesh.
+type PolicySimulation struct {
+	// Created is true when the policy doesn't exist yet.
+	Created bool `json:"created"`
+	// Dataplanes matched by the policy before or after it's applied.
+	Dataplanes []PolicySimulationDataplane `json:"dataplanes"`
+}
+
+type PolicySimulationDataplane struct {
+	Dataplane ResourceKeyEntry      `json:"dataplane"`
+	Match     PolicySimulationMatch `json:"match"`
+	// RulesDiff is a diff in JSONPatch format between rules of the policy type computed for the dataplane.
+	RulesDiff []api_common.JsonPatchItem `json:"rulesDiff"`
+	// XdsDiff is a diff in JSONPatch format between the current and the resulting XDS config of the proxy.
+	// It's only computed on a zone control plane.
+	XdsDiff *[]api_common.JsonPatchItem `json:"xdsDiff,omitempty"`
+	// XdsError is set when the XDS config of the proxy could not be computed, for example because the proxy never connected.
+	XdsError string `json:"xdsError,omitempty"`
+}
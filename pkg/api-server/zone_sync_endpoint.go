@@ -0,0 +1,77 @@
+package api_server
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/emicklei/go-restful/v3"
+
+	"github.com/kumahq/kuma/v3/pkg/core/resources/access"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/model"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/store"
+	rest_errors "github.com/kumahq/kuma/v3/pkg/core/rest/errors"
+	"github.com/kumahq/kuma/v3/pkg/core/user"
+	util_proto "github.com/kumahq/kuma/v3/pkg/util/proto"
+)
+
+type zoneSyncEndpoint struct {
+	resManager     manager.ReadOnlyResourceManager
+	resourceAccess access.ResourceAccess
+}
+
+type zoneSyncResponse struct {
+	Zone string `json:"zone"`
+	// ResourceSync is the result of the last check of resources synced from global to the zone.
+	// It's null when the zone has not been checked yet.
+	ResourceSync json.RawMessage `json:"resourceSync"`
+}
+
+func (ze *zoneSyncEndpoint) addEndpoint(ws *restful.WebService) {
+	ws.Route(
+		ws.GET("/zones/{name}/_sync").To(ze.getZoneSync).
+			Doc("Get state of resources synced from Global to the Zone").
+			Param(ws.PathParameter("name", "Name of a zone").DataType("string")).
+			Returns(http.StatusOK, "OK", nil).
+			Returns(http.StatusNotFound, "Not found", nil),
+	)
+}
+
+func (ze *zoneSyncEndpoint) getZoneSync(request *restful.Request, response *restful.Response) {
+	ctx := request.Request.Context()
+	name := request.PathParameter("name")
+
+	if err := ze.resourceAccess.ValidateGet(
+		ctx,
+		model.ResourceKey{Name: name},
+		system.ZoneInsightResourceTypeDescriptor,
+		user.FromCtx(ctx),
+	); err != nil {
+		rest_errors.HandleError(ctx, response, err, "Access Denied")
+		return
+	}
+
+	insight := system.NewZoneInsightResource()
+	if err := ze.resManager.Get(ctx, insight, store.GetByKey(name, model.NoMesh)); err != nil {
+		rest_errors.HandleError(ctx, response, err, "Could not retrieve ZoneInsight")
+		return
+	}
+
+	res := zoneSyncResponse{
+		Zone:         name,
+		ResourceSync: json.RawMessage("null"),
+	}
+	if sync := insight.Spec.GetResourceSync(); sync != nil {
+		bytes, err := util_proto.ToJSON(sync)
+		if err != nil {
+			rest_errors.HandleError(ctx, response, err, "Could not marshal resource sync")
+			return
+		}
+		res.ResourceSync = bytes
+	}
+
+	if err := response.WriteAsJson(res); err != nil {
+		rest_errors.HandleError(ctx, response, err, "Could not write response")
+	}
+}
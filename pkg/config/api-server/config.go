@@ -19,6 +19,9 @@ type ApiServerConfig struct {
 
 	// If true, then API Server will operate in read only mode (serving GET requests)
 	ReadOnly bool `json:"readOnly" envconfig:"kuma_api_server_read_only"`
+	// If true, then PUT of a resource applied by a field manager fails with a conflict when it changes the resource,
+	// unless it's forced. Otherwise PUT replaces the resource and removes the field manager.
+	FieldManagerConflictsOnPut bool `json:"fieldManagerConflictsOnPut" envconfig:"kuma_api_server_field_manager_conflicts_on_put"`
 	// Allowed domains for Cross-Origin Resource Sharing. The value can be either domain or regexp
 	CorsAllowedDomains []string `json:"corsAllowedDomains" envconfig:"kuma_api_server_cors_allowed_domains"`
 	// HTTP configuration of the API Server
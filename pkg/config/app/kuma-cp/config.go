@@ -12,6 +12,7 @@ import (
 	"github.com/kumahq/kuma/v3/pkg/config"
 	"github.com/kumahq/kuma/v3/pkg/config/access"
 	api_server "github.com/kumahq/kuma/v3/pkg/config/api-server"
+	"github.com/kumahq/kuma/v3/pkg/config/audit"
 	"github.com/kumahq/kuma/v3/pkg/config/core"
 	"github.com/kumahq/kuma/v3/pkg/config/core/resources/apis"
 	"github.com/kumahq/kuma/v3/pkg/config/core/resources/store"
@@ -173,6 +174,8 @@ type Config struct {
 	Tracing tracing.Config `json:"tracing"`
 	// EventBus is a configuration of the event bus which is local to one instance of CP.
 	EventBus eventbus.Config `json:"eventBus"`
+	// Audit is a configuration of the audit log of resource changes.
+	Audit audit.Config `json:"audit"`
 	// Policies is a configuration of plugin policies like MeshAccessLog, MeshTrace etc.
 	Policies *policies.Config `json:"policies"`
 	// CoreResources holds configuration for generated core resources like MeshService
@@ -277,6 +280,7 @@ var DefaultConfig = func() Config {
 		Experimental:  ExperimentalConfig{},
 		InterCp:       intercp.DefaultInterCpConfig(),
 		EventBus:      eventbus.Default(),
+		Audit:         audit.Default(),
 		Policies:      policies.Default(),
 		CoreResources: apis.Default(),
 		IPAM: IPAMConfig{
@@ -290,6 +294,7 @@ var DefaultConfig = func() Config {
 				CIDR: "243.0.0.0/8",
 			},
 			AllocationInterval: config_types.Duration{Duration: 5 * time.Second},
+			QuarantinePeriod:   config_types.Duration{Duration: 1 * time.Hour},
 			KnownInternalCIDRs: defaultKnownInternalCIDRs,
 		},
 		MeshService: MeshServiceConfig{
@@ -373,6 +378,9 @@ func (c *Config) Validate() error {
 	if err := c.InterCp.Validate(); err != nil {
 		return errors.Wrap(err, "InterCp validation failed")
 	}
+	if err := c.Audit.Validate(); err != nil {
+		return errors.Wrap(err, "Audit validation failed")
+	}
 	if err := c.Tracing.Validate(); err != nil {
 		return errors.Wrap(err, "Tracing validation failed")
 	}
@@ -473,6 +481,9 @@ type IPAMConfig struct {
 	MeshMultiZoneService MeshMultiZoneServiceIPAM `json:"meshMultiZoneService"`
 	// Interval on which Kuma will allocate new IPs and generate hostnames.
 	AllocationInterval config_types.Duration `json:"allocationInterval" envconfig:"KUMA_IPAM_ALLOCATION_INTERVAL"`
+	// QuarantinePeriod is how long an IP released by a deleted resource is kept before it can be allocated again.
+	// It should be longer than the time clients can cache a DNS record of the released IP.
+	QuarantinePeriod config_types.Duration `json:"quarantinePeriod" envconfig:"KUMA_IPAM_QUARANTINE_PERIOD"`
 	// KnownInternalCIDRs contains a list of CIDRs which are considered internal and trusted, Envoy attaches internal only headers to requests from these clients when forwarding HTTP requests
 	KnownInternalCIDRs []string `json:"knownInternalCIDRs" envconfig:"KUMA_IPAM_KNOWN_INTERNAL_CIDRS"`
 }
@@ -484,6 +495,12 @@ func (i IPAMConfig) Validate() error {
 	if err := i.MeshExternalService.Validate(); err != nil {
 		return errors.Wrap(err, "MeshExternalServie validation failed")
 	}
+	if err := i.MeshMultiZoneService.Validate(); err != nil {
+		return errors.Wrap(err, "MeshMultiZoneService validation failed")
+	}
+	if i.QuarantinePeriod.Duration < 0 {
+		return errors.New(".QuarantinePeriod must not be negative")
+	}
 	for _, knownInternalCIDR := range i.KnownInternalCIDRs {
 		if _, _, err := net.ParseCIDR(knownInternalCIDR); err != nil {
 			return errors.Wrap(err, fmt.Sprintf("entry '%s' in .KnownInternalCIDRs is invalid", knownInternalCIDR))
@@ -492,6 +509,31 @@ func (i IPAMConfig) Validate() error {
 	return nil
 }
 
+func validateVIPCIDRs(cidr string, cidrV6 string, field string) error {
+	if cidr == "" && cidrV6 == "" {
+		return errors.Errorf("%s or %sv6 has to be defined", field, field)
+	}
+	if cidr != "" {
+		ip, _, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return errors.Wrapf(err, "%s is invalid", field)
+		}
+		if cidrV6 != "" && ip.To4() == nil {
+			return errors.Errorf("%s has to be an IPv4 CIDR when %sv6 is defined", field, field)
+		}
+	}
+	if cidrV6 != "" {
+		ip, _, err := net.ParseCIDR(cidrV6)
+		if err != nil {
+			return errors.Wrapf(err, "%sv6 is invalid", field)
+		}
+		if ip.To4() != nil {
+			return errors.Errorf("%sv6 has to be an IPv6 CIDR", field)
+		}
+	}
+	return nil
+}
+
 var defaultKnownInternalCIDRs = []string{
 	// Private Address Space defined in RFC 1918: https://datatracker.ietf.org/doc/html/rfc1918#section-3
 	"10.0.0.0/8",
@@ -511,25 +553,23 @@ var defaultKnownInternalCIDRs = []string{
 type MeshServiceIPAM struct {
 	// CIDR for MeshService IPs
 	CIDR string `json:"cidr" envconfig:"KUMA_IPAM_MESH_SERVICE_CIDR"`
+	// IPv6 CIDR for MeshService IPs. When both CIDRs are set, every resource gets an IPv4 and an IPv6 VIP.
+	CIDRv6 string `json:"cidrV6" envconfig:"KUMA_IPAM_MESH_SERVICE_CIDR_V6"`
 }
 
 func (i MeshServiceIPAM) Validate() error {
-	if _, _, err := net.ParseCIDR(i.CIDR); err != nil {
-		return errors.Wrap(err, ".MeshServiceCIDR is invalid")
-	}
-	return nil
+	return validateVIPCIDRs(i.CIDR, i.CIDRv6, ".MeshServiceCIDR")
 }
 
 type MeshExternalServiceIPAM struct {
 	// CIDR for MeshExternalService IPs
 	CIDR string `json:"cidr" envconfig:"KUMA_IPAM_MESH_EXTERNAL_SERVICE_CIDR"`
+	// IPv6 CIDR for MeshExternalService IPs. When both CIDRs are set, every resource gets an IPv4 and an IPv6 VIP.
+	CIDRv6 string `json:"cidrV6" envconfig:"KUMA_IPAM_MESH_EXTERNAL_SERVICE_CIDR_V6"`
 }
 
 func (i MeshExternalServiceIPAM) Validate() error {
-	if _, _, err := net.ParseCIDR(i.CIDR); err != nil {
-		return errors.Wrap(err, ".MeshExternalServiceCIDR is invalid")
-	}
-	return nil
+	return validateVIPCIDRs(i.CIDR, i.CIDRv6, ".MeshExternalServiceCIDR")
 }
 
 func (c Config) GetEnvoyAdminPort() uint32 {
@@ -549,13 +589,12 @@ func (c Config) GetEnvoyReadinessPort() uint32 {
 type MeshMultiZoneServiceIPAM struct {
 	// CIDR for MeshMultiZone IPs
 	CIDR string `json:"cidr" envconfig:"KUMA_IPAM_MESH_MULTI_ZONE_SERVICE_CIDR"`
+	// IPv6 CIDR for MeshMultiZone IPs. When both CIDRs are set, every resource gets an IPv4 and an IPv6 VIP.
+	CIDRv6 string `json:"cidrV6" envconfig:"KUMA_IPAM_MESH_MULTI_ZONE_SERVICE_CIDR_V6"`
 }
 
 func (i MeshMultiZoneServiceIPAM) Validate() error {
-	if _, _, err := net.ParseCIDR(i.CIDR); err != nil {
-		return errors.Wrap(err, ".MeshMultiZoneServiceCIDR is invalid")
-	}
-	return nil
+	return validateVIPCIDRs(i.CIDR, i.CIDRv6, ".MeshMultiZoneServiceCIDR")
 }
 
 type MeshServiceConfig struct {
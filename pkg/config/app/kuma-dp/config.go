@@ -52,6 +52,14 @@ var DefaultConfig = func() Config {
 		ApplicationProbeProxyServer: ApplicationProbeProxyServer{
 			Port: 0,
 		},
+		AccessLog: AccessLog{
+			BufferSize:           1024,
+			BatchMaxBytes:        64 * 1024,
+			FlushInterval:        config_types.Duration{Duration: 1 * time.Second},
+			ReconnectBaseBackoff: config_types.Duration{Duration: 1 * time.Second},
+			ReconnectMaxBackoff:  config_types.Duration{Duration: 30 * time.Second},
+			SpillMaxBytes:        64 * 1024 * 1024,
+		},
 	}
 }
 
@@ -66,6 +74,8 @@ type Config struct {
 	// DNS defines a configuration for builtin DNS in Kuma DP
 	DNS                         DNS                         `json:"dns,omitempty"`
 	ApplicationProbeProxyServer ApplicationProbeProxyServer `json:"applicationProbeProxyServer,omitempty"`
+	// AccessLog defines how TCP access logs are forwarded to logging backends.
+	AccessLog AccessLog `json:"accessLog,omitempty"`
 }
 
 func (c *Config) Sanitize() {
@@ -73,6 +83,7 @@ func (c *Config) Sanitize() {
 	c.Dataplane.Sanitize()
 	c.DataplaneRuntime.Sanitize()
 	c.DNS.Sanitize()
+	c.AccessLog.Sanitize()
 }
 
 func (c *Config) PostProcess() error {
@@ -81,6 +92,7 @@ func (c *Config) PostProcess() error {
 		c.Dataplane.PostProcess(),
 		c.DataplaneRuntime.PostProcess(),
 		c.DNS.PostProcess(),
+		c.AccessLog.PostProcess(),
 	)
 }
 
@@ -290,6 +302,9 @@ func (c *Config) Validate() error {
 	if err := c.DNS.Validate(); err != nil {
 		errs = multierr.Append(errs, errors.Wrapf(err, ".DNS is not valid"))
 	}
+	if err := c.AccessLog.Validate(); err != nil {
+		errs = multierr.Append(errs, errors.Wrapf(err, ".AccessLog is not valid"))
+	}
 	return errs
 }
 
@@ -414,3 +429,42 @@ func (p *ApplicationProbeProxyServer) Validate() error {
 	}
 	return nil
 }
+
+type AccessLog struct {
+	config.BaseConfig
+
+	// BufferSize is the maximum number of access log entries buffered in memory for a single logging backend.
+	// When the buffer is full, entries are spilled to disk or dropped.
+	BufferSize uint32 `json:"bufferSize,omitempty" envconfig:"kuma_access_log_buffer_size"`
+	// BatchMaxBytes is the maximum size of access log entries sent to a logging backend with a single write.
+	BatchMaxBytes uint32 `json:"batchMaxBytes,omitempty" envconfig:"kuma_access_log_batch_max_bytes"`
+	// FlushInterval is the maximum time an access log entry waits for other entries to be sent together in a batch.
+	// If 0, entries are sent as soon as possible, batching only the entries that are already buffered.
+	FlushInterval config_types.Duration `json:"flushInterval,omitempty" envconfig:"kuma_access_log_flush_interval"`
+	// ReconnectBaseBackoff is the initial backoff between attempts to connect to an unavailable logging backend.
+	ReconnectBaseBackoff config_types.Duration `json:"reconnectBaseBackoff,omitempty" envconfig:"kuma_access_log_reconnect_base_backoff"`
+	// ReconnectMaxBackoff is the maximum backoff between attempts to connect to an unavailable logging backend.
+	ReconnectMaxBackoff config_types.Duration `json:"reconnectMaxBackoff,omitempty" envconfig:"kuma_access_log_reconnect_max_backoff"`
+	// SpillDir is a directory to which entries that don't fit in the buffer are written until the logging backend is available again.
+	// Entries left on disk are sent after kuma-dp restarts. If empty, entries that don't fit in the buffer are dropped.
+	SpillDir string `json:"spillDir,omitempty" envconfig:"kuma_access_log_spill_dir"`
+	// SpillMaxBytes is the maximum size of entries spilled to disk for a single logging backend.
+	SpillMaxBytes uint64 `json:"spillMaxBytes,omitempty" envconfig:"kuma_access_log_spill_max_bytes"`
+}
+
+func (a *AccessLog) Validate() error {
+	var errs error
+	if a.FlushInterval.Duration < 0 {
+		errs = multierr.Append(errs, errors.New(".FlushInterval must not be negative"))
+	}
+	if a.ReconnectBaseBackoff.Duration < 0 {
+		errs = multierr.Append(errs, errors.New(".ReconnectBaseBackoff must not be negative"))
+	}
+	if a.ReconnectMaxBackoff.Duration < a.ReconnectBaseBackoff.Duration {
+		errs = multierr.Append(errs, errors.New(".ReconnectMaxBackoff must not be lower than .ReconnectBaseBackoff"))
+	}
+	if a.SpillDir != "" && a.SpillMaxBytes == 0 {
+		errs = multierr.Append(errs, errors.New(".SpillMaxBytes must be positive when .SpillDir is set"))
+	}
+	return errs
+}
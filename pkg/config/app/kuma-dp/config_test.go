@@ -65,6 +65,13 @@ var _ = Describe("Config", func() {
 				"KUMA_DATAPLANE_RUNTIME_DYNAMIC_CONFIGURATION_REFRESH_INTERVAL": "5s",
 				"KUMA_DNS_ENABLED":                                              "true",
 				"KUMA_DNS_PROXY_PORT":                                           "5300",
+				"KUMA_ACCESS_LOG_BUFFER_SIZE":                                   "10",
+				"KUMA_ACCESS_LOG_BATCH_MAX_BYTES":                               "512",
+				"KUMA_ACCESS_LOG_FLUSH_INTERVAL":                                "100ms",
+				"KUMA_ACCESS_LOG_RECONNECT_BASE_BACKOFF":                        "2s",
+				"KUMA_ACCESS_LOG_RECONNECT_MAX_BACKOFF":                         "20s",
+				"KUMA_ACCESS_LOG_SPILL_DIR":                                     "/var/spool/kuma-dp",
+				"KUMA_ACCESS_LOG_SPILL_MAX_BYTES":                               "1024",
 			}
 			for key, value := range env {
 				os.Setenv(key, value)
@@ -93,6 +100,13 @@ var _ = Describe("Config", func() {
 			Expect(cfg.DataplaneRuntime.DynamicConfiguration.RefreshInterval.Duration).To(Equal(5 * time.Second))
 			Expect(cfg.DNS.Enabled).To(BeTrue())
 			Expect(cfg.DNS.ProxyPort).To(Equal(uint32(5300)))
+			Expect(cfg.AccessLog.BufferSize).To(Equal(uint32(10)))
+			Expect(cfg.AccessLog.BatchMaxBytes).To(Equal(uint32(512)))
+			Expect(cfg.AccessLog.FlushInterval.Duration).To(Equal(100 * time.Millisecond))
+			Expect(cfg.AccessLog.ReconnectBaseBackoff.Duration).To(Equal(2 * time.Second))
+			Expect(cfg.AccessLog.ReconnectMaxBackoff.Duration).To(Equal(20 * time.Second))
+			Expect(cfg.AccessLog.SpillDir).To(Equal("/var/spool/kuma-dp"))
+			Expect(cfg.AccessLog.SpillMaxBytes).To(Equal(uint64(1024)))
 		})
 	})
 
@@ -157,5 +171,15 @@ var _ = Describe("Config", func() {
 		Entry("invalid cp url", func(cfg *kuma_dp.Config) {
 			cfg.ControlPlane.URL = ":333"
 		}),
+		Entry("access log max backoff lower than base backoff", func(cfg *kuma_dp.Config) {
+			cfg.AccessLog.ReconnectBaseBackoff.Duration = 10 * time.Second
+			cfg.AccessLog.ReconnectMaxBackoff.Duration = time.Second
+		}),
+		Entry("access log spill dir without max bytes", func(cfg *kuma_dp.Config) {
+			cfg.AccessLog.SpillDir = "/tmp"
+		}),
+		Entry("access log negative flush interval", func(cfg *kuma_dp.Config) {
+			cfg.AccessLog.FlushInterval.Duration = -time.Second
+		}),
 	)
 })
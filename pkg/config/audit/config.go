@@ -0,0 +1,78 @@
+package audit
+
+import (
+	"net/url"
+	"time"
+
+	"github.com/pkg/errors"
+
+	config_types "github.com/kumahq/kuma/v3/pkg/config/types"
+)
+
+type Config struct {
+	// Enabled turns on recording of resources created, updated or deleted through the API server,
+	// Kubernetes admission webhooks and KDS.
+	Enabled bool `json:"enabled" envconfig:"kuma_audit_enabled"`
+	// RecentEntries is the number of the most recent entries kept in memory, so they can be queried via the API server.
+	RecentEntries uint `json:"recentEntries" envconfig:"kuma_audit_recent_entries"`
+	// QueueSize is the number of entries waiting to be written to sinks. Entries are dropped when the queue is full.
+	QueueSize uint `json:"queueSize" envconfig:"kuma_audit_queue_size"`
+	// Stdout sink writes entries as JSON lines to the standard output.
+	Stdout StdoutSink `json:"stdout"`
+	// File sink appends entries as JSON lines to a file.
+	File FileSink `json:"file"`
+	// Webhook sink sends every entry as JSON in a POST request.
+	Webhook WebhookSink `json:"webhook"`
+}
+
+type StdoutSink struct {
+	Enabled bool `json:"enabled" envconfig:"kuma_audit_stdout_enabled"`
+}
+
+type FileSink struct {
+	// Path of the file. The sink is disabled when the path is empty.
+	Path string `json:"path" envconfig:"kuma_audit_file_path"`
+}
+
+type WebhookSink struct {
+	// URL of the webhook. The sink is disabled when the URL is empty.
+	URL string `json:"url" envconfig:"kuma_audit_webhook_url"`
+	// Timeout of a request to the webhook.
+	Timeout config_types.Duration `json:"timeout" envconfig:"kuma_audit_webhook_timeout"`
+}
+
+func (c Config) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+	if c.QueueSize == 0 {
+		return errors.New(".QueueSize must be greater than 0")
+	}
+	if c.Webhook.URL != "" {
+		u, err := url.Parse(c.Webhook.URL)
+		if err != nil {
+			return errors.Wrap(err, ".Webhook.URL is not a valid URL")
+		}
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return errors.New(".Webhook.URL must be an http or https URL")
+		}
+		if c.Webhook.Timeout.Duration <= 0 {
+			return errors.New(".Webhook.Timeout must be greater than 0")
+		}
+	}
+	return nil
+}
+
+func Default() Config {
+	return Config{
+		Enabled:       false,
+		RecentEntries: 1000,
+		QueueSize:     1000,
+		Stdout: StdoutSink{
+			Enabled: true,
+		},
+		Webhook: WebhookSink{
+			Timeout: config_types.Duration{Duration: 5 * time.Second},
+		},
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"go.uber.org/multierr"
 
 	"github.com/kumahq/kuma/v3/pkg/config"
+	"github.com/kumahq/kuma/v3/pkg/config/plugins/resources/embedded"
 	"github.com/kumahq/kuma/v3/pkg/config/plugins/resources/k8s"
 	"github.com/kumahq/kuma/v3/pkg/config/plugins/resources/postgres"
 	config_types "github.com/kumahq/kuma/v3/pkg/config/types"
@@ -21,14 +22,17 @@ const (
 	PostgresStore   StoreType = "postgres"
 	PgxStore        StoreType = "pgx"
 	MemoryStore     StoreType = "memory"
+	EmbeddedStore   StoreType = "embedded"
 )
 
 // StoreConfig defines Resource Store configuration
 type StoreConfig struct {
-	// Type of Store used in the Control Plane. Can be either "kubernetes", "postgres" or "memory"
+	// Type of Store used in the Control Plane. Can be either "kubernetes", "postgres", "memory" or "embedded"
 	Type StoreType `json:"type" envconfig:"kuma_store_type"`
 	// Postgres Store configuration
 	Postgres *postgres.PostgresStoreConfig `json:"postgres"`
+	// Embedded Store configuration
+	Embedded *embedded.EmbeddedStoreConfig `json:"embedded"`
 	// Kubernetes Store configuration
 	Kubernetes *k8s.KubernetesStoreConfig `json:"kubernetes"`
 	// Cache configuration
@@ -38,21 +42,26 @@ type StoreConfig struct {
 	// UnsafeDelete skips validation of resource delete.
 	// For example you don't have to delete all Dataplane objects before you delete a Mesh
 	UnsafeDelete bool `json:"unsafeDelete" envconfig:"kuma_store_unsafe_delete"`
+	// Encryption of Secrets and GlobalSecrets at rest
+	Encryption EncryptionConfig `json:"encryption"`
 }
 
 func DefaultStoreConfig() *StoreConfig {
 	return &StoreConfig{
 		Type:       MemoryStore,
 		Postgres:   postgres.DefaultPostgresStoreConfig(),
+		Embedded:   embedded.DefaultEmbeddedStoreConfig(),
 		Kubernetes: k8s.DefaultKubernetesStoreConfig(),
 		Cache:      DefaultCacheStoreConfig(),
 		Upsert:     DefaultUpsertConfig(),
+		Encryption: DefaultEncryptionConfig(),
 	}
 }
 
 func (s *StoreConfig) Sanitize() {
 	s.Kubernetes.Sanitize()
 	s.Postgres.Sanitize()
+	s.Embedded.Sanitize()
 	s.Cache.Sanitize()
 }
 
@@ -60,11 +69,16 @@ func (s *StoreConfig) PostProcess() error {
 	return multierr.Combine(
 		s.Kubernetes.PostProcess(),
 		s.Postgres.PostProcess(),
+		s.Embedded.PostProcess(),
 		s.Cache.PostProcess(),
+		s.Encryption.PostProcess(),
 	)
 }
 
 func (s *StoreConfig) Validate() error {
+	if err := s.Encryption.Validate(); err != nil {
+		return errors.Wrap(err, "Encryption validation failed")
+	}
 	switch s.Type {
 	case PostgresStore:
 		if err := s.Postgres.Validate(); err != nil {
@@ -77,8 +91,12 @@ func (s *StoreConfig) Validate() error {
 		return nil
 	case MemoryStore:
 		return nil
+	case EmbeddedStore:
+		if err := s.Embedded.Validate(); err != nil {
+			return errors.Wrap(err, "Embedded validation failed")
+		}
 	default:
-		return errors.Errorf("Type should be either %s, %s or %s", PostgresStore, KubernetesStore, MemoryStore)
+		return errors.Errorf("Type should be either %s, %s, %s or %s", PostgresStore, KubernetesStore, MemoryStore, EmbeddedStore)
 	}
 	if err := s.Cache.Validate(); err != nil {
 		return errors.Wrap(err, "Cache validation failed")
@@ -129,3 +147,51 @@ func (u *UpsertConfig) Validate() error {
 }
 
 var _ config.Config = &UpsertConfig{}
+
+type EncryptionType = string
+
+const (
+	NoneEncryption     EncryptionType = "none"
+	EnvelopeEncryption EncryptionType = "envelope"
+)
+
+var _ config.Config = &EncryptionConfig{}
+
+// EncryptionConfig defines how Secrets and GlobalSecrets are encrypted in Postgres, Memory and Embedded stores.
+// Secrets are never encrypted by the Control Plane on Kubernetes, because they are stored as Kubernetes Secrets.
+type EncryptionConfig struct {
+	config.BaseConfig
+
+	// Type of encryption. Can be either "none" or "envelope".
+	// "envelope" encrypts every secret with a random AES-256-GCM data key that is wrapped by a key encryption key.
+	Type EncryptionType `json:"type" envconfig:"kuma_store_encryption_type"`
+	// KeyFile is a path to the file with key encryption keys, used when type is "envelope".
+	KeyFile string `json:"keyFile" envconfig:"kuma_store_encryption_key_file"`
+	// ReEncryptOnStart re-encrypts secrets that are stored in plaintext or with a key other than the active one
+	// when the Control Plane becomes a leader.
+	ReEncryptOnStart bool `json:"reEncryptOnStart" envconfig:"kuma_store_encryption_re_encrypt_on_start"`
+	// AllowPlaintext lets the Control Plane read secrets stored in plaintext, used when type is "envelope".
+	// It should be enabled only while an existing store is migrated to encryption.
+	AllowPlaintext bool `json:"allowPlaintext" envconfig:"kuma_store_encryption_allow_plaintext"`
+}
+
+func DefaultEncryptionConfig() EncryptionConfig {
+	return EncryptionConfig{
+		Type:             NoneEncryption,
+		ReEncryptOnStart: true,
+	}
+}
+
+func (e *EncryptionConfig) Validate() error {
+	switch e.Type {
+	case NoneEncryption:
+		return nil
+	case EnvelopeEncryption:
+		if e.KeyFile == "" {
+			return errors.New("KeyFile has to be defined when Type is envelope")
+		}
+		return nil
+	default:
+		return errors.Errorf("Type should be either %s or %s", NoneEncryption, EnvelopeEncryption)
+	}
+}
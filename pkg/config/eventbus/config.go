@@ -4,6 +4,9 @@ type Config struct {
 	// BufferSize controls the buffer for every single event listener.
 	// If we go over buffer, additional delay may happen to various operation like insight recomputation or KDS.
 	BufferSize uint `json:"bufferSize" envconfig:"kuma_event_bus_buffer_size"`
+	// HistorySize is the number of the most recent events kept in memory, so listeners that fell behind
+	// or reconnected can resume from the last event they received. History is disabled when set to 0.
+	HistorySize uint `json:"historySize" envconfig:"kuma_event_bus_history_size"`
 }
 
 func (c Config) Validate() error {
@@ -12,6 +15,7 @@ func (c Config) Validate() error {
 
 func Default() Config {
 	return Config{
-		BufferSize: 100,
+		BufferSize:  100,
+		HistorySize: 1000,
 	}
 }
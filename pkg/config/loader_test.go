@@ -118,6 +118,11 @@ var _ = Describe("Config loader", func() {
 			Expect(cfg.Store.Upsert.ConflictRetryMaxTimes).To(Equal(uint(15)))
 			Expect(cfg.Store.Upsert.ConflictRetryJitterPercent).To(Equal(uint(10)))
 
+			Expect(cfg.Store.Encryption.Type).To(Equal(store.EnvelopeEncryption))
+			Expect(cfg.Store.Encryption.KeyFile).To(Equal("/path/to/keys.yaml"))
+			Expect(cfg.Store.Encryption.ReEncryptOnStart).To(BeFalse())
+			Expect(cfg.Store.Encryption.AllowPlaintext).To(BeTrue())
+
 			Expect(cfg.Store.Postgres.TLS.Mode).To(Equal(postgres.VerifyFull))
 			Expect(cfg.Store.Postgres.TLS.CertPath).To(Equal("/path/to/cert"))
 			Expect(cfg.Store.Postgres.TLS.KeyPath).To(Equal("/path/to/key"))
@@ -129,7 +134,11 @@ var _ = Describe("Config loader", func() {
 			Expect(cfg.Store.Postgres.ReadReplica.Ratio).To(Equal(uint(80)))
 			Expect(cfg.Store.Postgres.TolerateNewerDBVersions).To(BeTrue())
 
+			Expect(cfg.Store.Embedded.Path).To(Equal("/tmp/kuma-cp.db"))
+			Expect(cfg.Store.Embedded.LockTimeout.Duration).To(Equal(7 * time.Second))
+
 			Expect(cfg.ApiServer.ReadOnly).To(BeTrue())
+			Expect(cfg.ApiServer.FieldManagerConflictsOnPut).To(BeTrue())
 			Expect(cfg.ApiServer.HTTP.Enabled).To(BeFalse())
 			Expect(cfg.ApiServer.HTTP.Interface).To(Equal("192.168.0.1"))
 			Expect(cfg.ApiServer.HTTP.Port).To(Equal(uint32(15681)))
@@ -376,11 +385,24 @@ var _ = Describe("Config loader", func() {
 			Expect(cfg.Multizone.Zone.KDS.EventBasedWatchdog.DelayFullResync).To(BeTrue())
 
 			Expect(cfg.EventBus.BufferSize).To(Equal(uint(30)))
+			Expect(cfg.EventBus.HistorySize).To(Equal(uint(500)))
+
+			Expect(cfg.Audit.Enabled).To(BeTrue())
+			Expect(cfg.Audit.RecentEntries).To(Equal(uint(200)))
+			Expect(cfg.Audit.QueueSize).To(Equal(uint(300)))
+			Expect(cfg.Audit.Stdout.Enabled).To(BeFalse())
+			Expect(cfg.Audit.File.Path).To(Equal("/var/log/kuma/audit.log"))
+			Expect(cfg.Audit.Webhook.URL).To(Equal("https://audit.example.com/entries"))
+			Expect(cfg.Audit.Webhook.Timeout.Duration).To(Equal(3 * time.Second))
 
 			Expect(cfg.IPAM.MeshService.CIDR).To(Equal("251.0.0.0/8"))
 			Expect(cfg.IPAM.MeshExternalService.CIDR).To(Equal("252.0.0.0/8"))
 			Expect(cfg.IPAM.MeshMultiZoneService.CIDR).To(Equal("253.0.0.0/8"))
+			Expect(cfg.IPAM.MeshService.CIDRv6).To(Equal("fd00:fd01::/64"))
+			Expect(cfg.IPAM.MeshExternalService.CIDRv6).To(Equal("fd00:fd02::/64"))
+			Expect(cfg.IPAM.MeshMultiZoneService.CIDRv6).To(Equal("fd00:fd03::/64"))
 			Expect(cfg.IPAM.AllocationInterval.Duration).To(Equal(7 * time.Second))
+			Expect(cfg.IPAM.QuarantinePeriod.Duration).To(Equal(2 * time.Hour))
 			Expect(cfg.IPAM.KnownInternalCIDRs).To(Equal([]string{"10.8.0.0/16", "127.0.0.6/32"}))
 			Expect(cfg.MeshService.GenerationInterval.Duration).To(Equal(8 * time.Second))
 			Expect(cfg.MeshService.DeletionGracePeriod.Duration).To(Equal(11 * time.Second))
@@ -431,6 +453,9 @@ store:
       port: 35432
       ratio: 80
     tolerateNewerDBVersions: true
+  embedded:
+    path: /tmp/kuma-cp.db
+    lockTimeout: 7s
   kubernetes:
     systemNamespace: test-namespace
   cache:
@@ -440,6 +465,11 @@ store:
     conflictRetryBaseBackoff: 4s
     conflictRetryMaxTimes: 15
     conflictRetryJitterPercent: 10
+  encryption:
+    type: envelope
+    keyFile: /path/to/keys.yaml
+    reEncryptOnStart: false
+    allowPlaintext: true
 bootstrapServer:
   params:
     adminPort: 1234
@@ -475,6 +505,7 @@ apiServer:
       validator:
         useSecrets: false
   readOnly: true
+  fieldManagerConflictsOnPut: true
   corsAllowedDomains:
     - https://kuma
     - https://someapi
@@ -787,6 +818,18 @@ experimental:
   skipPersistedVIPs: true
 eventBus:
   bufferSize: 30
+  historySize: 500
+audit:
+  enabled: true
+  recentEntries: 200
+  queueSize: 300
+  stdout:
+    enabled: false
+  file:
+    path: /var/log/kuma/audit.log
+  webhook:
+    url: https://audit.example.com/entries
+    timeout: 3s
 coreResources:
   enabled:
   - meshservice
@@ -807,11 +850,15 @@ tracing:
 ipam:
   meshService:
     cidr: 251.0.0.0/8
+    cidrV6: fd00:fd01::/64
   meshExternalService:
     cidr: 252.0.0.0/8
+    cidrV6: fd00:fd02::/64
   meshMultiZoneService:
     cidr: 253.0.0.0/8
+    cidrV6: fd00:fd03::/64
   allocationInterval: 7s
+  quarantinePeriod: 2h
   knownInternalCIDRs:
   - 10.8.0.0/16
   - 127.0.0.6/32
@@ -838,6 +885,10 @@ meshService:
 				"KUMA_ENVIRONMENT":                                                                         "kubernetes",
 				"KUMA_STORE_TYPE":                                                                          "postgres",
 				"KUMA_STORE_UNSAFE_DELETE":                                                                 "true",
+				"KUMA_STORE_ENCRYPTION_TYPE":                                                               "envelope",
+				"KUMA_STORE_ENCRYPTION_KEY_FILE":                                                           "/path/to/keys.yaml",
+				"KUMA_STORE_ENCRYPTION_RE_ENCRYPT_ON_START":                                                "false",
+				"KUMA_STORE_ENCRYPTION_ALLOW_PLAINTEXT":                                                    "true",
 				"KUMA_STORE_POSTGRES_HOST":                                                                 "postgres.host",
 				"KUMA_STORE_POSTGRES_PORT":                                                                 "5432",
 				"KUMA_STORE_POSTGRES_USER":                                                                 "kuma",
@@ -862,6 +913,8 @@ meshService:
 				"KUMA_STORE_POSTGRES_READ_REPLICA_RATIO":                                                   "80",
 				"KUMA_STORE_POSTGRES_MAX_CONNECTION_IDLE_TIME":                                             "99s",
 				"KUMA_STORE_POSTGRES_TOLERATE_NEWER_DB_VERSIONS":                                           "true",
+				"KUMA_STORE_EMBEDDED_PATH":                                                                 "/tmp/kuma-cp.db",
+				"KUMA_STORE_EMBEDDED_LOCK_TIMEOUT":                                                         "7s",
 				"KUMA_STORE_KUBERNETES_SYSTEM_NAMESPACE":                                                   "test-namespace",
 				"KUMA_STORE_CACHE_ENABLED":                                                                 "false",
 				"KUMA_STORE_CACHE_EXPIRATION_TIME":                                                         "3s",
@@ -869,6 +922,7 @@ meshService:
 				"KUMA_STORE_UPSERT_CONFLICT_RETRY_MAX_TIMES":                                               "15",
 				"KUMA_STORE_UPSERT_CONFLICT_RETRY_JITTER_PERCENT":                                          "10",
 				"KUMA_API_SERVER_READ_ONLY":                                                                "true",
+				"KUMA_API_SERVER_FIELD_MANAGER_CONFLICTS_ON_PUT":                                           "true",
 				"KUMA_API_SERVER_HTTP_PORT":                                                                "15681",
 				"KUMA_API_SERVER_HTTP_INTERFACE":                                                           "192.168.0.1",
 				"KUMA_API_SERVER_HTTP_ENABLED":                                                             "false",
@@ -1110,6 +1164,14 @@ meshService:
 				"KUMA_TRACING_OPENTELEMETRY_ENDPOINT":                                                      "otel-collector:4317",
 				"KUMA_TRACING_OPENTELEMETRY_ENABLED":                                                       "true",
 				"KUMA_EVENT_BUS_BUFFER_SIZE":                                                               "30",
+				"KUMA_EVENT_BUS_HISTORY_SIZE":                                                              "500",
+				"KUMA_AUDIT_ENABLED":                                                                       "true",
+				"KUMA_AUDIT_RECENT_ENTRIES":                                                                "200",
+				"KUMA_AUDIT_QUEUE_SIZE":                                                                    "300",
+				"KUMA_AUDIT_STDOUT_ENABLED":                                                                "false",
+				"KUMA_AUDIT_FILE_PATH":                                                                     "/var/log/kuma/audit.log",
+				"KUMA_AUDIT_WEBHOOK_URL":                                                                   "https://audit.example.com/entries",
+				"KUMA_AUDIT_WEBHOOK_TIMEOUT":                                                               "3s",
 				"KUMA_PLUGIN_POLICIES_ENABLED":                                                             "meshaccesslog,meshcircuitbreaker",
 				"KUMA_CORE_RESOURCES_ENABLED":                                                              "meshservice",
 				"KUMA_CORE_RESOURCES_STATUS_MESH_SERVICE_INTERVAL":                                         "6s",
@@ -1120,7 +1182,11 @@ meshService:
 				"KUMA_IPAM_MESH_SERVICE_CIDR":                                                              "251.0.0.0/8",
 				"KUMA_IPAM_MESH_EXTERNAL_SERVICE_CIDR":                                                     "252.0.0.0/8",
 				"KUMA_IPAM_MESH_MULTI_ZONE_SERVICE_CIDR":                                                   "253.0.0.0/8",
+				"KUMA_IPAM_MESH_SERVICE_CIDR_V6":                                                           "fd00:fd01::/64",
+				"KUMA_IPAM_MESH_EXTERNAL_SERVICE_CIDR_V6":                                                  "fd00:fd02::/64",
+				"KUMA_IPAM_MESH_MULTI_ZONE_SERVICE_CIDR_V6":                                                "fd00:fd03::/64",
 				"KUMA_IPAM_ALLOCATION_INTERVAL":                                                            "7s",
+				"KUMA_IPAM_QUARANTINE_PERIOD":                                                              "2h",
 				"KUMA_IPAM_KNOWN_INTERNAL_CIDRS":                                                           "10.8.0.0/16,127.0.0.6/32",
 				"KUMA_MESH_SERVICE_GENERATION_INTERVAL":                                                    "8s",
 				"KUMA_MESH_SERVICE_DELETION_GRACE_PERIOD":                                                  "11s",
@@ -15,6 +15,7 @@ var DefaultEnabled = []string{
 	"meshproxypatches",
 	"meshratelimits",
 	"meshretries",
+	"meshserviceprobes",
 	"meshtcproutes",
 	"meshtimeouts",
 	"meshtlses",
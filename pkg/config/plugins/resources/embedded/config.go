@@ -0,0 +1,40 @@
+package embedded
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/kumahq/kuma/v3/pkg/config"
+	config_types "github.com/kumahq/kuma/v3/pkg/config/types"
+)
+
+var _ config.Config = &EmbeddedStoreConfig{}
+
+// EmbeddedStoreConfig defines the configuration of the embedded store.
+// The embedded store keeps resources in a single file on the local disk, so it can only be used by one instance of the control plane.
+type EmbeddedStoreConfig struct {
+	config.BaseConfig
+
+	// Path to the database file. The file is created if it doesn't exist.
+	Path string `json:"path" envconfig:"kuma_store_embedded_path"`
+	// LockTimeout is the time to wait for the lock on the database file that is held by another process.
+	LockTimeout config_types.Duration `json:"lockTimeout" envconfig:"kuma_store_embedded_lock_timeout"`
+}
+
+func (e *EmbeddedStoreConfig) Validate() error {
+	if e.Path == "" {
+		return errors.New("Path should not be empty")
+	}
+	if e.LockTimeout.Duration < 0 {
+		return errors.New("LockTimeout cannot be negative")
+	}
+	return nil
+}
+
+func DefaultEmbeddedStoreConfig() *EmbeddedStoreConfig {
+	return &EmbeddedStoreConfig{
+		Path:        "/var/lib/kuma-cp/store.db",
+		LockTimeout: config_types.Duration{Duration: 10 * time.Second},
+	}
+}
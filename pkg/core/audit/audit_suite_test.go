@@ -0,0 +1,11 @@
+package audit_test
+
+import (
+	"testing"
+
+	"github.com/kumahq/kuma/v3/pkg/test"
+)
+
+func TestAudit(t *testing.T) {
+	test.RunSpecs(t, "Audit Suite")
+}
@@ -0,0 +1,86 @@
+package audit
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	core_model "github.com/kumahq/kuma/v3/pkg/core/resources/model"
+)
+
+// Operation is a change of a resource.
+type Operation string
+
+const (
+	OperationCreate Operation = "Create"
+	OperationUpdate Operation = "Update"
+	OperationDelete Operation = "Delete"
+)
+
+// Source is where the change of a resource came from.
+type Source string
+
+const (
+	SourceAPIServer Source = "api-server"
+	// SourceK8sAdmission marks changes admitted by the validating webhook. They are recorded at admission, before
+	// Kubernetes persists them, so the write can still fail, e.g. on a conflict or when another webhook rejects it.
+	SourceK8sAdmission Source = "k8s-admission"
+	SourceKDS          Source = "kds"
+)
+
+// Entry is a single record of the audit log.
+type Entry struct {
+	Time      time.Time               `json:"time"`
+	User      string                  `json:"user"`
+	Groups    []string                `json:"groups,omitempty"`
+	Operation Operation               `json:"operation"`
+	Source    Source                  `json:"source"`
+	Type      core_model.ResourceType `json:"type"`
+	Mesh      string                  `json:"mesh,omitempty"`
+	Name      string                  `json:"name"`
+	// BeforeHash is a hash of the spec before the change. It's empty when the resource is created.
+	BeforeHash string `json:"beforeHash,omitempty"`
+	// AfterHash is a hash of the spec after the change. It's empty when the resource is deleted.
+	AfterHash string `json:"afterHash,omitempty"`
+}
+
+type sourceCtx struct{}
+
+// WithSource marks changes of resources made with the context to be recorded in the audit log as coming from the source.
+// Changes made with a context without a source, like the ones made by the control plane itself, are not recorded.
+func WithSource(ctx context.Context, source Source) context.Context {
+	return context.WithValue(ctx, sourceCtx{}, source)
+}
+
+func SourceFromCtx(ctx context.Context) (Source, bool) {
+	source, ok := ctx.Value(sourceCtx{}).(Source)
+	return source, ok
+}
+
+type specBeforeCtx struct{}
+
+// WithSpecBefore passes the spec of the resource before an update, so the audit log has its hash without reading
+// the resource again. Callers that change the spec of a loaded resource have to pass the spec before the change.
+func WithSpecBefore(ctx context.Context, spec core_model.ResourceSpec) context.Context {
+	return context.WithValue(ctx, specBeforeCtx{}, spec)
+}
+
+func specBeforeFromCtx(ctx context.Context) core_model.ResourceSpec {
+	spec, _ := ctx.Value(specBeforeCtx{}).(core_model.ResourceSpec)
+	return spec
+}
+
+// HashSpec returns a hex encoded SHA-256 of the JSON representation of the spec.
+// It returns an empty string when the spec can't be marshaled.
+func HashSpec(spec core_model.ResourceSpec) string {
+	if spec == nil {
+		return ""
+	}
+	bytes, err := core_model.ToJSON(spec)
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(bytes)
+	return hex.EncodeToString(sum[:])
+}
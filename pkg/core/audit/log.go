@@ -0,0 +1,173 @@
+package audit
+
+import (
+	"context"
+	"io"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/kumahq/kuma/v3/pkg/core"
+	core_model "github.com/kumahq/kuma/v3/pkg/core/resources/model"
+	"github.com/kumahq/kuma/v3/pkg/core/runtime/component"
+	core_metrics "github.com/kumahq/kuma/v3/pkg/metrics"
+)
+
+var log = core.Log.WithName("audit")
+
+// Log records changes of resources.
+type Log interface {
+	// Record adds the entry to the log. It must not block on I/O.
+	Record(entry Entry)
+	// Recent returns the most recent entries matching the query, the newest first.
+	Recent(query Query) []Entry
+}
+
+// Query selects entries of the audit log. Empty fields match all entries.
+type Query struct {
+	Type   core_model.ResourceType
+	Mesh   string
+	Name   string
+	User   string
+	Source Source
+	// Limit is the maximum number of returned entries. There is no limit when it's 0.
+	Limit int
+}
+
+func (q Query) Matches(entry Entry) bool {
+	return (q.Type == "" || q.Type == entry.Type) &&
+		(q.Mesh == "" || q.Mesh == entry.Mesh) &&
+		(q.Name == "" || q.Name == entry.Name) &&
+		(q.User == "" || q.User == entry.User) &&
+		(q.Source == "" || q.Source == entry.Source)
+}
+
+// NoopLog is used when the audit log is disabled.
+type NoopLog struct{}
+
+var _ Log = NoopLog{}
+
+func (NoopLog) Record(Entry) {}
+
+func (NoopLog) Recent(Query) []Entry {
+	return nil
+}
+
+// Recorder keeps the most recent entries in memory and writes entries to sinks in the background,
+// so recording a change doesn't slow down the operation that made it.
+type Recorder struct {
+	sinks   []Sink
+	queue   chan Entry
+	dropped prometheus.Counter
+	failed  *prometheus.CounterVec
+
+	mtx    sync.RWMutex
+	recent []Entry
+	next   int
+}
+
+var (
+	_ Log                 = &Recorder{}
+	_ component.Component = &Recorder{}
+)
+
+func NewRecorder(recentEntries uint, queueSize uint, sinks []Sink, metrics core_metrics.Metrics) (*Recorder, error) {
+	dropped := prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "audit_entries_dropped",
+		Help: "Number of audit entries not written to sinks because the queue was full",
+	})
+	failed := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "audit_sink_errors",
+		Help: "Number of audit entries that failed to be written to a sink",
+	}, []string{"sink"})
+	if err := metrics.BulkRegister(dropped, failed); err != nil {
+		return nil, err
+	}
+	return &Recorder{
+		sinks:   sinks,
+		queue:   make(chan Entry, queueSize),
+		dropped: dropped,
+		failed:  failed,
+		recent:  make([]Entry, 0, recentEntries),
+	}, nil
+}
+
+func (r *Recorder) Record(entry Entry) {
+	if entry.Time.IsZero() {
+		entry.Time = core.Now()
+	}
+	r.mtx.Lock()
+	switch {
+	case cap(r.recent) == 0:
+	case len(r.recent) < cap(r.recent):
+		r.recent = append(r.recent, entry)
+	default:
+		r.recent[r.next] = entry
+		r.next = (r.next + 1) % len(r.recent)
+	}
+	r.mtx.Unlock()
+
+	select {
+	case r.queue <- entry:
+	default:
+		r.dropped.Inc()
+		log.Info("[WARNING] audit entry is not written to sinks because the queue is full. Consider increasing the queue size using KUMA_AUDIT_QUEUE_SIZE",
+			"entry", entry,
+		)
+	}
+}
+
+func (r *Recorder) Recent(query Query) []Entry {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+	var entries []Entry
+	for i := range len(r.recent) {
+		// iterate from the newest entry which is right before the next slot to overwrite
+		entry := r.recent[(r.next-1-i+2*len(r.recent))%len(r.recent)]
+		if !query.Matches(entry) {
+			continue
+		}
+		entries = append(entries, entry)
+		if query.Limit > 0 && len(entries) == query.Limit {
+			break
+		}
+	}
+	return entries
+}
+
+func (r *Recorder) Start(stop <-chan struct{}) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	defer r.closeSinks()
+	for {
+		select {
+		case <-stop:
+			return nil
+		case entry := <-r.queue:
+			r.write(ctx, entry)
+		}
+	}
+}
+
+func (r *Recorder) NeedLeaderElection() bool {
+	return false
+}
+
+func (r *Recorder) write(ctx context.Context, entry Entry) {
+	for _, sink := range r.sinks {
+		if err := sink.Write(ctx, entry); err != nil {
+			r.failed.WithLabelValues(sink.Name()).Inc()
+			log.Error(err, "could not write audit entry", "sink", sink.Name(), "entry", entry)
+		}
+	}
+}
+
+func (r *Recorder) closeSinks() {
+	for _, sink := range r.sinks {
+		if closer, ok := sink.(io.Closer); ok {
+			if err := closer.Close(); err != nil {
+				log.Error(err, "could not close audit sink", "sink", sink.Name())
+			}
+		}
+	}
+}
@@ -0,0 +1,178 @@
+package audit_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/kumahq/kuma/v3/pkg/core/audit"
+	core_mesh "github.com/kumahq/kuma/v3/pkg/core/resources/apis/mesh"
+	core_metrics "github.com/kumahq/kuma/v3/pkg/metrics"
+	test_metrics "github.com/kumahq/kuma/v3/pkg/test/metrics"
+)
+
+type syncBuffer struct {
+	mtx sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+	return b.buf.String()
+}
+
+var _ = Describe("Recorder", func() {
+	var metrics core_metrics.Metrics
+
+	BeforeEach(func() {
+		var err error
+		metrics, err = core_metrics.NewMetrics("")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	entry := func(name string) audit.Entry {
+		return audit.Entry{
+			User:      "john",
+			Operation: audit.OperationCreate,
+			Source:    audit.SourceAPIServer,
+			Type:      core_mesh.MeshType,
+			Name:      name,
+		}
+	}
+
+	names := func(entries []audit.Entry) []string {
+		var out []string
+		for _, e := range entries {
+			out = append(out, e.Name)
+		}
+		return out
+	}
+
+	It("should keep the most recent entries, the newest first", func() {
+		// given
+		recorder, err := audit.NewRecorder(3, 10, nil, metrics)
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		for _, name := range []string{"m-1", "m-2", "m-3", "m-4", "m-5"} {
+			recorder.Record(entry(name))
+		}
+
+		// then
+		Expect(names(recorder.Recent(audit.Query{}))).To(Equal([]string{"m-5", "m-4", "m-3"}))
+		Expect(names(recorder.Recent(audit.Query{Limit: 2}))).To(Equal([]string{"m-5", "m-4"}))
+		Expect(names(recorder.Recent(audit.Query{Name: "m-4"}))).To(Equal([]string{"m-4"}))
+		Expect(recorder.Recent(audit.Query{Source: audit.SourceKDS})).To(BeEmpty())
+	})
+
+	It("should write entries to sinks", func() {
+		// given
+		buf := &syncBuffer{}
+		recorder, err := audit.NewRecorder(3, 10, []audit.Sink{audit.NewWriterSink("buffer", buf)}, metrics)
+		Expect(err).ToNot(HaveOccurred())
+		stop := make(chan struct{})
+		defer close(stop)
+		go func() {
+			defer GinkgoRecover()
+			Expect(recorder.Start(stop)).To(Succeed())
+		}()
+
+		// when
+		e := entry("m-1")
+		e.Time = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+		recorder.Record(e)
+
+		// then
+		Eventually(buf.String).Should(Equal(`{"time":"2026-01-01T00:00:00Z","user":"john","operation":"Create","source":"api-server","type":"Mesh","name":"m-1"}` + "\n"))
+	})
+
+	It("should drop entries when the queue is full", func() {
+		// given
+		recorder, err := audit.NewRecorder(3, 1, nil, metrics)
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		recorder.Record(entry("m-1"))
+		recorder.Record(entry("m-2"))
+
+		// then
+		Expect(test_metrics.FindMetric(metrics, "audit_entries_dropped").Counter.GetValue()).To(Equal(1.0))
+		Expect(recorder.Recent(audit.Query{})).To(HaveLen(2))
+	})
+})
+
+var _ = Describe("Sinks", func() {
+	It("should append entries to a file", func() {
+		// given
+		path := filepath.Join(GinkgoT().TempDir(), "audit.log")
+		Expect(os.WriteFile(path, []byte("{}\n"), 0o600)).To(Succeed())
+		sink, err := audit.NewFileSink(path)
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		Expect(sink.Write(context.Background(), audit.Entry{Name: "m-1"})).To(Succeed())
+		Expect(sink.(io.Closer).Close()).To(Succeed())
+
+		// then
+		content, err := os.ReadFile(path)
+		Expect(err).ToNot(HaveOccurred())
+		lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+		Expect(lines).To(HaveLen(2))
+		Expect(lines[1]).To(ContainSubstring(`"name":"m-1"`))
+	})
+
+	It("should post entries to a webhook", func() {
+		// given
+		received := make(chan audit.Entry, 1)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+			Expect(r.Method).To(Equal(http.MethodPost))
+			Expect(r.Header.Get("Content-Type")).To(Equal("application/json"))
+			var e audit.Entry
+			Expect(json.NewDecoder(r.Body).Decode(&e)).To(Succeed())
+			received <- e
+		}))
+		defer server.Close()
+		sink := audit.NewWebhookSink(server.URL, server.Client())
+
+		// when
+		err := sink.Write(context.Background(), audit.Entry{Name: "m-1", Operation: audit.OperationDelete})
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(<-received).To(Equal(audit.Entry{Name: "m-1", Operation: audit.OperationDelete}))
+	})
+
+	It("should return an error when a webhook rejects an entry", func() {
+		// given
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer server.Close()
+		sink := audit.NewWebhookSink(server.URL, server.Client())
+
+		// when
+		err := sink.Write(context.Background(), audit.Entry{Name: "m-1"})
+
+		// then
+		Expect(err).To(MatchError("webhook responded with status code 500"))
+	})
+})
@@ -0,0 +1,129 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	config_audit "github.com/kumahq/kuma/v3/pkg/config/audit"
+)
+
+// Sink is a destination of audit entries.
+type Sink interface {
+	Name() string
+	Write(ctx context.Context, entry Entry) error
+}
+
+// NewSinks creates sinks enabled in the config.
+func NewSinks(cfg config_audit.Config) ([]Sink, error) {
+	var sinks []Sink
+	if cfg.Stdout.Enabled {
+		sinks = append(sinks, NewWriterSink("stdout", os.Stdout))
+	}
+	if cfg.File.Path != "" {
+		sink, err := NewFileSink(cfg.File.Path)
+		if err != nil {
+			return nil, err
+		}
+		sinks = append(sinks, sink)
+	}
+	if cfg.Webhook.URL != "" {
+		sinks = append(sinks, NewWebhookSink(cfg.Webhook.URL, &http.Client{Timeout: cfg.Webhook.Timeout.Duration}))
+	}
+	return sinks, nil
+}
+
+type writerSink struct {
+	name string
+	mtx  sync.Mutex
+	w    io.Writer
+}
+
+// NewWriterSink writes entries as JSON lines.
+func NewWriterSink(name string, w io.Writer) Sink {
+	return &writerSink{
+		name: name,
+		w:    w,
+	}
+}
+
+func (s *writerSink) Name() string {
+	return s.name
+}
+
+func (s *writerSink) Write(_ context.Context, entry Entry) error {
+	bytes, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	_, err = s.w.Write(append(bytes, '\n'))
+	return err
+}
+
+type fileSink struct {
+	Sink
+	file *os.File
+}
+
+// NewFileSink appends entries as JSON lines to the file, creating it when it doesn't exist.
+func NewFileSink(path string) (Sink, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not open audit log file %s", path)
+	}
+	return &fileSink{
+		Sink: NewWriterSink("file", file),
+		file: file,
+	}, nil
+}
+
+func (s *fileSink) Close() error {
+	return s.file.Close()
+}
+
+type webhookSink struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhookSink sends every entry as JSON in a POST request to the URL.
+func NewWebhookSink(url string, client *http.Client) Sink {
+	return &webhookSink{
+		url:    url,
+		client: client,
+	}
+}
+
+func (s *webhookSink) Name() string {
+	return "webhook"
+}
+
+func (s *webhookSink) Write(ctx context.Context, entry Entry) error {
+	body, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return errors.Errorf("webhook responded with status code %d", resp.StatusCode)
+	}
+	return nil
+}
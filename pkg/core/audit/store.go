@@ -0,0 +1,140 @@
+package audit
+
+import (
+	"context"
+	"sync"
+
+	core_model "github.com/kumahq/kuma/v3/pkg/core/resources/model"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/store"
+	"github.com/kumahq/kuma/v3/pkg/core/user"
+)
+
+// auditedStore records changes of resources made with a context that has a source (see WithSource).
+type auditedStore struct {
+	store.ResourceStore
+	log Log
+}
+
+// NewStore wraps the store so changes of resources coming from the API server or KDS are recorded in the log.
+func NewStore(delegate store.ResourceStore, log Log) store.ResourceStore {
+	return &auditedStore{
+		ResourceStore: delegate,
+		log:           log,
+	}
+}
+
+func (s *auditedStore) Create(ctx context.Context, resource core_model.Resource, fs ...store.CreateOptionsFunc) error {
+	if err := s.ResourceStore.Create(ctx, resource, fs...); err != nil {
+		return err
+	}
+	opts := store.NewCreateOptions(fs...)
+	s.record(ctx, OperationCreate, resource.Descriptor().Name, core_model.ResourceKey{Mesh: opts.Mesh, Name: opts.Name}, nil, resource.GetSpec())
+	return nil
+}
+
+func (s *auditedStore) Update(ctx context.Context, resource core_model.Resource, fs ...store.UpdateOptionsFunc) error {
+	if err := s.ResourceStore.Update(ctx, resource, fs...); err != nil {
+		return err
+	}
+	// the caller changed the spec of the loaded resource, so the spec before the update comes from the caller (see WithSpecBefore)
+	s.record(ctx, OperationUpdate, resource.Descriptor().Name, core_model.MetaToResourceKey(resource.GetMeta()), specBeforeFromCtx(ctx), resource.GetSpec())
+	return nil
+}
+
+func (s *auditedStore) Delete(ctx context.Context, resource core_model.Resource, fs ...store.DeleteOptionsFunc) error {
+	opts := store.NewDeleteOptions(fs...)
+	// callers load the resource before deleting it, otherwise the spec before is unknown
+	var before core_model.ResourceSpec
+	if resource.GetMeta() != nil {
+		before = resource.GetSpec()
+	}
+	if err := s.ResourceStore.Delete(ctx, resource, fs...); err != nil {
+		return err
+	}
+	s.record(ctx, OperationDelete, resource.Descriptor().Name, core_model.ResourceKey{Mesh: opts.Mesh, Name: opts.Name}, before, nil)
+	return nil
+}
+
+func (s *auditedStore) record(ctx context.Context, op Operation, typ core_model.ResourceType, key core_model.ResourceKey, before, after core_model.ResourceSpec) {
+	source, ok := SourceFromCtx(ctx)
+	if !ok {
+		return
+	}
+	u := user.FromCtx(ctx)
+	entry := Entry{
+		User:       u.Name,
+		Groups:     u.Groups,
+		Operation:  op,
+		Source:     source,
+		Type:       typ,
+		Mesh:       key.Mesh,
+		Name:       key.Name,
+		BeforeHash: HashSpec(before),
+		AfterHash:  HashSpec(after),
+	}
+	// a change made in a transaction is recorded only once the transaction is committed
+	if tx, ok := store.TxFromCtx(ctx); ok {
+		if tx, ok := tx.(*auditedTransaction); ok {
+			tx.add(s.log, entry)
+			return
+		}
+	}
+	s.log.Record(entry)
+}
+
+type auditedTransactions struct {
+	store.Transactions
+}
+
+// NewTransactions wraps the transactions so changes recorded by the audited store in a transaction
+// are added to the log after the transaction is committed and dropped when it's rolled back.
+func NewTransactions(delegate store.Transactions) store.Transactions {
+	return &auditedTransactions{
+		Transactions: delegate,
+	}
+}
+
+func (t *auditedTransactions) Begin(ctx context.Context) (store.Transaction, error) {
+	tx, err := t.Transactions.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &auditedTransaction{Transaction: tx}, nil
+}
+
+type pendingEntry struct {
+	log   Log
+	entry Entry
+}
+
+type auditedTransaction struct {
+	store.Transaction
+	sync.Mutex
+	pending []pendingEntry
+}
+
+func (t *auditedTransaction) add(log Log, entry Entry) {
+	t.Lock()
+	defer t.Unlock()
+	t.pending = append(t.pending, pendingEntry{log: log, entry: entry})
+}
+
+func (t *auditedTransaction) Commit(ctx context.Context) error {
+	if err := t.Transaction.Commit(ctx); err != nil {
+		return err
+	}
+	t.Lock()
+	defer t.Unlock()
+	for _, p := range t.pending {
+		p.log.Record(p.entry)
+	}
+	t.pending = nil
+	return nil
+}
+
+func (t *auditedTransaction) Rollback(ctx context.Context) error {
+	t.Lock()
+	t.pending = nil
+	t.Unlock()
+	return t.Transaction.Rollback(ctx)
+}
@@ -0,0 +1,169 @@
+package audit_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/v3/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/v3/pkg/core/audit"
+	core_mesh "github.com/kumahq/kuma/v3/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/model"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/store"
+	"github.com/kumahq/kuma/v3/pkg/core/user"
+	core_metrics "github.com/kumahq/kuma/v3/pkg/metrics"
+	"github.com/kumahq/kuma/v3/pkg/plugins/resources/memory"
+)
+
+var _ = Describe("Audited store", func() {
+	var recorder *audit.Recorder
+	var auditedStore store.ResourceStore
+
+	BeforeEach(func() {
+		metrics, err := core_metrics.NewMetrics("")
+		Expect(err).ToNot(HaveOccurred())
+		recorder, err = audit.NewRecorder(10, 10, nil, metrics)
+		Expect(err).ToNot(HaveOccurred())
+		auditedStore = audit.NewStore(memory.NewStore(), recorder)
+	})
+
+	It("should record changes made with a source", func() {
+		// given
+		ctx := audit.WithSource(user.Ctx(context.Background(), user.User{Name: "john", Groups: []string{"devs"}}), audit.SourceAPIServer)
+		mesh := core_mesh.NewMeshResource()
+
+		// when
+		Expect(auditedStore.Create(ctx, mesh, store.CreateByKey("mesh-1", model.NoMesh))).To(Succeed())
+		created := audit.HashSpec(mesh.Spec)
+		_ = mesh.SetSpec(&mesh_proto.Mesh{SkipCreatingInitialPolicies: []string{"*"}})
+		Expect(auditedStore.Update(audit.WithSpecBefore(ctx, core_mesh.NewMeshResource().Spec), mesh)).To(Succeed())
+		updated := audit.HashSpec(mesh.Spec)
+		Expect(auditedStore.Delete(ctx, mesh, store.DeleteByKey("mesh-1", model.NoMesh))).To(Succeed())
+
+		// then
+		entries := recorder.Recent(audit.Query{})
+		Expect(entries).To(HaveLen(3))
+		for i := range entries {
+			Expect(entries[i].Time.IsZero()).To(BeFalse())
+			entries[i].Time = time.Time{}
+		}
+		base := audit.Entry{User: "john", Groups: []string{"devs"}, Source: audit.SourceAPIServer, Type: core_mesh.MeshType, Name: "mesh-1"}
+		deleted, update, create := base, base, base
+		deleted.Operation, deleted.BeforeHash = audit.OperationDelete, updated
+		update.Operation, update.BeforeHash, update.AfterHash = audit.OperationUpdate, created, updated
+		create.Operation, create.AfterHash = audit.OperationCreate, created
+		Expect(entries).To(Equal([]audit.Entry{deleted, update, create}))
+		Expect(created).ToNot(Equal(updated))
+	})
+
+	It("should record an update without the spec before with an empty hash before", func() {
+		// given
+		ctx := audit.WithSource(context.Background(), audit.SourceKDS)
+		mesh := core_mesh.NewMeshResource()
+		Expect(auditedStore.Create(ctx, mesh, store.CreateByKey("mesh-1", model.NoMesh))).To(Succeed())
+
+		// when
+		mesh.Spec.SkipCreatingInitialPolicies = []string{"*"}
+		Expect(auditedStore.Update(ctx, mesh)).To(Succeed())
+
+		// then
+		entries := recorder.Recent(audit.Query{})
+		Expect(entries).To(HaveLen(2))
+		Expect(entries[0].Operation).To(Equal(audit.OperationUpdate))
+		Expect(entries[0].BeforeHash).To(BeEmpty())
+		Expect(entries[0].AfterHash).To(Equal(audit.HashSpec(mesh.Spec)))
+	})
+
+	It("should not record changes made without a source", func() {
+		// when
+		Expect(auditedStore.Create(context.Background(), core_mesh.NewMeshResource(), store.CreateByKey("mesh-1", model.NoMesh))).To(Succeed())
+
+		// then
+		Expect(recorder.Recent(audit.Query{})).To(BeEmpty())
+	})
+
+	It("should not record failed changes", func() {
+		// given
+		ctx := audit.WithSource(context.Background(), audit.SourceKDS)
+
+		// when
+		err := auditedStore.Delete(ctx, core_mesh.NewMeshResource(), store.DeleteByKey("mesh-1", model.NoMesh))
+
+		// then
+		Expect(store.IsNotFound(err)).To(BeTrue())
+		Expect(recorder.Recent(audit.Query{})).To(BeEmpty())
+	})
+
+	Context("in a transaction", func() {
+		create := func(ctx context.Context) error {
+			return auditedStore.Create(ctx, core_mesh.NewMeshResource(), store.CreateByKey("mesh-1", model.NoMesh))
+		}
+
+		It("should record changes once the transaction is committed", func() {
+			// given
+			ctx := audit.WithSource(context.Background(), audit.SourceAPIServer)
+			tx, err := audit.NewTransactions(store.NoTransactions{}).Begin(ctx)
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			Expect(create(store.CtxWithTx(ctx, tx))).To(Succeed())
+
+			// then
+			Expect(recorder.Recent(audit.Query{})).To(BeEmpty())
+
+			// when
+			Expect(tx.Commit(ctx)).To(Succeed())
+
+			// then
+			entries := recorder.Recent(audit.Query{})
+			Expect(entries).To(HaveLen(1))
+			Expect(entries[0].Operation).To(Equal(audit.OperationCreate))
+		})
+
+		It("should not record changes of a rolled back transaction", func() {
+			// given
+			ctx := audit.WithSource(context.Background(), audit.SourceAPIServer)
+
+			// when
+			err := store.InTx(ctx, audit.NewTransactions(store.NoTransactions{}), func(ctx context.Context) error {
+				if err := create(ctx); err != nil {
+					return err
+				}
+				return errors.New("failed after the create")
+			})
+
+			// then
+			Expect(err).To(MatchError("failed after the create"))
+			Expect(recorder.Recent(audit.Query{})).To(BeEmpty())
+		})
+
+		It("should not record changes of a transaction that failed to commit", func() {
+			// given
+			ctx := audit.WithSource(context.Background(), audit.SourceAPIServer)
+
+			// when
+			err := store.InTx(ctx, audit.NewTransactions(failingCommitTransactions{}), create)
+
+			// then
+			Expect(err).To(MatchError("commit failed"))
+			Expect(recorder.Recent(audit.Query{})).To(BeEmpty())
+		})
+	})
+})
+
+type failingCommitTransactions struct{}
+
+func (failingCommitTransactions) Begin(context.Context) (store.Transaction, error) {
+	return failingCommitTransaction{}, nil
+}
+
+type failingCommitTransaction struct {
+	store.NoopTransaction
+}
+
+func (failingCommitTransaction) Commit(context.Context) error {
+	return errors.New("commit failed")
+}
@@ -6,6 +6,7 @@ import (
 	"net/http"
 
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/kumahq/kuma/v3/pkg/api-server/customization"
 	kuma_cp "github.com/kumahq/kuma/v3/pkg/config/app/kuma-cp"
@@ -13,6 +14,7 @@ import (
 	"github.com/kumahq/kuma/v3/pkg/config/core/resources/store"
 	"github.com/kumahq/kuma/v3/pkg/core"
 	"github.com/kumahq/kuma/v3/pkg/core/access"
+	"github.com/kumahq/kuma/v3/pkg/core/audit"
 	config_manager "github.com/kumahq/kuma/v3/pkg/core/config/manager"
 	"github.com/kumahq/kuma/v3/pkg/core/datasource"
 	"github.com/kumahq/kuma/v3/pkg/core/dns/lookup"
@@ -91,6 +93,10 @@ func buildRuntime(appCtx context.Context, cfg kuma_cp.Config) (core_runtime.Runt
 	if err := initializeMetrics(builder); err != nil {
 		return nil, err
 	}
+	auditRecorder, err := initializeAuditLog(cfg, builder)
+	if err != nil {
+		return nil, err
+	}
 	if err := initializeResourceStore(cfg, builder); err != nil {
 		return nil, err
 	}
@@ -115,7 +121,12 @@ func buildRuntime(appCtx context.Context, cfg kuma_cp.Config) (core_runtime.Runt
 		Mesh:      mesh_managers.NewMeshValidator(builder.ResourceStore()),
 	})
 
-	if err := initializeResourceManager(cfg, builder); err != nil { //nolint:contextcheck
+	cipher, err := initializeSecretCipher(cfg, builder.Metrics())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := initializeResourceManager(cfg, builder, cipher); err != nil { //nolint:contextcheck
 		return nil, err
 	}
 
@@ -204,6 +215,18 @@ func buildRuntime(appCtx context.Context, cfg kuma_cp.Config) (core_runtime.Runt
 		return nil, err
 	}
 
+	if auditRecorder != nil {
+		if err := rt.Add(auditRecorder); err != nil {
+			return nil, err
+		}
+	}
+
+	if rotatable, ok := cipher.(secret_cipher.RotatableCipher); ok && cfg.Store.Encryption.ReEncryptOnStart {
+		if err := rt.Add(secret_manager.NewKeyRotator(builder.SecretStore(), rt.ResourceManager(), rotatable, rt.Tenants())); err != nil {
+			return nil, err
+		}
+	}
+
 	for name, plugin := range core_plugins.Plugins().RuntimePlugins() {
 		if err := plugin.Customize(rt); err != nil {
 			return nil, errors.Wrapf(err, "failed to configure runtime plugin:'%s'", name)
@@ -269,6 +292,9 @@ func initializeResourceStore(cfg kuma_cp.Config, builder *core_runtime.Builder)
 	case store.PostgresStore:
 		pluginName = core_plugins.Postgres
 		pluginConfig = cfg.Store.Postgres
+	case store.EmbeddedStore:
+		pluginName = core_plugins.Embedded
+		pluginConfig = cfg.Store.Embedded
 	default:
 		return errors.Errorf("unknown store type %s", cfg.Store.Type)
 	}
@@ -282,8 +308,11 @@ func initializeResourceStore(cfg kuma_cp.Config, builder *core_runtime.Builder)
 		return err
 	}
 	builder.WithResourceStore(core_store.NewCustomizableResourceStore(rs))
+	if cfg.Audit.Enabled {
+		transactions = audit.NewTransactions(transactions)
+	}
 	builder.WithTransactions(transactions)
-	eventBus, err := events.NewEventBus(cfg.EventBus.BufferSize, builder.Metrics())
+	eventBus, err := events.NewEventBus(cfg.EventBus.BufferSize, builder.Metrics(), events.WithHistorySize(cfg.EventBus.HistorySize))
 	if err != nil {
 		return err
 	}
@@ -298,17 +327,39 @@ func initializeResourceStore(cfg kuma_cp.Config, builder *core_runtime.Builder)
 		return err
 	}
 
-	builder.WithResourceStore(core_store.NewCustomizableResourceStore(meteredStore))
+	var resourceStore core_store.ResourceStore = meteredStore
+	if cfg.Audit.Enabled {
+		resourceStore = audit.NewStore(meteredStore, builder.AuditLog())
+	}
+	builder.WithResourceStore(core_store.NewCustomizableResourceStore(resourceStore))
 	return nil
 }
 
+// initializeAuditLog returns the recorder that has to be started, or nil when the audit log is disabled.
+func initializeAuditLog(cfg kuma_cp.Config, builder *core_runtime.Builder) (*audit.Recorder, error) {
+	if !cfg.Audit.Enabled {
+		builder.WithAuditLog(audit.NoopLog{})
+		return nil, nil
+	}
+	sinks, err := audit.NewSinks(cfg.Audit)
+	if err != nil {
+		return nil, err
+	}
+	recorder, err := audit.NewRecorder(cfg.Audit.RecentEntries, cfg.Audit.QueueSize, sinks, builder.Metrics())
+	if err != nil {
+		return nil, err
+	}
+	builder.WithAuditLog(recorder)
+	return recorder, nil
+}
+
 func initializeSecretStore(cfg kuma_cp.Config, builder *core_runtime.Builder) error {
 	var pluginName core_plugins.PluginName
 	var pluginConfig core_plugins.PluginConfig
 	switch cfg.Store.Type {
 	case store.KubernetesStore:
 		pluginName = core_plugins.Kubernetes
-	case store.MemoryStore, store.PostgresStore:
+	case store.MemoryStore, store.PostgresStore, store.EmbeddedStore:
 		pluginName = core_plugins.Universal
 	default:
 		return errors.Errorf("unknown store type %s", cfg.Store.Type)
@@ -320,6 +371,9 @@ func initializeSecretStore(cfg kuma_cp.Config, builder *core_runtime.Builder) er
 	if ss, err := plugin.NewSecretStore(builder, pluginConfig); err != nil {
 		return err
 	} else {
+		if cfg.Audit.Enabled {
+			ss = audit.NewStore(ss, builder.AuditLog())
+		}
 		builder.WithSecretStore(ss)
 		return nil
 	}
@@ -331,7 +385,7 @@ func initializeConfigStore(cfg kuma_cp.Config, builder *core_runtime.Builder) er
 	switch cfg.Store.Type {
 	case store.KubernetesStore:
 		pluginName = core_plugins.Kubernetes
-	case store.MemoryStore, store.PostgresStore:
+	case store.MemoryStore, store.PostgresStore, store.EmbeddedStore:
 		pluginName = core_plugins.Universal
 	default:
 		return errors.Errorf("unknown store type %s", cfg.Store.Type)
@@ -386,7 +440,39 @@ func initializeAPIServerAuthenticator(builder *core_runtime.Builder) error {
 	return nil
 }
 
-func initializeResourceManager(cfg kuma_cp.Config, builder *core_runtime.Builder) error {
+func initializeSecretCipher(cfg kuma_cp.Config, metrics metrics.Metrics) (secret_cipher.Cipher, error) {
+	switch cfg.Store.Type {
+	case store.KubernetesStore:
+		return secret_cipher.None(), nil // deliberately turn encryption off on Kubernetes
+	case store.MemoryStore, store.PostgresStore, store.EmbeddedStore:
+	default:
+		return nil, errors.Errorf("unknown store type %s", cfg.Store.Type)
+	}
+	switch cfg.Store.Encryption.Type {
+	case store.EnvelopeEncryption:
+		provider, err := secret_cipher.NewFileKeyProvider(cfg.Store.Encryption.KeyFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not load key encryption keys")
+		}
+		var opts []secret_cipher.EnvelopeOption
+		if cfg.Store.Encryption.AllowPlaintext {
+			plaintextReads := prometheus.NewCounter(prometheus.CounterOpts{
+				Name: "secrets_plaintext_reads",
+				Help: "Number of secrets read from the store in plaintext while encryption is enabled",
+			})
+			if err := metrics.Register(plaintextReads); err != nil {
+				return nil, err
+			}
+			log.Info("WARNING: secrets stored in plaintext are readable. Unset KUMA_STORE_ENCRYPTION_ALLOW_PLAINTEXT once all secrets are re-encrypted.")
+			opts = append(opts, secret_cipher.AllowPlaintext(plaintextReads))
+		}
+		return secret_cipher.NewEnvelope(provider, opts...), nil
+	default:
+		return secret_cipher.None(), nil
+	}
+}
+
+func initializeResourceManager(cfg kuma_cp.Config, builder *core_runtime.Builder, cipher secret_cipher.Cipher) error {
 	defaultManager := core_manager.NewResourceManager(builder.ResourceStore())
 	customizableManager := core_manager.NewCustomizableResourceManager(defaultManager, nil)
 
@@ -429,15 +515,6 @@ func initializeResourceManager(cfg kuma_cp.Config, builder *core_runtime.Builder
 		zoneinsight.NewZoneInsightManager(builder.ResourceStore(), builder.Config().Metrics.Zone),
 	)
 
-	var cipher secret_cipher.Cipher
-	switch cfg.Store.Type {
-	case store.KubernetesStore:
-		cipher = secret_cipher.None() // deliberately turn encryption off on Kubernetes
-	case store.MemoryStore, store.PostgresStore:
-		cipher = secret_cipher.TODO() // get back to encryption in universal case
-	default:
-		return errors.Errorf("unknown store type %s", cfg.Store.Type)
-	}
 	customizableManager.Customize(
 		system.SecretType,
 		secret_manager.NewSecretManager(builder.SecretStore(), cipher),
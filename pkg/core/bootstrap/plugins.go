@@ -7,6 +7,7 @@ import (
 	_ "github.com/kumahq/kuma/v3/pkg/plugins/bootstrap/universal"
 	_ "github.com/kumahq/kuma/v3/pkg/plugins/config/k8s"
 	_ "github.com/kumahq/kuma/v3/pkg/plugins/config/universal"
+	_ "github.com/kumahq/kuma/v3/pkg/plugins/resources/embedded"
 	_ "github.com/kumahq/kuma/v3/pkg/plugins/resources/k8s"
 	_ "github.com/kumahq/kuma/v3/pkg/plugins/resources/memory"
 	_ "github.com/kumahq/kuma/v3/pkg/plugins/resources/postgres"
@@ -3,6 +3,9 @@ package zone
 import (
 	"context"
 
+	"github.com/pkg/errors"
+
+	"github.com/kumahq/kuma/v3/pkg/core/resources/apis/system"
 	core_manager "github.com/kumahq/kuma/v3/pkg/core/resources/manager"
 	"github.com/kumahq/kuma/v3/pkg/core/resources/model"
 	core_store "github.com/kumahq/kuma/v3/pkg/core/resources/store"
@@ -34,6 +37,24 @@ func (z *zoneManager) Delete(ctx context.Context, r model.Resource, opts ...core
 	return z.ResourceManager.Delete(ctx, r, opts...)
 }
 
+func (z *zoneManager) Update(ctx context.Context, r model.Resource, opts ...core_store.UpdateOptionsFunc) error {
+	zone, ok := r.(*system.ZoneResource)
+	if !ok {
+		return errors.Errorf("invalid resource type: expected=%T, got=%T", (*system.ZoneResource)(nil), r)
+	}
+	// removing a mesh from the sync deletes its resources from the zone, so it's skipped like the validation of delete
+	if !z.unsafeDelete {
+		current := system.NewZoneResource()
+		if err := z.Get(ctx, current, core_store.GetBy(model.MetaToResourceKey(zone.GetMeta()))); err != nil {
+			return err
+		}
+		if err := z.validator.ValidateUpdate(ctx, current, zone); err != nil {
+			return err
+		}
+	}
+	return z.ResourceManager.Update(ctx, r, opts...)
+}
+
 func (z *zoneManager) DeleteAll(ctx context.Context, rl model.ResourceList, opts ...core_store.DeleteAllOptionsFunc) error {
 	return core_manager.DeleteAllResources(z, ctx, rl, opts...)
 }
@@ -7,8 +7,10 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	mesh_proto "github.com/kumahq/kuma/v3/api/mesh/v1alpha1"
 	"github.com/kumahq/kuma/v3/api/system/v1alpha1"
 	"github.com/kumahq/kuma/v3/pkg/core/managers/apis/zone"
+	core_mesh "github.com/kumahq/kuma/v3/pkg/core/resources/apis/mesh"
 	"github.com/kumahq/kuma/v3/pkg/core/resources/apis/system"
 	"github.com/kumahq/kuma/v3/pkg/core/resources/model"
 	"github.com/kumahq/kuma/v3/pkg/core/resources/store"
@@ -81,4 +83,59 @@ var _ = Describe("Zone Manager", func() {
 		// then
 		Expect(err).ToNot(HaveOccurred())
 	})
+
+	Context("sync of meshes", func() {
+		createDataplaneInsight := func(name string, mesh string, zone string, online bool) {
+			subscription := &mesh_proto.DiscoverySubscription{
+				ConnectTime: proto.MustTimestampProto(time.Now()),
+			}
+			if !online {
+				subscription.DisconnectTime = proto.MustTimestampProto(time.Now())
+			}
+			Expect(resStore.Create(context.Background(), &core_mesh.DataplaneInsightResource{
+				Spec: &mesh_proto.DataplaneInsight{
+					Subscriptions: []*mesh_proto.DiscoverySubscription{subscription},
+				},
+			}, store.CreateByKey(name, mesh), store.CreateWithLabels(map[string]string{mesh_proto.ZoneTag: zone}))).To(Succeed())
+		}
+
+		updateSync := func(unsafeDelete bool, meshes ...string) error {
+			zoneManager := zone.NewZoneManager(resStore, validator, unsafeDelete)
+			zone := system.NewZoneResource()
+			Expect(resStore.Get(context.Background(), zone, store.GetByKey("zone-1", model.NoMesh))).To(Succeed())
+			zone.Spec.Sync = &v1alpha1.ZoneSync{Meshes: meshes}
+			return zoneManager.Update(context.Background(), zone)
+		}
+
+		BeforeEach(func() {
+			Expect(resStore.Create(context.Background(), system.NewZoneResource(), store.CreateByKey("zone-1", model.NoMesh))).To(Succeed())
+			createDataplaneInsight("dp-1", "mesh-1", "zone-1", true)
+			createDataplaneInsight("dp-2", "mesh-2", "zone-1", false)
+			createDataplaneInsight("dp-3", "mesh-3", "zone-2", true)
+		})
+
+		It("should not stop syncing a mesh with online data plane proxies in the zone", func() {
+			// when
+			err := updateSync(false, "mesh-4")
+
+			// then
+			Expect(err).To(MatchError(ContainSubstring(`sync.meshes: unable to stop syncing mesh "mesh-1", the zone has online data plane proxies of the mesh`)))
+		})
+
+		It("should stop syncing meshes without online data plane proxies in the zone", func() {
+			// when
+			err := updateSync(false, "mesh-1")
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should stop syncing a mesh with online data plane proxies in the zone when unsafe delete is enabled", func() {
+			// when
+			err := updateSync(true, "mesh-4")
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
 })
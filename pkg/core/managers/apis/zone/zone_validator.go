@@ -2,9 +2,13 @@ package zone
 
 import (
 	"context"
+	"fmt"
+	"slices"
 
 	"github.com/pkg/errors"
 
+	mesh_proto "github.com/kumahq/kuma/v3/api/mesh/v1alpha1"
+	core_mesh "github.com/kumahq/kuma/v3/pkg/core/resources/apis/mesh"
 	"github.com/kumahq/kuma/v3/pkg/core/resources/apis/system"
 	"github.com/kumahq/kuma/v3/pkg/core/resources/model"
 	"github.com/kumahq/kuma/v3/pkg/core/resources/store"
@@ -32,3 +36,39 @@ func (v *Validator) ValidateDelete(ctx context.Context, name string) error {
 	}
 	return nil
 }
+
+// ValidateUpdate checks that meshes which are no longer synced to the zone have no online data plane proxies
+// in the zone. The mesh and its policies are deleted from the zone, so the proxies would lose their configuration.
+func (v *Validator) ValidateUpdate(ctx context.Context, previous *system.ZoneResource, current *system.ZoneResource) error {
+	meshes := current.Spec.GetSync().GetMeshes()
+	if len(meshes) == 0 {
+		return nil
+	}
+	previousMeshes := previous.Spec.GetSync().GetMeshes()
+	insights := &core_mesh.DataplaneInsightResourceList{}
+	if err := v.Store.List(ctx, insights); err != nil {
+		return errors.Wrap(err, "unable to list DataplaneInsights")
+	}
+	var removed []string
+	for _, insight := range insights.Items {
+		mesh := insight.GetMeta().GetMesh()
+		if insight.GetMeta().GetLabels()[mesh_proto.ZoneTag] != current.GetMeta().GetName() || !insight.Spec.IsOnline() {
+			continue
+		}
+		if slices.Contains(meshes, mesh) || slices.Contains(removed, mesh) {
+			continue
+		}
+		if len(previousMeshes) == 0 || slices.Contains(previousMeshes, mesh) {
+			removed = append(removed, mesh)
+		}
+	}
+	slices.Sort(removed)
+	validationErr := &validators.ValidationError{}
+	for _, mesh := range removed {
+		validationErr.AddViolationAt(
+			validators.RootedAt("sync").Field("meshes"),
+			fmt.Sprintf("unable to stop syncing mesh %q, the zone has online data plane proxies of the mesh", mesh),
+		)
+	}
+	return validationErr.OrNil()
+}
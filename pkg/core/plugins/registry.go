@@ -30,6 +30,7 @@ const (
 	Universal  PluginName = "universal"
 	Memory     PluginName = "memory"
 	Postgres   PluginName = "postgres"
+	Embedded   PluginName = "embedded"
 )
 
 type RegisteredPolicyPlugin struct {
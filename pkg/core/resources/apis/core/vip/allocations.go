@@ -0,0 +1,67 @@
+package vip
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	system_proto "github.com/kumahq/kuma/v3/api/system/v1alpha1"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/model"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/store"
+)
+
+// Allocations is a persisted state of VIPs allocated for a resource type.
+type Allocations struct {
+	// VIPs maps an allocated VIP to its allocation.
+	VIPs map[string]Allocation `json:"vips"`
+}
+
+type Allocation struct {
+	// ReleasedAt is a time when the VIP stopped being used by any resource.
+	// The VIP is quarantined until the quarantine period passes. Nil means that the VIP is in use.
+	ReleasedAt *time.Time `json:"releasedAt,omitempty"`
+}
+
+// AllocationsKey returns a key of a Config resource that keeps VIPs allocated for a resource type.
+func AllocationsKey(typeName model.ResourceType) model.ResourceKey {
+	return model.ResourceKey{
+		Name: "kuma-vips-" + strings.ToLower(string(typeName)),
+	}
+}
+
+func LoadAllocations(ctx context.Context, resManager manager.ReadOnlyResourceManager, typeName model.ResourceType) (*Allocations, error) {
+	allocations := &Allocations{}
+	config := system.NewConfigResource()
+	err := resManager.Get(ctx, config, store.GetBy(AllocationsKey(typeName)))
+	switch {
+	case store.IsNotFound(err):
+	case err != nil:
+		return nil, err
+	case config.Spec.GetConfig() != "":
+		if err := json.Unmarshal([]byte(config.Spec.GetConfig()), allocations); err != nil {
+			return nil, errors.Wrap(err, "could not unmarshal allocated vips")
+		}
+	}
+	if allocations.VIPs == nil {
+		allocations.VIPs = map[string]Allocation{}
+	}
+	return allocations, nil
+}
+
+func (a *Allocations) Save(ctx context.Context, resManager manager.ResourceManager, typeName model.ResourceType) error {
+	bytes, err := json.Marshal(a)
+	if err != nil {
+		return err
+	}
+	return manager.Upsert(ctx, resManager, AllocationsKey(typeName), system.NewConfigResource(), func(resource model.Resource) error {
+		resource.(*system.ConfigResource).Spec = &system_proto.Config{
+			Config: string(bytes),
+		}
+		return nil
+	})
+}
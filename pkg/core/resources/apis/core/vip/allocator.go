@@ -2,7 +2,9 @@ package vip
 
 import (
 	"context"
+	"maps"
 	"net"
+	"slices"
 	"time"
 
 	"github.com/Nordix/simple-ipam/pkg/ipam"
@@ -26,19 +28,17 @@ type ResourceHoldingVIPs interface {
 }
 
 // Allocator manages IPs for resources holding vips like MeshService/MeshExternalServices/MeshMultiZoneService.
-// Each time allocator starts it initiates the IPAM based on existing vips
+// A resource type can have an IPv4 and an IPv6 CIDR, then every resource gets a VIP of each IP family.
 // We don't free addresses explicitly, but we always allocate next free IP to avoid a problem when we
 // 1) Remove MeshService A with IP X
 // 2) Add new MeshService B that gets IP X
 // 3) Clients that were sending the traffic to A now sends the traffic to B for brief amount of time
-// IPAM is kept in memory to avoid state management, so technically this problem can still happen when leader changes
-// However, leader should not change before TTL of a DNS that serves this VIP.
-//
-// It's technically possible to allocate all addresses by creating and removing services in the loop.
-// However, CIDR has range of 16M addresses, after that the component will just restart.
+// Allocations are persisted in a Config resource of each type, so this problem doesn't happen when leader changes.
+// When a resource is removed, its VIP is quarantined for a quarantine period and only then it can be allocated again.
 type Allocator struct {
 	logger            logr.Logger
 	interval          time.Duration
+	quarantinePeriod  time.Duration
 	cidrToDescriptors map[string]model.ResourceTypeDescriptor
 	resManager        manager.ResourceManager
 	metric            prometheus.Histogram
@@ -49,6 +49,7 @@ var _ component.Component = &Allocator{}
 func NewAllocator(
 	logger logr.Logger,
 	interval time.Duration,
+	quarantinePeriod time.Duration,
 	cidrToDescriptors map[string]model.ResourceTypeDescriptor,
 	metrics core_metrics.Metrics,
 	resManager manager.ResourceManager,
@@ -63,12 +64,20 @@ func NewAllocator(
 	return &Allocator{
 		logger:            logger,
 		interval:          interval,
+		quarantinePeriod:  quarantinePeriod,
 		cidrToDescriptors: cidrToDescriptors,
 		metric:            metric,
 		resManager:        resManager,
 	}, nil
 }
 
+// typeAllocator allocates VIPs of a single resource type from all CIDRs of the type.
+type typeAllocator struct {
+	typeDesc    model.ResourceTypeDescriptor
+	ipams       []*ipam.IPAM
+	allocations *Allocations
+}
+
 func (a *Allocator) Start(stop <-chan struct{}) error {
 	// sleep to mitigate update conflicts with other components
 	util_time.SleepUpTo(a.interval)
@@ -76,43 +85,118 @@ func (a *Allocator) Start(stop <-chan struct{}) error {
 	ticker := time.NewTicker(a.interval)
 	ctx := user.Ctx(context.Background(), user.ControlPlane)
 
-	ipams := map[string]*ipam.IPAM{}
+	allocators, err := a.initAllocators(ctx)
+	if err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ticker.C:
+			start := time.Now()
+			for _, allocator := range allocators {
+				if err := a.allocateVIPs(ctx, allocator, start); err != nil {
+					a.logger.Error(err, "could not allocate vips", "type", allocator.typeDesc.Name)
+				}
+			}
+			a.metric.Observe(float64(time.Since(start).Milliseconds()))
+
+		case <-stop:
+			a.logger.Info("stopping")
+			return nil
+		}
+	}
+}
 
-	for cidr, typeDescriptor := range a.cidrToDescriptors {
+func (a *Allocator) initAllocators(ctx context.Context) ([]*typeAllocator, error) {
+	byType := map[model.ResourceType]*typeAllocator{}
+	cidrs := slices.Sorted(maps.Keys(a.cidrToDescriptors))
+	for _, cidr := range cidrs {
+		typeDesc := a.cidrToDescriptors[cidr]
 		newIPAM, err := ipam.New(cidr)
 		if err != nil {
-			return errors.Wrapf(err, "could not allocate IPAM of CIDR %s", cidr)
+			return nil, errors.Wrapf(err, "could not allocate IPAM of CIDR %s", cidr)
 		}
+		allocator, ok := byType[typeDesc.Name]
+		if !ok {
+			allocator = &typeAllocator{typeDesc: typeDesc}
+			byType[typeDesc.Name] = allocator
+		}
+		allocator.ipams = append(allocator.ipams, newIPAM)
+	}
 
-		resources, err := a.listResourceHoldingVIPs(ctx, typeDescriptor)
+	var allocators []*typeAllocator
+	for _, typeName := range slices.Sorted(maps.Keys(byType)) {
+		allocator := byType[typeName]
+		allocations, err := LoadAllocations(ctx, a.resManager, typeName)
 		if err != nil {
-			return errors.Wrapf(err, "could not list resources for IPAM initialization for %s", typeDescriptor.Name)
+			return nil, errors.Wrapf(err, "could not load allocated vips of %s", typeName)
+		}
+		allocator.allocations = allocations
+		for vip := range allocations.VIPs {
+			allocator.reserve(net.ParseIP(vip))
 		}
 
+		resources, err := a.listResourceHoldingVIPs(ctx, allocator.typeDesc)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not list resources for IPAM initialization for %s", typeName)
+		}
 		for _, res := range resources {
 			for _, vip := range res.VIPs() {
-				_ = newIPAM.Reserve(net.ParseIP(vip)) // ignore error for outside of range
+				allocator.reserve(net.ParseIP(vip))
 			}
 		}
-		ipams[cidr] = newIPAM
+		allocators = append(allocators, allocator)
 	}
+	return allocators, nil
+}
 
-	for {
-		select {
-		case <-ticker.C:
-			start := time.Now()
-			for cidr, typeDesc := range a.cidrToDescriptors {
-				if err := a.allocateVIPs(ctx, typeDesc, ipams[cidr]); err != nil {
-					a.logger.Error(err, "could not allocate vips", "type", typeDesc.Name)
-				}
-			}
-			a.metric.Observe(float64(time.Since(start).Milliseconds()))
+func (t *typeAllocator) reserve(ip net.IP) {
+	if ipamOf := t.ipamContaining(ip); ipamOf != nil {
+		_ = ipamOf.Reserve(ip) // ignore error when the ip is already reserved
+	}
+}
 
-		case <-stop:
-			a.logger.Info("stopping")
-			return nil
+func (t *typeAllocator) ipamContaining(ip net.IP) *ipam.IPAM {
+	if ip == nil {
+		return nil
+	}
+	for _, i := range t.ipams {
+		if i.CIDR.Contains(ip) {
+			return i
 		}
 	}
+	return nil
+}
+
+// missingIPAMs returns IPAMs from which the resource should get a VIP.
+// Resources with VIPs that are not managed by this allocator (e.g. ClusterIP of a Kubernetes Service) are skipped.
+func (t *typeAllocator) missingIPAMs(resource ResourceHoldingVIPs) []*ipam.IPAM {
+	var ips []net.IP
+	managed := false
+	for _, vip := range resource.VIPs() {
+		ip := net.ParseIP(vip)
+		ips = append(ips, ip)
+		if t.ipamContaining(ip) != nil {
+			managed = true
+		}
+	}
+	if len(ips) > 0 && !managed {
+		return nil
+	}
+	var missing []*ipam.IPAM
+	for _, i := range t.ipams {
+		if !slices.ContainsFunc(ips, func(ip net.IP) bool {
+			return ip != nil && isIPv4(ip) == isIPv4(i.CIDR.IP)
+		}) {
+			missing = append(missing, i)
+		}
+	}
+	return missing
+}
+
+func isIPv4(ip net.IP) bool {
+	return ip.To4() != nil
 }
 
 func (a *Allocator) listResourceHoldingVIPs(ctx context.Context, typeDesc model.ResourceTypeDescriptor) ([]ResourceHoldingVIPs, error) {
@@ -127,14 +211,19 @@ func (a *Allocator) listResourceHoldingVIPs(ctx context.Context, typeDesc model.
 	return result, nil
 }
 
-func (a *Allocator) allocateVIPs(ctx context.Context, typeDesc model.ResourceTypeDescriptor, kumaIpam *ipam.IPAM) error {
+func (a *Allocator) allocateVIPs(ctx context.Context, allocator *typeAllocator, now time.Time) error {
+	typeDesc := allocator.typeDesc
 	resources, err := a.listResourceHoldingVIPs(ctx, typeDesc)
 	if err != nil {
 		return err
 	}
 
+	changed := a.releaseVIPs(allocator, resources, now)
+
+	var toUpdate []ResourceHoldingVIPs
 	for _, resource := range resources {
-		if len(resource.VIPs()) != 0 {
+		missing := allocator.missingIPAMs(resource)
+		if len(missing) == 0 {
 			continue
 		}
 		log := a.logger.WithValues(
@@ -142,14 +231,33 @@ func (a *Allocator) allocateVIPs(ctx context.Context, typeDesc model.ResourceTyp
 			"mesh", resource.GetMeta().GetMesh(),
 			"type", resource.Descriptor().Name,
 		)
-		ip, err := kumaIpam.Allocate()
-		if err != nil {
-			return errors.Wrapf(err, "could not allocate vip for %s %s", typeDesc.Name, resource.GetMeta().GetName())
+		for _, kumaIpam := range missing {
+			ip, err := kumaIpam.Allocate()
+			if err != nil {
+				return errors.Wrapf(err, "could not allocate vip for %s %s", typeDesc.Name, resource.GetMeta().GetName())
+			}
+			log.Info("allocating IP", "ip", ip.String())
+			resource.AllocateVIP(ip.String())
+			allocator.allocations.VIPs[ip.String()] = Allocation{}
 		}
-		log.Info("allocating IP", "ip", ip.String())
-		resource.AllocateVIP(ip.String())
+		toUpdate = append(toUpdate, resource)
+		changed = true
+	}
+
+	// allocations are persisted before they are used, so a VIP is never handed out twice when the leader changes
+	if changed {
+		if err := allocator.allocations.Save(ctx, a.resManager, typeDesc.Name); err != nil {
+			return errors.Wrap(err, "could not persist allocated vips")
+		}
+	}
 
+	for _, resource := range toUpdate {
 		if err := a.resManager.Update(ctx, resource); err != nil {
+			log := a.logger.WithValues(
+				"name", resource.GetMeta().GetName(),
+				"mesh", resource.GetMeta().GetMesh(),
+				"type", resource.Descriptor().Name,
+			)
 			msg := "could not update the resource with allocated Kuma VIP. Will try to update in the next allocation window"
 			if store.IsConflict(err) {
 				log.Info(msg, "cause", "conflict", "interval", a.interval)
@@ -161,6 +269,46 @@ func (a *Allocator) allocateVIPs(ctx context.Context, typeDesc model.ResourceTyp
 	return nil
 }
 
+// releaseVIPs quarantines VIPs that are no longer used by any resource and frees VIPs which quarantine has passed.
+// It returns true if allocations have changed.
+func (a *Allocator) releaseVIPs(allocator *typeAllocator, resources []ResourceHoldingVIPs, now time.Time) bool {
+	changed := false
+	used := map[string]struct{}{}
+	for _, resource := range resources {
+		for _, vip := range resource.VIPs() {
+			ip := net.ParseIP(vip)
+			if allocator.ipamContaining(ip) == nil {
+				continue
+			}
+			used[ip.String()] = struct{}{}
+			allocator.reserve(ip)
+			if allocation, ok := allocator.allocations.VIPs[ip.String()]; !ok || allocation.ReleasedAt != nil {
+				allocator.allocations.VIPs[ip.String()] = Allocation{}
+				changed = true
+			}
+		}
+	}
+	for vip, allocation := range allocator.allocations.VIPs {
+		if _, ok := used[vip]; ok {
+			continue
+		}
+		switch {
+		case allocation.ReleasedAt == nil:
+			releasedAt := now.UTC()
+			allocator.allocations.VIPs[vip] = Allocation{ReleasedAt: &releasedAt}
+			changed = true
+		case now.Sub(*allocation.ReleasedAt) >= a.quarantinePeriod:
+			ip := net.ParseIP(vip)
+			if ipamOf := allocator.ipamContaining(ip); ipamOf != nil {
+				ipamOf.Free(ip)
+			}
+			delete(allocator.allocations.VIPs, vip)
+			changed = true
+		}
+	}
+	return changed
+}
+
 func (a *Allocator) NeedLeaderElection() bool {
 	return true
 }
@@ -25,29 +25,35 @@ var _ = Describe("VIP Allocator", func() {
 	var resManager manager.ResourceManager
 	var metrics core_metrics.Metrics
 
-	BeforeEach(func() {
+	defaultCIDRs := map[string]model.ResourceTypeDescriptor{
+		"241.0.0.0/8": meshservice_api.MeshServiceResourceTypeDescriptor,
+		"242.0.0.0/8": meshextenralservice_api.MeshExternalServiceResourceTypeDescriptor,
+	}
+
+	startAllocator := func(cidrs map[string]model.ResourceTypeDescriptor, quarantinePeriod time.Duration) chan struct{} {
 		m, err := core_metrics.NewMetrics("")
 		Expect(err).ToNot(HaveOccurred())
 		metrics = m
-		resManager = manager.NewResourceManager(memory.NewStore())
-
 		allocator, err := vip.NewAllocator(
 			logr.Discard(),
 			50*time.Millisecond,
-			map[string]model.ResourceTypeDescriptor{
-				"241.0.0.0/8": meshservice_api.MeshServiceResourceTypeDescriptor,
-				"242.0.0.0/8": meshextenralservice_api.MeshExternalServiceResourceTypeDescriptor,
-			},
+			quarantinePeriod,
+			cidrs,
 			metrics,
 			resManager,
 		)
-
 		Expect(err).ToNot(HaveOccurred())
-		stopCh = make(chan struct{})
+		stop := make(chan struct{})
 		go func() {
 			defer GinkgoRecover()
-			Expect(allocator.Start(stopCh)).To(Succeed())
+			Expect(allocator.Start(stop)).To(Succeed())
 		}()
+		return stop
+	}
+
+	BeforeEach(func() {
+		resManager = manager.NewResourceManager(memory.NewStore())
+		stopCh = startAllocator(defaultCIDRs, time.Hour)
 
 		Expect(samples.MeshDefaultBuilder().Create(resManager)).To(Succeed())
 	})
@@ -66,6 +72,11 @@ var _ = Describe("VIP Allocator", func() {
 		return ms.Status.VIPs[0].IP
 	}
 
+	restartAllocator := func(cidrs map[string]model.ResourceTypeDescriptor, quarantinePeriod time.Duration) {
+		close(stopCh)
+		stopCh = startAllocator(cidrs, quarantinePeriod)
+	}
+
 	It("should allocate vip for MeshService without vip", func() {
 		// when
 		err := samples.MeshServiceBackendBuilder().WithoutVIP().Create(resManager)
@@ -116,4 +127,120 @@ var _ = Describe("VIP Allocator", func() {
 			g.Expect(test_metrics.FindMetric(metrics, "component_vip_allocator")).ToNot(BeNil())
 		}, "10s", "100ms").Should(Succeed())
 	})
+
+	It("should not reuse IPs after restart", func() {
+		// given
+		err := samples.MeshServiceBackendBuilder().WithoutVIP().Create(resManager)
+		Expect(err).ToNot(HaveOccurred())
+		Eventually(func(g Gomega) {
+			g.Expect(vipOfMeshService("backend")).Should(Equal("241.0.0.0"))
+		}, "10s", "100ms").Should(Succeed())
+
+		// when the leader changes and resource is reapplied
+		restartAllocator(defaultCIDRs, time.Hour)
+		err = resManager.Delete(context.Background(), meshservice_api.NewMeshServiceResource(), store.DeleteByKey("backend", model.DefaultMesh))
+		Expect(err).ToNot(HaveOccurred())
+		err = samples.MeshServiceBackendBuilder().WithoutVIP().Create(resManager)
+		Expect(err).ToNot(HaveOccurred())
+
+		// then
+		Eventually(func(g Gomega) {
+			g.Expect(vipOfMeshService("backend")).Should(Equal("241.0.0.1"))
+		}, "10s", "100ms").Should(Succeed())
+	})
+
+	It("should free IPs after quarantine period", func() {
+		// given
+		restartAllocator(defaultCIDRs, 500*time.Millisecond)
+		err := samples.MeshServiceBackendBuilder().WithoutVIP().Create(resManager)
+		Expect(err).ToNot(HaveOccurred())
+		Eventually(func(g Gomega) {
+			g.Expect(vipOfMeshService("backend")).Should(Equal("241.0.0.0"))
+		}, "10s", "100ms").Should(Succeed())
+
+		// when
+		err = resManager.Delete(context.Background(), meshservice_api.NewMeshServiceResource(), store.DeleteByKey("backend", model.DefaultMesh))
+		Expect(err).ToNot(HaveOccurred())
+
+		// then the IP is quarantined
+		Eventually(func(g Gomega) {
+			allocations, err := vip.LoadAllocations(context.Background(), resManager, meshservice_api.MeshServiceType)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(allocations.VIPs).To(HaveKeyWithValue("241.0.0.0", HaveField("ReleasedAt", Not(BeNil()))))
+		}, "10s", "50ms").Should(Succeed())
+
+		// and freed after quarantine period
+		Eventually(func(g Gomega) {
+			allocations, err := vip.LoadAllocations(context.Background(), resManager, meshservice_api.MeshServiceType)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(allocations.VIPs).To(BeEmpty())
+		}, "10s", "100ms").Should(Succeed())
+	})
+
+	It("should allocate IPv4 and IPv6 vips", func() {
+		// given
+		restartAllocator(map[string]model.ResourceTypeDescriptor{
+			"241.0.0.0/8":    meshservice_api.MeshServiceResourceTypeDescriptor,
+			"fd00:fd01::/64": meshservice_api.MeshServiceResourceTypeDescriptor,
+			"242.0.0.0/8":    meshextenralservice_api.MeshExternalServiceResourceTypeDescriptor,
+			"fd00:fd02::/64": meshextenralservice_api.MeshExternalServiceResourceTypeDescriptor,
+		}, time.Hour)
+
+		// when
+		Expect(samples.MeshServiceBackendBuilder().WithoutVIP().Create(resManager)).To(Succeed())
+		Expect(samples.MeshExternalServiceExampleBuilder().WithoutVIP().Create(resManager)).To(Succeed())
+
+		// then
+		Eventually(func(g Gomega) {
+			ms := meshservice_api.NewMeshServiceResource()
+			g.Expect(resManager.Get(context.Background(), ms, store.GetByKey("backend", model.DefaultMesh))).To(Succeed())
+			g.Expect(ms.VIPs()).To(ConsistOf("241.0.0.0", "fd00:fd01::"))
+
+			mes := meshextenralservice_api.NewMeshExternalServiceResource()
+			g.Expect(resManager.Get(context.Background(), mes, store.GetByKey("example", model.DefaultMesh))).To(Succeed())
+			g.Expect(mes.Status.VIP.IP).To(Or(Equal("242.0.0.0"), Equal("fd00:fd02::")))
+			g.Expect(mes.VIPs()).To(ConsistOf("242.0.0.0", "fd00:fd02::"))
+		}, "10s", "100ms").Should(Succeed())
+	})
+
+	It("should add IPv6 vip to resource that already has IPv4 vip", func() {
+		// given
+		Expect(samples.MeshServiceBackendBuilder().WithoutVIP().Create(resManager)).To(Succeed())
+		Eventually(func(g Gomega) {
+			g.Expect(vipOfMeshService("backend")).Should(Equal("241.0.0.0"))
+		}, "10s", "100ms").Should(Succeed())
+
+		// when
+		restartAllocator(map[string]model.ResourceTypeDescriptor{
+			"241.0.0.0/8":    meshservice_api.MeshServiceResourceTypeDescriptor,
+			"fd00:fd01::/64": meshservice_api.MeshServiceResourceTypeDescriptor,
+		}, time.Hour)
+
+		// then
+		Eventually(func(g Gomega) {
+			ms := meshservice_api.NewMeshServiceResource()
+			g.Expect(resManager.Get(context.Background(), ms, store.GetByKey("backend", model.DefaultMesh))).To(Succeed())
+			g.Expect(ms.VIPs()).To(Equal([]string{"241.0.0.0", "fd00:fd01::"}))
+		}, "10s", "100ms").Should(Succeed())
+	})
+
+	It("should not allocate vips for resources with vips managed outside of Kuma", func() {
+		// given
+		restartAllocator(map[string]model.ResourceTypeDescriptor{
+			"241.0.0.0/8":    meshservice_api.MeshServiceResourceTypeDescriptor,
+			"fd00:fd01::/64": meshservice_api.MeshServiceResourceTypeDescriptor,
+		}, time.Hour)
+
+		// when
+		Expect(samples.MeshServiceBackendBuilder().WithKumaVIP("10.96.0.10").Create(resManager)).To(Succeed())
+		Expect(samples.MeshServiceBackendBuilder().WithName("other").WithoutVIP().Create(resManager)).To(Succeed())
+
+		// then
+		Eventually(func(g Gomega) {
+			g.Expect(vipOfMeshService("other")).ToNot(BeEmpty())
+		}, "10s", "100ms").Should(Succeed())
+		ms := meshservice_api.NewMeshServiceResource()
+		Expect(resManager.Get(context.Background(), ms, store.GetByKey("backend", model.DefaultMesh))).To(Succeed())
+		Expect(ms.VIPs()).To(Equal([]string{"10.96.0.10"}))
+	})
 })
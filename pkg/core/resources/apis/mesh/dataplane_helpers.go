@@ -2,6 +2,7 @@ package mesh
 
 import (
 	"hash/fnv"
+	"maps"
 	"net"
 	"slices"
 	"strconv"
@@ -191,6 +192,20 @@ func DisplayTags(dataplane *mesh_proto.Dataplane, labels map[string]string) mesh
 	return tags
 }
 
+// InboundTags returns the tags of the inbound, sourced from the Dataplane's own
+// resource labels. The inbound's protocol is carried alongside them because it
+// is a per-port property that resource labels cannot express.
+func (d *DataplaneResource) InboundTags(inbound *mesh_proto.Dataplane_Networking_Inbound) map[string]string {
+	tags := maps.Clone(d.GetMeta().GetLabels())
+	if tags == nil {
+		tags = map[string]string{}
+	}
+	if protocol := inbound.GetProtocol(); protocol != "" {
+		tags[mesh_proto.ProtocolTag] = protocol
+	}
+	return tags
+}
+
 // SortDataplanes sorts dataplanes by creation time, then by name.
 // Used by generators to ensure consistent processing order.
 func SortDataplanes(dps []*DataplaneResource) []*DataplaneResource {
@@ -10,12 +10,14 @@ import (
 func (t *MeshExternalServiceResource) Deprecations() []string {
 	var deprecations []string
 
-	portName := t.Spec.Match.GetName()
-	id := kri.WithSectionName(kri.From(t), portName)
-	for _, err := range sni.ValidateKRI(id) {
-		deprecations = append(deprecations, fmt.Sprintf(
-			"Invalid %s SNI (port %q): %s. This is deprecated.",
-			MeshExternalServiceResourceTypeDescriptor.Name, portName, err))
+	for _, port := range t.Spec.Match.Ports() {
+		portName := port.GetName()
+		id := kri.WithSectionName(kri.From(t), portName)
+		for _, err := range sni.ValidateKRI(id) {
+			deprecations = append(deprecations, fmt.Sprintf(
+				"Invalid %s SNI (port %q): %s. This is deprecated.",
+				MeshExternalServiceResourceTypeDescriptor.Name, portName, err))
+		}
 	}
 
 	return deprecations
@@ -2,6 +2,7 @@ package v1alpha1
 
 import (
 	"fmt"
+	"slices"
 
 	"github.com/kumahq/kuma/v3/pkg/core/kri"
 	core_meta "github.com/kumahq/kuma/v3/pkg/core/metadata"
@@ -9,54 +10,119 @@ import (
 	"github.com/kumahq/kuma/v3/pkg/core/resources/apis/core/vip"
 	"github.com/kumahq/kuma/v3/pkg/core/resources/sni"
 	xds_types "github.com/kumahq/kuma/v3/pkg/core/xds/types"
+	"github.com/kumahq/kuma/v3/pkg/util/pointer"
 )
 
 var _ vip.ResourceHoldingVIPs = &MeshExternalServiceResource{}
 
 func (t *MeshExternalServiceResource) VIPs() []string {
-	if t.Status.VIP.IP == "" {
-		return nil
+	var vips []string
+	if t.Status.VIP.IP != "" {
+		vips = append(vips, t.Status.VIP.IP)
+	}
+	for _, vip := range t.Status.VIPs {
+		if vip.IP != "" && !slices.Contains(vips, vip.IP) {
+			vips = append(vips, vip.IP)
+		}
 	}
-	return []string{t.Status.VIP.IP}
+	return vips
 }
 
 func (t *MeshExternalServiceResource) AllocateVIP(vip string) {
-	t.Status.VIP = VIP{
-		IP: vip,
+	if t.Status.VIP.IP == "" {
+		t.Status.VIP = VIP{
+			IP: vip,
+		}
 	}
+	t.Status.VIPs = append(t.Status.VIPs, VIP{
+		IP: vip,
+	})
 }
 
 func (t *MeshExternalServiceResource) AsOutbounds() xds_types.Outbounds {
-	if t.Status.VIP.IP != "" {
-		return xds_types.Outbounds{{
-			Address:  t.Status.VIP.IP,
-			Port:     uint32(t.Spec.Match.Port),
-			Resource: kri.WithSectionName(kri.From(t), t.Spec.Match.GetName()),
-		}}
+	var outbounds xds_types.Outbounds
+	for _, vip := range t.VIPs() {
+		for _, port := range t.Spec.Match.Ports() {
+			outbounds = append(outbounds, &xds_types.Outbound{
+				Address:  vip,
+				Port:     uint32(port.Port),
+				Resource: kri.WithSectionName(kri.From(t), port.GetName()),
+			})
+		}
 	}
-	return nil
+	return outbounds
 }
 
-func (t *MeshExternalServiceResource) Domains() *xds_types.VIPDomains {
-	if t.Status.VIP.IP != "" {
-		var domains []string
-		for _, address := range t.Status.Addresses {
-			domains = append(domains, address.Hostname)
-		}
-		return &xds_types.VIPDomains{
-			Address: t.Status.VIP.IP,
+func (t *MeshExternalServiceResource) Domains() []xds_types.VIPDomains {
+	var domains []string
+	for _, address := range t.Status.Addresses {
+		domains = append(domains, address.Hostname)
+	}
+	// DNS resolves every subdomain of the wildcard hostname to the VIP
+	domains = append(domains, t.MatchedHostnames()...)
+	var result []xds_types.VIPDomains
+	for _, vip := range t.VIPs() {
+		result = append(result, xds_types.VIPDomains{
+			Address: vip,
 			Domains: domains,
-		}
+		})
 	}
-	return nil
+	return result
 }
 
 func (t *MeshExternalServiceResource) GetPorts() []core.Port {
-	return []core.Port{t.Spec.Match}
+	var ports []core.Port
+	for _, port := range t.Spec.Match.Ports() {
+		ports = append(ports, port)
+	}
+	return ports
 }
 
 func (t *MeshExternalServiceResource) FindPortByName(name string) (core.Port, bool) {
-	return t.Spec.Match, true
+	if t.Spec.Match.EndPort == nil {
+		return t.Spec.Match, true
+	}
+	for _, port := range t.Spec.Match.Ports() {
+		if port.GetName() == name {
+			return port, true
+		}
+	}
+	return nil, false
+}
+
+// MatchedHostnames returns hostnames which traffic is routed by SNI or Host header.
+// It's empty unless the match is of type WildcardHostname.
+func (t *MeshExternalServiceResource) MatchedHostnames() []string {
+	if t.Spec.Match.Type != WildcardHostnameType || pointer.Deref(t.Spec.Match.Hostname) == "" {
+		return nil
+	}
+	return []string{*t.Spec.Match.Hostname}
+}
+
+// IsTLSOriginated returns true when the proxy originates TLS to the external service,
+// so the application sends plaintext traffic without SNI.
+func (t *MeshExternalServiceResource) IsTLSOriginated() bool {
+	return t.Spec.Tls != nil && t.Spec.Tls.Enabled
+}
+
+// Ports returns a match for every port of the port range. Without a port range, it returns the match itself.
+func (m Match) Ports() []Match {
+	if m.EndPort == nil {
+		return []Match{m}
+	}
+	var ports []Match
+	for port := m.Port; port <= *m.EndPort; port++ {
+		p := m
+		p.Port = port
+		p.EndPort = nil
+		ports = append(ports, p)
+	}
+	return ports
+}
+
+// EndpointPort returns the port of an endpoint to which traffic of the given port of the range is forwarded.
+func (m Match) EndpointPort(port int32, endpoint Endpoint) int32 {
+	return endpoint.Port + port - m.Port
 }
 
 func (m Match) GetName() string {
@@ -75,7 +141,11 @@ func (s *MeshExternalService) SNIs() []sni.Section {
 	if s == nil {
 		return nil
 	}
-	return []sni.Section{{Port: s.Match.Port, SectionName: s.Match.GetName()}}
+	var sections []sni.Section
+	for _, port := range s.Match.Ports() {
+		sections = append(sections, sni.Section{Port: port.Port, SectionName: port.GetName()})
+	}
+	return sections
 }
 
 func (l *MeshExternalServiceResourceList) GetDestinations() []core.Destination {
@@ -29,22 +29,33 @@ type MeshExternalService struct {
 	Tls *Tls `json:"tls,omitempty"`
 }
 
-// +kubebuilder:validation:Enum=HostnameGenerator
+// +kubebuilder:validation:Enum=HostnameGenerator;WildcardHostname
 type MatchType string
 
 const (
 	HostnameGeneratorType MatchType = "HostnameGenerator"
+	WildcardHostnameType  MatchType = "WildcardHostname"
 )
 
 type Match struct {
-	// Type of the match, only `HostnameGenerator` is available at the moment.
+	// Type of the match, one of `HostnameGenerator`, `WildcardHostname`.
+	// `HostnameGenerator` matches the hostnames generated by HostnameGenerators.
+	// `WildcardHostname` additionally matches every subdomain of `hostname`, traffic is routed by SNI or Host header.
 	// +kubebuilder:validation:Optional
 	// +kubebuilder:default=HostnameGenerator
 	Type MatchType `json:"type"`
+	// Hostname is a wildcard hostname like `*.s3.amazonaws.com`. Required when type is `WildcardHostname`.
+	// +kubebuilder:example="*.s3.amazonaws.com"
+	Hostname *string `json:"hostname,omitempty"`
 	// Port defines a port to which a user does request.
 	// +kubebuilder:validation:Minimum=1
 	// +kubebuilder:validation:Maximum=65535
 	Port int32 `json:"port"`
+	// EndPort defines the last port of a port range that starts at `port`.
+	// Every port of the range is exposed separately and forwarded to the same offset from the port of an endpoint.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	EndPort *int32 `json:"endPort,omitempty"`
 	// Protocol defines a protocol of the communication. Possible values: `tcp`, `grpc`, `http`, `http2`.
 	// +kubebuilder:validation:Optional
 	// +kubebuilder:default=tcp
@@ -141,6 +152,8 @@ type SANMatch struct {
 type MeshExternalServiceStatus struct {
 	// Vip section for allocated IP
 	VIP VIP `json:"vip,omitempty"`
+	// VIPs is a list of allocated IPs, one per IP family. The first allocated IP is also kept in the vip section.
+	VIPs []VIP `json:"vips,omitempty"`
 	// Addresses section for generated domains
 	Addresses          []hostnamegenerator_api.Address                 `json:"addresses,omitempty"`
 	HostnameGenerators []hostnamegenerator_api.HostnameGeneratorStatus `json:"hostnameGenerators,omitempty"`
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math"
 	"slices"
+	"strings"
 
 	"github.com/asaskevich/govalidator"
 
@@ -15,6 +16,9 @@ import (
 	"github.com/kumahq/kuma/v3/pkg/util/pointer"
 )
 
+// maxPortRangeSize limits the number of listeners and clusters generated for a single port range.
+const maxPortRangeSize = 256
+
 var (
 	allMatchProtocols    = core_meta.ProtocolList{core_meta.ProtocolTCP, core_meta.ProtocolGRPC, core_meta.ProtocolHTTP, core_meta.ProtocolHTTP2}
 	allVerificationModes = []string{string(TLSVerificationSkipSAN), string(TLSVerificationSkipCA), string(TLSVerificationSkipAll), string(TLSVerificationSecured)}
@@ -35,11 +39,16 @@ func (r *MeshExternalServiceResource) validate() error {
 	if r.Spec.Extension == nil {
 		if r.Spec.Endpoints != nil {
 			verr.AddErrorAt(path.Field("endpoints"), validateEndpoints(pointer.Deref(r.Spec.Endpoints)))
+			verr.AddErrorAt(path.Field("endpoints"), validateEndpointPortRange(r.Spec.Match, pointer.Deref(r.Spec.Endpoints)))
 		}
 
 		if r.Spec.Tls != nil {
 			verr.AddErrorAt(path.Field("tls"), validateTls(r.Spec.Tls))
 		}
+		// TCP traffic of a wildcard hostname is routed by SNI, which plaintext traffic doesn't have
+		if r.Spec.Match.Type == WildcardHostnameType && r.Spec.Match.Protocol == core_meta.ProtocolTCP && r.IsTLSOriginated() {
+			verr.AddViolationAt(path.Field("tls").Field("enabled"), fmt.Sprintf("must be false when match type is '%s' and protocol is '%s'", WildcardHostnameType, core_meta.ProtocolTCP))
+		}
 	} else if r.Spec.Tls != nil && r.Spec.Tls.Verification != nil {
 		// an extension owns the rest of the tls validation, but never the data source
 		// shape: File/EnvVar are read from the control plane process itself, and the
@@ -129,12 +138,30 @@ func validateSecureDataSource(path validators.PathBuilder, sds *datasource_api.S
 
 func validateMatch(match Match) validators.ValidationError {
 	var verr validators.ValidationError
-	if match.Type != HostnameGeneratorType && match.Type != "" {
-		verr.AddViolation(validators.RootedAt("type").String(), fmt.Sprintf("unrecognized type '%s' - only '%s' is supported", match.Type, HostnameGeneratorType))
+	switch match.Type {
+	case HostnameGeneratorType, "":
+		if match.Hostname != nil {
+			verr.AddViolationAt(validators.RootedAt("hostname"), fmt.Sprintf("must not be defined when type is '%s'", HostnameGeneratorType))
+		}
+	case WildcardHostnameType:
+		verr.Add(validateWildcardHostname(validators.RootedAt("hostname"), match.Hostname))
+	default:
+		verr.AddViolation(validators.RootedAt("type").String(), fmt.Sprintf("unrecognized type '%s' - only '%s' and '%s' are supported", match.Type, HostnameGeneratorType, WildcardHostnameType))
 	}
 	if match.Port == 0 || match.Port > math.MaxUint16 {
 		verr.AddViolationAt(validators.RootedAt("port"), "port must be a valid (1-65535)")
 	}
+	if match.EndPort != nil {
+		endPort := *match.EndPort
+		switch {
+		case endPort > math.MaxUint16:
+			verr.AddViolationAt(validators.RootedAt("endPort"), "port must be a valid (1-65535)")
+		case endPort < match.Port:
+			verr.AddViolationAt(validators.RootedAt("endPort"), "must be greater than or equal to port")
+		case endPort-match.Port >= maxPortRangeSize:
+			verr.AddViolationAt(validators.RootedAt("endPort"), fmt.Sprintf("port range must not contain more than %d ports", maxPortRangeSize))
+		}
+	}
 	if !allMatchProtocols.Contains(match.Protocol) {
 		verr.AddErrorAt(validators.RootedAt("protocol"), validators.MakeFieldMustBeOneOfErr("protocol", allMatchProtocols.Strings()...))
 	}
@@ -142,6 +169,34 @@ func validateMatch(match Match) validators.ValidationError {
 	return verr
 }
 
+func validateWildcardHostname(path validators.PathBuilder, hostname *string) validators.ValidationError {
+	var verr validators.ValidationError
+	switch {
+	case pointer.Deref(hostname) == "":
+		verr.AddViolationAt(path, fmt.Sprintf("must be defined when type is '%s'", WildcardHostnameType))
+	case !strings.HasPrefix(*hostname, "*.") || !govalidator.IsDNSName(strings.TrimPrefix(*hostname, "*.")):
+		verr.AddViolationAt(path, "must be a wildcard hostname like '*.example.com'")
+	}
+	return verr
+}
+
+// validateEndpointPortRange checks that every port of the match range maps to a valid port of the endpoints.
+func validateEndpointPortRange(match Match, endpoints []Endpoint) validators.ValidationError {
+	var verr validators.ValidationError
+	if match.EndPort == nil || *match.EndPort < match.Port {
+		return verr
+	}
+	for i, endpoint := range endpoints {
+		if endpoint.Port == 0 {
+			continue
+		}
+		if match.EndpointPort(*match.EndPort, endpoint) > math.MaxUint16 {
+			verr.AddViolationAt(validators.Root().Index(i).Field("port"), "port range of the match exceeds 65535 for this endpoint")
+		}
+	}
+	return verr
+}
+
 func validateEndpoints(endpoints []Endpoint) validators.ValidationError {
 	var verr validators.ValidationError
 
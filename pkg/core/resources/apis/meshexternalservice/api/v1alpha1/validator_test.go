@@ -100,6 +100,38 @@ var _ = Describe("MeshExternalService", func() {
 				name: "external-service",
 				file: "endpoints-with-priority-valid",
 			}),
+			Entry("wildcard hostname with port range", testCase{
+				name: "external-service",
+				file: "wildcard-port-range-valid",
+			}),
+			Entry("wildcard hostname with invalid hostname and port range", testCase{
+				name: "external-service",
+				file: "wildcard-port-range-invalid",
+			}),
+			Entry("wildcard hostname with tls origination of tcp", testCase{
+				name: "external-service",
+				file: "wildcard-tls-tcp-invalid",
+			}),
+			Entry("wildcard hostname with tls origination of http", testCase{
+				name: "external-service",
+				file: "wildcard-tls-http-valid",
+			}),
+			Entry("wildcard hostname without hostname", testCase{
+				name: "external-service",
+				file: "wildcard-missing-hostname-invalid",
+			}),
+			Entry("hostname with HostnameGenerator type", testCase{
+				name: "external-service",
+				file: "hostname-generator-with-hostname-invalid",
+			}),
+			Entry("port range too large", testCase{
+				name: "external-service",
+				file: "port-range-too-large-invalid",
+			}),
+			Entry("port range exceeding endpoint port", testCase{
+				name: "external-service",
+				file: "port-range-exceeds-endpoint-port-invalid",
+			}),
 		)
 	})
 })
@@ -54,6 +54,16 @@ func (in *Extension) DeepCopy() *Extension {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Match) DeepCopyInto(out *Match) {
 	*out = *in
+	if in.Hostname != nil {
+		in, out := &in.Hostname, &out.Hostname
+		*out = new(string)
+		**out = **in
+	}
+	if in.EndPort != nil {
+		in, out := &in.EndPort, &out.EndPort
+		*out = new(int32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Match.
@@ -69,7 +79,7 @@ func (in *Match) DeepCopy() *Match {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MeshExternalService) DeepCopyInto(out *MeshExternalService) {
 	*out = *in
-	out.Match = in.Match
+	in.Match.DeepCopyInto(&out.Match)
 	if in.Extension != nil {
 		in, out := &in.Extension, &out.Extension
 		*out = new(Extension)
@@ -107,6 +117,11 @@ func (in *MeshExternalService) DeepCopy() *MeshExternalService {
 func (in *MeshExternalServiceStatus) DeepCopyInto(out *MeshExternalServiceStatus) {
 	*out = *in
 	out.VIP = in.VIP
+	if in.VIPs != nil {
+		in, out := &in.VIPs, &out.VIPs
+		*out = make([]VIP, len(*in))
+		copy(*out, *in)
+	}
 	if in.Addresses != nil {
 		in, out := &in.Addresses, &out.Addresses
 		*out = make([]apiv1alpha1.Address, len(*in))
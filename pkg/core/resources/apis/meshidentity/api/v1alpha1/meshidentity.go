@@ -31,13 +31,15 @@ type SpiffeID struct {
 	Path        *string `json:"path,omitempty"`
 }
 
-// +kubebuilder:validation:Enum=Bundled;Spire;Extension
+// +kubebuilder:validation:Enum=Bundled;Spire;Extension;Vault;CertManager
 type ProviderType string
 
 const (
-	BundledType   ProviderType = "Bundled"
-	SpireType     ProviderType = "Spire"
-	ExtensionType ProviderType = "Extension"
+	BundledType     ProviderType = "Bundled"
+	SpireType       ProviderType = "Spire"
+	ExtensionType   ProviderType = "Extension"
+	VaultType       ProviderType = "Vault"
+	CertManagerType ProviderType = "CertManager"
 )
 
 type Provider struct {
@@ -52,6 +54,10 @@ type Provider struct {
 	Spire *Spire `json:"spire,omitempty"`
 	// Extension indicates that custom provider is used.
 	Extension *Extension `json:"extension,omitempty"`
+	// Vault indicates that certificates are signed by the PKI secrets engine of HashiCorp Vault.
+	Vault *Vault `json:"vault,omitempty"`
+	// CertManager indicates that certificates are signed by a cert-manager issuer.
+	CertManager *CertManager `json:"certManager,omitempty"`
 }
 
 type CertificateParameters struct {
@@ -109,12 +115,93 @@ type Extension struct {
 	Config *apiextensionsv1.JSON `json:"config,omitempty"`
 }
 
+type Vault struct {
+	// Address is the address of the Vault server, e.g. https://vault.example.com:8200.
+	// +required
+	Address string `json:"address"`
+	// Namespace is the Vault Enterprise namespace of the PKI secrets engine.
+	Namespace *string `json:"namespace,omitempty"`
+	// PKI configures the PKI secrets engine used to sign workload certificates.
+	// +required
+	PKI VaultPKI `json:"pki"`
+	// Auth configures how the control plane authenticates to Vault.
+	// +required
+	Auth VaultAuth `json:"auth"`
+	// TLS configures the connection to the Vault server.
+	TLS *VaultTLS `json:"tls,omitempty"`
+	// MeshTrustCreation defines whether a MeshTrust resource should be automatically created
+	// from the CA chain of the PKI secrets engine. If not defined, the control plane automatically generates a MeshTrust.
+	MeshTrustCreation *MeshTrustCreationMode `json:"meshTrustCreation,omitempty"`
+	// CertificateParameters allows users to define certificate generation parameters.
+	CertificateParameters *CertificateParameters `json:"certificateParameters,omitempty"`
+}
+
+type VaultPKI struct {
+	// Mount is the path at which the PKI secrets engine is mounted.
+	// Default "pki".
+	Mount *string `json:"mount,omitempty"`
+	// Role is the name of the role used to sign certificates. The role has to
+	// allow URI SANs of the SPIFFE IDs of the workloads.
+	// +required
+	Role string `json:"role"`
+}
+
+type VaultAuth struct {
+	// Token is the Vault token used to authenticate to Vault.
+	// +required
+	Token *datasource_api.SecureDataSource `json:"token"`
+}
+
+type VaultTLS struct {
+	// CACert is the CA certificate used to verify the certificate of the Vault server.
+	CACert *datasource_api.SecureDataSource `json:"caCert,omitempty"`
+	// ServerName is the SNI used when connecting to the Vault server.
+	ServerName *string `json:"serverName,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=Issuer;ClusterIssuer
+type CertManagerIssuerKind string
+
+const (
+	IssuerKind        CertManagerIssuerKind = "Issuer"
+	ClusterIssuerKind CertManagerIssuerKind = "ClusterIssuer"
+)
+
+type CertManager struct {
+	// IssuerRef references the cert-manager issuer that signs workload certificates.
+	// CertificateRequests are created in the system namespace of the control plane,
+	// so an Issuer has to be in this namespace as well.
+	// +required
+	IssuerRef CertManagerIssuerRef `json:"issuerRef"`
+	// Timeout is the time to wait for a CertificateRequest to be issued.
+	// Default 10 seconds.
+	Timeout *k8s.Duration `json:"timeout,omitempty"`
+	// MeshTrustCreation defines whether a MeshTrust resource should be automatically created
+	// from the CA returned by the issuer. If not defined, the control plane automatically generates a MeshTrust.
+	MeshTrustCreation *MeshTrustCreationMode `json:"meshTrustCreation,omitempty"`
+	// CertificateParameters allows users to define certificate generation parameters.
+	CertificateParameters *CertificateParameters `json:"certificateParameters,omitempty"`
+}
+
+type CertManagerIssuerRef struct {
+	// Name is the name of the issuer.
+	// +required
+	Name string `json:"name"`
+	// Kind is the kind of the issuer.
+	// Default Issuer.
+	Kind *CertManagerIssuerKind `json:"kind,omitempty"`
+	// Group is the API group of the issuer.
+	// Default cert-manager.io.
+	Group *string `json:"group,omitempty"`
+}
+
 const (
 	ReadyConditionType            string = "Ready"
 	ProviderConditionType         string = "Provider"
 	SpiffeIDProviderConditionType string = "SpiffeIDProvider"
 	MeshTrustConditionType        string = "MeshTrustCreated"
 	DependenciesReadyType         string = "DependenciesReady"
+	IssuerReadyConditionType      string = "IssuerReady"
 )
 
 type MeshIdentityStatus struct {
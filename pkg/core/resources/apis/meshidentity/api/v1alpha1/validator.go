@@ -1,6 +1,8 @@
 package v1alpha1
 
 import (
+	"net/url"
+	"strings"
 	"text/template"
 
 	"github.com/pkg/errors"
@@ -49,8 +51,12 @@ func validateProvider(provider Provider) validators.ValidationError {
 		verr.Add(validateSpire(validators.RootedAt("spire"), provider.Spire))
 	case ExtensionType:
 		verr.Add(validateExtension(validators.RootedAt("extension"), provider.Extension))
+	case VaultType:
+		verr.Add(validateVault(validators.RootedAt("vault"), provider.Vault))
+	case CertManagerType:
+		verr.Add(validateCertManager(validators.RootedAt("certManager"), provider.CertManager))
 	default:
-		verr.AddError("type", validators.MakeFieldMustBeOneOfErr(string(provider.Type), string(BundledType), string(SpireType), string(ExtensionType)))
+		verr.AddError("type", validators.MakeFieldMustBeOneOfErr(string(provider.Type), string(BundledType), string(SpireType), string(ExtensionType), string(VaultType), string(CertManagerType)))
 	}
 	return verr
 }
@@ -61,17 +67,7 @@ func validateBundled(path validators.PathBuilder, b *Bundled) validators.Validat
 		verr.AddViolationAt(path, "configuration needs to be defined")
 		return verr
 	}
-	if b.MeshTrustCreation != nil {
-		switch *b.MeshTrustCreation {
-		case MeshTrustCreationEnabled:
-		case MeshTrustCreationDisabled:
-		default:
-			verr.AddErrorAt(
-				path.Field("meshTrustCreation"),
-				validators.MakeFieldMustBeOneOfErr(string(*b.MeshTrustCreation), string(MeshTrustCreationEnabled), string(MeshTrustCreationDisabled)),
-			)
-		}
-	}
+	verr.Add(validateMeshTrustCreation(path.Field("meshTrustCreation"), b.MeshTrustCreation))
 	if b.Autogenerate != nil && pointer.Deref(b.Autogenerate.Enabled) {
 		if b.CA != nil {
 			verr.AddViolationAt(path.Field("ca"), "shouldn't be defined once using autogenerated")
@@ -92,11 +88,84 @@ func validateBundled(path validators.PathBuilder, b *Bundled) validators.Validat
 			}
 		}
 	}
-	if b.CertificateParameters != nil {
-		if b.CertificateParameters.Expiry != nil {
-			verr.Add(validators.ValidateDurationNotNegative(path.Field("certificateParameters").Field("expiry"), b.CertificateParameters.Expiry))
+	verr.Add(validateCertificateParameters(path.Field("certificateParameters"), b.CertificateParameters))
+	return verr
+}
+
+func validateMeshTrustCreation(path validators.PathBuilder, mode *MeshTrustCreationMode) validators.ValidationError {
+	var verr validators.ValidationError
+	if mode == nil {
+		return verr
+	}
+	switch *mode {
+	case MeshTrustCreationEnabled:
+	case MeshTrustCreationDisabled:
+	default:
+		verr.AddErrorAt(path, validators.MakeFieldMustBeOneOfErr(string(*mode), string(MeshTrustCreationEnabled), string(MeshTrustCreationDisabled)))
+	}
+	return verr
+}
+
+func validateCertificateParameters(path validators.PathBuilder, params *CertificateParameters) validators.ValidationError {
+	var verr validators.ValidationError
+	if params != nil && params.Expiry != nil {
+		verr.Add(validators.ValidateDurationNotNegative(path.Field("expiry"), params.Expiry))
+	}
+	return verr
+}
+
+func validateVault(path validators.PathBuilder, v *Vault) validators.ValidationError {
+	var verr validators.ValidationError
+	if v == nil {
+		verr.AddViolationAt(path, "configuration needs to be defined")
+		return verr
+	}
+	if v.Address == "" {
+		verr.AddViolationAt(path.Field("address"), validators.MustBeDefined)
+	} else if u, err := url.Parse(v.Address); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		verr.AddViolationAt(path.Field("address"), "must be a valid http or https URL")
+	}
+	if v.PKI.Mount != nil && strings.Trim(*v.PKI.Mount, "/") == "" {
+		verr.AddViolationAt(path.Field("pki").Field("mount"), validators.MustNotBeEmpty)
+	}
+	if v.PKI.Role == "" {
+		verr.AddViolationAt(path.Field("pki").Field("role"), validators.MustBeDefined)
+	}
+	if v.Auth.Token == nil {
+		verr.AddViolationAt(path.Field("auth").Field("token"), validators.MustBeDefined)
+	} else {
+		verr.Add(v.Auth.Token.ValidateSecureDataSource(path.Field("auth").Field("token")))
+	}
+	if v.TLS != nil && v.TLS.CACert != nil {
+		verr.Add(v.TLS.CACert.ValidateSecureDataSource(path.Field("tls").Field("caCert")))
+	}
+	verr.Add(validateMeshTrustCreation(path.Field("meshTrustCreation"), v.MeshTrustCreation))
+	verr.Add(validateCertificateParameters(path.Field("certificateParameters"), v.CertificateParameters))
+	return verr
+}
+
+func validateCertManager(path validators.PathBuilder, c *CertManager) validators.ValidationError {
+	var verr validators.ValidationError
+	if c == nil {
+		verr.AddViolationAt(path, "configuration needs to be defined")
+		return verr
+	}
+	if c.IssuerRef.Name == "" {
+		verr.AddViolationAt(path.Field("issuerRef").Field("name"), validators.MustBeDefined)
+	}
+	if c.IssuerRef.Kind != nil {
+		switch *c.IssuerRef.Kind {
+		case IssuerKind, ClusterIssuerKind:
+		default:
+			verr.AddErrorAt(path.Field("issuerRef").Field("kind"), validators.MakeFieldMustBeOneOfErr(string(*c.IssuerRef.Kind), string(IssuerKind), string(ClusterIssuerKind)))
 		}
 	}
+	if c.IssuerRef.Group != nil && *c.IssuerRef.Group == "" {
+		verr.AddViolationAt(path.Field("issuerRef").Field("group"), validators.MustNotBeEmpty)
+	}
+	verr.Add(validators.ValidateDurationGreaterThanZeroOrNil(path.Field("timeout"), c.Timeout))
+	verr.Add(validateMeshTrustCreation(path.Field("meshTrustCreation"), c.MeshTrustCreation))
+	verr.Add(validateCertificateParameters(path.Field("certificateParameters"), c.CertificateParameters))
 	return verr
 }
 
@@ -96,6 +96,62 @@ func (in *CA) DeepCopy() *CA {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertManager) DeepCopyInto(out *CertManager) {
+	*out = *in
+	in.IssuerRef.DeepCopyInto(&out.IssuerRef)
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MeshTrustCreation != nil {
+		in, out := &in.MeshTrustCreation, &out.MeshTrustCreation
+		*out = new(MeshTrustCreationMode)
+		**out = **in
+	}
+	if in.CertificateParameters != nil {
+		in, out := &in.CertificateParameters, &out.CertificateParameters
+		*out = new(CertificateParameters)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertManager.
+func (in *CertManager) DeepCopy() *CertManager {
+	if in == nil {
+		return nil
+	}
+	out := new(CertManager)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertManagerIssuerRef) DeepCopyInto(out *CertManagerIssuerRef) {
+	*out = *in
+	if in.Kind != nil {
+		in, out := &in.Kind, &out.Kind
+		*out = new(CertManagerIssuerKind)
+		**out = **in
+	}
+	if in.Group != nil {
+		in, out := &in.Group, &out.Group
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertManagerIssuerRef.
+func (in *CertManagerIssuerRef) DeepCopy() *CertManagerIssuerRef {
+	if in == nil {
+		return nil
+	}
+	out := new(CertManagerIssuerRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CertificateParameters) DeepCopyInto(out *CertificateParameters) {
 	*out = *in
@@ -204,6 +260,16 @@ func (in *Provider) DeepCopyInto(out *Provider) {
 		*out = new(Extension)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Vault != nil {
+		in, out := &in.Vault, &out.Vault
+		*out = new(Vault)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CertManager != nil {
+		in, out := &in.CertManager, &out.CertManager
+		*out = new(CertManager)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Provider.
@@ -300,3 +366,105 @@ func (in *SpireAgent) DeepCopy() *SpireAgent {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Vault) DeepCopyInto(out *Vault) {
+	*out = *in
+	if in.Namespace != nil {
+		in, out := &in.Namespace, &out.Namespace
+		*out = new(string)
+		**out = **in
+	}
+	in.PKI.DeepCopyInto(&out.PKI)
+	in.Auth.DeepCopyInto(&out.Auth)
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(VaultTLS)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MeshTrustCreation != nil {
+		in, out := &in.MeshTrustCreation, &out.MeshTrustCreation
+		*out = new(MeshTrustCreationMode)
+		**out = **in
+	}
+	if in.CertificateParameters != nil {
+		in, out := &in.CertificateParameters, &out.CertificateParameters
+		*out = new(CertificateParameters)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Vault.
+func (in *Vault) DeepCopy() *Vault {
+	if in == nil {
+		return nil
+	}
+	out := new(Vault)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VaultAuth) DeepCopyInto(out *VaultAuth) {
+	*out = *in
+	if in.Token != nil {
+		in, out := &in.Token, &out.Token
+		*out = new(datasource.SecureDataSource)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultAuth.
+func (in *VaultAuth) DeepCopy() *VaultAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(VaultAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VaultPKI) DeepCopyInto(out *VaultPKI) {
+	*out = *in
+	if in.Mount != nil {
+		in, out := &in.Mount, &out.Mount
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultPKI.
+func (in *VaultPKI) DeepCopy() *VaultPKI {
+	if in == nil {
+		return nil
+	}
+	out := new(VaultPKI)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VaultTLS) DeepCopyInto(out *VaultTLS) {
+	*out = *in
+	if in.CACert != nil {
+		in, out := &in.CACert, &out.CACert
+		*out = new(datasource.SecureDataSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServerName != nil {
+		in, out := &in.ServerName, &out.ServerName
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultTLS.
+func (in *VaultTLS) DeepCopy() *VaultTLS {
+	if in == nil {
+		return nil
+	}
+	out := new(VaultTLS)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -11,3 +11,11 @@ import . "github.com/kumahq/kuma/v3/pkg/core/xds/origin"
 // OriginIdentityBundled is the origin for resources related to bundled
 // MeshIdentity provider
 const OriginIdentityBundled Origin = "IdentityBundled"
+
+// OriginIdentityVault is the origin for resources related to Vault
+// MeshIdentity provider
+const OriginIdentityVault Origin = "IdentityVault"
+
+// OriginIdentityCertManager is the origin for resources related to
+// cert-manager MeshIdentity provider
+const OriginIdentityCertManager Origin = "IdentityCertManager"
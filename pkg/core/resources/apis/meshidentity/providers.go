@@ -3,7 +3,9 @@ package meshidentity
 import (
 	"github.com/kumahq/kuma/v3/pkg/core/plugins"
 	"github.com/kumahq/kuma/v3/pkg/core/resources/apis/meshidentity/providers/bundled"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/apis/meshidentity/providers/certmanager"
 	"github.com/kumahq/kuma/v3/pkg/core/resources/apis/meshidentity/providers/spire"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/apis/meshidentity/providers/vault"
 )
 
 // Map of all providers supported by the MeshIdentity resource.
@@ -11,6 +13,8 @@ import (
 // it must be added here manually. The purpose of keeping it manual is to allow
 // child projects to extend it.
 var NameToModule = map[string]*plugins.PluginInitializer{
-	"bundled": {InitFn: bundled.InitProvider, Initialized: false},
-	"spire":   {InitFn: spire.InitProvider, Initialized: false},
+	"bundled":     {InitFn: bundled.InitProvider, Initialized: false},
+	"spire":       {InitFn: spire.InitProvider, Initialized: false},
+	"vault":       {InitFn: vault.InitProvider, Initialized: false},
+	"certmanager": {InitFn: certmanager.InitProvider, Initialized: false},
 }
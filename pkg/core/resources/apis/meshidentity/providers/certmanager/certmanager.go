@@ -0,0 +1,283 @@
+package certmanager
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	kube_meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	kube_client "sigs.k8s.io/controller-runtime/pkg/client"
+
+	config_core "github.com/kumahq/kuma/v3/pkg/config/core"
+	"github.com/kumahq/kuma/v3/pkg/core"
+	meshidentity_api "github.com/kumahq/kuma/v3/pkg/core/resources/apis/meshidentity/api/v1alpha1"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/apis/meshidentity/metadata"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/apis/meshidentity/providers"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/apis/meshidentity/providers/csr"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/model"
+	"github.com/kumahq/kuma/v3/pkg/core/xds"
+	"github.com/kumahq/kuma/v3/pkg/metrics"
+	"github.com/kumahq/kuma/v3/pkg/util/pointer"
+	"github.com/kumahq/kuma/v3/pkg/xds/cache/once"
+)
+
+const (
+	defaultGroup   = "cert-manager.io"
+	defaultTimeout = 10 * time.Second
+	pollInterval   = 200 * time.Millisecond
+
+	// The CA of the issuer is only returned together with a signed certificate,
+	// so it is refreshed by signing a certificate for the trust domain.
+	caCacheExpirationTime = time.Hour
+)
+
+var (
+	DefaultWorkloadCertValidityPeriod = kube_meta.Duration{Duration: 24 * time.Hour}
+
+	certificateRequestGVK = schema.GroupVersionKind{Group: defaultGroup, Version: "v1", Kind: "CertificateRequest"}
+)
+
+var (
+	_ providers.IdentityProvider = &certManagerIdentityProvider{}
+	_ providers.IssuerChecker    = &certManagerIdentityProvider{}
+)
+
+type certManagerIdentityProvider struct {
+	logger      logr.Logger
+	client      kube_client.Client
+	namespace   string
+	zone        string
+	environment config_core.EnvironmentType
+	caCache     *once.Cache
+}
+
+// NewCertManagerIdentityProvider creates a provider which signs workload
+// certificates by creating cert-manager CertificateRequests in the namespace.
+// The client is nil when the control plane does not run on Kubernetes.
+func NewCertManagerIdentityProvider(client kube_client.Client, namespace string, metrics metrics.Metrics, zone string, environment config_core.EnvironmentType) (providers.IdentityProvider, error) {
+	c, err := once.New(caCacheExpirationTime, "cert_manager_ca_cache", metrics)
+	if err != nil {
+		return nil, err
+	}
+	return &certManagerIdentityProvider{
+		logger:      core.Log.WithName("identity-provider").WithName("cert-manager"),
+		client:      client,
+		namespace:   namespace,
+		zone:        zone,
+		environment: environment,
+		caCache:     c,
+	}, nil
+}
+
+func (c *certManagerIdentityProvider) Validate(_ context.Context, identity *meshidentity_api.MeshIdentityResource) error {
+	if c.client == nil {
+		return errors.New("CertManager provider is only supported on Kubernetes")
+	}
+	if identity.Spec.Provider.CertManager == nil {
+		return errors.Errorf("MeshIdentity %q has provider type CertManager but certManager config is nil", identity.GetMeta().GetName())
+	}
+	return nil
+}
+
+func (c *certManagerIdentityProvider) Initialize(context.Context, *meshidentity_api.MeshIdentityResource) error {
+	return nil
+}
+
+// CheckIssuer verifies that the referenced issuer exists and is ready.
+func (c *certManagerIdentityProvider) CheckIssuer(ctx context.Context, identity *meshidentity_api.MeshIdentityResource) error {
+	if err := c.Validate(ctx, identity); err != nil {
+		return err
+	}
+	ref := identity.Spec.Provider.CertManager.IssuerRef
+	kind := pointer.DerefOr(ref.Kind, meshidentity_api.IssuerKind)
+	issuer := &unstructured.Unstructured{}
+	issuer.SetGroupVersionKind(schema.GroupVersionKind{Group: pointer.DerefOr(ref.Group, defaultGroup), Version: "v1", Kind: string(kind)})
+	key := kube_client.ObjectKey{Name: ref.Name}
+	if kind == meshidentity_api.IssuerKind {
+		key.Namespace = c.namespace
+	}
+	if err := c.client.Get(ctx, key, issuer); err != nil {
+		return errors.Wrapf(err, "could not get %s %q", kind, ref.Name)
+	}
+	ready, found := condition(issuer, "Ready")
+	if !found {
+		return errors.Errorf("%s %q has no Ready condition", kind, ref.Name)
+	}
+	if ready.status != string(kube_meta.ConditionTrue) {
+		return errors.Errorf("%s %q is not ready: %s", kind, ref.Name, ready.message)
+	}
+	return nil
+}
+
+func (c *certManagerIdentityProvider) GetMeshTrustCA(ctx context.Context, identity *meshidentity_api.MeshIdentityResource) ([]byte, error) {
+	conf := identity.Spec.Provider.CertManager
+	if pointer.DerefOr(conf.MeshTrustCreation, meshidentity_api.MeshTrustCreationEnabled) == meshidentity_api.MeshTrustCreationDisabled {
+		return nil, nil
+	}
+	trustDomain, err := identity.Spec.GetTrustDomain(identity.GetMeta(), c.zone)
+	if err != nil {
+		return nil, err
+	}
+	cacheKey := fmt.Sprintf("ca:%s:%s", identity.GetMeta().GetMesh(), model.GetDisplayName(identity.GetMeta()))
+	ca, err := c.caCache.GetOrRetrieve(ctx, cacheKey, once.RetrieverFunc(func(ctx context.Context, _ string) (any, error) {
+		_, ca, err := c.issue(ctx, identity, "spiffe://"+trustDomain)
+		if err != nil {
+			return nil, err
+		}
+		if len(ca) == 0 {
+			return nil, errors.New("issuer did not return a CA certificate")
+		}
+		return ca, nil
+	}))
+	if err != nil {
+		return nil, err
+	}
+	return ca.([]byte), nil
+}
+
+func (c *certManagerIdentityProvider) CreateIdentity(ctx context.Context, identity *meshidentity_api.MeshIdentityResource, proxy *xds.Proxy) (*xds.WorkloadIdentity, error) {
+	if err := c.Validate(ctx, identity); err != nil {
+		return nil, err
+	}
+	spiffeID, err := csr.SpiffeID(identity, proxy, c.zone, c.environment)
+	if err != nil {
+		return nil, err
+	}
+	c.logger.V(1).Info("requesting an identity", "dpp", model.MetaToResourceKey(proxy.Dataplane.GetMeta()), "spiffeID", spiffeID, "identity", model.MetaToResourceKey(identity.GetMeta()))
+	request, err := csr.NewRequest(spiffeID)
+	if err != nil {
+		return nil, err
+	}
+	cert, ca, err := c.sign(ctx, identity, request)
+	if err != nil {
+		return nil, err
+	}
+	chain, err := csr.CertificateChain(cert, ca)
+	if err != nil {
+		return nil, err
+	}
+	c.logger.V(1).Info("identity issued", "dpp", model.MetaToResourceKey(proxy.Dataplane.GetMeta()), "spiffeID", spiffeID, "identity", model.MetaToResourceKey(identity.GetMeta()))
+	return csr.NewWorkloadIdentity(identity, chain, request.KeyPEM, metadata.OriginIdentityCertManager)
+}
+
+func (c *certManagerIdentityProvider) issue(ctx context.Context, identity *meshidentity_api.MeshIdentityResource, spiffeID string) ([]byte, []byte, error) {
+	request, err := csr.NewRequest(spiffeID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return c.sign(ctx, identity, request)
+}
+
+// sign creates a CertificateRequest and waits until it is issued. The
+// CertificateRequest is removed afterwards, since the private key is only
+// known to the control plane and the request cannot be reused.
+func (c *certManagerIdentityProvider) sign(ctx context.Context, identity *meshidentity_api.MeshIdentityResource, request *csr.Request) ([]byte, []byte, error) {
+	conf := identity.Spec.Provider.CertManager
+	validity := DefaultWorkloadCertValidityPeriod
+	if conf.CertificateParameters != nil {
+		validity = pointer.DerefOr(conf.CertificateParameters.Expiry, DefaultWorkloadCertValidityPeriod)
+	}
+	cr := &unstructured.Unstructured{}
+	cr.SetGroupVersionKind(certificateRequestGVK)
+	cr.SetNamespace(c.namespace)
+	cr.SetGenerateName(identity.GetMeta().GetName() + "-")
+	cr.SetLabels(map[string]string{
+		"kuma.io/mesh":         identity.GetMeta().GetMesh(),
+		"kuma.io/meshidentity": identity.GetMeta().GetName(),
+	})
+	cr.Object["spec"] = map[string]any{
+		"request":  base64.StdEncoding.EncodeToString(request.CSRPEM),
+		"duration": validity.Duration.String(),
+		"isCA":     false,
+		"usages":   []any{"digital signature", "key encipherment", "server auth", "client auth"},
+		"issuerRef": map[string]any{
+			"name":  conf.IssuerRef.Name,
+			"kind":  string(pointer.DerefOr(conf.IssuerRef.Kind, meshidentity_api.IssuerKind)),
+			"group": pointer.DerefOr(conf.IssuerRef.Group, defaultGroup),
+		},
+	}
+	if err := c.client.Create(ctx, cr); err != nil {
+		return nil, nil, errors.Wrap(err, "could not create CertificateRequest")
+	}
+	defer func() {
+		if err := c.client.Delete(context.WithoutCancel(ctx), cr); err != nil && kube_client.IgnoreNotFound(err) != nil {
+			c.logger.Error(err, "could not delete CertificateRequest", "name", cr.GetName(), "namespace", cr.GetNamespace())
+		}
+	}()
+
+	var cert, ca []byte
+	timeout := defaultTimeout
+	if conf.Timeout != nil {
+		timeout = conf.Timeout.Duration
+	}
+	err := wait.PollUntilContextTimeout(ctx, pollInterval, timeout, true, func(ctx context.Context) (bool, error) {
+		current := &unstructured.Unstructured{}
+		current.SetGroupVersionKind(certificateRequestGVK)
+		if err := c.client.Get(ctx, kube_client.ObjectKeyFromObject(cr), current); err != nil {
+			return false, err
+		}
+		if denied, found := condition(current, "Denied"); found && denied.status == string(kube_meta.ConditionTrue) {
+			return false, errors.Errorf("CertificateRequest %q was denied: %s", cr.GetName(), denied.message)
+		}
+		ready, found := condition(current, "Ready")
+		if !found {
+			return false, nil
+		}
+		if ready.status != string(kube_meta.ConditionTrue) {
+			if ready.reason == "Failed" || ready.reason == "Denied" {
+				return false, errors.Errorf("CertificateRequest %q failed: %s", cr.GetName(), ready.message)
+			}
+			return false, nil
+		}
+		var err error
+		if cert, err = decodedField(current, "status", "certificate"); err != nil {
+			return false, err
+		}
+		if ca, err = decodedField(current, "status", "ca"); err != nil {
+			return false, err
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "CertificateRequest %q was not issued", cr.GetName())
+	}
+	if len(cert) == 0 {
+		return nil, nil, errors.Errorf("CertificateRequest %q has no certificate", cr.GetName())
+	}
+	return cert, ca, nil
+}
+
+type conditionStatus struct {
+	status  string
+	reason  string
+	message string
+}
+
+func condition(obj *unstructured.Unstructured, conditionType string) (conditionStatus, bool) {
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	for _, c := range conditions {
+		cond, ok := c.(map[string]any)
+		if !ok || cond["type"] != conditionType {
+			continue
+		}
+		status, _ := cond["status"].(string)
+		reason, _ := cond["reason"].(string)
+		message, _ := cond["message"].(string)
+		return conditionStatus{status: status, reason: reason, message: message}, true
+	}
+	return conditionStatus{}, false
+}
+
+func decodedField(obj *unstructured.Unstructured, fields ...string) ([]byte, error) {
+	value, _, err := unstructured.NestedString(obj.Object, fields...)
+	if err != nil || value == "" {
+		return nil, err
+	}
+	return base64.StdEncoding.DecodeString(value)
+}
@@ -0,0 +1,11 @@
+package certmanager_test
+
+import (
+	"testing"
+
+	"github.com/kumahq/kuma/v3/pkg/test"
+)
+
+func TestCertManager(t *testing.T) {
+	test.RunSpecs(t, "CertManager Provider Suite")
+}
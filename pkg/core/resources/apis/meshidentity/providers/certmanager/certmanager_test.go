@@ -0,0 +1,257 @@
+package certmanager_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"time"
+
+	envoy_auth "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	envoy_resource "github.com/envoyproxy/go-control-plane/pkg/resource/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	kube_runtime "k8s.io/apimachinery/pkg/runtime"
+	kube_client "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	mesh_proto "github.com/kumahq/kuma/v3/api/mesh/v1alpha1"
+	config_core "github.com/kumahq/kuma/v3/pkg/config/core"
+	"github.com/kumahq/kuma/v3/pkg/core/kri"
+	meshidentity_api "github.com/kumahq/kuma/v3/pkg/core/resources/apis/meshidentity/api/v1alpha1"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/apis/meshidentity/providers"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/apis/meshidentity/providers/certmanager"
+	core_metrics "github.com/kumahq/kuma/v3/pkg/metrics"
+	"github.com/kumahq/kuma/v3/pkg/plugins/runtime/k8s/metadata"
+	"github.com/kumahq/kuma/v3/pkg/test/resources/builders"
+	xds_builders "github.com/kumahq/kuma/v3/pkg/test/xds/builders"
+)
+
+const namespace = "kuma-system"
+
+var _ = Describe("CertManager Provider", func() {
+	var issuer *fakeIssuer
+	var kubeClient kube_client.Client
+	var provider providers.IdentityProvider
+
+	newProvider := func(client kube_client.Client) providers.IdentityProvider {
+		GinkgoHelper()
+		metrics, err := core_metrics.NewMetrics("zone")
+		Expect(err).ToNot(HaveOccurred())
+		p, err := certmanager.NewCertManagerIdentityProvider(client, namespace, metrics, "my-zone", config_core.KubernetesEnvironment)
+		Expect(err).ToNot(HaveOccurred())
+		return p
+	}
+
+	BeforeEach(func() {
+		issuer = newFakeIssuer()
+		kubeClient = fake.NewClientBuilder().
+			WithScheme(kube_runtime.NewScheme()).
+			WithObjects(issuerObject("Issuer", "my-issuer", namespace, "True", "Signing CA verified")).
+			WithObjects(issuerObject("ClusterIssuer", "not-ready", "", "False", "secret \"ca\" not found")).
+			WithInterceptorFuncs(interceptor.Funcs{
+				// cert-manager signs the request asynchronously, here the status
+				// is set already when the CertificateRequest is created
+				Create: func(ctx context.Context, client kube_client.WithWatch, obj kube_client.Object, opts ...kube_client.CreateOption) error {
+					issuer.process(obj.(*unstructured.Unstructured))
+					return client.Create(ctx, obj, opts...)
+				},
+			}).
+			Build()
+		provider = newProvider(kubeClient)
+	})
+
+	proxy := func() *xds_builders.ProxyBuilder {
+		return xds_builders.Proxy().
+			WithDataplane(builders.Dataplane().
+				WithName("web-01").
+				WithAddress("192.168.0.2").
+				WithLabels(map[string]string{
+					metadata.KumaServiceAccount: "my-sa",
+					mesh_proto.KubeNamespaceTag: "my-ns",
+				}).
+				WithInboundOfTagsAndProtocol("http", mesh_proto.ServiceTag, "web"))
+	}
+
+	It("should sign a workload certificate with a CertificateRequest", func() {
+		// given
+		meshIdentity := builders.MeshIdentity().WithCertManager(meshidentity_api.IssuerKind, "my-issuer").Build()
+
+		// when
+		identity, err := provider.CreateIdentity(context.Background(), meshIdentity, proxy().Build())
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(identity.KRI).To(Equal(kri.From(meshIdentity)))
+		Expect(identity.CertLifetime()).To(BeNumerically("~", 99*time.Minute, time.Minute))
+
+		secret := identity.AdditionalResources.Resources(envoy_resource.SecretType)[kri.From(meshIdentity).String()].Resource.(*envoy_auth.Secret)
+		block, _ := pem.Decode(secret.GetTlsCertificate().GetCertificateChain().GetInlineBytes())
+		cert, err := x509.ParseCertificate(block.Bytes)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cert.URIs[0].String()).To(Equal("spiffe://default.my-zone.mesh.local/ns/my-ns/sa/my-sa"))
+
+		// and the request was sent to the referenced issuer
+		Expect(issuer.issuerRefs).To(ConsistOf(map[string]any{"name": "my-issuer", "kind": "Issuer", "group": "cert-manager.io"}))
+
+		// and the CertificateRequest was cleaned up
+		list := &unstructured.UnstructuredList{}
+		list.SetAPIVersion("cert-manager.io/v1")
+		list.SetKind("CertificateRequestList")
+		Expect(kubeClient.List(context.Background(), list, kube_client.InNamespace(namespace))).To(Succeed())
+		Expect(list.Items).To(BeEmpty())
+	})
+
+	It("should return the CA of the issuer for MeshTrust", func() {
+		// given
+		meshIdentity := builders.MeshIdentity().WithCertManager(meshidentity_api.IssuerKind, "my-issuer").Build()
+
+		// when
+		ca, err := provider.GetMeshTrustCA(context.Background(), meshIdentity)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = provider.GetMeshTrustCA(context.Background(), meshIdentity)
+		Expect(err).ToNot(HaveOccurred())
+
+		// then
+		Expect(ca).To(Equal(issuer.caPEM))
+		// and the CA is cached
+		Expect(issuer.issuerRefs).To(HaveLen(1))
+	})
+
+	It("should fail when the CertificateRequest is denied", func() {
+		// given
+		issuer.deny = true
+		meshIdentity := builders.MeshIdentity().WithCertManager(meshidentity_api.IssuerKind, "my-issuer").Build()
+
+		// when
+		_, err := provider.CreateIdentity(context.Background(), meshIdentity, proxy().Build())
+
+		// then
+		Expect(err).To(MatchError(ContainSubstring("was denied: rejected by approver")))
+	})
+
+	DescribeTable("should check the issuer",
+		func(kind meshidentity_api.CertManagerIssuerKind, name string, expectedErr string) {
+			// given
+			meshIdentity := builders.MeshIdentity().WithCertManager(kind, name).Build()
+
+			// when
+			err := provider.(providers.IssuerChecker).CheckIssuer(context.Background(), meshIdentity)
+
+			// then
+			if expectedErr == "" {
+				Expect(err).ToNot(HaveOccurred())
+			} else {
+				Expect(err).To(MatchError(ContainSubstring(expectedErr)))
+			}
+		},
+		Entry("ready issuer", meshidentity_api.IssuerKind, "my-issuer", ""),
+		Entry("issuer not ready", meshidentity_api.ClusterIssuerKind, "not-ready", `ClusterIssuer "not-ready" is not ready: secret "ca" not found`),
+		Entry("missing issuer", meshidentity_api.IssuerKind, "other", `could not get Issuer "other"`),
+	)
+
+	It("should not be supported outside of Kubernetes", func() {
+		// given
+		meshIdentity := builders.MeshIdentity().WithCertManager(meshidentity_api.IssuerKind, "my-issuer").Build()
+
+		// when
+		err := newProvider(nil).Validate(context.Background(), meshIdentity)
+
+		// then
+		Expect(err).To(MatchError("CertManager provider is only supported on Kubernetes"))
+	})
+})
+
+func issuerObject(kind, name, namespace, status, message string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion("cert-manager.io/v1")
+	obj.SetKind(kind)
+	obj.SetName(name)
+	obj.SetNamespace(namespace)
+	obj.Object["status"] = map[string]any{
+		"conditions": []any{
+			map[string]any{"type": "Ready", "status": status, "message": message},
+		},
+	}
+	return obj
+}
+
+// fakeIssuer signs CertificateRequests the way a cert-manager CA issuer does.
+type fakeIssuer struct {
+	caPEM      []byte
+	ca         *x509.Certificate
+	caKey      *ecdsa.PrivateKey
+	deny       bool
+	issuerRefs []any
+}
+
+func newFakeIssuer() *fakeIssuer {
+	GinkgoHelper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).ToNot(HaveOccurred())
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "cert-manager-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	Expect(err).ToNot(HaveOccurred())
+	ca, err := x509.ParseCertificate(der)
+	Expect(err).ToNot(HaveOccurred())
+	return &fakeIssuer{
+		caPEM: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		ca:    ca,
+		caKey: key,
+	}
+}
+
+func (f *fakeIssuer) process(cr *unstructured.Unstructured) {
+	GinkgoHelper()
+	issuerRef, _, _ := unstructured.NestedMap(cr.Object, "spec", "issuerRef")
+	f.issuerRefs = append(f.issuerRefs, issuerRef)
+	if f.deny {
+		cr.Object["status"] = map[string]any{
+			"conditions": []any{
+				map[string]any{"type": "Denied", "status": "True", "reason": "Denied", "message": "rejected by approver"},
+			},
+		}
+		return
+	}
+	request, _, _ := unstructured.NestedString(cr.Object, "spec", "request")
+	csrPEM, err := base64.StdEncoding.DecodeString(request)
+	Expect(err).ToNot(HaveOccurred())
+	block, _ := pem.Decode(csrPEM)
+	csr, err := x509.ParseCertificateRequest(block.Bytes)
+	Expect(err).ToNot(HaveOccurred())
+	durationStr, _, _ := unstructured.NestedString(cr.Object, "spec", "duration")
+	duration, err := time.ParseDuration(durationStr)
+	Expect(err).ToNot(HaveOccurred())
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		URIs:         csr.URIs,
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(duration),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, f.ca, csr.PublicKey, f.caKey)
+	Expect(err).ToNot(HaveOccurred())
+	cr.Object["status"] = map[string]any{
+		"certificate": base64.StdEncoding.EncodeToString(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		"ca":          base64.StdEncoding.EncodeToString(f.caPEM),
+		"conditions": []any{
+			map[string]any{"type": "Ready", "status": "True", "reason": "Issued"},
+		},
+	}
+}
@@ -0,0 +1,35 @@
+package certmanager
+
+import (
+	kube_client "sigs.k8s.io/controller-runtime/pkg/client"
+
+	config_core "github.com/kumahq/kuma/v3/pkg/config/core"
+	core_plugins "github.com/kumahq/kuma/v3/pkg/core/plugins"
+	meshidentity_api "github.com/kumahq/kuma/v3/pkg/core/resources/apis/meshidentity/api/v1alpha1"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/apis/meshidentity/providers"
+	k8s_extensions "github.com/kumahq/kuma/v3/pkg/plugins/extensions/k8s"
+)
+
+var _ core_plugins.IdentityProviderPlugin = &plugin{}
+
+type plugin struct{}
+
+func InitProvider() {
+	core_plugins.Register(core_plugins.PluginName(meshidentity_api.CertManagerType), &plugin{})
+}
+
+func (p plugin) NewIdentityProvider(context core_plugins.PluginContext, config core_plugins.PluginConfig) (providers.IdentityProvider, error) {
+	var client kube_client.Client
+	if context.Config().Environment == config_core.KubernetesEnvironment {
+		if mgr, ok := k8s_extensions.FromManagerContext(context.Extensions()); ok {
+			client = mgr.GetClient()
+		}
+	}
+	return NewCertManagerIdentityProvider(
+		client,
+		context.Config().Store.Kubernetes.SystemNamespace,
+		context.Metrics(),
+		context.Config().Multizone.Zone.Name,
+		context.Config().Environment,
+	)
+}
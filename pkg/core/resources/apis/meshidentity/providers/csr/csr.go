@@ -0,0 +1,159 @@
+// Package csr contains helpers shared by identity providers that delegate
+// signing of workload certificates to an external CA (e.g. Vault or
+// cert-manager). The control plane generates the private key and a
+// certificate signing request, the external CA signs it and the result is
+// turned into a workload identity served to the proxy over SDS.
+package csr
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"net/url"
+	"reflect"
+
+	envoy_tls "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	"github.com/pkg/errors"
+
+	config_core "github.com/kumahq/kuma/v3/pkg/config/core"
+	"github.com/kumahq/kuma/v3/pkg/core"
+	"github.com/kumahq/kuma/v3/pkg/core/kri"
+	meshidentity_api "github.com/kumahq/kuma/v3/pkg/core/resources/apis/meshidentity/api/v1alpha1"
+	"github.com/kumahq/kuma/v3/pkg/core/xds"
+	"github.com/kumahq/kuma/v3/pkg/core/xds/origin"
+	bldrs_auth "github.com/kumahq/kuma/v3/pkg/envoy/builders/auth"
+	bldrs_common "github.com/kumahq/kuma/v3/pkg/envoy/builders/common"
+	bldrs_core "github.com/kumahq/kuma/v3/pkg/envoy/builders/core"
+	bldrs_tls "github.com/kumahq/kuma/v3/pkg/envoy/builders/tls"
+	"github.com/kumahq/kuma/v3/pkg/util/pointer"
+)
+
+// Request is a certificate signing request together with the private key it
+// was generated for.
+type Request struct {
+	CSRPEM []byte
+	KeyPEM []byte
+}
+
+// NewRequest generates an ECDSA P-256 key and a certificate signing request
+// with the SPIFFE ID as the only URI SAN.
+func NewRequest(spiffeID string) (*Request, error) {
+	id, err := url.Parse(spiffeID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid SPIFFE ID %q", spiffeID)
+	}
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate private key")
+	}
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		URIs: []*url.URL{id},
+	}, key)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create certificate signing request")
+	}
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal private key")
+	}
+	return &Request{
+		CSRPEM: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csr}),
+		KeyPEM: pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER}),
+	}, nil
+}
+
+// CertificateChain returns the signed certificate followed by the
+// intermediate CAs. Self-signed (root) CAs are skipped, since they are
+// distributed to the proxies through MeshTrust.
+func CertificateChain(certPEM []byte, caPEMs ...[]byte) ([]byte, error) {
+	chain := [][]byte{bytes.TrimSpace(certPEM)}
+	for _, caPEM := range caPEMs {
+		rest := caPEM
+		for {
+			var block *pem.Block
+			block, rest = pem.Decode(rest)
+			if block == nil {
+				break
+			}
+			if block.Type != "CERTIFICATE" {
+				continue
+			}
+			cert, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to parse CA certificate")
+			}
+			if isSelfSigned(cert) {
+				continue
+			}
+			chain = append(chain, bytes.TrimSpace(pem.EncodeToMemory(block)))
+		}
+	}
+	return append(bytes.Join(chain, []byte("\n")), '\n'), nil
+}
+
+func isSelfSigned(cert *x509.Certificate) bool {
+	if !reflect.DeepEqual(cert.Subject.ToRDNSequence(), cert.Issuer.ToRDNSequence()) {
+		return false
+	}
+	return cert.CheckSignatureFrom(cert) == nil
+}
+
+// NewWorkloadIdentity builds the workload identity of the proxy from the
+// certificate chain signed for the request. The expiration time is taken
+// from the leaf certificate and the generation time is the time it was
+// received, because external CAs usually backdate NotBefore. This way the
+// identity is rotated in time even if the CA shortened the requested TTL.
+func NewWorkloadIdentity(identity *meshidentity_api.MeshIdentityResource, chainPEM []byte, keyPEM []byte, o origin.Origin) (*xds.WorkloadIdentity, error) {
+	block, _ := pem.Decode(chainPEM)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, errors.New("signed certificate is not a PEM encoded certificate")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse signed certificate")
+	}
+	identifier := kri.From(identity)
+	secretName := identifier.String()
+	secret, err := bldrs_auth.NewSecret().
+		Configure(bldrs_auth.Name(secretName)).
+		Configure(bldrs_auth.TlsCertificate(
+			bldrs_auth.NewTlsCertificate().
+				Configure(bldrs_auth.CertificateChain(
+					bldrs_core.NewDataSource().Configure(bldrs_core.InlineBytes(chainPEM)))).
+				Configure(bldrs_auth.PrivateKey(
+					bldrs_core.NewDataSource().Configure(bldrs_core.InlineBytes(keyPEM)))))).Build()
+	if err != nil {
+		return nil, err
+	}
+	resources := xds.NewResourceSet()
+	resources.Add(&xds.Resource{
+		Name:     secretName,
+		Origin:   o,
+		Resource: secret,
+	})
+	return &xds.WorkloadIdentity{
+		KRI:            identifier,
+		ManagementMode: xds.KumaManagementMode,
+		ExpirationTime: pointer.To(cert.NotAfter),
+		GenerationTime: pointer.To(core.Now()),
+		IdentitySourceConfigurer: func() bldrs_common.Configurer[envoy_tls.SdsSecretConfig] {
+			return bldrs_tls.SdsSecretConfigSource(
+				secretName,
+				bldrs_core.NewConfigSource().Configure(bldrs_core.Sds()),
+			)
+		},
+		AdditionalResources: resources,
+	}, nil
+}
+
+// SpiffeID returns the SPIFFE ID of the proxy for the identity.
+func SpiffeID(identity *meshidentity_api.MeshIdentityResource, proxy *xds.Proxy, zone string, environment config_core.EnvironmentType) (string, error) {
+	trustDomain, err := identity.Spec.GetTrustDomain(identity.GetMeta(), zone)
+	if err != nil {
+		return "", err
+	}
+	return identity.Spec.GetSpiffeID(trustDomain, proxy.Dataplane.GetMeta(), environment)
+}
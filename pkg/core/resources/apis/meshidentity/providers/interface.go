@@ -18,3 +18,10 @@ type IdentityProvider interface {
 }
 
 type IdentityProviders = map[string]IdentityProvider
+
+// IssuerChecker is implemented by providers that delegate signing of
+// certificates to an external issuer. The result of the check is reported in
+// the IssuerReady condition of the MeshIdentity.
+type IssuerChecker interface {
+	CheckIssuer(context.Context, *meshidentity_api.MeshIdentityResource) error
+}
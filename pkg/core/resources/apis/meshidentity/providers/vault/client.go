@@ -0,0 +1,164 @@
+package vault
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	tokenHeader     = "X-Vault-Token"
+	namespaceHeader = "X-Vault-Namespace"
+	requestTimeout  = 10 * time.Second
+)
+
+// client is a minimal client of the PKI secrets engine of Vault.
+// See https://developer.hashicorp.com/vault/api-docs/secret/pki
+type client struct {
+	address    string
+	namespace  string
+	token      string
+	httpClient *http.Client
+}
+
+func newClient(address, namespace, token string, httpClient *http.Client) *client {
+	return &client{
+		address:    strings.TrimSuffix(address, "/"),
+		namespace:  namespace,
+		token:      strings.TrimSpace(token),
+		httpClient: httpClient,
+	}
+}
+
+// tlsSettings are the TLS settings of a connection to Vault. Connections with the same settings share an HTTP client.
+type tlsSettings struct {
+	caCert     string
+	serverName string
+}
+
+func newHTTPClient(settings tlsSettings) (*http.Client, error) {
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: settings.serverName,
+	}
+	if settings.caCert != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(settings.caCert)) {
+			return nil, errors.New("could not parse the CA certificate of Vault")
+		}
+		tlsConfig.RootCAs = pool
+	}
+	return &http.Client{
+		Timeout: requestTimeout,
+		Transport: &http.Transport{
+			Proxy:           http.ProxyFromEnvironment,
+			TLSClientConfig: tlsConfig,
+		},
+	}, nil
+}
+
+type signRequest struct {
+	CSR     string `json:"csr"`
+	URISans string `json:"uri_sans,omitempty"`
+	TTL     string `json:"ttl,omitempty"`
+	Format  string `json:"format"`
+}
+
+type signResponse struct {
+	Data struct {
+		Certificate string   `json:"certificate"`
+		IssuingCA   string   `json:"issuing_ca"`
+		CAChain     []string `json:"ca_chain"`
+	} `json:"data"`
+}
+
+type errorResponse struct {
+	Errors []string `json:"errors"`
+}
+
+// sign signs the certificate signing request with the role.
+func (c *client) sign(ctx context.Context, mount, role string, csrPEM []byte, uriSAN string, ttl time.Duration) (*signResponse, error) {
+	body, err := json.Marshal(signRequest{
+		CSR:     string(csrPEM),
+		URISans: uriSAN,
+		TTL:     ttl.String(),
+		Format:  "pem",
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp := &signResponse{}
+	if err := c.do(ctx, http.MethodPost, fmt.Sprintf("/v1/%s/sign/%s", mount, role), body, func(r io.Reader) error {
+		return json.NewDecoder(r).Decode(resp)
+	}); err != nil {
+		return nil, err
+	}
+	if resp.Data.Certificate == "" {
+		return nil, errors.New("Vault returned an empty certificate")
+	}
+	return resp, nil
+}
+
+// caChain returns the PEM encoded CA chain of the PKI secrets engine.
+func (c *client) caChain(ctx context.Context, mount string) ([]byte, error) {
+	var chain []byte
+	if err := c.do(ctx, http.MethodGet, fmt.Sprintf("/v1/%s/cert/ca_chain", mount), nil, func(r io.Reader) error {
+		resp := &struct {
+			Data struct {
+				Certificate string `json:"certificate"`
+			} `json:"data"`
+		}{}
+		if err := json.NewDecoder(r).Decode(resp); err != nil {
+			return err
+		}
+		chain = []byte(resp.Data.Certificate)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	if len(bytes.TrimSpace(chain)) == 0 {
+		return nil, errors.New("Vault returned an empty CA chain")
+	}
+	return chain, nil
+}
+
+func (c *client) do(ctx context.Context, method, path string, body []byte, decode func(io.Reader) error) error {
+	var reqBody io.Reader
+	if body != nil {
+		reqBody = bytes.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.address+path, reqBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set(tokenHeader, c.token)
+	if c.namespace != "" {
+		req.Header.Set(namespaceHeader, c.namespace)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "request to Vault failed")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		errResp := &errorResponse{}
+		_ = json.NewDecoder(resp.Body).Decode(errResp)
+		if len(errResp.Errors) > 0 {
+			return errors.Errorf("Vault responded with status %d: %s", resp.StatusCode, strings.Join(errResp.Errors, "; "))
+		}
+		return errors.Errorf("Vault responded with status %d", resp.StatusCode)
+	}
+	return decode(resp.Body)
+}
@@ -0,0 +1,175 @@
+package vault
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	k8s "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	config_core "github.com/kumahq/kuma/v3/pkg/config/core"
+	"github.com/kumahq/kuma/v3/pkg/core"
+	meshidentity_api "github.com/kumahq/kuma/v3/pkg/core/resources/apis/meshidentity/api/v1alpha1"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/apis/meshidentity/metadata"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/apis/meshidentity/providers"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/apis/meshidentity/providers/csr"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/model"
+	"github.com/kumahq/kuma/v3/pkg/core/xds"
+	"github.com/kumahq/kuma/v3/pkg/util/pointer"
+)
+
+const defaultMount = "pki"
+
+var DefaultWorkloadCertValidityPeriod = k8s.Duration{Duration: 24 * time.Hour}
+
+var (
+	_ providers.IdentityProvider = &vaultIdentityProvider{}
+	_ providers.IssuerChecker    = &vaultIdentityProvider{}
+)
+
+type vaultIdentityProvider struct {
+	logger        logr.Logger
+	secretManager manager.ReadOnlyResourceManager
+	zone          string
+	environment   config_core.EnvironmentType
+
+	sync.Mutex
+	// httpClients are reused across requests, so connections to Vault are kept alive between signing certificates
+	httpClients map[tlsSettings]*http.Client
+}
+
+// NewVaultIdentityProvider creates a provider which signs workload certificates
+// with the PKI secrets engine of HashiCorp Vault. Private keys never leave the
+// control plane, only certificate signing requests are sent to Vault.
+func NewVaultIdentityProvider(secretManager manager.ReadOnlyResourceManager, zone string, environment config_core.EnvironmentType) providers.IdentityProvider {
+	return &vaultIdentityProvider{
+		logger:        core.Log.WithName("identity-provider").WithName("vault"),
+		secretManager: secretManager,
+		zone:          zone,
+		environment:   environment,
+		httpClients:   map[tlsSettings]*http.Client{},
+	}
+}
+
+func (v *vaultIdentityProvider) Validate(ctx context.Context, identity *meshidentity_api.MeshIdentityResource) error {
+	_, err := v.client(ctx, identity)
+	return err
+}
+
+func (v *vaultIdentityProvider) Initialize(context.Context, *meshidentity_api.MeshIdentityResource) error {
+	return nil
+}
+
+// CheckIssuer verifies that Vault is reachable and the PKI secrets engine has a CA.
+func (v *vaultIdentityProvider) CheckIssuer(ctx context.Context, identity *meshidentity_api.MeshIdentityResource) error {
+	_, err := v.caChain(ctx, identity)
+	return err
+}
+
+func (v *vaultIdentityProvider) GetMeshTrustCA(ctx context.Context, identity *meshidentity_api.MeshIdentityResource) ([]byte, error) {
+	if pointer.DerefOr(identity.Spec.Provider.Vault.MeshTrustCreation, meshidentity_api.MeshTrustCreationEnabled) == meshidentity_api.MeshTrustCreationDisabled {
+		return nil, nil
+	}
+	return v.caChain(ctx, identity)
+}
+
+func (v *vaultIdentityProvider) CreateIdentity(ctx context.Context, identity *meshidentity_api.MeshIdentityResource, proxy *xds.Proxy) (*xds.WorkloadIdentity, error) {
+	conf := identity.Spec.Provider.Vault
+	spiffeID, err := csr.SpiffeID(identity, proxy, v.zone, v.environment)
+	if err != nil {
+		return nil, err
+	}
+	request, err := csr.NewRequest(spiffeID)
+	if err != nil {
+		return nil, err
+	}
+	c, err := v.client(ctx, identity)
+	if err != nil {
+		return nil, err
+	}
+
+	v.logger.V(1).Info("signing an identity", "dpp", model.MetaToResourceKey(proxy.Dataplane.GetMeta()), "spiffeID", spiffeID, "identity", model.MetaToResourceKey(identity.GetMeta()))
+	validity := DefaultWorkloadCertValidityPeriod
+	if conf.CertificateParameters != nil {
+		validity = pointer.DerefOr(conf.CertificateParameters.Expiry, DefaultWorkloadCertValidityPeriod)
+	}
+	signed, err := c.sign(ctx, mount(conf), conf.PKI.Role, request.CSRPEM, spiffeID, validity.Duration)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to sign the certificate")
+	}
+	cas := [][]byte{[]byte(signed.Data.IssuingCA)}
+	if len(signed.Data.CAChain) > 0 {
+		cas = nil
+		for _, ca := range signed.Data.CAChain {
+			cas = append(cas, []byte(ca))
+		}
+	}
+	chain, err := csr.CertificateChain([]byte(signed.Data.Certificate), cas...)
+	if err != nil {
+		return nil, err
+	}
+	v.logger.V(1).Info("identity signed", "dpp", model.MetaToResourceKey(proxy.Dataplane.GetMeta()), "spiffeID", spiffeID, "identity", model.MetaToResourceKey(identity.GetMeta()))
+	return csr.NewWorkloadIdentity(identity, chain, request.KeyPEM, metadata.OriginIdentityVault)
+}
+
+func (v *vaultIdentityProvider) caChain(ctx context.Context, identity *meshidentity_api.MeshIdentityResource) ([]byte, error) {
+	c, err := v.client(ctx, identity)
+	if err != nil {
+		return nil, err
+	}
+	return c.caChain(ctx, mount(identity.Spec.Provider.Vault))
+}
+
+func (v *vaultIdentityProvider) client(ctx context.Context, identity *meshidentity_api.MeshIdentityResource) (*client, error) {
+	conf := identity.Spec.Provider.Vault
+	if conf == nil {
+		return nil, errors.Errorf("MeshIdentity %q has provider type Vault but vault config is nil", identity.GetMeta().GetName())
+	}
+	mesh := identity.GetMeta().GetMesh()
+	if conf.Auth.Token == nil {
+		return nil, errors.New("Vault token is not defined")
+	}
+	token, err := conf.Auth.Token.ReadByControlPlane(ctx, v.secretManager, mesh)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read the Vault token")
+	}
+	settings := tlsSettings{}
+	if conf.TLS != nil {
+		settings.serverName = pointer.Deref(conf.TLS.ServerName)
+		if conf.TLS.CACert != nil {
+			caCert, err := conf.TLS.CACert.ReadByControlPlane(ctx, v.secretManager, mesh)
+			if err != nil {
+				return nil, errors.Wrap(err, "could not read the CA certificate of Vault")
+			}
+			settings.caCert = string(caCert)
+		}
+	}
+	httpClient, err := v.httpClient(settings)
+	if err != nil {
+		return nil, err
+	}
+	return newClient(conf.Address, pointer.Deref(conf.Namespace), string(token), httpClient), nil
+}
+
+func (v *vaultIdentityProvider) httpClient(settings tlsSettings) (*http.Client, error) {
+	v.Lock()
+	defer v.Unlock()
+	if httpClient, ok := v.httpClients[settings]; ok {
+		return httpClient, nil
+	}
+	httpClient, err := newHTTPClient(settings)
+	if err != nil {
+		return nil, err
+	}
+	v.httpClients[settings] = httpClient
+	return httpClient, nil
+}
+
+func mount(conf *meshidentity_api.Vault) string {
+	return strings.Trim(pointer.DerefOr(conf.PKI.Mount, defaultMount), "/")
+}
@@ -0,0 +1,11 @@
+package vault_test
+
+import (
+	"testing"
+
+	"github.com/kumahq/kuma/v3/pkg/test"
+)
+
+func TestVault(t *testing.T) {
+	test.RunSpecs(t, "Vault Provider Suite")
+}
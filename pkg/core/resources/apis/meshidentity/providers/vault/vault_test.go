@@ -0,0 +1,348 @@
+package vault_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	envoy_auth "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	envoy_resource "github.com/envoyproxy/go-control-plane/pkg/resource/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/v3/api/mesh/v1alpha1"
+	config_core "github.com/kumahq/kuma/v3/pkg/config/core"
+	"github.com/kumahq/kuma/v3/pkg/core/kri"
+	meshidentity_api "github.com/kumahq/kuma/v3/pkg/core/resources/apis/meshidentity/api/v1alpha1"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/apis/meshidentity/providers"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/apis/meshidentity/providers/vault"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/v3/pkg/plugins/resources/memory"
+	"github.com/kumahq/kuma/v3/pkg/plugins/runtime/k8s/metadata"
+	"github.com/kumahq/kuma/v3/pkg/test/resources/builders"
+	xds_builders "github.com/kumahq/kuma/v3/pkg/test/xds/builders"
+	"github.com/kumahq/kuma/v3/pkg/util/pointer"
+)
+
+const (
+	vaultToken = "s.test-token"
+	vaultRole  = "kuma-workloads"
+)
+
+var _ = Describe("Vault Provider", func() {
+	var fake *fakeVault
+	var server *httptest.Server
+	var connections *atomic.Int32
+	var provider providers.IdentityProvider
+
+	BeforeEach(func() {
+		fake = newFakeVault()
+		connections = &atomic.Int32{}
+		server = httptest.NewUnstartedServer(fake)
+		server.Config.ConnState = func(_ net.Conn, state http.ConnState) {
+			if state == http.StateNew {
+				connections.Add(1)
+			}
+		}
+		server.Start()
+		provider = vault.NewVaultIdentityProvider(manager.NewResourceManager(memory.NewStore()), "my-zone", config_core.KubernetesEnvironment)
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	proxy := func() *xds_builders.ProxyBuilder {
+		return xds_builders.Proxy().
+			WithDataplane(builders.Dataplane().
+				WithName("web-01").
+				WithAddress("192.168.0.2").
+				WithLabels(map[string]string{
+					metadata.KumaServiceAccount: "my-sa",
+					mesh_proto.KubeNamespaceTag: "my-ns",
+				}).
+				WithInboundOfTagsAndProtocol("http", mesh_proto.ServiceTag, "web"))
+	}
+
+	It("should sign a workload certificate with the PKI secrets engine", func() {
+		// given
+		meshIdentity := builders.MeshIdentity().WithVault(server.URL, vaultRole, vaultToken).Build()
+
+		// when
+		identity, err := provider.CreateIdentity(context.Background(), meshIdentity, proxy().Build())
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(identity.KRI).To(Equal(kri.From(meshIdentity)))
+		Expect(identity.ExpirationTime).ToNot(BeNil())
+		Expect(identity.CertLifetime()).To(BeNumerically("~", 99*time.Minute, time.Minute))
+
+		chain := readCertificateChain(identity.AdditionalResources.Resources(envoy_resource.SecretType)[kri.From(meshIdentity).String()].Resource.(*envoy_auth.Secret))
+		// the leaf and the intermediate, the root is distributed by MeshTrust
+		Expect(chain).To(HaveLen(2))
+		Expect(chain[0].URIs).To(HaveLen(1))
+		Expect(chain[0].URIs[0].String()).To(Equal("spiffe://default.my-zone.mesh.local/ns/my-ns/sa/my-sa"))
+		Expect(chain[1].Subject.CommonName).To(Equal("intermediate"))
+
+		// and the request was authenticated and sent to the default mount
+		Expect(fake.requests()).To(ContainElement("POST /v1/pki/sign/kuma-workloads"))
+	})
+
+	It("should reuse connections to Vault", func() {
+		// given
+		meshIdentity := builders.MeshIdentity().WithVault(server.URL, vaultRole, vaultToken).Build()
+
+		// when
+		_, err := provider.CreateIdentity(context.Background(), meshIdentity, proxy().Build())
+		Expect(err).ToNot(HaveOccurred())
+		_, err = provider.CreateIdentity(context.Background(), meshIdentity, proxy().Build())
+		Expect(err).ToNot(HaveOccurred())
+		_, err = provider.GetMeshTrustCA(context.Background(), meshIdentity)
+		Expect(err).ToNot(HaveOccurred())
+
+		// then
+		Expect(connections.Load()).To(Equal(int32(1)))
+	})
+
+	It("should use the configured mount and namespace", func() {
+		// given
+		meshIdentity := builders.MeshIdentity().WithVault(server.URL, vaultRole, vaultToken).Build()
+		meshIdentity.Spec.Provider.Vault.PKI.Mount = pointer.To("/pki_int/")
+		meshIdentity.Spec.Provider.Vault.Namespace = pointer.To("payments")
+
+		// when
+		_, err := provider.CreateIdentity(context.Background(), meshIdentity, proxy().Build())
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(fake.requests()).To(ContainElement("POST /v1/pki_int/sign/kuma-workloads"))
+		Expect(fake.namespaces()).To(ConsistOf("payments"))
+	})
+
+	It("should return the CA chain for MeshTrust", func() {
+		// given
+		meshIdentity := builders.MeshIdentity().WithVault(server.URL, vaultRole, vaultToken).Build()
+
+		// when
+		ca, err := provider.GetMeshTrustCA(context.Background(), meshIdentity)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(ca)).To(ContainSubstring(string(fake.rootPEM)))
+	})
+
+	It("should not return the CA when MeshTrust creation is disabled", func() {
+		// given
+		meshIdentity := builders.MeshIdentity().WithVault(server.URL, vaultRole, vaultToken).Build()
+		meshIdentity.Spec.Provider.Vault.MeshTrustCreation = pointer.To(meshidentity_api.MeshTrustCreationDisabled)
+
+		// when
+		ca, err := provider.GetMeshTrustCA(context.Background(), meshIdentity)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ca).To(BeNil())
+	})
+
+	It("should report the issuer as not ready when the token is rejected", func() {
+		// given
+		meshIdentity := builders.MeshIdentity().WithVault(server.URL, vaultRole, "s.wrong-token").Build()
+
+		// when
+		err := provider.(providers.IssuerChecker).CheckIssuer(context.Background(), meshIdentity)
+
+		// then
+		Expect(err).To(MatchError(ContainSubstring("Vault responded with status 403: permission denied")))
+	})
+
+	It("should report the issuer as ready", func() {
+		// given
+		meshIdentity := builders.MeshIdentity().WithVault(server.URL, vaultRole, vaultToken).Build()
+
+		// when
+		err := provider.(providers.IssuerChecker).CheckIssuer(context.Background(), meshIdentity)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should fail when the role does not allow the SPIFFE ID", func() {
+		// given
+		meshIdentity := builders.MeshIdentity().WithVault(server.URL, "other-role", vaultToken).Build()
+
+		// when
+		_, err := provider.CreateIdentity(context.Background(), meshIdentity, proxy().Build())
+
+		// then
+		Expect(err).To(MatchError(ContainSubstring(`unknown role: other-role`)))
+	})
+})
+
+// fakeVault implements the subset of the PKI secrets engine API used by the
+// provider. It signs certificates with an intermediate CA issued by a root CA.
+type fakeVault struct {
+	sync.Mutex
+	rootPEM         []byte
+	intermediatePEM []byte
+	intermediate    *x509.Certificate
+	intermediateKey *ecdsa.PrivateKey
+	seenRequests    []string
+	seenNamespaces  []string
+}
+
+func newFakeVault() *fakeVault {
+	GinkgoHelper()
+	rootKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).ToNot(HaveOccurred())
+	rootTemplate := caTemplate("root")
+	rootDER, err := x509.CreateCertificate(rand.Reader, rootTemplate, rootTemplate, rootKey.Public(), rootKey)
+	Expect(err).ToNot(HaveOccurred())
+	root, err := x509.ParseCertificate(rootDER)
+	Expect(err).ToNot(HaveOccurred())
+
+	intermediateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).ToNot(HaveOccurred())
+	intermediateDER, err := x509.CreateCertificate(rand.Reader, caTemplate("intermediate"), root, intermediateKey.Public(), rootKey)
+	Expect(err).ToNot(HaveOccurred())
+	intermediate, err := x509.ParseCertificate(intermediateDER)
+	Expect(err).ToNot(HaveOccurred())
+
+	return &fakeVault{
+		rootPEM:         pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: rootDER}),
+		intermediatePEM: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: intermediateDER}),
+		intermediate:    intermediate,
+		intermediateKey: intermediateKey,
+	}
+}
+
+func caTemplate(name string) *x509.Certificate {
+	return &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+}
+
+func (f *fakeVault) requests() []string {
+	f.Lock()
+	defer f.Unlock()
+	return append([]string{}, f.seenRequests...)
+}
+
+func (f *fakeVault) namespaces() []string {
+	f.Lock()
+	defer f.Unlock()
+	return append([]string{}, f.seenNamespaces...)
+}
+
+func (f *fakeVault) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.Lock()
+	f.seenRequests = append(f.seenRequests, r.Method+" "+r.URL.Path)
+	if ns := r.Header.Get("X-Vault-Namespace"); ns != "" {
+		f.seenNamespaces = append(f.seenNamespaces, ns)
+	}
+	f.Unlock()
+
+	if r.Header.Get("X-Vault-Token") != vaultToken {
+		writeJSON(w, http.StatusForbidden, map[string]any{"errors": []string{"permission denied"}})
+		return
+	}
+	switch {
+	case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/cert/ca_chain"):
+		writeJSON(w, http.StatusOK, map[string]any{"data": map[string]any{
+			"certificate": string(f.intermediatePEM) + string(f.rootPEM),
+		}})
+	case r.Method == http.MethodPost && strings.HasSuffix(r.URL.Path, "/sign/"+vaultRole):
+		f.sign(w, r)
+	case r.Method == http.MethodPost && strings.Contains(r.URL.Path, "/sign/"):
+		role := r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:]
+		writeJSON(w, http.StatusBadRequest, map[string]any{"errors": []string{"unknown role: " + role}})
+	default:
+		writeJSON(w, http.StatusNotFound, map[string]any{"errors": []string{}})
+	}
+}
+
+func (f *fakeVault) sign(w http.ResponseWriter, r *http.Request) {
+	req := struct {
+		CSR     string `json:"csr"`
+		URISans string `json:"uri_sans"`
+		TTL     string `json:"ttl"`
+	}{}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]any{"errors": []string{err.Error()}})
+		return
+	}
+	block, _ := pem.Decode([]byte(req.CSR))
+	csr, err := x509.ParseCertificateRequest(block.Bytes)
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]any{"errors": []string{err.Error()}})
+		return
+	}
+	ttl, err := time.ParseDuration(req.TTL)
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]any{"errors": []string{err.Error()}})
+		return
+	}
+	if len(csr.URIs) != 1 || csr.URIs[0].String() != req.URISans {
+		writeJSON(w, http.StatusBadRequest, map[string]any{"errors": []string{"URI SANs do not match the CSR"}})
+		return
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		URIs:         csr.URIs,
+		NotBefore:    time.Now().Add(-30 * time.Second),
+		NotAfter:     time.Now().Add(ttl),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, f.intermediate, csr.PublicKey, f.intermediateKey)
+	if err != nil {
+		writeJSON(w, http.StatusInternalServerError, map[string]any{"errors": []string{err.Error()}})
+		return
+	}
+	writeJSON(w, http.StatusOK, map[string]any{"data": map[string]any{
+		"certificate": string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		"issuing_ca":  string(f.intermediatePEM),
+		"ca_chain":    []string{string(f.intermediatePEM), string(f.rootPEM)},
+	}})
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func readCertificateChain(secret *envoy_auth.Secret) []*x509.Certificate {
+	GinkgoHelper()
+	var chain []*x509.Certificate
+	rest := secret.GetTlsCertificate().GetCertificateChain().GetInlineBytes()
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		Expect(err).ToNot(HaveOccurred())
+		chain = append(chain, cert)
+	}
+	return chain
+}
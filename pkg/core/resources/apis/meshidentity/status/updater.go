@@ -167,6 +167,23 @@ func (i *IdentityProviderReconciler) initialize(ctx context.Context, mid *meshid
 			Message: "Provider successfully initialized",
 		})
 	}
+	if checker, ok := provider.(providers.IssuerChecker); ok {
+		if err := checker.CheckIssuer(ctx, mid); err != nil {
+			conditions = append(conditions, common_api.Condition{
+				Type:    meshidentity_api.IssuerReadyConditionType,
+				Status:  kube_meta.ConditionFalse,
+				Reason:  "IssuerNotReady",
+				Message: err.Error(),
+			})
+			return conditions
+		}
+		conditions = append(conditions, common_api.Condition{
+			Type:    meshidentity_api.IssuerReadyConditionType,
+			Status:  kube_meta.ConditionTrue,
+			Reason:  "IssuerReady",
+			Message: "Issuer is ready to sign certificates",
+		})
+	}
 	ca, err := provider.GetMeshTrustCA(ctx, mid)
 	if err != nil {
 		conditions = append(conditions, common_api.Condition{
@@ -227,7 +244,7 @@ func (i *IdentityProviderReconciler) createOrUpdateMeshTrust(ctx context.Context
 		// Check if the CA PEM is already present in the MeshTrust resource
 		caBundleExists := false
 		for _, bundle := range meshTrust.Spec.CABundles {
-			if pemEqual(bundle.PEM.Value, caPEM) {
+			if bundle.PEM != nil && pemEqual(bundle.PEM.Value, caPEM) {
 				caBundleExists = true
 				break
 			}
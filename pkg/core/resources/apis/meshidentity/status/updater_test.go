@@ -2,6 +2,8 @@ package status
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -15,6 +17,7 @@ import (
 	meshidentity_api "github.com/kumahq/kuma/v3/pkg/core/resources/apis/meshidentity/api/v1alpha1"
 	"github.com/kumahq/kuma/v3/pkg/core/resources/apis/meshidentity/providers"
 	"github.com/kumahq/kuma/v3/pkg/core/resources/apis/meshidentity/providers/bundled"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/apis/meshidentity/providers/vault"
 	meshtrust_api "github.com/kumahq/kuma/v3/pkg/core/resources/apis/meshtrust/api/v1alpha1"
 	"github.com/kumahq/kuma/v3/pkg/core/resources/apis/system"
 	"github.com/kumahq/kuma/v3/pkg/core/resources/manager"
@@ -31,6 +34,7 @@ var _ = Describe("Updater", func() {
 	var stopCh chan struct{}
 	var resManager manager.ResourceManager
 	var metrics core_metrics.Metrics
+	var sealedVault *httptest.Server
 
 	BeforeEach(func() {
 		m, err := core_metrics.NewMetrics("")
@@ -41,8 +45,14 @@ var _ = Describe("Updater", func() {
 		bundledProvider, err := bundled.NewBundledIdentityProvider(resManager, resManager, metrics, "zone", config_core.KubernetesEnvironment)
 		Expect(err).ToNot(HaveOccurred())
 
+		sealedVault = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			_, _ = w.Write([]byte(`{"errors":["Vault is sealed"]}`))
+		}))
+
 		updater, err := New(logr.Discard(), 50*time.Millisecond, resManager, resManager, providers.IdentityProviders{
 			"Bundled": bundledProvider,
+			"Vault":   vault.NewVaultIdentityProvider(resManager, "zone", config_core.KubernetesEnvironment),
 		}, "east")
 		Expect(err).ToNot(HaveOccurred())
 		stopCh = make(chan struct{})
@@ -54,6 +64,7 @@ var _ = Describe("Updater", func() {
 
 	AfterEach(func() {
 		close(stopCh)
+		sealedVault.Close()
 	})
 
 	It("should initialize MeshIdentity and create MeshTrust when meshServices.mode is not Exclusive", func() {
@@ -192,6 +203,47 @@ var _ = Describe("Updater", func() {
 		Expect(resManager.Get(context.Background(), privateKey, store.GetByKey(bundled.PrivateKeyName(identity.Meta.GetName()), "default"))).To(HaveOccurred())
 	})
 
+	It("should report issuer that is not ready", func() {
+		// when
+		Expect(
+			samples.MeshDefaultBuilder().
+				Create(resManager),
+		).To(Succeed())
+		identity := builders.MeshIdentity().WithVault(sealedVault.URL, "kuma", "s.token").Build()
+		Expect(resManager.Create(context.Background(), identity, store.CreateBy(model.MetaToResourceKey(identity.GetMeta())))).To(Succeed())
+
+		// then
+		Eventually(func(g Gomega) {
+			mid := meshidentity_api.NewMeshIdentityResource()
+			err := resManager.Get(context.Background(), mid, store.GetBy(model.MetaToResourceKey(identity.GetMeta())))
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(mid.Status.Conditions).To(ConsistOf(
+				common_api.Condition{
+					Type:    meshidentity_api.ProviderConditionType,
+					Status:  kube_meta.ConditionTrue,
+					Reason:  "ProviderInitialized",
+					Message: "Provider successfully initialized",
+				},
+				common_api.Condition{
+					Type:    meshidentity_api.IssuerReadyConditionType,
+					Status:  kube_meta.ConditionFalse,
+					Reason:  "IssuerNotReady",
+					Message: "Vault responded with status 503: Vault is sealed",
+				},
+				common_api.Condition{
+					Type:    meshidentity_api.ReadyConditionType,
+					Status:  kube_meta.ConditionFalse,
+					Reason:  "Failure",
+					Message: "One of initialization steps failed",
+				},
+			))
+		}, "10s", "100ms").Should(Succeed())
+
+		// MeshTrust shouldn't be created
+		meshTrust := meshtrust_api.NewMeshTrustResource()
+		Expect(resManager.Get(context.Background(), meshTrust, store.GetByKey(identity.Meta.GetName(), "default"))).To(HaveOccurred())
+	})
+
 	It("should not initialize provider if secrets already exists", func() {
 		// when
 		Expect(
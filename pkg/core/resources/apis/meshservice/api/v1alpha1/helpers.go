@@ -144,18 +144,19 @@ func (t *MeshServiceResource) AsOutbounds() xds_types.Outbounds {
 	return outbounds
 }
 
-func (t *MeshServiceResource) Domains() *xds_types.VIPDomains {
-	if len(t.Status.VIPs) > 0 {
-		var domains []string
-		for _, addr := range t.Status.Addresses {
-			domains = append(domains, addr.Hostname)
-		}
-		return &xds_types.VIPDomains{
-			Address: t.Status.VIPs[0].IP,
+func (t *MeshServiceResource) Domains() []xds_types.VIPDomains {
+	var domains []string
+	for _, addr := range t.Status.Addresses {
+		domains = append(domains, addr.Hostname)
+	}
+	var result []xds_types.VIPDomains
+	for _, vip := range t.Status.VIPs {
+		result = append(result, xds_types.VIPDomains{
+			Address: vip.IP,
 			Domains: domains,
-		}
+		})
 	}
-	return nil
+	return result
 }
 
 func (t *MeshServiceResource) GetPorts() []core.Port {
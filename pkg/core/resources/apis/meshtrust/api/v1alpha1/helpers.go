@@ -1,5 +1,7 @@
 package v1alpha1
 
+import "slices"
+
 func GetAllTrustDomains(meshTrusts MeshTrustResourceList) map[string]struct{} {
 	trustDomains := map[string]struct{}{}
 	for _, meshTrust := range meshTrusts.Items {
@@ -7,3 +9,8 @@ func GetAllTrustDomains(meshTrusts MeshTrustResourceList) map[string]struct{} {
 	}
 	return trustDomains
 }
+
+// IsExportedTo returns true if MeshTrusts of the given mesh can import the CA bundles of this MeshTrust.
+func (m *MeshTrust) IsExportedTo(mesh string) bool {
+	return m.Export != nil && slices.Contains(m.Export.Meshes, mesh)
+}
@@ -18,6 +18,18 @@ type MeshTrust struct {
 	// +required
 	// +kubebuilder:validation:MinItems=1
 	CABundles []CABundle `json:"caBundles"`
+	// Export allows MeshTrusts of other meshes to import the CA bundles of this
+	// resource with MeshTrustRef. If not specified, the CA bundles can't be
+	// imported by any other mesh.
+	Export *Export `json:"export,omitempty"`
+}
+
+type Export struct {
+	// Meshes is a list of meshes whose MeshTrusts can import the CA bundles of
+	// this resource.
+	// +required
+	// +kubebuilder:validation:MinItems=1
+	Meshes []string `json:"meshes"`
 }
 
 type MeshTrustStatus struct {
@@ -30,11 +42,12 @@ type Origin struct {
 	KRI *string `json:"kri,omitempty"`
 }
 
-// +kubebuilder:validation:Enum=Pem
+// +kubebuilder:validation:Enum=Pem;MeshTrustRef
 type CABundleType string
 
 const (
-	PemCABundleType CABundleType = "Pem"
+	PemCABundleType          CABundleType = "Pem"
+	MeshTrustRefCABundleType CABundleType = "MeshTrustRef"
 )
 
 type CABundle struct {
@@ -43,6 +56,22 @@ type CABundle struct {
 	Type CABundleType `json:"type"`
 	// Pem contains the PEM-encoded CA bundle if the Type is set to a PEM-based format.
 	PEM *PEM `json:"pem,omitempty"`
+	// MeshTrustRef references a MeshTrust of another mesh whose CA bundles are
+	// imported if the Type is set to MeshTrustRef. This allows accepting
+	// identities issued in a peer mesh. The referenced MeshTrust has to export
+	// its CA bundles to the mesh of this resource and its trust domain has to
+	// match the TrustDomain of this resource. Only its PEM-encoded CA bundles
+	// are imported (references are not followed).
+	MeshTrustRef *MeshTrustRef `json:"meshTrustRef,omitempty"`
+}
+
+type MeshTrustRef struct {
+	// Mesh is the name of the mesh of the referenced MeshTrust.
+	// +required
+	Mesh string `json:"mesh"`
+	// Name is the name of the referenced MeshTrust.
+	// +required
+	Name string `json:"name"`
 }
 
 type PEM struct {
@@ -9,8 +9,9 @@ import (
 func (r *MeshTrustResource) validate() error {
 	var verr validators.ValidationError
 	path := validators.RootedAt("spec")
-	verr.Add(validateCABundles(path.Field("caBundles"), r.Spec.CABundles))
+	verr.Add(validateCABundles(path.Field("caBundles"), r.Spec.CABundles, r.GetMeta().GetMesh()))
 	verr.Add(validateTrustDomain(path, r.Spec.TrustDomain))
+	verr.Add(validateExport(path.Field("export"), r.Spec.Export, r.GetMeta().GetMesh()))
 	return verr.OrNil()
 }
 
@@ -23,7 +24,7 @@ func validateTrustDomain(path validators.PathBuilder, td string) validators.Vali
 	return verr
 }
 
-func validateCABundles(path validators.PathBuilder, bundles []CABundle) validators.ValidationError {
+func validateCABundles(path validators.PathBuilder, bundles []CABundle, mesh string) validators.ValidationError {
 	var verr validators.ValidationError
 	if len(bundles) == 0 {
 		verr.AddViolationAt(path, validators.MustNotBeEmpty)
@@ -32,6 +33,9 @@ func validateCABundles(path validators.PathBuilder, bundles []CABundle) validato
 	for i, bundle := range bundles {
 		switch bundle.Type {
 		case PemCABundleType:
+			if bundle.MeshTrustRef != nil {
+				verr.AddViolationAt(path.Index(i).Field("meshTrustRef"), validators.MustNotBeDefined)
+			}
 			path := path.Index(i).Field("pem")
 			if bundle.PEM == nil {
 				verr.AddViolationAt(path, validators.MustBeDefined)
@@ -40,8 +44,48 @@ func validateCABundles(path validators.PathBuilder, bundles []CABundle) validato
 			if !isPEMCertificate(bundle.PEM.Value) {
 				verr.AddViolationAt(path.Field("value"), "provided certificate has incorrect format")
 			}
+		case MeshTrustRefCABundleType:
+			if bundle.PEM != nil {
+				verr.AddViolationAt(path.Index(i).Field("pem"), validators.MustNotBeDefined)
+			}
+			verr.Add(validateMeshTrustRef(path.Index(i).Field("meshTrustRef"), bundle.MeshTrustRef, mesh))
 		default:
-			verr.AddViolationAt(path.Field("type"), validators.MustBeOneOf(string(bundle.Type), string(PemCABundleType)))
+			verr.AddViolationAt(path.Index(i).Field("type"), validators.MustBeOneOf(string(bundle.Type), string(PemCABundleType), string(MeshTrustRefCABundleType)))
+		}
+	}
+	return verr
+}
+
+func validateMeshTrustRef(path validators.PathBuilder, ref *MeshTrustRef, mesh string) validators.ValidationError {
+	var verr validators.ValidationError
+	if ref == nil {
+		verr.AddViolationAt(path, validators.MustBeDefined)
+		return verr
+	}
+	if ref.Mesh == "" {
+		verr.AddViolationAt(path.Field("mesh"), validators.MustNotBeEmpty)
+	} else if ref.Mesh == mesh {
+		verr.AddViolationAt(path.Field("mesh"), "must reference a different mesh")
+	}
+	if ref.Name == "" {
+		verr.AddViolationAt(path.Field("name"), validators.MustNotBeEmpty)
+	}
+	return verr
+}
+
+func validateExport(path validators.PathBuilder, export *Export, mesh string) validators.ValidationError {
+	var verr validators.ValidationError
+	if export == nil {
+		return verr
+	}
+	if len(export.Meshes) == 0 {
+		verr.AddViolationAt(path.Field("meshes"), validators.MustNotBeEmpty)
+	}
+	for i, exportedTo := range export.Meshes {
+		if exportedTo == "" {
+			verr.AddViolationAt(path.Field("meshes").Index(i), validators.MustNotBeEmpty)
+		} else if exportedTo == mesh {
+			verr.AddViolationAt(path.Field("meshes").Index(i), "must reference a different mesh")
 		}
 	}
 	return verr
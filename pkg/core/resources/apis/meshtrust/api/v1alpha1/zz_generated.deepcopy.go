@@ -14,6 +14,11 @@ func (in *CABundle) DeepCopyInto(out *CABundle) {
 		*out = new(PEM)
 		**out = **in
 	}
+	if in.MeshTrustRef != nil {
+		in, out := &in.MeshTrustRef, &out.MeshTrustRef
+		*out = new(MeshTrustRef)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CABundle.
@@ -26,6 +31,26 @@ func (in *CABundle) DeepCopy() *CABundle {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Export) DeepCopyInto(out *Export) {
+	*out = *in
+	if in.Meshes != nil {
+		in, out := &in.Meshes, &out.Meshes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Export.
+func (in *Export) DeepCopy() *Export {
+	if in == nil {
+		return nil
+	}
+	out := new(Export)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MeshTrust) DeepCopyInto(out *MeshTrust) {
 	*out = *in
@@ -36,6 +61,11 @@ func (in *MeshTrust) DeepCopyInto(out *MeshTrust) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Export != nil {
+		in, out := &in.Export, &out.Export
+		*out = new(Export)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshTrust.
@@ -48,6 +78,21 @@ func (in *MeshTrust) DeepCopy() *MeshTrust {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MeshTrustRef) DeepCopyInto(out *MeshTrustRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshTrustRef.
+func (in *MeshTrustRef) DeepCopy() *MeshTrustRef {
+	if in == nil {
+		return nil
+	}
+	out := new(MeshTrustRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MeshTrustStatus) DeepCopyInto(out *MeshTrustStatus) {
 	*out = *in
@@ -2,13 +2,19 @@ package v1alpha1
 
 import (
 	"bytes"
+	"maps"
+	"slices"
 	"sort"
 
 	envoy_auth "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	"github.com/spiffe/go-spiffe/v2/spiffeid"
 	"google.golang.org/protobuf/types/known/anypb"
 
+	"github.com/kumahq/kuma/v3/pkg/core"
 	core_plugins "github.com/kumahq/kuma/v3/pkg/core/plugins"
+	meshtrust_api "github.com/kumahq/kuma/v3/pkg/core/resources/apis/meshtrust/api/v1alpha1"
 	"github.com/kumahq/kuma/v3/pkg/core/resources/apis/meshtrust/metadata"
+	core_model "github.com/kumahq/kuma/v3/pkg/core/resources/model"
 	core_xds "github.com/kumahq/kuma/v3/pkg/core/xds"
 	bldrs_auth "github.com/kumahq/kuma/v3/pkg/envoy/builders/auth"
 	bldrs_core "github.com/kumahq/kuma/v3/pkg/envoy/builders/core"
@@ -17,6 +23,8 @@ import (
 	"github.com/kumahq/kuma/v3/pkg/xds/generator/system_names"
 )
 
+var log = core.Log.WithName("meshtrust").WithName("generator")
+
 var _ core_plugins.CoreResourcePlugin = &plugin{}
 
 type plugin struct{}
@@ -31,13 +39,16 @@ func (p *plugin) Generate(rs *core_xds.ResourceSet, xdsCtx xds_context.Context,
 	// We should investigate whether it's possible to support both mechanisms simultaneously.
 	// TODO: https://github.com/kumahq/kuma/issues/14685
 	externallyManaged := pointer.Deref(proxy.WorkloadIdentity).ManagementMode == core_xds.ExternalManagementMode
-	hasTrustDomains := len(xdsCtx.Mesh.CAsByTrustDomain) > 0
+	if externallyManaged {
+		return nil
+	}
 
-	if externallyManaged || !hasTrustDomains {
+	casByTrustDomain := trustedCAs(xdsCtx.Mesh)
+	if len(casByTrustDomain) == 0 {
 		return nil
 	}
 
-	config, err := validationCtx(xdsCtx)
+	config, err := validationCtx(casByTrustDomain)
 	if err != nil {
 		return err
 	}
@@ -50,9 +61,61 @@ func (p *plugin) Generate(rs *core_xds.ResourceSet, xdsCtx xds_context.Context,
 	return nil
 }
 
-func validationCtx(xdsCtx xds_context.Context) (*envoy_auth.Secret, error) {
+// trustedCAs returns the CAs of the mesh grouped by trust domain together with the CA bundles
+// imported from MeshTrusts of other meshes. Trust domains without any CA are skipped.
+func trustedCAs(meshCtx xds_context.MeshContext) map[string][]xds_context.PEMBytes {
+	casByTrustDomain := map[string][]xds_context.PEMBytes{}
+	maps.Copy(casByTrustDomain, meshCtx.CAsByTrustDomain)
+	for _, trust := range meshCtx.Resources.MeshTrusts().Items {
+		for _, ca := range trust.Spec.CABundles {
+			if ca.Type != meshtrust_api.MeshTrustRefCABundleType || ca.MeshTrustRef == nil {
+				continue
+			}
+			trustDomain := trust.Spec.TrustDomain
+			imported := importedCAs(meshCtx, trust, *ca.MeshTrustRef)
+			// slices.Concat allocates, so CAs shared by all proxies of the mesh are not modified
+			casByTrustDomain[trustDomain] = slices.Concat(casByTrustDomain[trustDomain], imported)
+		}
+	}
+	maps.DeleteFunc(casByTrustDomain, func(_ string, cas []xds_context.PEMBytes) bool {
+		return len(cas) == 0
+	})
+	return casByTrustDomain
+}
+
+// importedCAs returns PEM CA bundles of the MeshTrust referenced by the trust. They are imported only
+// if the referenced MeshTrust is exported to the mesh of the trust and has the same trust domain.
+func importedCAs(meshCtx xds_context.MeshContext, trust *meshtrust_api.MeshTrustResource, ref meshtrust_api.MeshTrustRef) []xds_context.PEMBytes {
+	l := log.WithValues("mesh", trust.GetMeta().GetMesh(), "name", trust.GetMeta().GetName(), "referencedMesh", ref.Mesh, "referencedName", ref.Name)
+	referenced, ok := meshCtx.MeshTrustsByKey[core_model.ResourceKey{Mesh: ref.Mesh, Name: ref.Name}]
+	if !ok {
+		l.V(1).Info("referenced MeshTrust does not exist, ignoring it")
+		return nil
+	}
+	if !referenced.Spec.IsExportedTo(trust.GetMeta().GetMesh()) {
+		l.V(1).Info("referenced MeshTrust is not exported to the mesh, ignoring it")
+		return nil
+	}
+	if _, err := spiffeid.TrustDomainFromString(referenced.Spec.TrustDomain); err != nil {
+		l.V(1).Info("referenced MeshTrust has an invalid trust domain, ignoring it", "referencedTrustDomain", referenced.Spec.TrustDomain, "err", err)
+		return nil
+	}
+	if referenced.Spec.TrustDomain != trust.Spec.TrustDomain {
+		l.V(1).Info("referenced MeshTrust has a different trust domain, ignoring it", "trustDomain", trust.Spec.TrustDomain, "referencedTrustDomain", referenced.Spec.TrustDomain)
+		return nil
+	}
+	var cas []xds_context.PEMBytes
+	for _, ca := range referenced.Spec.CABundles {
+		if ca.Type == meshtrust_api.PemCABundleType && ca.PEM != nil {
+			cas = append(cas, xds_context.PEMBytes(ca.PEM.Value))
+		}
+	}
+	return cas
+}
+
+func validationCtx(casByTrustDomain map[string][]xds_context.PEMBytes) (*envoy_auth.Secret, error) {
 	validatorsPerTrustDomain := []*envoy_auth.SPIFFECertValidatorConfig_TrustDomain{}
-	for domain, trusts := range xdsCtx.Mesh.CAsByTrustDomain {
+	for domain, trusts := range casByTrustDomain {
 		// concatenate multiple CAs
 		allCAs := [][]byte{}
 		for _, ca := range trusts {
@@ -10,8 +10,10 @@ import (
 	"github.com/kumahq/kuma/v3/pkg/core/kri"
 	meshtrust_api "github.com/kumahq/kuma/v3/pkg/core/resources/apis/meshtrust/api/v1alpha1"
 	generator "github.com/kumahq/kuma/v3/pkg/core/resources/apis/meshtrust/generator/v1alpha1"
+	core_model "github.com/kumahq/kuma/v3/pkg/core/resources/model"
 	core_xds "github.com/kumahq/kuma/v3/pkg/core/xds"
 	"github.com/kumahq/kuma/v3/pkg/test/matchers"
+	"github.com/kumahq/kuma/v3/pkg/test/resources/builders"
 	"github.com/kumahq/kuma/v3/pkg/test/resources/samples"
 	xds_builders "github.com/kumahq/kuma/v3/pkg/test/xds/builders"
 	util_yaml "github.com/kumahq/kuma/v3/pkg/util/yaml"
@@ -24,6 +26,8 @@ var _ = Describe("MeshTrust Secret Generator", func() {
 		caseName         string
 		workloadIdentity *core_xds.WorkloadIdentity
 		trustDomains     map[string][]xds_context.PEMBytes
+		meshTrusts       []*meshtrust_api.MeshTrustResource
+		otherMeshTrusts  []*meshtrust_api.MeshTrustResource
 	}
 	DescribeTable("should generate proper Envoy config",
 		func(given testCase) {
@@ -32,6 +36,12 @@ var _ = Describe("MeshTrust Secret Generator", func() {
 				WithMeshBuilder(samples.MeshDefaultBuilder()).
 				Build()
 			context.Mesh.CAsByTrustDomain = given.trustDomains
+			context.Mesh.Resources = xds_context.NewResources()
+			context.Mesh.Resources.MeshLocalResources[meshtrust_api.MeshTrustType] = &meshtrust_api.MeshTrustResourceList{Items: given.meshTrusts}
+			context.Mesh.MeshTrustsByKey = map[core_model.ResourceKey]*meshtrust_api.MeshTrustResource{}
+			for _, trust := range append(given.meshTrusts, given.otherMeshTrusts...) {
+				context.Mesh.MeshTrustsByKey[core_model.MetaToResourceKey(trust.GetMeta())] = trust
+			}
 			resourceSet := core_xds.NewResourceSet()
 			proxy := xds_builders.Proxy().
 				WithWorkloadIdentity(given.workloadIdentity).
@@ -84,5 +94,88 @@ var _ = Describe("MeshTrust Secret Generator", func() {
 				"domain-2": {xds_context.PEMBytes("789")},
 			},
 		}),
+		Entry("with CAs imported from a MeshTrust of another mesh", testCase{
+			caseName: "secrets-imported-from-other-mesh",
+			workloadIdentity: &core_xds.WorkloadIdentity{
+				KRI:            kri.Identifier{ResourceType: meshtrust_api.MeshTrustType, Mesh: "default", Name: "identity"},
+				ManagementMode: core_xds.KumaManagementMode,
+			},
+			trustDomains: map[string][]xds_context.PEMBytes{
+				"payments.mesh.local": {xds_context.PEMBytes("local-ca")},
+			},
+			meshTrusts: []*meshtrust_api.MeshTrustResource{
+				builders.MeshTrust().
+					WithName("payments").
+					WithTrustDomain("payments.mesh.local").
+					WithCA("local-ca").
+					WithMeshTrustRef("payments", "payments-trust").
+					Build(),
+			},
+			otherMeshTrusts: []*meshtrust_api.MeshTrustResource{
+				builders.MeshTrust().
+					WithMesh("payments").
+					WithName("payments-trust").
+					WithTrustDomain("payments.mesh.local").
+					WithCA("peer-ca").
+					WithExport("default").
+					Build(),
+			},
+		}),
+		Entry("without CAs of MeshTrusts of other meshes that can't be imported", testCase{
+			caseName: "secrets-not-imported-from-other-mesh",
+			workloadIdentity: &core_xds.WorkloadIdentity{
+				KRI:            kri.Identifier{ResourceType: meshtrust_api.MeshTrustType, Mesh: "default", Name: "identity"},
+				ManagementMode: core_xds.KumaManagementMode,
+			},
+			trustDomains: map[string][]xds_context.PEMBytes{
+				"payments.mesh.local": {xds_context.PEMBytes("local-ca")},
+				"Invalid Domain":      nil,
+			},
+			meshTrusts: []*meshtrust_api.MeshTrustResource{
+				builders.MeshTrust().
+					WithName("payments").
+					WithTrustDomain("payments.mesh.local").
+					WithCA("local-ca").
+					WithMeshTrustRef("payments", "not-exported").
+					WithMeshTrustRef("payments", "exported-to-other-mesh").
+					WithMeshTrustRef("payments", "other-trust-domain").
+					WithMeshTrustRef("payments", "missing").
+					Build(),
+				builders.MeshTrust().
+					WithName("invalid").
+					WithTrustDomain("Invalid Domain").
+					WithMeshTrustRef("payments", "invalid-trust-domain").
+					Build(),
+			},
+			otherMeshTrusts: []*meshtrust_api.MeshTrustResource{
+				builders.MeshTrust().
+					WithMesh("payments").
+					WithName("not-exported").
+					WithTrustDomain("payments.mesh.local").
+					WithCA("not-exported-ca").
+					Build(),
+				builders.MeshTrust().
+					WithMesh("payments").
+					WithName("exported-to-other-mesh").
+					WithTrustDomain("payments.mesh.local").
+					WithCA("exported-to-other-mesh-ca").
+					WithExport("billing").
+					Build(),
+				builders.MeshTrust().
+					WithMesh("payments").
+					WithName("other-trust-domain").
+					WithTrustDomain("other.mesh.local").
+					WithCA("other-trust-domain-ca").
+					WithExport("default").
+					Build(),
+				builders.MeshTrust().
+					WithMesh("payments").
+					WithName("invalid-trust-domain").
+					WithTrustDomain("Invalid Domain").
+					WithCA("invalid-trust-domain-ca").
+					WithExport("default").
+					Build(),
+			},
+		}),
 	)
 })
@@ -23,6 +23,9 @@ const (
 	DataplaneTokenSigningKeyPrefix = "dataplane-token-signing-key-"
 	// DataplaneTokenRevolationsPrefix is the prefix for the secret holding the dataplane token revocations
 	DataplaneTokenRevocationsPrefix = "dataplane-token-revocations-"
+
+	// TokenRevocationDetailsPrefix is the prefix for the secret holding details of the token revocations
+	TokenRevocationDetailsPrefix = "token-revocation-details-"
 )
 
 func DataplaneTokenSigningKey(mesh string) string {
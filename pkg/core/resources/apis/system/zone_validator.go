@@ -1,7 +1,10 @@
 package system
 
 import (
+	"fmt"
+
 	core_model "github.com/kumahq/kuma/v3/pkg/core/resources/model"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/registry"
 	"github.com/kumahq/kuma/v3/pkg/core/validators"
 )
 
@@ -10,5 +13,27 @@ func (t *ZoneResource) Validate() error {
 	if meta := t.GetMeta(); meta != nil {
 		verr.Add(validators.ValidateRFC1035Name(validators.RootedAt("name"), core_model.GetDisplayName(meta)))
 	}
+	verr.Add(t.validateSync(validators.RootedAt("sync")))
 	return verr.OrNil()
 }
+
+func (t *ZoneResource) validateSync(path validators.PathBuilder) validators.ValidationError {
+	var verr validators.ValidationError
+	for i, mesh := range t.Spec.GetSync().GetMeshes() {
+		if mesh == "" {
+			verr.AddViolationAt(path.Field("meshes").Index(i), validators.MustNotBeEmpty)
+		}
+	}
+	for i, typ := range t.Spec.GetSync().GetResourceTypes() {
+		desc, err := registry.Global().DescriptorFor(core_model.ResourceType(typ))
+		switch {
+		case err != nil:
+			verr.AddViolationAt(path.Field("resourceTypes").Index(i), fmt.Sprintf("unknown resource type %q", typ))
+		case desc.Scope != core_model.ScopeMesh:
+			verr.AddViolationAt(path.Field("resourceTypes").Index(i), "must be a mesh-scoped resource type")
+		case !core_model.SentFromGlobalToZone().Apply(desc):
+			verr.AddViolationAt(path.Field("resourceTypes").Index(i), "must be a resource type synced from global to zones")
+		}
+	}
+	return verr
+}
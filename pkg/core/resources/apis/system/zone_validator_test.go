@@ -6,7 +6,10 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	system_proto "github.com/kumahq/kuma/v3/api/system/v1alpha1"
+	_ "github.com/kumahq/kuma/v3/pkg/core/resources/apis/mesh"
 	"github.com/kumahq/kuma/v3/pkg/core/resources/apis/system"
+	_ "github.com/kumahq/kuma/v3/pkg/plugins/policies/meshtimeout"
 	test_model "github.com/kumahq/kuma/v3/pkg/test/resources/model"
 )
 
@@ -36,4 +39,40 @@ var _ = Describe("Zone", func() {
 		Entry("name of 63 characters", strings.Repeat("e", 63), ""),
 		Entry("name of 64 characters", strings.Repeat("e", 64), "name: must be no more than 63 characters"),
 	)
+
+	DescribeTable("should validate sync",
+		func(sync *system_proto.ZoneSync, expectedViolation string) {
+			// given
+			zone := system.NewZoneResource()
+			zone.SetMeta(&test_model.ResourceMeta{Name: "east-1"})
+			zone.Spec.Sync = sync
+
+			// when
+			err := zone.Validate()
+
+			// then
+			if expectedViolation == "" {
+				Expect(err).ToNot(HaveOccurred())
+			} else {
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring(expectedViolation))
+			}
+		},
+		Entry("meshes and resource types", &system_proto.ZoneSync{
+			Meshes:        []string{"default", "payments"},
+			ResourceTypes: []string{"MeshTimeout"},
+		}, ""),
+		Entry("empty mesh", &system_proto.ZoneSync{
+			Meshes: []string{""},
+		}, "sync.meshes[0]: must not be empty"),
+		Entry("unknown resource type", &system_proto.ZoneSync{
+			ResourceTypes: []string{"MeshTimeout", "Unknown"},
+		}, `sync.resourceTypes[1]: unknown resource type "Unknown"`),
+		Entry("resource type that is not scoped to a mesh", &system_proto.ZoneSync{
+			ResourceTypes: []string{"Zone"},
+		}, "sync.resourceTypes[0]: must be a mesh-scoped resource type"),
+		Entry("resource type that is not synced from global", &system_proto.ZoneSync{
+			ResourceTypes: []string{"Dataplane"},
+		}, "sync.resourceTypes[0]: must be a resource type synced from global to zones"),
+	)
 })
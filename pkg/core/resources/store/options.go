@@ -187,6 +187,11 @@ type ListOptions struct {
 	NameContains string
 	Ordered      bool
 	ResourceKeys map[core_model.ResourceKey]struct{}
+	// LabelSelector and FieldSelector are selectors in the format of Kubernetes selectors
+	// which are evaluated by the API server. They are passed to the API server by the remote store,
+	// other stores filter resources only with FilterFunc.
+	LabelSelector string
+	FieldSelector string
 }
 
 type ListOptionsFunc func(*ListOptions)
@@ -233,6 +238,18 @@ func ListByFilterFunc(filterFunc ListFilterFunc) ListOptionsFunc {
 	}
 }
 
+func ListByLabelSelector(selector string) ListOptionsFunc {
+	return func(opts *ListOptions) {
+		opts.LabelSelector = selector
+	}
+}
+
+func ListByFieldSelector(selector string) ListOptionsFunc {
+	return func(opts *ListOptions) {
+		opts.FieldSelector = selector
+	}
+}
+
 func ListOrdered() ListOptionsFunc {
 	return func(opts *ListOptions) {
 		opts.Ordered = true
@@ -254,5 +271,5 @@ func (l *ListOptions) IsCacheable() bool {
 }
 
 func (l *ListOptions) HashCode() string {
-	return fmt.Sprintf("%s:%t:%s:%d:%s", l.Mesh, l.Ordered, l.NameContains, l.PageSize, l.PageOffset)
+	return fmt.Sprintf("%s:%t:%s:%d:%s:%s:%s", l.Mesh, l.Ordered, l.NameContains, l.PageSize, l.PageOffset, l.LabelSelector, l.FieldSelector)
 }
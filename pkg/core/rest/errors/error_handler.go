@@ -95,12 +95,32 @@ func HandleError(ctx context.Context, response *restful.Response, err error, tit
 			Title:  "Method not Allowed",
 			Detail: err.Error(),
 		}
+	case errors.Is(err, &ApplyConflict{}):
+		var conflict *ApplyConflict
+		errors.As(err, &conflict)
+		kumaErr = &types.Error{
+			Status: 409,
+			Title:  "Conflict",
+			Detail: err.Error(),
+		}
+		for _, field := range conflict.Fields {
+			kumaErr.InvalidParameters = append(kumaErr.InvalidParameters, types.InvalidParameter{
+				Field:  field,
+				Reason: fmt.Sprintf("modified in a resource managed by field manager %q", conflict.Manager),
+			})
+		}
 	case errors.Is(err, &Conflict{}) || store.IsAlreadyExists(err) || store.IsConflict(err):
 		kumaErr = &types.Error{
 			Status: 409,
 			Title:  "Conflict",
 			Detail: err.Error(),
 		}
+	case errors.Is(err, &Gone{}):
+		kumaErr = &types.Error{
+			Status: 410,
+			Title:  title,
+			Detail: err.Error(),
+		}
 	case errors.Is(err, &ServiceUnavailable{}):
 		kumaErr = &types.Error{
 			Status: 503,
@@ -3,6 +3,7 @@ package errors
 import (
 	"fmt"
 	"reflect"
+	"strings"
 )
 
 type Unauthenticated struct{}
@@ -23,6 +24,21 @@ func (e *Conflict) Error() string {
 	return "Conflict"
 }
 
+// ApplyConflict is returned when a change of a resource managed by another field manager is not forced.
+// The field manager owns the whole resource, not single fields, so Fields are the paths the change would modify.
+type ApplyConflict struct {
+	Manager string
+	Fields  []string
+}
+
+func (e *ApplyConflict) Error() string {
+	return fmt.Sprintf("resource is managed by field manager %q, the change would modify: %s", e.Manager, strings.Join(e.Fields, ", "))
+}
+
+func (e *ApplyConflict) Is(err error) bool {
+	return reflect.TypeFor[*ApplyConflict]() == reflect.TypeOf(err)
+}
+
 type ServiceUnavailable struct{}
 
 func (e *ServiceUnavailable) Error() string {
@@ -66,3 +82,22 @@ func (e *NotFound) Error() string {
 func (e *NotFound) Is(err error) bool {
 	return reflect.TypeFor[*NotFound]() == reflect.TypeOf(err)
 }
+
+func NewGoneError(msg string) error {
+	return &Gone{msg: msg}
+}
+
+type Gone struct {
+	msg string
+}
+
+func (e *Gone) Error() string {
+	if e.msg == "" {
+		return "gone"
+	}
+	return fmt.Sprintf("gone: %s", e.msg)
+}
+
+func (e *Gone) Is(err error) bool {
+	return reflect.TypeFor[*Gone]() == reflect.TypeOf(err)
+}
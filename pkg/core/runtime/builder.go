@@ -12,6 +12,7 @@ import (
 	api_server "github.com/kumahq/kuma/v3/pkg/api-server/customization"
 	kuma_cp "github.com/kumahq/kuma/v3/pkg/config/app/kuma-cp"
 	"github.com/kumahq/kuma/v3/pkg/core"
+	"github.com/kumahq/kuma/v3/pkg/core/audit"
 	config_manager "github.com/kumahq/kuma/v3/pkg/core/config/manager"
 	"github.com/kumahq/kuma/v3/pkg/core/datasource"
 	"github.com/kumahq/kuma/v3/pkg/core/dns/lookup"
@@ -50,6 +51,7 @@ type BuilderContext interface {
 	LeaderInfo() component.LeaderInfo
 	Metrics() metrics.Metrics
 	EventBus() events.EventBus
+	AuditLog() audit.Log
 	APIManager() api_server.APIManager
 	DpServer() *dp_server.DpServer
 	ResourceValidators() ResourceValidators
@@ -84,6 +86,7 @@ type Builder struct {
 	eac            admin.EnvoyAdminClient
 	metrics        metrics.Metrics
 	erf            events.EventBus
+	auditLog       audit.Log
 	apim           api_server.APIManager
 	xds            xds_runtime.XDSRuntimeContext
 	dps            *dp_server.DpServer
@@ -206,6 +209,11 @@ func (b *Builder) WithEventBus(erf events.EventBus) *Builder {
 	return b
 }
 
+func (b *Builder) WithAuditLog(auditLog audit.Log) *Builder {
+	b.auditLog = auditLog
+	return b
+}
+
 func (b *Builder) WithAPIManager(apim api_server.APIManager) *Builder {
 	b.apim = apim
 	return b
@@ -326,6 +334,9 @@ func (b *Builder) Build() (Runtime, error) {
 	if b.erf == nil {
 		return nil, errors.Errorf("EventReaderFactory has not been configured")
 	}
+	if b.auditLog == nil {
+		return nil, errors.Errorf("AuditLog has not been configured")
+	}
 	if b.apim == nil {
 		return nil, errors.Errorf("APIManager has not been configured")
 	}
@@ -383,6 +394,7 @@ func (b *Builder) Build() (Runtime, error) {
 			eac:                      b.eac,
 			metrics:                  b.metrics,
 			erf:                      b.erf,
+			auditLog:                 b.auditLog,
 			apim:                     b.apim,
 			xds:                      b.xds,
 			dps:                      b.dps,
@@ -470,6 +482,10 @@ func (b *Builder) EventBus() events.EventBus {
 	return b.erf
 }
 
+func (b *Builder) AuditLog() audit.Log {
+	return b.auditLog
+}
+
 func (b *Builder) APIManager() api_server.APIManager {
 	return b.apim
 }
@@ -14,6 +14,7 @@ import (
 	config_core "github.com/kumahq/kuma/v3/pkg/config/core"
 	"github.com/kumahq/kuma/v3/pkg/core"
 	"github.com/kumahq/kuma/v3/pkg/core/access"
+	"github.com/kumahq/kuma/v3/pkg/core/audit"
 	config_manager "github.com/kumahq/kuma/v3/pkg/core/config/manager"
 	"github.com/kumahq/kuma/v3/pkg/core/datasource"
 	"github.com/kumahq/kuma/v3/pkg/core/dns/lookup"
@@ -77,6 +78,7 @@ type RuntimeContext interface {
 	EnvoyAdminClient() admin.EnvoyAdminClient
 	Metrics() metrics.Metrics
 	EventBus() events.EventBus
+	AuditLog() audit.Log
 	APIInstaller() api_server.APIInstaller
 	XDS() xds_runtime.XDSRuntimeContext
 	DpServer() *dp_server.DpServer
@@ -200,6 +202,7 @@ type runtimeContext struct {
 	eac                      admin.EnvoyAdminClient
 	metrics                  metrics.Metrics
 	erf                      events.EventBus
+	auditLog                 audit.Log
 	apim                     api_server.APIInstaller
 	xds                      xds_runtime.XDSRuntimeContext
 	dps                      *dp_server.DpServer
@@ -228,6 +231,10 @@ func (rc *runtimeContext) EventBus() events.EventBus {
 	return rc.erf
 }
 
+func (rc *runtimeContext) AuditLog() audit.Log {
+	return rc.auditLog
+}
+
 func (rc *runtimeContext) Config() kuma_cp.Config {
 	return rc.cfg
 }
@@ -0,0 +1,169 @@
+package cipher
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"io"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// envelopePrefix marks data encrypted by the envelope cipher. Data without the prefix
+// is plaintext, it can be read only when it's allowed by AllowPlaintext.
+var envelopePrefix = []byte("kuma:enc:v1:")
+
+// ErrPlaintext is returned when data is stored in plaintext, but reading plaintext is not allowed.
+var ErrPlaintext = errors.New("data is not encrypted, reading plaintext is allowed only while the store is migrated to encryption")
+
+const dataKeySize = 32
+
+// KeyProvider wraps and unwraps data encryption keys with a key encryption key (KEK).
+// It's the extension point for KMS-style backends, the KEK itself never leaves the provider.
+type KeyProvider interface {
+	// ActiveKeyID returns the ID of the KEK that is used to wrap new data keys.
+	ActiveKeyID() string
+	// WrapKey encrypts a data key with the KEK identified by keyID.
+	WrapKey(keyID string, dataKey []byte) ([]byte, error)
+	// UnwrapKey decrypts a data key with the KEK identified by keyID.
+	UnwrapKey(keyID string, wrappedKey []byte) ([]byte, error)
+}
+
+// RotatableCipher is a Cipher that can tell which key was used to encrypt data,
+// so data encrypted with an old key can be re-encrypted with the active one.
+type RotatableCipher interface {
+	Cipher
+	// NeedsRotation returns true if data is not encrypted with the active key.
+	NeedsRotation(data []byte) bool
+}
+
+type envelopeData struct {
+	KeyID      string `json:"kid"`
+	WrappedKey []byte `json:"wk"`
+	Nonce      []byte `json:"n"`
+	Ciphertext []byte `json:"ct"`
+}
+
+type EnvelopeOption func(*envelope)
+
+// AllowPlaintext lets the cipher read data stored in plaintext, so secrets stored before encryption
+// was enabled can be read until they are re-encrypted. Every plaintext read is counted by reads.
+func AllowPlaintext(reads prometheus.Counter) EnvelopeOption {
+	return func(e *envelope) {
+		e.allowPlaintext = true
+		e.plaintextReads = reads
+	}
+}
+
+// NewEnvelope returns a cipher that encrypts every value with a random AES-256-GCM data key,
+// the data key is then wrapped by the KeyProvider and stored with its key ID next to the ciphertext.
+func NewEnvelope(provider KeyProvider, opts ...EnvelopeOption) RotatableCipher {
+	e := &envelope{
+		provider: provider,
+		rand:     rand.Reader,
+	}
+	for _, opt := range opts {
+		opt(e)
+	}
+	return e
+}
+
+var _ RotatableCipher = &envelope{}
+
+type envelope struct {
+	provider       KeyProvider
+	rand           io.Reader
+	allowPlaintext bool
+	plaintextReads prometheus.Counter
+}
+
+func (e *envelope) Encrypt(data []byte) ([]byte, error) {
+	dataKey := make([]byte, dataKeySize)
+	if _, err := io.ReadFull(e.rand, dataKey); err != nil {
+		return nil, errors.Wrap(err, "could not generate data key")
+	}
+	gcm, err := newGCM(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(e.rand, nonce); err != nil {
+		return nil, errors.Wrap(err, "could not generate nonce")
+	}
+	keyID := e.provider.ActiveKeyID()
+	wrappedKey, err := e.provider.WrapKey(keyID, dataKey)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not wrap data key with key %q", keyID)
+	}
+	env := envelopeData{
+		KeyID:      keyID,
+		WrappedKey: wrappedKey,
+		Nonce:      nonce,
+		Ciphertext: gcm.Seal(nil, nonce, data, []byte(keyID)),
+	}
+	marshaled, err := json.Marshal(env)
+	if err != nil {
+		return nil, err
+	}
+	return append(append([]byte{}, envelopePrefix...), marshaled...), nil
+}
+
+func (e *envelope) Decrypt(data []byte) ([]byte, error) {
+	env, ok, err := parseEnvelope(data)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		// without this check anyone who can write to the store could replace an encrypted secret with plaintext unnoticed
+		if !e.allowPlaintext {
+			return nil, ErrPlaintext
+		}
+		if e.plaintextReads != nil {
+			e.plaintextReads.Inc()
+		}
+		return data, nil
+	}
+	dataKey, err := e.provider.UnwrapKey(env.KeyID, env.WrappedKey)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not unwrap data key with key %q", env.KeyID)
+	}
+	gcm, err := newGCM(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	plaintext, err := gcm.Open(nil, env.Nonce, env.Ciphertext, []byte(env.KeyID))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decrypt data")
+	}
+	return plaintext, nil
+}
+
+func (e *envelope) NeedsRotation(data []byte) bool {
+	env, ok, err := parseEnvelope(data)
+	if err != nil || !ok {
+		return true
+	}
+	return env.KeyID != e.provider.ActiveKeyID()
+}
+
+func parseEnvelope(data []byte) (envelopeData, bool, error) {
+	if !bytes.HasPrefix(data, envelopePrefix) {
+		return envelopeData{}, false, nil
+	}
+	env := envelopeData{}
+	if err := json.Unmarshal(data[len(envelopePrefix):], &env); err != nil {
+		return envelopeData{}, false, errors.Wrap(err, "could not parse encrypted data")
+	}
+	return env, true, nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,178 @@
+package cipher_test
+
+import (
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"github.com/kumahq/kuma/v3/pkg/core/secrets/cipher"
+)
+
+var _ = Describe("Envelope cipher", func() {
+	keyFile := func(activeKeyID string) cipher.KeyFile {
+		return cipher.KeyFile{
+			ActiveKeyID: activeKeyID,
+			Keys: []cipher.KeyFileItem{
+				{ID: "key-1", Key: "YWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWE="},
+				{ID: "key-2", Key: "YmJiYmJiYmJiYmJiYmJiYmJiYmJiYmJiYmJiYmJiYmI="},
+			},
+		}
+	}
+
+	newCipher := func(activeKeyID string) cipher.RotatableCipher {
+		provider, err := cipher.NewStaticKeyProvider(keyFile(activeKeyID))
+		Expect(err).ToNot(HaveOccurred())
+		return cipher.NewEnvelope(provider)
+	}
+
+	It("should encrypt and decrypt data", func() {
+		// given
+		c := newCipher("key-1")
+
+		// when
+		encrypted, err := c.Encrypt([]byte("top-secret"))
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(encrypted)).ToNot(ContainSubstring("top-secret"))
+		Expect(string(encrypted)).To(HavePrefix("kuma:enc:v1:"))
+
+		// when
+		decrypted, err := c.Decrypt(encrypted)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(decrypted).To(Equal([]byte("top-secret")))
+	})
+
+	It("should produce different ciphertext for the same data", func() {
+		// given
+		c := newCipher("key-1")
+
+		// when
+		first, err := c.Encrypt([]byte("top-secret"))
+		Expect(err).ToNot(HaveOccurred())
+		second, err := c.Encrypt([]byte("top-secret"))
+		Expect(err).ToNot(HaveOccurred())
+
+		// then
+		Expect(first).ToNot(Equal(second))
+	})
+
+	It("should reject data that was not encrypted", func() {
+		// given
+		c := newCipher("key-1")
+
+		// when
+		_, err := c.Decrypt([]byte("plaintext"))
+
+		// then
+		Expect(err).To(MatchError(cipher.ErrPlaintext))
+		Expect(c.NeedsRotation([]byte("plaintext"))).To(BeTrue())
+	})
+
+	It("should pass through and count data that was not encrypted when plaintext is allowed", func() {
+		// given
+		provider, err := cipher.NewStaticKeyProvider(keyFile("key-1"))
+		Expect(err).ToNot(HaveOccurred())
+		reads := prometheus.NewCounter(prometheus.CounterOpts{Name: "reads"})
+		c := cipher.NewEnvelope(provider, cipher.AllowPlaintext(reads))
+
+		// when
+		decrypted, err := c.Decrypt([]byte("plaintext"))
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(decrypted).To(Equal([]byte("plaintext")))
+		Expect(testutil.ToFloat64(reads)).To(Equal(1.0))
+	})
+
+	It("should decrypt data encrypted with a previous key after rotation", func() {
+		// given
+		encrypted, err := newCipher("key-1").Encrypt([]byte("top-secret"))
+		Expect(err).ToNot(HaveOccurred())
+		rotated := newCipher("key-2")
+
+		// when
+		decrypted, err := rotated.Decrypt(encrypted)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(decrypted).To(Equal([]byte("top-secret")))
+		Expect(rotated.NeedsRotation(encrypted)).To(BeTrue())
+
+		// when
+		reEncrypted, err := rotated.Encrypt(decrypted)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(rotated.NeedsRotation(reEncrypted)).To(BeFalse())
+	})
+
+	It("should fail when the key that encrypted the data is not available", func() {
+		// given
+		encrypted, err := newCipher("key-1").Encrypt([]byte("top-secret"))
+		Expect(err).ToNot(HaveOccurred())
+		provider, err := cipher.NewStaticKeyProvider(cipher.KeyFile{
+			ActiveKeyID: "key-2",
+			Keys:        keyFile("key-2").Keys[1:],
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		_, err = cipher.NewEnvelope(provider).Decrypt(encrypted)
+
+		// then
+		Expect(err).To(MatchError(ContainSubstring(`unknown key "key-1"`)))
+	})
+
+	It("should fail on tampered data", func() {
+		// given
+		c := newCipher("key-1")
+		encrypted, err := c.Encrypt([]byte("top-secret"))
+		Expect(err).ToNot(HaveOccurred())
+
+		// when
+		tampered := []byte(string(encrypted[:len(encrypted)-10]) + `AAAAAAA"}`)
+		_, err = c.Decrypt(tampered)
+
+		// then
+		Expect(err).To(HaveOccurred())
+	})
+
+	Describe("file key provider", func() {
+		It("should load keys from a file", func() {
+			// when
+			provider, err := cipher.NewFileKeyProvider(filepath.Join("testdata", "keys.yaml"))
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(provider.ActiveKeyID()).To(Equal("key-2"))
+		})
+
+		DescribeTable("should validate keys",
+			func(given cipher.KeyFile, expectedErr string) {
+				// when
+				_, err := cipher.NewStaticKeyProvider(given)
+
+				// then
+				Expect(err).To(MatchError(expectedErr))
+			},
+			Entry("missing active key", cipher.KeyFile{
+				ActiveKeyID: "key-3",
+				Keys:        keyFile("").Keys,
+			}, `active key "key-3" is not defined`),
+			Entry("wrong key length", cipher.KeyFile{
+				ActiveKeyID: "key-1",
+				Keys:        []cipher.KeyFileItem{{ID: "key-1", Key: "YWFh"}},
+			}, `key "key-1" has to be 32 bytes long, got 3`),
+			Entry("duplicated key", cipher.KeyFile{
+				ActiveKeyID: "key-1",
+				Keys:        append(keyFile("").Keys, keyFile("").Keys[0]),
+			}, `duplicated key id "key-1"`),
+		)
+	})
+})
@@ -0,0 +1,116 @@
+package cipher
+
+import (
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"io"
+	"os"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/yaml"
+)
+
+// KeyFile is the format of the file with key encryption keys.
+//
+//	activeKeyId: key-2
+//	keys:
+//	- id: key-1
+//	  key: <base64 encoded 32 bytes>
+//	- id: key-2
+//	  key: <base64 encoded 32 bytes>
+//
+// Old keys have to be kept in the file until all secrets are re-encrypted with the active key.
+type KeyFile struct {
+	ActiveKeyID string        `json:"activeKeyId"`
+	Keys        []KeyFileItem `json:"keys"`
+}
+
+type KeyFileItem struct {
+	ID  string `json:"id"`
+	Key string `json:"key"`
+}
+
+// NewFileKeyProvider loads key encryption keys from a file.
+func NewFileKeyProvider(path string) (KeyProvider, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not read key file %s", path)
+	}
+	keyFile := KeyFile{}
+	if err := yaml.Unmarshal(content, &keyFile); err != nil {
+		return nil, errors.Wrapf(err, "could not parse key file %s", path)
+	}
+	return NewStaticKeyProvider(keyFile)
+}
+
+// NewStaticKeyProvider returns a KeyProvider that wraps data keys with AES-256-GCM using the given keys.
+func NewStaticKeyProvider(keyFile KeyFile) (KeyProvider, error) {
+	keys := map[string][]byte{}
+	for _, item := range keyFile.Keys {
+		if item.ID == "" {
+			return nil, errors.New("key id cannot be empty")
+		}
+		if _, ok := keys[item.ID]; ok {
+			return nil, errors.Errorf("duplicated key id %q", item.ID)
+		}
+		key, err := base64.StdEncoding.DecodeString(item.Key)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not decode key %q", item.ID)
+		}
+		if len(key) != dataKeySize {
+			return nil, errors.Errorf("key %q has to be %d bytes long, got %d", item.ID, dataKeySize, len(key))
+		}
+		keys[item.ID] = key
+	}
+	if _, ok := keys[keyFile.ActiveKeyID]; !ok {
+		return nil, errors.Errorf("active key %q is not defined", keyFile.ActiveKeyID)
+	}
+	return &staticKeyProvider{
+		activeKeyID: keyFile.ActiveKeyID,
+		keys:        keys,
+	}, nil
+}
+
+var _ KeyProvider = &staticKeyProvider{}
+
+type staticKeyProvider struct {
+	activeKeyID string
+	keys        map[string][]byte
+}
+
+func (s *staticKeyProvider) ActiveKeyID() string {
+	return s.activeKeyID
+}
+
+func (s *staticKeyProvider) WrapKey(keyID string, dataKey []byte) ([]byte, error) {
+	gcm, err := s.gcm(keyID)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	return gcm.Seal(nonce, nonce, dataKey, nil), nil
+}
+
+func (s *staticKeyProvider) UnwrapKey(keyID string, wrappedKey []byte) ([]byte, error) {
+	gcm, err := s.gcm(keyID)
+	if err != nil {
+		return nil, err
+	}
+	if len(wrappedKey) < gcm.NonceSize() {
+		return nil, errors.New("wrapped key is too short")
+	}
+	nonce, ciphertext := wrappedKey[:gcm.NonceSize()], wrappedKey[gcm.NonceSize():]
+	return gcm.Open(nil, nonce, ciphertext, nil)
+}
+
+func (s *staticKeyProvider) gcm(keyID string) (cipher.AEAD, error) {
+	key, ok := s.keys[keyID]
+	if !ok {
+		return nil, errors.Errorf("unknown key %q", keyID)
+	}
+	return newGCM(key)
+}
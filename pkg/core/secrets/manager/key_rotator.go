@@ -0,0 +1,126 @@
+package manager
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/kumahq/kuma/v3/pkg/core"
+	secret_model "github.com/kumahq/kuma/v3/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/model"
+	core_store "github.com/kumahq/kuma/v3/pkg/core/resources/store"
+	"github.com/kumahq/kuma/v3/pkg/core/runtime/component"
+	secret_cipher "github.com/kumahq/kuma/v3/pkg/core/secrets/cipher"
+	secret_store "github.com/kumahq/kuma/v3/pkg/core/secrets/store"
+	"github.com/kumahq/kuma/v3/pkg/core/user"
+	"github.com/kumahq/kuma/v3/pkg/multitenant"
+)
+
+var rotatorLog = core.Log.WithName("secrets").WithName("key-rotator")
+
+// KeyRotator re-encrypts Secrets and GlobalSecrets that are stored in plaintext
+// or encrypted with a key encryption key other than the active one.
+// Secrets are read and written through the resource manager, so they go through the same
+// validation and hooks as any other update.
+type KeyRotator struct {
+	secretStore secret_store.SecretStore
+	resManager  manager.ResourceManager
+	cipher      secret_cipher.RotatableCipher
+	tenants     multitenant.Tenants
+}
+
+var _ component.Component = &KeyRotator{}
+
+func NewKeyRotator(
+	secretStore secret_store.SecretStore,
+	resManager manager.ResourceManager,
+	cipher secret_cipher.RotatableCipher,
+	tenants multitenant.Tenants,
+) *KeyRotator {
+	return &KeyRotator{
+		secretStore: secretStore,
+		resManager:  resManager,
+		cipher:      cipher,
+		tenants:     tenants,
+	}
+}
+
+func (r *KeyRotator) Start(_ <-chan struct{}) error {
+	ctx := user.Ctx(context.Background(), user.ControlPlane)
+	tenantIDs, err := r.tenants.GetIDs(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get tenants")
+	}
+	for _, tenantID := range tenantIDs {
+		rotated, err := r.Rotate(multitenant.WithTenant(ctx, tenantID))
+		if err != nil {
+			return errors.Wrapf(err, "could not re-encrypt secrets of tenant %q", tenantID)
+		}
+		if rotated > 0 {
+			rotatorLog.Info("re-encrypted secrets with the active key", "tenantID", tenantID, "count", rotated)
+		}
+	}
+	return nil
+}
+
+func (r *KeyRotator) NeedLeaderElection() bool {
+	return true
+}
+
+// Rotate re-encrypts all secrets of the tenant in the context that need it and returns how many of them were updated.
+func (r *KeyRotator) Rotate(ctx context.Context) (int, error) {
+	// the store is listed directly, because only the stored value tells which key encrypted it
+	secrets := &secret_model.SecretResourceList{}
+	if err := r.secretStore.List(ctx, secrets); err != nil {
+		return 0, err
+	}
+	globalSecrets := &secret_model.GlobalSecretResourceList{}
+	if err := r.secretStore.List(ctx, globalSecrets); err != nil {
+		return 0, err
+	}
+	var resources []model.Resource
+	for _, secret := range secrets.Items {
+		if r.needsRotation(secret.Spec.GetData().GetValue()) {
+			resources = append(resources, secret)
+		}
+	}
+	for _, secret := range globalSecrets.Items {
+		if r.needsRotation(secret.Spec.GetData().GetValue()) {
+			resources = append(resources, secret)
+		}
+	}
+
+	rotated := 0
+	for _, stored := range resources {
+		desc := stored.Descriptor()
+		resource := desc.NewObject()
+		key := model.MetaToResourceKey(stored.GetMeta())
+		if err := r.resManager.Get(ctx, resource, core_store.GetBy(key)); err != nil {
+			switch {
+			case core_store.IsNotFound(err):
+				continue
+			case errors.Is(err, secret_cipher.ErrPlaintext):
+				rotatorLog.Info("skipping secret stored in plaintext, set KUMA_STORE_ENCRYPTION_ALLOW_PLAINTEXT to re-encrypt it",
+					"type", desc.Name, "name", key.Name, "mesh", key.Mesh)
+				continue
+			default:
+				return rotated, errors.Wrapf(err, "could not read %s %s", desc.Name, key.Name)
+			}
+		}
+		// the manager encrypts the value with the active key
+		if err := r.resManager.Update(ctx, resource); err != nil {
+			if core_store.IsConflict(err) {
+				// the secret was modified in the meantime, so it's already encrypted with the active key
+				continue
+			}
+			return rotated, errors.Wrapf(err, "could not re-encrypt %s %s", desc.Name, key.Name)
+		}
+		rotated++
+	}
+	return rotated, nil
+}
+
+func (r *KeyRotator) needsRotation(value []byte) bool {
+	return len(value) > 0 && r.cipher.NeedsRotation(value)
+}
@@ -0,0 +1,153 @@
+package manager_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	system_proto "github.com/kumahq/kuma/v3/api/system/v1alpha1"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/apis/system"
+	core_manager "github.com/kumahq/kuma/v3/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/model"
+	core_store "github.com/kumahq/kuma/v3/pkg/core/resources/store"
+	"github.com/kumahq/kuma/v3/pkg/core/secrets/cipher"
+	secrets_manager "github.com/kumahq/kuma/v3/pkg/core/secrets/manager"
+	secrets_store "github.com/kumahq/kuma/v3/pkg/core/secrets/store"
+	"github.com/kumahq/kuma/v3/pkg/multitenant"
+	resources_memory "github.com/kumahq/kuma/v3/pkg/plugins/resources/memory"
+	util_proto "github.com/kumahq/kuma/v3/pkg/util/proto"
+)
+
+var _ = Describe("Key Rotator", func() {
+	var secretStore secrets_store.SecretStore
+
+	newCipher := func(activeKeyID string, opts ...cipher.EnvelopeOption) cipher.RotatableCipher {
+		provider, err := cipher.NewStaticKeyProvider(cipher.KeyFile{
+			ActiveKeyID: activeKeyID,
+			Keys: []cipher.KeyFileItem{
+				{ID: "key-1", Key: "YWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWFhYWE="},
+				{ID: "key-2", Key: "YmJiYmJiYmJiYmJiYmJiYmJiYmJiYmJiYmJiYmJiYmI="},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		return cipher.NewEnvelope(provider, opts...)
+	}
+
+	newRotator := func(c cipher.RotatableCipher) *secrets_manager.KeyRotator {
+		resManager := core_manager.NewCustomizableResourceManager(core_manager.NewResourceManager(resources_memory.NewStore()), nil)
+		resManager.Customize(system.SecretType, secrets_manager.NewSecretManager(secretStore, c))
+		resManager.Customize(system.GlobalSecretType, secrets_manager.NewGlobalSecretManager(secretStore, c))
+		return secrets_manager.NewKeyRotator(secretStore, resManager, c, multitenant.SingleTenant)
+	}
+
+	createSecrets := func(secManager, globalSecManager core_manager.ResourceManager) {
+		secret := system.NewSecretResource()
+		secret.Spec = &system_proto.Secret{
+			Data: util_proto.Bytes([]byte("secret-value")),
+		}
+		Expect(secManager.Create(context.Background(), secret, core_store.CreateByKey("sec-1", model.DefaultMesh))).To(Succeed())
+
+		globalSecret := system.NewGlobalSecretResource()
+		globalSecret.Spec = &system_proto.Secret{
+			Data: util_proto.Bytes([]byte("global-secret-value")),
+		}
+		Expect(globalSecManager.Create(context.Background(), globalSecret, core_store.CreateByKey("global-sec-1", model.NoMesh))).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		secretStore = secrets_store.NewSecretStore(resources_memory.NewStore())
+	})
+
+	It("should re-encrypt secrets stored in plaintext", func() {
+		// given secrets created without encryption
+		createSecrets(
+			secrets_manager.NewSecretManager(secretStore, cipher.None()),
+			secrets_manager.NewGlobalSecretManager(secretStore, cipher.None()),
+		)
+		c := newCipher("key-1", cipher.AllowPlaintext(nil))
+
+		// when
+		rotated, err := newRotator(c).Rotate(context.Background())
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(rotated).To(Equal(2))
+
+		stored := system.NewSecretResource()
+		Expect(secretStore.Get(context.Background(), stored, core_store.GetByKey("sec-1", model.DefaultMesh))).To(Succeed())
+		Expect(string(stored.Spec.Data.Value)).ToNot(ContainSubstring("secret-value"))
+		Expect(c.NeedsRotation(stored.Spec.Data.Value)).To(BeFalse())
+
+		// and secrets are readable through the manager
+		secret := system.NewSecretResource()
+		Expect(secrets_manager.NewSecretManager(secretStore, c).Get(context.Background(), secret, core_store.GetByKey("sec-1", model.DefaultMesh))).To(Succeed())
+		Expect(secret.Spec.Data.Value).To(Equal([]byte("secret-value")))
+	})
+
+	It("should re-encrypt secrets encrypted with a previous key", func() {
+		// given
+		old := newCipher("key-1")
+		createSecrets(
+			secrets_manager.NewSecretManager(secretStore, old),
+			secrets_manager.NewGlobalSecretManager(secretStore, old),
+		)
+		c := newCipher("key-2")
+
+		// when
+		rotated, err := newRotator(c).Rotate(context.Background())
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(rotated).To(Equal(2))
+
+		globalSecret := system.NewGlobalSecretResource()
+		Expect(secrets_manager.NewGlobalSecretManager(secretStore, c).Get(context.Background(), globalSecret, core_store.GetByKey("global-sec-1", model.NoMesh))).To(Succeed())
+		Expect(globalSecret.Spec.Data.Value).To(Equal([]byte("global-secret-value")))
+
+		// when rotated again
+		rotated, err = newRotator(c).Rotate(context.Background())
+
+		// then nothing is changed
+		Expect(err).ToNot(HaveOccurred())
+		Expect(rotated).To(Equal(0))
+	})
+
+	It("should skip secrets stored in plaintext when reading plaintext is not allowed", func() {
+		// given
+		createSecrets(
+			secrets_manager.NewSecretManager(secretStore, cipher.None()),
+			secrets_manager.NewGlobalSecretManager(secretStore, cipher.None()),
+		)
+
+		// when
+		rotated, err := newRotator(newCipher("key-1")).Rotate(context.Background())
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(rotated).To(Equal(0))
+
+		stored := system.NewSecretResource()
+		Expect(secretStore.Get(context.Background(), stored, core_store.GetByKey("sec-1", model.DefaultMesh))).To(Succeed())
+		Expect(stored.Spec.Data.Value).To(Equal([]byte("secret-value")))
+	})
+
+	It("should re-encrypt secrets of tenants on start", func() {
+		// given
+		tenantCtx := multitenant.WithTenant(context.Background(), multitenant.GlobalTenantID)
+		secret := system.NewSecretResource()
+		secret.Spec = &system_proto.Secret{
+			Data: util_proto.Bytes([]byte("secret-value")),
+		}
+		Expect(secretStore.Create(tenantCtx, secret, core_store.CreateByKey("sec-1", model.DefaultMesh))).To(Succeed())
+		c := newCipher("key-1", cipher.AllowPlaintext(nil))
+
+		// when
+		Expect(newRotator(c).Start(nil)).To(Succeed())
+
+		// then
+		stored := system.NewSecretResource()
+		Expect(secretStore.Get(tenantCtx, stored, core_store.GetByKey("sec-1", model.DefaultMesh))).To(Succeed())
+		Expect(c.NeedsRotation(stored.Spec.Data.Value)).To(BeFalse())
+	})
+})
@@ -1,25 +1,88 @@
 package tokens
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"slices"
 	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
 
 	system_proto "github.com/kumahq/kuma/v3/api/system/v1alpha1"
+	config_store "github.com/kumahq/kuma/v3/pkg/config/core/resources/store"
 	"github.com/kumahq/kuma/v3/pkg/core/resources/apis/system"
 	"github.com/kumahq/kuma/v3/pkg/core/resources/manager"
 	core_model "github.com/kumahq/kuma/v3/pkg/core/resources/model"
 	core_store "github.com/kumahq/kuma/v3/pkg/core/resources/store"
+	util_proto "github.com/kumahq/kuma/v3/pkg/util/proto"
 )
 
 // Revocations keeps track of revoked tokens.
 // If only one token is compromised, it's more convenient to revoke it instead of rotate signing key and regenerate all tokens.
 // Revocation list is stored as Secret (in case of mesh scoped tokens) or GlobalSecret (global scoped tokens).
-// IDs of token are stored in secret in comma separated format: "id1,id2".
+// The secret holds comma separated IDs ("id1,id2"), which is the format every version of the control plane enforces.
+// Details of the revocations (reason, time of revocation and expiration) are stored as a JSON encoded RevocationList
+// in a separate secret (see DetailsKey), so control planes that were not upgraded yet keep reading the IDs.
 type Revocations interface {
 	IsRevoked(ctx context.Context, id string) (bool, error)
 }
 
+// Revocation is a single entry of the revocation list.
+type Revocation struct {
+	// ID is the ID (jti claim) of the revoked token.
+	ID string `json:"id"`
+	// Reason is an optional description why the token was revoked.
+	Reason string `json:"reason,omitempty"`
+	// RevokedAt is the time when the token was revoked. It's empty for entries in the legacy format.
+	RevokedAt *time.Time `json:"revokedAt,omitempty"`
+	// ExpiresAt is the expiration time of the revoked token.
+	// Once the token expires, it can't be used anyway, so the entry is pruned from the list.
+	// Entries without expiration are never pruned.
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+}
+
+func (r Revocation) IsExpired(now time.Time) bool {
+	return r.ExpiresAt != nil && r.ExpiresAt.Before(now)
+}
+
+type RevocationList struct {
+	Revocations []Revocation `json:"revocations"`
+}
+
+// DetailsKey returns the key of the secret with details of the revocations stored in the secret of a given key.
+// The name has a prefix, because a suffix could clash with the revocation secret of a mesh whose name has the same suffix.
+func DetailsKey(revocationKey core_model.ResourceKey) core_model.ResourceKey {
+	return core_model.ResourceKey{
+		Mesh: revocationKey.Mesh,
+		Name: system.TokenRevocationDetailsPrefix + revocationKey.Name,
+	}
+}
+
+// ParseRevocationList parses the content of the revocation secret or the secret with details of the revocations.
+func ParseRevocationList(data []byte) (RevocationList, error) {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 {
+		return RevocationList{}, nil
+	}
+	if data[0] == '{' {
+		list := RevocationList{}
+		if err := json.Unmarshal(data, &list); err != nil {
+			return RevocationList{}, errors.Wrap(err, "could not parse revocation list")
+		}
+		return list, nil
+	}
+	list := RevocationList{}
+	for id := range strings.SplitSeq(string(data), ",") {
+		if id = strings.TrimSpace(id); id != "" {
+			list.Revocations = append(list.Revocations, Revocation{ID: id})
+		}
+	}
+	return list, nil
+}
+
 func NewRevocations(manager manager.ReadOnlyResourceManager, revocationKey core_model.ResourceKey) Revocations {
 	return &secretRevocations{
 		manager:       manager,
@@ -30,44 +93,254 @@ func NewRevocations(manager manager.ReadOnlyResourceManager, revocationKey core_
 type secretRevocations struct {
 	manager       manager.ReadOnlyResourceManager
 	revocationKey core_model.ResourceKey
+
+	sync.Mutex
+	// cached IDs of the secret of a given version, so we don't parse it on every token validation.
+	// The version alone doesn't identify the content, because it starts over when the secret is deleted and created again.
+	version          string
+	modificationTime time.Time
+	ids              map[string]struct{}
 }
 
 func (s *secretRevocations) IsRevoked(ctx context.Context, id string) (bool, error) {
-	data, err := s.getSecretData(ctx)
+	res, err := s.getSecret(ctx)
 	if err != nil {
 		return false, err
 	}
-	if len(data) == 0 {
+	if res == nil {
 		return false, nil
 	}
-	rawIds := strings.TrimSuffix(string(data), "\n")
-	ids := strings.Split(rawIds, ",")
-	if slices.Contains(ids, id) {
-		return true, nil
+	ids, err := s.revokedIDs(res)
+	if err != nil {
+		return false, err
 	}
-	return false, nil
+	_, revoked := ids[id]
+	return revoked, nil
 }
 
-func (s *secretRevocations) getSecretData(ctx context.Context) ([]byte, error) {
+func (s *secretRevocations) revokedIDs(res core_model.Resource) (map[string]struct{}, error) {
+	s.Lock()
+	defer s.Unlock()
+	version := res.GetMeta().GetVersion()
+	modificationTime := res.GetMeta().GetModificationTime()
+	if s.ids != nil && version != "" && s.version == version && s.modificationTime.Equal(modificationTime) {
+		return s.ids, nil
+	}
+	list, err := ParseRevocationList(res.GetSpec().(*system_proto.Secret).GetData().GetValue())
+	if err != nil {
+		return nil, err
+	}
+	ids := make(map[string]struct{}, len(list.Revocations))
+	for _, revocation := range list.Revocations {
+		ids[revocation.ID] = struct{}{}
+	}
+	s.version = version
+	s.modificationTime = modificationTime
+	s.ids = ids
+	return ids, nil
+}
+
+func (s *secretRevocations) getSecret(ctx context.Context) (core_model.Resource, error) {
 	// Do a list operation instead of get because of the cache in ReadOnlyResourceManager
 	// For the majority of cases, users do not set revocation secret.
 	// We don't cache not found result of get operation, so with many execution to IsRevoked() we would send a lot of requests to a DB.
 	// We could do get requests and preserve separate cache here (taking into account resource not found),
 	// but there is a high chance that SecretResourceList is already in the cache, because of XDS reconciliation, so we can avoid I/O at all.
-	var resources core_model.ResourceList
-	if s.revocationKey.Mesh == "" {
-		resources = &system.GlobalSecretResourceList{}
-	} else {
-		resources = &system.SecretResourceList{}
-	}
-
+	resources := newRevocationSecretList(s.revocationKey)
 	if err := s.manager.List(ctx, resources, core_store.ListByMesh(s.revocationKey.Mesh)); err != nil {
 		return nil, err
 	}
 	for _, res := range resources.GetItems() {
 		if res.GetMeta().GetName() == s.revocationKey.Name {
-			return res.GetSpec().(*system_proto.Secret).GetData().GetValue(), nil
+			return res, nil
 		}
 	}
 	return nil, nil
 }
+
+// RevocationManager manages the revocation list stored in a secret.
+type RevocationManager interface {
+	// Revoke adds the token to the revocation list. Revoking an already revoked token is a noop.
+	Revoke(ctx context.Context, revocation Revocation) error
+	// List returns all entries of the revocation list.
+	List(ctx context.Context) ([]Revocation, error)
+	// Prune removes entries of tokens that expired before now and returns the number of removed entries.
+	Prune(ctx context.Context, now time.Time) (int, error)
+}
+
+func NewRevocationManager(resManager manager.ResourceManager, revocationKey core_model.ResourceKey, upsertCfg config_store.UpsertConfig) RevocationManager {
+	return &secretRevocationManager{
+		resManager:    resManager,
+		revocationKey: revocationKey,
+		upsertCfg:     upsertCfg,
+	}
+}
+
+type secretRevocationManager struct {
+	resManager    manager.ResourceManager
+	revocationKey core_model.ResourceKey
+	upsertCfg     config_store.UpsertConfig
+}
+
+var _ RevocationManager = &secretRevocationManager{}
+
+func (s *secretRevocationManager) Revoke(ctx context.Context, revocation Revocation) error {
+	if revocation.ID == "" {
+		return errors.New("token ID cannot be empty")
+	}
+	// Details are written first. If the write of IDs fails, the details are ignored until the token is revoked again.
+	if err := s.update(ctx, DetailsKey(s.revocationKey), func(list *RevocationList) bool {
+		if slices.ContainsFunc(list.Revocations, func(r Revocation) bool { return r.ID == revocation.ID }) {
+			return false
+		}
+		list.Revocations = append(list.Revocations, revocation)
+		return true
+	}, setSecretDetails); err != nil {
+		return errors.Wrap(err, "could not update details of revocations")
+	}
+	return s.update(ctx, s.revocationKey, func(list *RevocationList) bool {
+		if slices.ContainsFunc(list.Revocations, func(r Revocation) bool { return r.ID == revocation.ID }) {
+			return false
+		}
+		list.Revocations = append(list.Revocations, Revocation{ID: revocation.ID})
+		return true
+	}, setSecretIDs)
+}
+
+func (s *secretRevocationManager) List(ctx context.Context) ([]Revocation, error) {
+	ids, err := s.get(ctx, s.revocationKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(ids.Revocations) == 0 {
+		return nil, nil
+	}
+	details, err := s.get(ctx, DetailsKey(s.revocationKey))
+	if err != nil {
+		return nil, err
+	}
+	detailsByID := map[string]Revocation{}
+	for _, revocation := range details.Revocations {
+		detailsByID[revocation.ID] = revocation
+	}
+	// IDs are the source of truth, details may be missing for tokens revoked by an older control plane.
+	var revocations []Revocation
+	for _, revocation := range ids.Revocations {
+		if detailed, ok := detailsByID[revocation.ID]; ok {
+			revocation = detailed
+		}
+		revocations = append(revocations, revocation)
+	}
+	return revocations, nil
+}
+
+func (s *secretRevocationManager) Prune(ctx context.Context, now time.Time) (int, error) {
+	details, err := s.get(ctx, DetailsKey(s.revocationKey))
+	if err != nil {
+		return 0, err
+	}
+	expired := map[string]struct{}{}
+	for _, revocation := range details.Revocations {
+		if revocation.IsExpired(now) {
+			expired[revocation.ID] = struct{}{}
+		}
+	}
+	if len(expired) == 0 {
+		return 0, nil
+	}
+	isExpired := func(r Revocation) bool {
+		_, ok := expired[r.ID]
+		return ok
+	}
+	pruned := 0
+	// IDs are removed first, so a failed write of details is retried on the next prune.
+	if err := s.update(ctx, s.revocationKey, func(list *RevocationList) bool {
+		before := len(list.Revocations)
+		list.Revocations = slices.DeleteFunc(list.Revocations, isExpired)
+		pruned = before - len(list.Revocations)
+		return pruned > 0
+	}, setSecretIDs); err != nil {
+		return 0, err
+	}
+	if err := s.update(ctx, DetailsKey(s.revocationKey), func(list *RevocationList) bool {
+		before := len(list.Revocations)
+		list.Revocations = slices.DeleteFunc(list.Revocations, isExpired)
+		return before != len(list.Revocations)
+	}, setSecretDetails); err != nil {
+		return 0, errors.Wrap(err, "could not update details of revocations")
+	}
+	return pruned, nil
+}
+
+func (s *secretRevocationManager) get(ctx context.Context, key core_model.ResourceKey) (RevocationList, error) {
+	resource := newRevocationSecret(key)
+	if err := s.resManager.Get(ctx, resource, core_store.GetBy(key)); err != nil {
+		if core_store.IsNotFound(err) {
+			return RevocationList{}, nil
+		}
+		return RevocationList{}, err
+	}
+	return ParseRevocationList(secretData(resource))
+}
+
+// update applies the change to the list stored in the secret. The change returns false when the list is not modified.
+func (s *secretRevocationManager) update(
+	ctx context.Context,
+	key core_model.ResourceKey,
+	change func(list *RevocationList) bool,
+	set func(resource core_model.Resource, list RevocationList) error,
+) error {
+	return manager.Upsert(ctx, s.resManager, key, newRevocationSecret(key), func(resource core_model.Resource) error {
+		list, err := ParseRevocationList(secretData(resource))
+		if err != nil {
+			return err
+		}
+		if !change(&list) {
+			return manager.ErrSkipUpsert
+		}
+		return set(resource, list)
+	}, s.conflictRetry()) // we need retry because concurrent revokes and the pruner update the same secret
+}
+
+func (s *secretRevocationManager) conflictRetry() manager.UpsertFunc {
+	return manager.WithConflictRetry(s.upsertCfg.ConflictRetryBaseBackoff.Duration, s.upsertCfg.ConflictRetryMaxTimes, s.upsertCfg.ConflictRetryJitterPercent)
+}
+
+func newRevocationSecret(key core_model.ResourceKey) core_model.Resource {
+	if key.Mesh == "" {
+		return system.NewGlobalSecretResource()
+	}
+	return system.NewSecretResource()
+}
+
+func newRevocationSecretList(key core_model.ResourceKey) core_model.ResourceList {
+	if key.Mesh == "" {
+		return &system.GlobalSecretResourceList{}
+	}
+	return &system.SecretResourceList{}
+}
+
+func secretData(resource core_model.Resource) []byte {
+	return resource.GetSpec().(*system_proto.Secret).GetData().GetValue()
+}
+
+// setSecretIDs stores IDs in the comma separated format, so control planes that were not upgraded yet can read them.
+func setSecretIDs(resource core_model.Resource, list RevocationList) error {
+	ids := make([]string, 0, len(list.Revocations))
+	for _, revocation := range list.Revocations {
+		ids = append(ids, revocation.ID)
+	}
+	return resource.SetSpec(&system_proto.Secret{
+		Data: util_proto.Bytes([]byte(strings.Join(ids, ","))),
+	})
+}
+
+func setSecretDetails(resource core_model.Resource, list RevocationList) error {
+	data, err := json.Marshal(list)
+	if err != nil {
+		return err
+	}
+	return resource.SetSpec(&system_proto.Secret{
+		Data: util_proto.Bytes(data),
+	})
+}
@@ -0,0 +1,264 @@
+package tokens_test
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	system_proto "github.com/kumahq/kuma/v3/api/system/v1alpha1"
+	config_store "github.com/kumahq/kuma/v3/pkg/config/core/resources/store"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/manager"
+	core_model "github.com/kumahq/kuma/v3/pkg/core/resources/model"
+	core_store "github.com/kumahq/kuma/v3/pkg/core/resources/store"
+	"github.com/kumahq/kuma/v3/pkg/core/tokens"
+	"github.com/kumahq/kuma/v3/pkg/plugins/resources/memory"
+	util_proto "github.com/kumahq/kuma/v3/pkg/util/proto"
+)
+
+// barrierResourceManager blocks the first gets until all of them are issued,
+// so concurrent upserts read the same version of the resource.
+type barrierResourceManager struct {
+	manager.ResourceManager
+	sync.Mutex
+	waiting int
+	barrier sync.WaitGroup
+}
+
+func newBarrierResourceManager(rm manager.ResourceManager, parties int) *barrierResourceManager {
+	b := &barrierResourceManager{ResourceManager: rm, waiting: parties}
+	b.barrier.Add(parties)
+	return b
+}
+
+func (b *barrierResourceManager) Get(ctx context.Context, resource core_model.Resource, fs ...core_store.GetOptionsFunc) error {
+	err := b.ResourceManager.Get(ctx, resource, fs...)
+	b.Lock()
+	wait := b.waiting > 0
+	if wait {
+		b.waiting--
+		b.barrier.Done()
+	}
+	b.Unlock()
+	if wait {
+		b.barrier.Wait()
+	}
+	return err
+}
+
+var _ = Describe("Revocations", func() {
+	Describe("ParseRevocationList", func() {
+		It("should parse legacy format", func() {
+			// when
+			list, err := tokens.ParseRevocationList([]byte("id-1, id-2,\n"))
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(list.Revocations).To(Equal([]tokens.Revocation{{ID: "id-1"}, {ID: "id-2"}}))
+		})
+
+		It("should parse JSON format", func() {
+			// when
+			list, err := tokens.ParseRevocationList([]byte(`{"revocations":[{"id":"id-1","reason":"leaked","expiresAt":"2030-01-01T00:00:00Z"}]}`))
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(list.Revocations).To(HaveLen(1))
+			Expect(list.Revocations[0].ID).To(Equal("id-1"))
+			Expect(list.Revocations[0].Reason).To(Equal("leaked"))
+			Expect(*list.Revocations[0].ExpiresAt).To(Equal(time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)))
+		})
+
+		It("should return an error on invalid JSON", func() {
+			// when
+			_, err := tokens.ParseRevocationList([]byte(`{"revocations":`))
+
+			// then
+			Expect(err).To(MatchError(ContainSubstring("could not parse revocation list")))
+		})
+	})
+
+	Describe("RevocationManager", func() {
+		var ctx context.Context
+		var store core_store.ResourceStore
+		var revocationManager tokens.RevocationManager
+		var revocations tokens.Revocations
+		now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+		BeforeEach(func() {
+			ctx = context.Background()
+			store = memory.NewStore()
+			resManager := manager.NewResourceManager(store)
+			revocationManager = tokens.NewRevocationManager(resManager, TokenRevocationsGlobalSecretKey, config_store.DefaultUpsertConfig())
+			revocations = tokens.NewRevocations(resManager, TokenRevocationsGlobalSecretKey)
+		})
+
+		It("should revoke tokens", func() {
+			// when
+			expiresAt := now.Add(time.Hour)
+			Expect(revocationManager.Revoke(ctx, tokens.Revocation{ID: "id-1", Reason: "leaked", ExpiresAt: &expiresAt})).To(Succeed())
+			Expect(revocationManager.Revoke(ctx, tokens.Revocation{ID: "id-2"})).To(Succeed())
+			Expect(revocationManager.Revoke(ctx, tokens.Revocation{ID: "id-1"})).To(Succeed())
+
+			// then
+			list, err := revocationManager.List(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(list).To(HaveLen(2))
+			Expect(list[0].Reason).To(Equal("leaked"))
+
+			// and
+			revoked, err := revocations.IsRevoked(ctx, "id-2")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(revoked).To(BeTrue())
+			revoked, err = revocations.IsRevoked(ctx, "id-3")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(revoked).To(BeFalse())
+		})
+
+		It("should not use cached IDs of a deleted and recreated secret", func() {
+			// given
+			secret := func(ids string) *system.GlobalSecretResource {
+				sec := system.NewGlobalSecretResource()
+				sec.Spec = &system_proto.Secret{Data: util_proto.Bytes([]byte(ids))}
+				return sec
+			}
+			Expect(store.Create(ctx, secret("id-1"), core_store.CreateBy(TokenRevocationsGlobalSecretKey), core_store.CreatedAt(now))).To(Succeed())
+			revoked, err := revocations.IsRevoked(ctx, "id-1")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(revoked).To(BeTrue())
+
+			// when the secret is recreated with the same version
+			Expect(store.Delete(ctx, system.NewGlobalSecretResource(), core_store.DeleteBy(TokenRevocationsGlobalSecretKey))).To(Succeed())
+			Expect(store.Create(ctx, secret("id-2"), core_store.CreateBy(TokenRevocationsGlobalSecretKey), core_store.CreatedAt(now.Add(time.Second)))).To(Succeed())
+
+			// then
+			revoked, err = revocations.IsRevoked(ctx, "id-1")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(revoked).To(BeFalse())
+			revoked, err = revocations.IsRevoked(ctx, "id-2")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(revoked).To(BeTrue())
+		})
+
+		It("should keep IDs readable by control planes using the legacy format", func() {
+			// given
+			expiresAt := now.Add(time.Hour)
+
+			// when
+			Expect(revocationManager.Revoke(ctx, tokens.Revocation{ID: "id-1", Reason: "leaked", ExpiresAt: &expiresAt})).To(Succeed())
+			Expect(revocationManager.Revoke(ctx, tokens.Revocation{ID: "id-2"})).To(Succeed())
+
+			// then
+			sec := system.NewGlobalSecretResource()
+			Expect(store.Get(ctx, sec, core_store.GetBy(TokenRevocationsGlobalSecretKey))).To(Succeed())
+			Expect(string(sec.Spec.GetData().GetValue())).To(Equal("id-1,id-2"))
+
+			// and details are stored in a separate secret
+			details := system.NewGlobalSecretResource()
+			Expect(store.Get(ctx, details, core_store.GetBy(tokens.DetailsKey(TokenRevocationsGlobalSecretKey)))).To(Succeed())
+			list, err := tokens.ParseRevocationList(details.Spec.GetData().GetValue())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(list.Revocations).To(HaveLen(2))
+			Expect(list.Revocations[0].Reason).To(Equal("leaked"))
+		})
+
+		It("should not lose concurrent revocations", func() {
+			// given
+			upsertCfg := config_store.DefaultUpsertConfig()
+			upsertCfg.ConflictRetryBaseBackoff.Duration = time.Millisecond
+			rm := newBarrierResourceManager(manager.NewResourceManager(store), 2)
+
+			// when
+			var wg sync.WaitGroup
+			errs := make(chan error, 2)
+			for _, id := range []string{"id-1", "id-2"} {
+				wg.Go(func() {
+					errs <- tokens.NewRevocationManager(rm, TokenRevocationsGlobalSecretKey, upsertCfg).Revoke(ctx, tokens.Revocation{ID: id})
+				})
+			}
+			wg.Wait()
+			close(errs)
+
+			// then
+			for err := range errs {
+				Expect(err).ToNot(HaveOccurred())
+			}
+			list, err := revocationManager.List(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(list).To(ConsistOf(tokens.Revocation{ID: "id-1"}, tokens.Revocation{ID: "id-2"}))
+		})
+
+		It("should convert legacy revocation list", func() {
+			// given
+			sec := system.NewGlobalSecretResource()
+			sec.Spec = &system_proto.Secret{
+				Data: util_proto.Bytes([]byte("id-1")),
+			}
+			Expect(store.Create(ctx, sec, core_store.CreateBy(TokenRevocationsGlobalSecretKey))).To(Succeed())
+
+			// when
+			Expect(revocationManager.Revoke(ctx, tokens.Revocation{ID: "id-2"})).To(Succeed())
+
+			// then
+			list, err := revocationManager.List(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(list).To(Equal([]tokens.Revocation{{ID: "id-1"}, {ID: "id-2"}}))
+		})
+
+		It("should prune expired tokens", func() {
+			// given
+			expired := now.Add(-time.Minute)
+			valid := now.Add(time.Minute)
+			Expect(revocationManager.Revoke(ctx, tokens.Revocation{ID: "expired", ExpiresAt: &expired})).To(Succeed())
+			Expect(revocationManager.Revoke(ctx, tokens.Revocation{ID: "valid", ExpiresAt: &valid})).To(Succeed())
+			Expect(revocationManager.Revoke(ctx, tokens.Revocation{ID: "no-expiration"})).To(Succeed())
+
+			// when
+			pruned, err := revocationManager.Prune(ctx, now)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pruned).To(Equal(1))
+			list, err := revocationManager.List(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(list).To(HaveLen(2))
+			Expect(list[0].ID).To(Equal("valid"))
+			Expect(list[1].ID).To(Equal("no-expiration"))
+		})
+
+		It("should prune expired tokens from both secrets", func() {
+			// given
+			expired := now.Add(-time.Minute)
+			Expect(revocationManager.Revoke(ctx, tokens.Revocation{ID: "expired", ExpiresAt: &expired})).To(Succeed())
+			Expect(revocationManager.Revoke(ctx, tokens.Revocation{ID: "no-expiration"})).To(Succeed())
+
+			// when
+			_, err := revocationManager.Prune(ctx, now)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			sec := system.NewGlobalSecretResource()
+			Expect(store.Get(ctx, sec, core_store.GetBy(TokenRevocationsGlobalSecretKey))).To(Succeed())
+			Expect(string(sec.Spec.GetData().GetValue())).To(Equal("no-expiration"))
+			details := system.NewGlobalSecretResource()
+			Expect(store.Get(ctx, details, core_store.GetBy(tokens.DetailsKey(TokenRevocationsGlobalSecretKey)))).To(Succeed())
+			list, err := tokens.ParseRevocationList(details.Spec.GetData().GetValue())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(list.Revocations).To(Equal([]tokens.Revocation{{ID: "no-expiration"}}))
+		})
+
+		It("should not create the secret when pruning empty list", func() {
+			// when
+			pruned, err := revocationManager.Prune(ctx, now)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pruned).To(Equal(0))
+			err = store.Get(ctx, system.NewGlobalSecretResource(), core_store.GetBy(TokenRevocationsGlobalSecretKey))
+			Expect(core_store.IsNotFound(err)).To(BeTrue())
+		})
+	})
+})
@@ -101,7 +101,12 @@ func dummyIdentity(identity *meshidentity_api.MeshIdentityResource) *core_xds.Wo
 }
 
 func Diff(before, after ProxyConfig) ([]api_common.JsonPatchItem, error) {
-	snapshotToNode := func(s map[string]any) (jd.JsonNode, error) {
+	return DiffJSON(before, after)
+}
+
+// DiffJSON computes a diff in JSONPatch format between JSON representations of any values.
+func DiffJSON(before, after any) ([]api_common.JsonPatchItem, error) {
+	snapshotToNode := func(s any) (jd.JsonNode, error) {
 		bytes, err := json.Marshal(s)
 		if err != nil {
 			return nil, err
@@ -1,6 +1,8 @@
 package tls
 
 import (
+	"slices"
+
 	envoy_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoy_tls "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
 	envoy_type_matcher "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
@@ -79,6 +81,15 @@ func KumaAlpnProtocol() Configurer[envoy_tls.CommonTlsContext] {
 	}
 }
 
+// AlpnProtocols exposes the protocols followed by Kuma's own protocol, so
+// connections between proxies of the mesh keep working.
+func AlpnProtocols(protocols []string) Configurer[envoy_tls.CommonTlsContext] {
+	return func(c *envoy_tls.CommonTlsContext) error {
+		c.AlpnProtocols = append(slices.Clone(protocols), xds_tls.KumaALPNProtocols...)
+		return nil
+	}
+}
+
 func CipherSuites(cipherSuites []common_tls.TlsCipher) Configurer[envoy_tls.CommonTlsContext] {
 	return func(c *envoy_tls.CommonTlsContext) error {
 		if c.TlsParams == nil {
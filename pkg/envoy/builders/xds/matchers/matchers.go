@@ -9,6 +9,7 @@ import (
 	actionv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/common/matcher/action/v3"
 	networkv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/matching/common_inputs/network/v3"
 	sslv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/matching/common_inputs/ssl/v3"
+	envoy_matcher "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
 	"google.golang.org/protobuf/types/known/anypb"
 
 	common_api "github.com/kumahq/kuma/v3/api/common/v1alpha1"
@@ -215,6 +216,45 @@ func SNIPredicate(sni *common_api.SNIMatch) Configurer[matcher_config.Matcher_Ma
 	}
 }
 
+// HttpRequestHeaderPredicate matches the value of the request header. For
+// pseudo-headers like ":path" or ":method" it matches the path and the method
+// of the request.
+func HttpRequestHeaderPredicate(name string, stringMatcher *matcher_config.StringMatcher) Configurer[matcher_config.Matcher_MatcherList_Predicate] {
+	return func(predicate *matcher_config.Matcher_MatcherList_Predicate) error {
+		predicate.MatchType = &matcher_config.Matcher_MatcherList_Predicate_SinglePredicate_{
+			SinglePredicate: &matcher_config.Matcher_MatcherList_Predicate_SinglePredicate{
+				Input: &xds_config.TypedExtensionConfig{
+					Name:        "envoy.matching.inputs.request_headers",
+					TypedConfig: util_proto.MustMarshalAny(&envoy_matcher.HttpRequestHeaderMatchInput{HeaderName: name}),
+				},
+				Matcher: &matcher_config.Matcher_MatcherList_Predicate_SinglePredicate_ValueMatch{
+					ValueMatch: stringMatcher,
+				},
+			},
+		}
+		return nil
+	}
+}
+
+// HttpRequestQueryParamPredicate matches the value of the query parameter of
+// the request.
+func HttpRequestQueryParamPredicate(name string, stringMatcher *matcher_config.StringMatcher) Configurer[matcher_config.Matcher_MatcherList_Predicate] {
+	return func(predicate *matcher_config.Matcher_MatcherList_Predicate) error {
+		predicate.MatchType = &matcher_config.Matcher_MatcherList_Predicate_SinglePredicate_{
+			SinglePredicate: &matcher_config.Matcher_MatcherList_Predicate_SinglePredicate{
+				Input: &xds_config.TypedExtensionConfig{
+					Name:        "envoy.matching.inputs.query_params",
+					TypedConfig: util_proto.MustMarshalAny(&envoy_matcher.HttpRequestQueryParamMatchInput{QueryParam: name}),
+				},
+				Matcher: &matcher_config.Matcher_MatcherList_Predicate_SinglePredicate_ValueMatch{
+					ValueMatch: stringMatcher,
+				},
+			},
+		}
+		return nil
+	}
+}
+
 func AndPredicate(matchers []*matcher_config.Matcher_MatcherList_Predicate) Configurer[matcher_config.Matcher_MatcherList_Predicate] {
 	return func(predicate *matcher_config.Matcher_MatcherList_Predicate) error {
 		if len(matchers) == 1 {
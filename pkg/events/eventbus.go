@@ -12,11 +12,62 @@ import (
 var log = core.Log.WithName("eventbus")
 
 type subscriber struct {
-	ch         chan Event
+	name       string
+	filter     Filter
 	predicates []Predicate
+	// only one of the channels is set depending on whether the listener receives sequence numbers
+	ch          chan Event
+	sequencedCh chan SequencedEvent
 }
 
-func NewEventBus(bufferSize uint, metrics core_metrics.Metrics) (EventBus, error) {
+func (s *subscriber) matches(event Event) bool {
+	matched := s.filter.Matches(event)
+	for _, predicate := range s.predicates {
+		if !predicate(event) {
+			matched = false
+		}
+	}
+	return matched
+}
+
+// offer enqueues the event without blocking. It returns false when the buffer of the listener is full.
+// The channel of a sequenced listener is closed then, so it doesn't receive events after the missed one.
+func (s *subscriber) offer(event SequencedEvent) bool {
+	if s.sequencedCh != nil {
+		select {
+		case s.sequencedCh <- event:
+			return true
+		default:
+			close(s.sequencedCh)
+			return false
+		}
+	}
+	select {
+	case s.ch <- event.Event:
+		return true
+	default:
+		return false
+	}
+}
+
+func (s *subscriber) queued() int {
+	if s.sequencedCh != nil {
+		return len(s.sequencedCh)
+	}
+	return len(s.ch)
+}
+
+type Option func(*eventBus)
+
+// WithHistorySize sets the number of the most recent events kept by the event bus, so they can be replayed
+// to sequenced listeners that resume their subscription. History is disabled when the size is 0.
+func WithHistorySize(size uint) Option {
+	return func(b *eventBus) {
+		b.history = newHistory(b.instanceID, size)
+	}
+}
+
+func NewEventBus(bufferSize uint, metrics core_metrics.Metrics, opts ...Option) (EventBus, error) {
 	metric := prometheus.NewCounter(prometheus.CounterOpts{
 		Name: "events_dropped",
 		Help: "Number of dropped events in event bus due to full channels",
@@ -24,65 +75,188 @@ func NewEventBus(bufferSize uint, metrics core_metrics.Metrics) (EventBus, error
 	if err := metrics.Register(metric); err != nil {
 		return nil, err
 	}
-	return &eventBus{
-		subscribers: map[string]subscriber{},
-		bufferSize:  bufferSize,
-		metric:      metric,
-	}, nil
+	listenerDropped := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "events_listener_dropped",
+		Help: "Number of dropped events in event bus due to full channel of a named listener",
+	}, []string{"listener"})
+	if err := metrics.Register(listenerDropped); err != nil {
+		return nil, err
+	}
+	instanceID := core.NewUUID()
+	bus := &eventBus{
+		instanceID:      instanceID,
+		subscribers:     map[string]*subscriber{},
+		bufferSize:      bufferSize,
+		metric:          metric,
+		listenerDropped: listenerDropped,
+		history:         newHistory(instanceID, 0),
+	}
+	for _, opt := range opts {
+		opt(bus)
+	}
+	if err := metrics.Register(&lagCollector{bus: bus}); err != nil {
+		return nil, err
+	}
+	return bus, nil
 }
 
 type eventBus struct {
-	mtx         sync.RWMutex
-	subscribers map[string]subscriber
-	bufferSize  uint
-	metric      prometheus.Counter
+	mtx             sync.RWMutex
+	subscribers     map[string]*subscriber
+	bufferSize      uint
+	metric          prometheus.Counter
+	listenerDropped *prometheus.CounterVec
+	instanceID      string
+
+	// sendMtx serializes Sends, so listeners receive events in the order of their sequence numbers.
+	// It guards lastSeq and history and is acquired before mtx.
+	sendMtx sync.Mutex
+	lastSeq uint64
+	history *history
 }
 
 // Subscribe subscribes to a stream of events given Predicates
 // Predicate should not block on I/O, otherwise the whole event bus can block.
 // All predicates must pass for the event to enqueued.
 func (b *eventBus) Subscribe(predicates ...Predicate) Listener {
-	id := core.NewUUID()
 	b.mtx.Lock()
 	defer b.mtx.Unlock()
 
 	events := make(chan Event, b.bufferSize)
-	b.subscribers[id] = subscriber{
+	id := b.subscribe(&subscriber{
 		ch:         events,
 		predicates: predicates,
-	}
+	})
 	return &reader{
 		events: events,
-		close: func() {
-			b.mtx.Lock()
-			defer b.mtx.Unlock()
-			delete(b.subscribers, id)
-		},
+		close:  b.unsubscribeFn(id),
+	}
+}
+
+// SubscribeSequenced subscribes to a stream of events with sequence numbers.
+// When the subscription resumes from a sequence number, matching events from the history are enqueued first,
+// so the listener doesn't miss events that were sent while it was not subscribed.
+// The channel of the listener is closed when its buffer is full.
+func (b *eventBus) SubscribeSequenced(s Subscription) (SequencedListener, error) {
+	b.sendMtx.Lock()
+	defer b.sendMtx.Unlock()
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	sub := &subscriber{
+		name:       s.Name,
+		filter:     s.Filter,
+		predicates: s.Predicates,
+	}
+	var replay []SequencedEvent
+	if s.Since != nil {
+		// no event is being sent while the lock is held, so every event up to lastSeq is in the history
+		events, ok := b.history.since(*s.Since, b.lastSeq)
+		if !ok {
+			return nil, ResyncRequiredErr
+		}
+		for _, event := range events {
+			if sub.matches(event.Event) {
+				replay = append(replay, event)
+			}
+		}
+	}
+	sub.sequencedCh = make(chan SequencedEvent, int(b.bufferSize)+len(replay))
+	for _, event := range replay {
+		sub.sequencedCh <- event
+	}
+	id := b.subscribe(sub)
+	return &sequencedReader{
+		events: sub.sequencedCh,
+		close:  b.unsubscribeFn(id),
+	}, nil
+}
+
+func (b *eventBus) subscribe(sub *subscriber) string {
+	id := core.NewUUID()
+	b.subscribers[id] = sub
+	return id
+}
+
+func (b *eventBus) unsubscribeFn(id string) func() {
+	return func() {
+		b.mtx.Lock()
+		defer b.mtx.Unlock()
+		delete(b.subscribers, id)
 	}
 }
 
+// Send enqueues the event for every matching listener.
 func (b *eventBus) Send(event Event) {
+	b.sendMtx.Lock()
+	defer b.sendMtx.Unlock()
+
+	b.lastSeq++
+	sequenced := SequencedEvent{InstanceID: b.instanceID, Seq: b.lastSeq, Event: event}
+	b.history.add(sequenced)
+
 	b.mtx.RLock()
-	defer b.mtx.RUnlock()
-	for _, sub := range b.subscribers {
-		matched := true
-		for _, predicate := range sub.predicates {
-			if !predicate(event) {
-				matched = false
-			}
+	var fellBehind []string
+	for id, sub := range b.subscribers {
+		if !sub.matches(event) {
+			continue
 		}
-		if matched {
-			select {
-			case sub.ch <- event:
-			default:
-				b.metric.Inc()
-				log.Info("[WARNING] event is not sent because the channel is full. Ignoring event. Consider increasing buffer size using KUMA_EVENT_BUS_BUFFER_SIZE",
-					"bufferSize", b.bufferSize,
-					"event", event,
-				)
+		if !sub.offer(sequenced) {
+			b.metric.Inc()
+			if sub.name != "" {
+				b.listenerDropped.WithLabelValues(sub.name).Inc()
+			}
+			log.Info("[WARNING] event is not sent because the channel is full. Ignoring event. Consider increasing buffer size using KUMA_EVENT_BUS_BUFFER_SIZE",
+				"bufferSize", b.bufferSize,
+				"listener", sub.name,
+				"event", event,
+			)
+			if sub.sequencedCh != nil {
+				// the sequenced listener is closed instead of silently missing the event,
+				// so it can resume its subscription from the last received event
+				fellBehind = append(fellBehind, id)
 			}
 		}
 	}
+	b.mtx.RUnlock()
+
+	if len(fellBehind) > 0 {
+		b.mtx.Lock()
+		for _, id := range fellBehind {
+			delete(b.subscribers, id)
+		}
+		b.mtx.Unlock()
+	}
+}
+
+var lagDesc = prometheus.NewDesc(
+	"events_listener_lag",
+	"Number of events enqueued for a named listener that were not received yet",
+	[]string{"listener"},
+	nil,
+)
+
+// lagCollector reports the lag of listeners when metrics are scraped, so it's not computed on every event.
+type lagCollector struct {
+	bus *eventBus
+}
+
+func (c *lagCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- lagDesc
+}
+
+func (c *lagCollector) Collect(ch chan<- prometheus.Metric) {
+	c.bus.mtx.RLock()
+	lagByName := map[string]int{}
+	for _, sub := range c.bus.subscribers {
+		if sub.name != "" {
+			lagByName[sub.name] += sub.queued()
+		}
+	}
+	c.bus.mtx.RUnlock()
+	for name, lag := range lagByName {
+		ch <- prometheus.MustNewConstMetric(lagDesc, prometheus.GaugeValue, float64(lag), name)
+	}
 }
 
 type reader struct {
@@ -97,3 +271,16 @@ func (k *reader) Recv() <-chan Event {
 func (k *reader) Close() {
 	k.close()
 }
+
+type sequencedReader struct {
+	events chan SequencedEvent
+	close  func()
+}
+
+func (k *sequencedReader) Recv() <-chan SequencedEvent {
+	return k.events
+}
+
+func (k *sequencedReader) Close() {
+	k.close()
+}
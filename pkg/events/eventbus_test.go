@@ -1,12 +1,16 @@
 package events_test
 
 import (
+	"sync"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	"github.com/kumahq/kuma/v3/pkg/core/resources/model"
 	"github.com/kumahq/kuma/v3/pkg/events"
 	core_metrics "github.com/kumahq/kuma/v3/pkg/metrics"
 	test_metrics "github.com/kumahq/kuma/v3/pkg/test/metrics"
+	"github.com/kumahq/kuma/v3/pkg/util/pointer"
 )
 
 var _ = Describe("EventBus", func() {
@@ -66,4 +70,253 @@ var _ = Describe("EventBus", func() {
 		Expect(chHadEvent(listener.Recv())).To(BeFalse())
 		Expect(test_metrics.FindMetric(metrics, "events_dropped").Counter.GetValue()).To(Equal(0.0))
 	})
+
+	Describe("sequenced listeners", func() {
+		var metrics core_metrics.Metrics
+		var eventBus events.EventBus
+
+		BeforeEach(func() {
+			var err error
+			metrics, err = core_metrics.NewMetrics("")
+			Expect(err).ToNot(HaveOccurred())
+			eventBus, err = events.NewEventBus(10, metrics, events.WithHistorySize(3))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		changed := func(mesh string) events.ResourceChangedEvent {
+			return events.ResourceChangedEvent{
+				Operation: events.Update,
+				Type:      "Dataplane",
+				Key:       model.ResourceKey{Mesh: mesh, Name: "dp-1"},
+			}
+		}
+
+		// firstEventID sends the event and returns its id
+		firstEventID := func(event events.Event) events.EventID {
+			listener, err := eventBus.SubscribeSequenced(events.Subscription{})
+			Expect(err).ToNot(HaveOccurred())
+			defer listener.Close()
+			eventBus.Send(event)
+			return (<-listener.Recv()).ID()
+		}
+
+		It("should assign sequence numbers to events", func() {
+			// given
+			listener, err := eventBus.SubscribeSequenced(events.Subscription{})
+			Expect(err).ToNot(HaveOccurred())
+			defer listener.Close()
+
+			// when
+			eventBus.Send(changed("mesh-1"))
+			eventBus.Send(changed("mesh-2"))
+
+			// then
+			first := <-listener.Recv()
+			Expect(first.InstanceID).ToNot(BeEmpty())
+			Expect(first).To(Equal(events.SequencedEvent{InstanceID: first.InstanceID, Seq: 1, Event: changed("mesh-1")}))
+			Expect(<-listener.Recv()).To(Equal(events.SequencedEvent{InstanceID: first.InstanceID, Seq: 2, Event: changed("mesh-2")}))
+		})
+
+		It("should replay events from the history", func() {
+			// given
+			since := firstEventID(changed("mesh-1"))
+			eventBus.Send(changed("mesh-2"))
+			eventBus.Send(changed("mesh-1"))
+
+			// when
+			listener, err := eventBus.SubscribeSequenced(events.Subscription{
+				Filter: events.Filter{Meshes: []string{"mesh-1"}},
+				Since:  &since,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			defer listener.Close()
+			eventBus.Send(changed("mesh-1"))
+
+			// then only events after the sequence number and matching the filter are received
+			Expect(<-listener.Recv()).To(Equal(events.SequencedEvent{InstanceID: since.InstanceID, Seq: 3, Event: changed("mesh-1")}))
+			Expect(<-listener.Recv()).To(Equal(events.SequencedEvent{InstanceID: since.InstanceID, Seq: 4, Event: changed("mesh-1")}))
+		})
+
+		It("should not replay anything when the listener is up to date", func() {
+			// given
+			since := firstEventID(changed("mesh-1"))
+
+			// when
+			listener, err := eventBus.SubscribeSequenced(events.Subscription{Since: &since})
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			defer listener.Close()
+			Expect(listener.Recv()).To(BeEmpty())
+		})
+
+		DescribeTable("should require resync when events are not in the history",
+			func(since func(id events.EventID) events.EventID) {
+				// given
+				id := firstEventID(changed("mesh-1"))
+				for range 4 {
+					eventBus.Send(changed("mesh-1"))
+				}
+
+				// when
+				_, err := eventBus.SubscribeSequenced(events.Subscription{Since: pointer.To(since(id))})
+
+				// then
+				Expect(err).To(MatchError(events.ResyncRequiredErr))
+			},
+			Entry("evicted events", func(id events.EventID) events.EventID {
+				return id
+			}),
+			Entry("sequence issued before restart", func(id events.EventID) events.EventID {
+				return events.EventID{InstanceID: id.InstanceID, Seq: 10}
+			}),
+			Entry("sequence issued by another instance", func(id events.EventID) events.EventID {
+				return events.EventID{InstanceID: "other", Seq: 5}
+			}),
+		)
+
+		DescribeTable("ParseEventID",
+			func(value string, expected events.EventID, expectedErr string) {
+				id, err := events.ParseEventID(value)
+				if expectedErr != "" {
+					Expect(err).To(MatchError(expectedErr))
+					return
+				}
+				Expect(err).ToNot(HaveOccurred())
+				Expect(id).To(Equal(expected))
+				Expect(id.String()).To(Equal(value))
+			},
+			Entry("valid id", "5f0e-42:7", events.EventID{InstanceID: "5f0e-42", Seq: 7}, ""),
+			Entry("missing instance", "7", events.EventID{}, `event id "7" is not in the format <instance id>:<sequence number>`),
+			Entry("invalid sequence", "i:x", events.EventID{}, `sequence number "x" of event id "i:x" is not a number`),
+		)
+
+		It("should close a listener that fell behind", func() {
+			// given
+			listener, err := eventBus.SubscribeSequenced(events.Subscription{Name: "test"})
+			Expect(err).ToNot(HaveOccurred())
+			defer listener.Close()
+
+			// when
+			for range 11 {
+				eventBus.Send(changed("mesh-1"))
+			}
+
+			// then buffered events are received before the channel is closed
+			var received []events.SequencedEvent
+			for event := range listener.Recv() {
+				received = append(received, event)
+			}
+			Expect(received).To(HaveLen(10))
+			Expect(test_metrics.FindMetric(metrics, "events_listener_dropped", "listener", "test").Counter.GetValue()).To(Equal(1.0))
+			Expect(test_metrics.FindMetric(metrics, "events_dropped").Counter.GetValue()).To(Equal(1.0))
+		})
+
+		It("should deliver events sent concurrently in the order of their sequence numbers", func() {
+			// given
+			metrics, err := core_metrics.NewMetrics("")
+			Expect(err).ToNot(HaveOccurred())
+			eventBus, err := events.NewEventBus(100, metrics, events.WithHistorySize(100))
+			Expect(err).ToNot(HaveOccurred())
+			var listeners []events.SequencedListener
+			for range 3 {
+				listener, err := eventBus.SubscribeSequenced(events.Subscription{})
+				Expect(err).ToNot(HaveOccurred())
+				defer listener.Close()
+				listeners = append(listeners, listener)
+			}
+
+			// when
+			var wg sync.WaitGroup
+			for range 10 {
+				wg.Go(func() {
+					for range 10 {
+						eventBus.Send(changed("mesh-1"))
+					}
+				})
+			}
+			wg.Wait()
+
+			// then
+			var instanceID string
+			for _, listener := range listeners {
+				var seq uint64
+				for range 100 {
+					event := <-listener.Recv()
+					instanceID = event.InstanceID
+					Expect(event.Seq).To(Equal(seq + 1))
+					seq = event.Seq
+				}
+			}
+
+			// and events from the history are replayed in order
+			resumed, err := eventBus.SubscribeSequenced(events.Subscription{Since: &events.EventID{InstanceID: instanceID, Seq: 50}})
+			Expect(err).ToNot(HaveOccurred())
+			defer resumed.Close()
+			for seq := uint64(51); seq <= 100; seq++ {
+				Expect((<-resumed.Recv()).Seq).To(Equal(seq))
+			}
+		})
+
+		It("should close a listener that fell behind on concurrent sends once", func() {
+			// given
+			listener, err := eventBus.SubscribeSequenced(events.Subscription{Name: "test"})
+			Expect(err).ToNot(HaveOccurred())
+			defer listener.Close()
+
+			// when
+			var wg sync.WaitGroup
+			for range 10 {
+				wg.Go(func() {
+					for range 10 {
+						eventBus.Send(changed("mesh-1"))
+					}
+				})
+			}
+			wg.Wait()
+
+			// then
+			var received []events.SequencedEvent
+			for event := range listener.Recv() {
+				received = append(received, event)
+			}
+			Expect(received).To(HaveLen(10))
+		})
+
+		It("should report lag of named listeners", func() {
+			// given
+			listener, err := eventBus.SubscribeSequenced(events.Subscription{Name: "test"})
+			Expect(err).ToNot(HaveOccurred())
+			defer listener.Close()
+
+			// when
+			for range 5 {
+				eventBus.Send(changed("mesh-1"))
+			}
+			<-listener.Recv()
+
+			// then
+			Expect(test_metrics.FindMetric(metrics, "events_listener_lag", "listener", "test").Gauge.GetValue()).To(Equal(4.0))
+		})
+	})
+
+	DescribeTable("Filter",
+		func(filter events.Filter, event events.Event, matches bool) {
+			Expect(filter.Matches(event)).To(Equal(matches))
+		},
+		Entry("empty filter matches any event", events.Filter{}, events.TriggerInsightsComputationEvent{}, true),
+		Entry("resource type", events.Filter{ResourceTypes: []model.ResourceType{"Mesh"}}, events.ResourceChangedEvent{Type: "Mesh"}, true),
+		Entry("other resource type", events.Filter{ResourceTypes: []model.ResourceType{"Mesh"}}, events.ResourceChangedEvent{Type: "Dataplane"}, false),
+		Entry("mesh and tenant",
+			events.Filter{Meshes: []string{"default"}, TenantIDs: []string{"t1"}},
+			events.ResourceChangedEvent{Key: model.ResourceKey{Mesh: "default"}, TenantID: "t1"},
+			true,
+		),
+		Entry("other tenant",
+			events.Filter{Meshes: []string{"default"}, TenantIDs: []string{"t1"}},
+			events.ResourceChangedEvent{Key: model.ResourceKey{Mesh: "default"}, TenantID: "t2"},
+			false,
+		),
+		Entry("event without the attribute", events.Filter{Meshes: []string{"default"}}, events.TriggerKDSResyncEvent{Type: "Mesh"}, false),
+	)
 })
@@ -0,0 +1,58 @@
+package events
+
+import (
+	"slices"
+
+	"github.com/kumahq/kuma/v3/pkg/core/resources/model"
+)
+
+// Filter selects events by their attributes. Empty fields match all events.
+// An event that doesn't carry an attribute doesn't match a filter on this attribute,
+// for example TriggerInsightsComputationEvent doesn't match a filter on resource types.
+type Filter struct {
+	ResourceTypes []model.ResourceType
+	Meshes        []string
+	TenantIDs     []string
+}
+
+func (f Filter) IsEmpty() bool {
+	return len(f.ResourceTypes) == 0 && len(f.Meshes) == 0 && len(f.TenantIDs) == 0
+}
+
+func (f Filter) Matches(event Event) bool {
+	if f.IsEmpty() {
+		return true
+	}
+	attrs := attributesOf(event)
+	if len(f.ResourceTypes) > 0 && (attrs.resourceType == nil || !slices.Contains(f.ResourceTypes, *attrs.resourceType)) {
+		return false
+	}
+	if len(f.Meshes) > 0 && (attrs.mesh == nil || !slices.Contains(f.Meshes, *attrs.mesh)) {
+		return false
+	}
+	if len(f.TenantIDs) > 0 && (attrs.tenantID == nil || !slices.Contains(f.TenantIDs, *attrs.tenantID)) {
+		return false
+	}
+	return true
+}
+
+type attributes struct {
+	resourceType *model.ResourceType
+	mesh         *string
+	tenantID     *string
+}
+
+func attributesOf(event Event) attributes {
+	switch e := event.(type) {
+	case ResourceChangedEvent:
+		return attributes{resourceType: &e.Type, mesh: &e.Key.Mesh, tenantID: &e.TenantID}
+	case TriggerKDSResyncEvent:
+		return attributes{resourceType: &e.Type}
+	case TriggerInsightsComputationEvent:
+		return attributes{tenantID: &e.TenantID}
+	case WorkloadIdentityChangedEvent:
+		return attributes{mesh: &e.ResourceKey.Mesh}
+	default:
+		return attributes{}
+	}
+}
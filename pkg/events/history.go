@@ -0,0 +1,57 @@
+package events
+
+// history is a ring buffer of the most recent events.
+type history struct {
+	// instanceID identifies the event bus, sequence numbers of other instances or of previous runs
+	// of the control plane can't be resumed from this history.
+	instanceID string
+	items      []SequencedEvent
+	start      int
+	len        int
+}
+
+func newHistory(instanceID string, size uint) *history {
+	return &history{
+		instanceID: instanceID,
+		items:      make([]SequencedEvent, size),
+	}
+}
+
+func (h *history) add(event SequencedEvent) {
+	if len(h.items) == 0 {
+		return
+	}
+	if h.len < len(h.items) {
+		h.items[(h.start+h.len)%len(h.items)] = event
+		h.len++
+		return
+	}
+	h.items[h.start] = event
+	h.start = (h.start + 1) % len(h.items)
+}
+
+// since returns events that came after the event with the id.
+// It returns false when some of these events are no longer in the history. lastSeq is the sequence number of the last sent event.
+func (h *history) since(id EventID, lastSeq uint64) ([]SequencedEvent, bool) {
+	if id.InstanceID != h.instanceID {
+		// the id was issued by another instance or before a restart of the control plane
+		return nil, false
+	}
+	if id.Seq > lastSeq {
+		return nil, false
+	}
+	if id.Seq == lastSeq {
+		return nil, true
+	}
+	if h.len == 0 || h.items[h.start].Seq > id.Seq+1 {
+		return nil, false
+	}
+	var events []SequencedEvent
+	for i := range h.len {
+		event := h.items[(h.start+i)%len(h.items)]
+		if event.Seq > id.Seq {
+			events = append(events, event)
+		}
+	}
+	return events, true
+}
@@ -1,6 +1,9 @@
 package events
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -19,6 +22,19 @@ const (
 	Delete
 )
 
+func (o Op) String() string {
+	switch o {
+	case Create:
+		return "Create"
+	case Update:
+		return "Update"
+	case Delete:
+		return "Delete"
+	default:
+		return "Unknown"
+	}
+}
+
 type ResourceChangedEvent struct {
 	Operation Op
 	Type      model.ResourceType
@@ -73,7 +89,75 @@ type ListenerFactory interface {
 	Subscribe(...Predicate) Listener
 }
 
+// EventID identifies a sequenced event. Sequence numbers are assigned by each instance of the control plane
+// independently, so they can be only resumed on the instance which issued them.
+type EventID struct {
+	// InstanceID is generated by the event bus on start, so it changes on every restart of the control plane.
+	InstanceID string
+	Seq        uint64
+}
+
+// String returns the id in the format `<instance id>:<sequence number>`.
+func (i EventID) String() string {
+	return fmt.Sprintf("%s:%d", i.InstanceID, i.Seq)
+}
+
+// ParseEventID parses the id in the format returned by EventID.String().
+func ParseEventID(value string) (EventID, error) {
+	instanceID, seq, found := strings.Cut(value, ":")
+	if !found || instanceID == "" {
+		return EventID{}, errors.Errorf("event id %q is not in the format <instance id>:<sequence number>", value)
+	}
+	n, err := strconv.ParseUint(seq, 10, 64)
+	if err != nil {
+		return EventID{}, errors.Errorf("sequence number %q of event id %q is not a number", seq, value)
+	}
+	return EventID{InstanceID: instanceID, Seq: n}, nil
+}
+
+// SequencedEvent is an event with a sequence number assigned by the event bus.
+// Sequence numbers start from 1 and are not preserved across restarts of the control plane.
+type SequencedEvent struct {
+	InstanceID string
+	Seq        uint64
+	Event      Event
+}
+
+func (e SequencedEvent) ID() EventID {
+	return EventID{InstanceID: e.InstanceID, Seq: e.Seq}
+}
+
+// SequencedListener receives events with sequence numbers. Unlike Listener, it doesn't silently miss events
+// when it falls behind. Its channel is closed instead, and it should subscribe again from the last received event.
+type SequencedListener interface {
+	Recv() <-chan SequencedEvent
+	Close()
+}
+
+// Subscription describes a subscription to a stream of sequenced events.
+type Subscription struct {
+	// Name identifies the listener in metrics. It should not contain high cardinality values.
+	Name string
+	// Filter selects events by their attributes.
+	Filter Filter
+	// Predicates have to pass for the event to be enqueued, the same as in ListenerFactory.
+	Predicates []Predicate
+	// Since is the id of the last event received by the listener.
+	// When set, events from the history of the event bus that came after it are replayed before new events.
+	Since *EventID
+}
+
+// ResyncRequiredErr is returned when events that came after the requested event are no longer in the history
+// or the event was issued by another instance of the control plane.
+// The listener should then recompute its state from scratch instead of relying on events.
+var ResyncRequiredErr = errors.New("events since the requested event are not available, resync required")
+
+type SequencedListenerFactory interface {
+	SubscribeSequenced(Subscription) (SequencedListener, error)
+}
+
 type EventBus interface {
 	Emitter
 	ListenerFactory
+	SequencedListenerFactory
 }
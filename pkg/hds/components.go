@@ -64,6 +64,7 @@ func DefaultCallbacks(rt core_runtime.Runtime, cache envoy_cache.SnapshotCache)
 			hasher{},
 			metrics,
 			rt.Config().GetEnvoyAdminPort(),
+			rt.Config().Store.Upsert,
 		),
 	}, nil
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/pkg/errors"
 
 	mesh_proto "github.com/kumahq/kuma/v3/api/mesh/v1alpha1"
+	config_store "github.com/kumahq/kuma/v3/pkg/config/core/resources/store"
 	dp_server "github.com/kumahq/kuma/v3/pkg/config/dp-server"
 	"github.com/kumahq/kuma/v3/pkg/core"
 	"github.com/kumahq/kuma/v3/pkg/core/resources/apis/mesh"
@@ -31,6 +32,7 @@ import (
 type streams struct {
 	watchdogCancel context.CancelFunc
 	activeStreams  map[xds.StreamID]bool
+	node           *envoy_core.Node
 }
 
 type tracker struct {
@@ -39,6 +41,7 @@ type tracker struct {
 	reconciler      *reconciler
 	log             logr.Logger
 	metrics         *hds_metrics.Metrics
+	upsertCfg       config_store.UpsertConfig
 
 	sync.RWMutex       // protects access to the fields below
 	streamsAssociation map[xds.StreamID]core_model.ResourceKey
@@ -54,6 +57,7 @@ func NewCallbacks(
 	hasher envoy_cache.NodeHash,
 	metrics *hds_metrics.Metrics,
 	defaultAdminPort uint32,
+	upsertCfg config_store.UpsertConfig,
 ) hds_callbacks.Callbacks {
 	return &tracker{
 		resourceManager:    resourceManager,
@@ -62,6 +66,7 @@ func NewCallbacks(
 		config:             config,
 		log:                log,
 		metrics:            metrics,
+		upsertCfg:          upsertCfg,
 		reconciler: &reconciler{
 			cache:     cache,
 			hasher:    hasher,
@@ -123,6 +128,7 @@ func (t *tracker) OnHealthCheckRequest(streamID xds.StreamID, req *envoy_service
 		streams.activeStreams = map[xds.StreamID]bool{}
 	}
 	streams.activeStreams[streamID] = true
+	streams.node = req.Node
 
 	if streams.watchdogCancel == nil { // watchdog was not started yet
 		ctx, cancel := context.WithCancel(context.Background())
@@ -168,6 +174,7 @@ func (t *tracker) OnEndpointHealthResponse(streamID xds.StreamID, resp *envoy_se
 	t.metrics.ResponsesReceivedMetric.Inc()
 
 	healthMap := map[uint32]bool{}
+	statuses := map[uint32]envoy_core.HealthStatus{}
 	envoyHealth := true // if there is no Envoy HC, assume it's healthy
 
 	for _, clusterHealth := range resp.GetClusterEndpointsHealth() {
@@ -190,15 +197,16 @@ func (t *tracker) OnEndpointHealthResponse(streamID xds.StreamID, resp *envoy_se
 				return err
 			}
 			healthMap[port] = health
+			statuses[port] = status
 		}
 	}
-	if err := t.updateDataplane(streamID, healthMap, envoyHealth); err != nil {
+	if err := t.updateDataplane(streamID, healthMap, statuses, envoyHealth); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (t *tracker) updateDataplane(streamID xds.StreamID, healthMap map[uint32]bool, envoyHealth bool) error {
+func (t *tracker) updateDataplane(streamID xds.StreamID, healthMap map[uint32]bool, statuses map[uint32]envoy_core.HealthStatus, envoyHealth bool) error {
 	ctx := user.Ctx(context.Background(), user.ControlPlane)
 	t.RLock()
 	defer t.RUnlock()
@@ -240,8 +248,68 @@ func (t *tracker) updateDataplane(streamID xds.StreamID, healthMap map[uint32]bo
 
 	if changed {
 		t.log.V(1).Info("status updated", "dataplaneKey", dataplaneKey)
-		return t.resourceManager.Update(ctx, dp, store.UpdateWithLabels(dp.Meta.GetLabels()))
+		if err := t.resourceManager.Update(ctx, dp, store.UpdateWithLabels(dp.Meta.GetLabels())); err != nil {
+			return err
+		}
 	}
 
+	// the insight only reports results of the probes, failing to store it must not close the stream
+	// which also stops updating readiness of the dataplane
+	probeTypes := t.reconciler.probeTypes(t.dpStreams[dataplaneKey].node)
+	if err := t.updateInsight(ctx, dataplaneKey, dp, statuses, probeTypes, envoyHealth); err != nil {
+		t.log.Error(err, "could not update health checks in DataplaneInsight", "dataplaneKey", dataplaneKey)
+	}
 	return nil
 }
+
+// updateInsight stores the results of the service probes in DataplaneInsight,
+// so they can be inspected in the _overview of the dataplane.
+func (t *tracker) updateInsight(
+	ctx context.Context,
+	dataplaneKey core_model.ResourceKey,
+	dp *mesh.DataplaneResource,
+	statuses map[uint32]envoy_core.HealthStatus,
+	probeTypes map[uint32]string,
+	envoyHealth bool,
+) error {
+	var inbounds []*mesh_proto.DataplaneInsight_HealthChecks_Inbound
+	for _, inbound := range dp.Spec.Networking.Inbound {
+		intf := dp.Spec.Networking.ToInboundInterface(inbound)
+		status, exist := statuses[intf.WorkloadPort]
+		if !exist {
+			continue
+		}
+		probeType, exist := probeTypes[intf.WorkloadPort]
+		if !exist {
+			continue
+		}
+		inbounds = append(inbounds, &mesh_proto.DataplaneInsight_HealthChecks_Inbound{
+			WorkloadPort: intf.WorkloadPort,
+			Probe:        probeType,
+			Status:       healthStatusName(status),
+		})
+	}
+
+	return manager.Upsert(ctx, t.resourceManager, dataplaneKey, mesh.NewDataplaneInsightResource(), func(resource core_model.Resource) error {
+		insight := resource.(*mesh.DataplaneInsightResource)
+		if !insight.Spec.UpdateHealthChecks(envoyHealth, inbounds, core.Now()) {
+			return manager.ErrSkipUpsert
+		}
+		return nil
+	}, manager.WithConflictRetry(t.upsertCfg.ConflictRetryBaseBackoff.Duration, t.upsertCfg.ConflictRetryMaxTimes, t.upsertCfg.ConflictRetryJitterPercent)) // we need retry because the xDS status sink also updates the insight.
+}
+
+var healthStatusNames = map[envoy_core.HealthStatus]string{
+	envoy_core.HealthStatus_HEALTHY:   "Healthy",
+	envoy_core.HealthStatus_UNHEALTHY: "Unhealthy",
+	envoy_core.HealthStatus_DRAINING:  "Draining",
+	envoy_core.HealthStatus_TIMEOUT:   "Timeout",
+	envoy_core.HealthStatus_DEGRADED:  "Degraded",
+}
+
+func healthStatusName(status envoy_core.HealthStatus) string {
+	if name, ok := healthStatusNames[status]; ok {
+		return name
+	}
+	return "Unknown"
+}
@@ -0,0 +1,145 @@
+package tracker
+
+import (
+	"context"
+	"time"
+
+	envoy_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_service_health "github.com/envoyproxy/go-control-plane/envoy/service/health/v3"
+	envoy_cache "github.com/envoyproxy/go-control-plane/pkg/cache/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/v3/api/mesh/v1alpha1"
+	config_store "github.com/kumahq/kuma/v3/pkg/config/core/resources/store"
+	dp_server "github.com/kumahq/kuma/v3/pkg/config/dp-server"
+	config_types "github.com/kumahq/kuma/v3/pkg/config/types"
+	"github.com/kumahq/kuma/v3/pkg/core"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/model"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/store"
+	hds_metrics "github.com/kumahq/kuma/v3/pkg/hds/metrics"
+	core_metrics "github.com/kumahq/kuma/v3/pkg/metrics"
+	"github.com/kumahq/kuma/v3/pkg/plugins/resources/memory"
+	"github.com/kumahq/kuma/v3/pkg/xds/envoy/names"
+)
+
+// conflictingInsightStore fails updates of DataplaneInsight with a conflict
+// the given number of times, like when the xDS status sink updates it meanwhile.
+type conflictingInsightStore struct {
+	store.ResourceStore
+	conflicts int
+}
+
+func (s *conflictingInsightStore) Update(ctx context.Context, resource model.Resource, fs ...store.UpdateOptionsFunc) error {
+	if resource.Descriptor().Name == mesh.DataplaneInsightType && s.conflicts > 0 {
+		s.conflicts--
+		return store.ErrorResourceConflict(resource.Descriptor().Name, resource.GetMeta().GetName(), resource.GetMeta().GetMesh())
+	}
+	return s.ResourceStore.Update(ctx, resource, fs...)
+}
+
+var _ = Describe("HDS callbacks", func() {
+	const streamID = 1
+	var insightStore *conflictingInsightStore
+	var resourceManager manager.ResourceManager
+	var callbacks *tracker
+
+	BeforeEach(func() {
+		insightStore = &conflictingInsightStore{ResourceStore: memory.NewStore()}
+		resourceManager = manager.NewResourceManager(insightStore)
+		ctx := context.Background()
+		Expect(resourceManager.Create(ctx, mesh.NewMeshResource(), store.CreateByKey("mesh-1", model.NoMesh))).To(Succeed())
+		dp := mesh.NewDataplaneResource()
+		dp.Spec = &mesh_proto.Dataplane{
+			Networking: &mesh_proto.Dataplane_Networking{
+				Address: "192.168.0.1",
+				Inbound: []*mesh_proto.Dataplane_Networking_Inbound{{
+					Port:        8080,
+					ServicePort: 80,
+					State:       mesh_proto.Dataplane_Networking_Inbound_Ready,
+					ServiceProbe: &mesh_proto.Dataplane_Networking_Inbound_ServiceProbe{
+						Tcp: &mesh_proto.Dataplane_Networking_Inbound_ServiceProbe_Tcp{},
+					},
+				}},
+			},
+		}
+		Expect(resourceManager.Create(ctx, dp, store.CreateByKey("dp-1", "mesh-1"))).To(Succeed())
+
+		metrics, err := core_metrics.NewMetrics("")
+		Expect(err).ToNot(HaveOccurred())
+		hdsMetrics, err := hds_metrics.NewMetrics(metrics)
+		Expect(err).ToNot(HaveOccurred())
+		hdsConfig := dp_server.DefaultHdsConfig()
+		hdsConfig.RefreshInterval = config_types.Duration{Duration: time.Hour}
+		callbacks = NewCallbacks(
+			core.Log,
+			resourceManager,
+			resourceManager,
+			envoy_cache.NewSnapshotCache(false, envoy_cache.IDHash{}, nil),
+			hdsConfig,
+			envoy_cache.IDHash{},
+			hdsMetrics,
+			9901,
+			config_store.UpsertConfig{
+				ConflictRetryBaseBackoff: config_types.Duration{Duration: time.Millisecond},
+				ConflictRetryMaxTimes:    3,
+			},
+		).(*tracker)
+
+		node := &envoy_core.Node{Id: "mesh-1.dp-1"}
+		Expect(callbacks.OnStreamOpen(ctx, streamID)).To(Succeed())
+		Expect(callbacks.OnHealthCheckRequest(streamID, &envoy_service_health.HealthCheckRequest{
+			Node: node,
+		})).To(Succeed())
+		// the probes are reported in the insight once their snapshot was set for the proxy
+		Expect(callbacks.reconciler.Reconcile(ctx, node)).To(Succeed())
+		DeferCleanup(callbacks.OnStreamClosed, int64(streamID))
+	})
+
+	unhealthyResponse := &envoy_service_health.EndpointHealthResponse{
+		ClusterEndpointsHealth: []*envoy_service_health.ClusterEndpointsHealth{{
+			ClusterName: names.GetLocalClusterName(80),
+			LocalityEndpointsHealth: []*envoy_service_health.LocalityEndpointsHealth{{
+				EndpointsHealth: []*envoy_service_health.EndpointHealth{{HealthStatus: envoy_core.HealthStatus_UNHEALTHY}},
+			}},
+		}},
+	}
+
+	insight := func() *mesh.DataplaneInsightResource {
+		insight := mesh.NewDataplaneInsightResource()
+		Expect(resourceManager.Get(context.Background(), insight, store.GetByKey("dp-1", "mesh-1"))).To(Succeed())
+		return insight
+	}
+
+	It("should retry updates of the insight on conflicts", func() {
+		// given an insight updated before
+		Expect(callbacks.OnEndpointHealthResponse(streamID, &envoy_service_health.EndpointHealthResponse{})).To(Succeed())
+		insightStore.conflicts = 2
+
+		// when
+		Expect(callbacks.OnEndpointHealthResponse(streamID, unhealthyResponse)).To(Succeed())
+
+		// then
+		Expect(insightStore.conflicts).To(BeZero())
+		Expect(insight().Spec.GetHealthChecks().GetInbounds()).To(HaveLen(1))
+		Expect(insight().Spec.GetHealthChecks().GetInbounds()[0].GetProbe()).To(Equal("tcp"))
+		Expect(insight().Spec.GetHealthChecks().GetInbounds()[0].GetStatus()).To(Equal("Unhealthy"))
+	})
+
+	It("should update readiness of the dataplane when the insight can't be updated", func() {
+		// given an insight that can't be updated
+		Expect(callbacks.OnEndpointHealthResponse(streamID, &envoy_service_health.EndpointHealthResponse{})).To(Succeed())
+		insightStore.conflicts = 10
+
+		// when
+		Expect(callbacks.OnEndpointHealthResponse(streamID, unhealthyResponse)).To(Succeed())
+
+		// then
+		dp := mesh.NewDataplaneResource()
+		Expect(resourceManager.Get(context.Background(), dp, store.GetByKey("dp-1", "mesh-1"))).To(Succeed())
+		Expect(dp.Spec.Networking.Inbound[0].State).To(Equal(mesh_proto.Dataplane_Networking_Inbound_NotReady))
+		Expect(insight().Spec.GetHealthChecks().GetInbounds()).To(BeEmpty())
+	})
+})
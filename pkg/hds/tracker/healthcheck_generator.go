@@ -6,25 +6,32 @@ import (
 	envoy_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoy_endpoint "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
 	envoy_service_health "github.com/envoyproxy/go-control-plane/envoy/service/health/v3"
+	envoy_type "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	"github.com/envoyproxy/go-control-plane/pkg/cache/types"
 	envoy_cache "github.com/envoyproxy/go-control-plane/pkg/cache/v3"
-	"google.golang.org/protobuf/types/known/durationpb"
-	"google.golang.org/protobuf/types/known/wrapperspb"
 
+	mesh_proto "github.com/kumahq/kuma/v3/api/mesh/v1alpha1"
 	dp_server "github.com/kumahq/kuma/v3/pkg/config/dp-server"
 	"github.com/kumahq/kuma/v3/pkg/core"
 	core_meta "github.com/kumahq/kuma/v3/pkg/core/metadata"
 	"github.com/kumahq/kuma/v3/pkg/core/resources/apis/mesh"
 	"github.com/kumahq/kuma/v3/pkg/core/resources/manager"
 	"github.com/kumahq/kuma/v3/pkg/core/resources/model"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/registry"
 	"github.com/kumahq/kuma/v3/pkg/core/resources/store"
 	"github.com/kumahq/kuma/v3/pkg/core/user"
 	"github.com/kumahq/kuma/v3/pkg/core/xds"
 	v3 "github.com/kumahq/kuma/v3/pkg/hds/v3"
+	"github.com/kumahq/kuma/v3/pkg/plugins/policies/core/matchers"
+	core_rules "github.com/kumahq/kuma/v3/pkg/plugins/policies/core/rules"
+	policies_xds "github.com/kumahq/kuma/v3/pkg/plugins/policies/core/xds"
+	meshserviceprobe_api "github.com/kumahq/kuma/v3/pkg/plugins/policies/meshserviceprobe/api/v1alpha1"
 	tproxy_dp "github.com/kumahq/kuma/v3/pkg/transparentproxy/config/dataplane"
 	"github.com/kumahq/kuma/v3/pkg/util/net"
+	"github.com/kumahq/kuma/v3/pkg/util/pointer"
 	util_proto "github.com/kumahq/kuma/v3/pkg/util/proto"
 	util_xds_v3 "github.com/kumahq/kuma/v3/pkg/util/xds/v3"
+	xds_context "github.com/kumahq/kuma/v3/pkg/xds/context"
 	"github.com/kumahq/kuma/v3/pkg/xds/envoy/names"
 	"github.com/kumahq/kuma/v3/pkg/xds/generator/metadata"
 	"github.com/kumahq/kuma/v3/pkg/xds/generator/system_names"
@@ -72,40 +79,22 @@ func (g *SnapshotGenerator) GenerateSnapshot(ctx context.Context, node *envoy_co
 		g.envoyHealthCheck(dp.AdminPort(g.defaultAdminPort), md.GetAdminSocketPath(), system_names.SystemResourceNameEnvoyAdmin),
 	}
 
-	for _, inbound := range dp.Spec.GetNetworking().GetInbound() {
-		if inbound.ServiceProbe == nil {
-			continue
-		}
-		serviceProbe := inbound.ServiceProbe
-		intf := dp.Spec.GetNetworking().ToInboundInterface(inbound)
-
-		var timeout *durationpb.Duration
-		if serviceProbe.Timeout == nil {
-			timeout = util_proto.Duration(g.config.CheckDefaults.Timeout.Duration)
-		} else {
-			timeout = serviceProbe.Timeout
-		}
-
-		var interval *durationpb.Duration
-		if serviceProbe.Interval == nil {
-			interval = util_proto.Duration(g.config.CheckDefaults.Interval.Duration)
-		} else {
-			interval = serviceProbe.Interval
-		}
-
-		var healthyThreshold *wrapperspb.UInt32Value
-		if serviceProbe.HealthyThreshold == nil {
-			healthyThreshold = util_proto.UInt32(g.config.CheckDefaults.HealthyThreshold)
-		} else {
-			healthyThreshold = serviceProbe.HealthyThreshold
-		}
+	fromRules, err := g.matchedServiceProbes(ctx, dp)
+	if err != nil {
+		return nil, err
+	}
 
-		var unhealthyThreshold *wrapperspb.UInt32Value
-		if serviceProbe.UnhealthyThreshold == nil {
-			unhealthyThreshold = util_proto.UInt32(g.config.CheckDefaults.UnhealthyThreshold)
-		} else {
-			unhealthyThreshold = serviceProbe.UnhealthyThreshold
+	err = policies_xds.ForEachInbound(dp, fromRules, func(match policies_xds.InboundMatch[meshserviceprobe_api.Conf]) error {
+		var healthCheck *envoy_core.HealthCheck
+		switch {
+		case len(match.Rules) > 0:
+			healthCheck = g.policyHealthCheck(match.Conf)
+		case match.Inbound.ServiceProbe != nil:
+			healthCheck = g.serviceProbeHealthCheck(match.Inbound.ServiceProbe)
+		default:
+			return nil
 		}
+		intf := match.Interface
 
 		hc := &envoy_service_health.ClusterHealthCheck{
 			ClusterName: names.GetLocalClusterName(intf.WorkloadPort),
@@ -123,18 +112,7 @@ func (g *SnapshotGenerator) GenerateSnapshot(ctx context.Context, node *envoy_co
 					},
 				}},
 			}},
-			HealthChecks: []*envoy_core.HealthCheck{
-				{
-					Timeout:            timeout,
-					Interval:           interval,
-					HealthyThreshold:   healthyThreshold,
-					UnhealthyThreshold: unhealthyThreshold,
-					NoTrafficInterval:  util_proto.Duration(g.config.CheckDefaults.NoTrafficInterval.Duration),
-					HealthChecker: &envoy_core.HealthCheck_TcpHealthCheck_{
-						TcpHealthCheck: &envoy_core.HealthCheck_TcpHealthCheck{},
-					},
-				},
-			},
+			HealthChecks: []*envoy_core.HealthCheck{healthCheck},
 		}
 
 		meta := xds.DataplaneMetadataFromXdsMetadata(node.GetMetadata())
@@ -148,6 +126,10 @@ func (g *SnapshotGenerator) GenerateSnapshot(ctx context.Context, node *envoy_co
 		}
 
 		healthChecks = append(healthChecks, hc)
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	hcs := &envoy_service_health.HealthCheckSpecifier{
@@ -205,6 +187,102 @@ func (g *SnapshotGenerator) envoyHealthCheck(port uint32, adminSocketPath, clust
 	}
 }
 
+// matchedServiceProbes resolves the MeshServiceProbe policies for the inbounds
+// of the dataplane. The policies are not matched when the plugin is disabled.
+func (g *SnapshotGenerator) matchedServiceProbes(ctx context.Context, dp *mesh.DataplaneResource) (core_rules.FromRules, error) {
+	if _, err := registry.Global().DescriptorFor(meshserviceprobe_api.MeshServiceProbeType); err != nil {
+		return core_rules.FromRules{}, nil
+	}
+	probes := &meshserviceprobe_api.MeshServiceProbeResourceList{}
+	if err := g.readOnlyResourceManager.List(ctx, probes, store.ListByMesh(dp.Meta.GetMesh())); err != nil {
+		return core_rules.FromRules{}, err
+	}
+	resources := xds_context.NewResources()
+	resources.MeshLocalResources[meshserviceprobe_api.MeshServiceProbeType] = probes
+	matched, err := matchers.MatchedPolicies(meshserviceprobe_api.MeshServiceProbeType, dp, resources)
+	if err != nil {
+		return core_rules.FromRules{}, err
+	}
+	return matched.FromRules, nil
+}
+
+// serviceProbeHealthCheck builds a TCP HC from the service probe defined on the inbound of the Dataplane
+func (g *SnapshotGenerator) serviceProbeHealthCheck(serviceProbe *mesh_proto.Dataplane_Networking_Inbound_ServiceProbe) *envoy_core.HealthCheck {
+	hc := g.defaultHealthCheck()
+	if serviceProbe.Timeout != nil {
+		hc.Timeout = serviceProbe.Timeout
+	}
+	if serviceProbe.Interval != nil {
+		hc.Interval = serviceProbe.Interval
+	}
+	if serviceProbe.HealthyThreshold != nil {
+		hc.HealthyThreshold = serviceProbe.HealthyThreshold
+	}
+	if serviceProbe.UnhealthyThreshold != nil {
+		hc.UnhealthyThreshold = serviceProbe.UnhealthyThreshold
+	}
+	return hc
+}
+
+// policyHealthCheck builds a HC from the MeshServiceProbe conf matched for the inbound
+func (g *SnapshotGenerator) policyHealthCheck(conf meshserviceprobe_api.Conf) *envoy_core.HealthCheck {
+	hc := g.defaultHealthCheck()
+	if conf.Timeout != nil {
+		hc.Timeout = util_proto.Duration(conf.Timeout.Duration)
+	}
+	if conf.Interval != nil {
+		hc.Interval = util_proto.Duration(conf.Interval.Duration)
+	}
+	if conf.HealthyThreshold != nil {
+		hc.HealthyThreshold = util_proto.UInt32(uint32(*conf.HealthyThreshold))
+	}
+	if conf.UnhealthyThreshold != nil {
+		hc.UnhealthyThreshold = util_proto.UInt32(uint32(*conf.UnhealthyThreshold))
+	}
+
+	switch pointer.Deref(conf.Type) {
+	case meshserviceprobe_api.HttpProbeType:
+		http := pointer.Deref(conf.Http)
+		var expectedStatuses []*envoy_type.Int64Range
+		for _, status := range pointer.Deref(http.ExpectedStatuses) {
+			expectedStatuses = append(expectedStatuses, &envoy_type.Int64Range{
+				Start: int64(status),
+				End:   int64(status) + 1,
+			})
+		}
+		hc.HealthChecker = &envoy_core.HealthCheck_HttpHealthCheck_{
+			HttpHealthCheck: &envoy_core.HealthCheck_HttpHealthCheck{
+				Path:             pointer.DerefOr(http.Path, "/"),
+				Host:             pointer.Deref(http.Host),
+				ExpectedStatuses: expectedStatuses,
+			},
+		}
+	case meshserviceprobe_api.GrpcProbeType:
+		grpc := pointer.Deref(conf.Grpc)
+		hc.HealthChecker = &envoy_core.HealthCheck_GrpcHealthCheck_{
+			GrpcHealthCheck: &envoy_core.HealthCheck_GrpcHealthCheck{
+				ServiceName: pointer.Deref(grpc.ServiceName),
+				Authority:   pointer.Deref(grpc.Authority),
+			},
+		}
+	}
+	return hc
+}
+
+// defaultHealthCheck builds a TCP HC with the parameters from the HDS configuration of the control plane
+func (g *SnapshotGenerator) defaultHealthCheck() *envoy_core.HealthCheck {
+	return &envoy_core.HealthCheck{
+		Timeout:            util_proto.Duration(g.config.CheckDefaults.Timeout.Duration),
+		Interval:           util_proto.Duration(g.config.CheckDefaults.Interval.Duration),
+		HealthyThreshold:   util_proto.UInt32(g.config.CheckDefaults.HealthyThreshold),
+		UnhealthyThreshold: util_proto.UInt32(g.config.CheckDefaults.UnhealthyThreshold),
+		NoTrafficInterval:  util_proto.Duration(g.config.CheckDefaults.NoTrafficInterval.Duration),
+		HealthChecker: &envoy_core.HealthCheck_TcpHealthCheck_{
+			TcpHealthCheck: &envoy_core.HealthCheck_TcpHealthCheck{},
+		},
+	}
+}
+
 func (g *SnapshotGenerator) upstreamBindConfig(addr string, port uint32) *envoy_core.BindConfig {
 	return &envoy_core.BindConfig{
 		SourceAddress: &envoy_core.SocketAddress{
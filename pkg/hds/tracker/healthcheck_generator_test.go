@@ -18,6 +18,7 @@ import (
 	"github.com/kumahq/kuma/v3/pkg/core/resources/store"
 	"github.com/kumahq/kuma/v3/pkg/core/xds"
 	v3 "github.com/kumahq/kuma/v3/pkg/hds/v3"
+	meshserviceprobe_api "github.com/kumahq/kuma/v3/pkg/plugins/policies/meshserviceprobe/api/v1alpha1"
 	"github.com/kumahq/kuma/v3/pkg/plugins/resources/memory"
 	"github.com/kumahq/kuma/v3/pkg/test/matchers"
 	util_proto "github.com/kumahq/kuma/v3/pkg/util/proto"
@@ -41,6 +42,7 @@ var _ = Describe("HDS Snapshot generator", func() {
 		metadata   *structpb.Struct
 		hdsConfig  *dp_server.HdsConfig
 		deleteMesh bool
+		policies   map[string]string
 	}
 
 	DescribeTable(
@@ -66,6 +68,12 @@ var _ = Describe("HDS Snapshot generator", func() {
 				Expect(err).ToNot(HaveOccurred())
 			}
 
+			for name, spec := range given.policies {
+				policy := meshserviceprobe_api.NewMeshServiceProbeResource()
+				Expect(model.FromYAML([]byte(spec), policy.Spec)).To(Succeed())
+				Expect(resourceManager.Create(context.Background(), policy, store.CreateByKey(name, "mesh-1"))).To(Succeed())
+			}
+
 			generator := NewSnapshotGenerator(resourceManager, given.hdsConfig, 9901)
 
 			// when
@@ -300,5 +308,62 @@ networking:
 				},
 			},
 		}),
+		Entry("should generate HTTP and gRPC service probes from MeshServiceProbe", testCase{
+			goldenFile: "hds.8.golden.yaml",
+			dataplane: `
+networking:
+  address: 10.20.0.1
+  inbound:
+    - port: 9000
+      name: http
+      serviceAddress: 192.168.0.1
+      servicePort: 80
+      serviceProbe:
+        tcp: {}
+      tags:
+        kuma.io/service: backend
+    - port: 9001
+      name: grpc
+      serviceAddress: 192.168.0.1
+      servicePort: 81
+      tags:
+        kuma.io/service: backend-grpc
+`,
+			policies: map[string]string{
+				"http-probe": `
+targetRef:
+  kind: Mesh
+rules:
+  - default:
+      interval: 5s
+      type: HTTP
+      http:
+        path: /healthz
+        host: backend.local
+        expectedStatuses: [200, 204]
+`,
+				"grpc-probe": `
+targetRef:
+  kind: Dataplane
+  sectionName: grpc
+rules:
+  - default:
+      type: GRPC
+      grpc:
+        serviceName: backend.v1.Backend
+`,
+			},
+			hdsConfig: &dp_server.HdsConfig{
+				Interval: config_types.Duration{Duration: 8 * time.Second},
+				Enabled:  true,
+				CheckDefaults: &dp_server.HdsCheck{
+					Interval:           config_types.Duration{Duration: 1 * time.Second},
+					NoTrafficInterval:  config_types.Duration{Duration: 2 * time.Second},
+					Timeout:            config_types.Duration{Duration: 3 * time.Second},
+					HealthyThreshold:   4,
+					UnhealthyThreshold: 5,
+				},
+			},
+		}),
 	)
 })
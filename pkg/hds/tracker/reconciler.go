@@ -4,10 +4,12 @@ import (
 	"context"
 
 	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_service_health "github.com/envoyproxy/go-control-plane/envoy/service/health/v3"
 	envoy_cache "github.com/envoyproxy/go-control-plane/pkg/cache/v3"
 
 	v3 "github.com/kumahq/kuma/v3/pkg/hds/v3"
 	util_xds_v3 "github.com/kumahq/kuma/v3/pkg/util/xds/v3"
+	"github.com/kumahq/kuma/v3/pkg/xds/envoy/names"
 )
 
 type reconciler struct {
@@ -41,3 +43,41 @@ func (r *reconciler) Clear(ctx context.Context, node *envoy_config_core_v3.Node)
 	// This fake value will be removed from cache on Envoy disconnect.
 	return r.cache.SetSnapshot(ctx, r.hasher.ID(node), util_xds_v3.NewSingleTypeSnapshot("", v3.HealthCheckSpecifierType, nil))
 }
+
+// probeTypes returns the type of the probe (tcp, http or grpc) of every
+// workload port probed by the snapshot last set for the node.
+func (r *reconciler) probeTypes(node *envoy_config_core_v3.Node) map[uint32]string {
+	probeTypes := map[uint32]string{}
+	if node == nil {
+		return probeTypes
+	}
+	snapshot, err := r.cache.GetSnapshot(r.hasher.ID(node))
+	if err != nil {
+		return probeTypes
+	}
+	for _, resource := range snapshot.GetResources(v3.HealthCheckSpecifierType) {
+		hcs, ok := resource.(*envoy_service_health.HealthCheckSpecifier)
+		if !ok {
+			continue
+		}
+		for _, clusterHealthCheck := range hcs.GetClusterHealthChecks() {
+			port, err := names.GetPortForLocalClusterName(clusterHealthCheck.GetClusterName())
+			if err != nil || len(clusterHealthCheck.GetHealthChecks()) == 0 {
+				continue // the health check of Envoy itself
+			}
+			probeTypes[port] = healthCheckerType(clusterHealthCheck.GetHealthChecks()[0])
+		}
+	}
+	return probeTypes
+}
+
+func healthCheckerType(hc *envoy_config_core_v3.HealthCheck) string {
+	switch hc.GetHealthChecker().(type) {
+	case *envoy_config_core_v3.HealthCheck_HttpHealthCheck_:
+		return "http"
+	case *envoy_config_core_v3.HealthCheck_GrpcHealthCheck_:
+		return "grpc"
+	default:
+		return "tcp"
+	}
+}
@@ -23,14 +23,28 @@ func Setup(rt runtime.Runtime) error {
 		logger.Info("MeshService is not enabled. Skip starting VIP allocator for MeshService.")
 		return nil
 	}
+	cfg := rt.Config().IPAM
+	cidrToDescriptors := map[string]core_model.ResourceTypeDescriptor{}
+	for _, cidr := range []string{cfg.MeshService.CIDR, cfg.MeshService.CIDRv6} {
+		if cidr != "" {
+			cidrToDescriptors[cidr] = meshservice_api.MeshServiceResourceTypeDescriptor
+		}
+	}
+	for _, cidr := range []string{cfg.MeshExternalService.CIDR, cfg.MeshExternalService.CIDRv6} {
+		if cidr != "" {
+			cidrToDescriptors[cidr] = meshexternalservice_api.MeshExternalServiceResourceTypeDescriptor
+		}
+	}
+	for _, cidr := range []string{cfg.MeshMultiZoneService.CIDR, cfg.MeshMultiZoneService.CIDRv6} {
+		if cidr != "" {
+			cidrToDescriptors[cidr] = meshmzservice_api.MeshMultiZoneServiceResourceTypeDescriptor
+		}
+	}
 	allocator, err := vip.NewAllocator(
 		logger,
-		rt.Config().IPAM.AllocationInterval.Duration,
-		map[string]core_model.ResourceTypeDescriptor{
-			rt.Config().IPAM.MeshService.CIDR:          meshservice_api.MeshServiceResourceTypeDescriptor,
-			rt.Config().IPAM.MeshExternalService.CIDR:  meshexternalservice_api.MeshExternalServiceResourceTypeDescriptor,
-			rt.Config().IPAM.MeshMultiZoneService.CIDR: meshmzservice_api.MeshMultiZoneServiceResourceTypeDescriptor,
-		},
+		cfg.AllocationInterval.Duration,
+		cfg.QuarantinePeriod.Duration,
+		cidrToDescriptors,
 		rt.Metrics(),
 		rt.ResourceManager(),
 	)
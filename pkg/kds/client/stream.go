@@ -14,7 +14,7 @@ import (
 	system_proto "github.com/kumahq/kuma/v3/api/system/v1alpha1"
 	core_model "github.com/kumahq/kuma/v3/pkg/core/resources/model"
 	"github.com/kumahq/kuma/v3/pkg/kds"
-	kds_cache "github.com/kumahq/kuma/v3/pkg/kds/cache"
+	"github.com/kumahq/kuma/v3/pkg/kds/drift"
 	"github.com/kumahq/kuma/v3/pkg/kds/util"
 	util_proto "github.com/kumahq/kuma/v3/pkg/util/proto"
 	kuma_version "github.com/kumahq/kuma/v3/pkg/version"
@@ -23,8 +23,18 @@ import (
 var _ DeltaKDSStream = &stream{}
 
 type latestReceived struct {
-	nonce         string
-	nameToVersion kds_cache.NameToVersion
+	nonce     string
+	resources map[string]drift.ReceivedResource
+	removed   []string
+}
+
+type StreamOption func(*stream)
+
+// WithReceivedResources records resources of every ACKed or NACKed response, so they can be verified against the store.
+func WithReceivedResources(received *drift.Received) StreamOption {
+	return func(s *stream) {
+		s.receivedResources = received
+	}
 }
 
 type stream struct {
@@ -34,6 +44,9 @@ type stream struct {
 	clientID           string
 	cpConfig           string
 	instanceID         string
+	receivedResources  *drift.Received
+	// received holds resources by name that were ACKed or NACKed on this stream.
+	received map[core_model.ResourceType]map[string]drift.ReceivedResource
 
 	sendCh        chan *envoy_sd.DeltaDiscoveryRequest
 	recvCh        chan *envoy_sd.DeltaDiscoveryResponse
@@ -64,6 +77,7 @@ func NewDeltaKDSStream(
 	instanceID string,
 	cpConfig string,
 	numberOfDistinctTypes int,
+	opts ...StreamOption,
 ) DeltaKDSStream {
 	// Use Background instead of s.Context() to decouple from the gRPC
 	// stream's context. When the server closes the stream, gRPC cancels
@@ -98,6 +112,10 @@ func NewDeltaKDSStream(
 		cancel:             cancel,
 		closeSendCh:        make(chan struct{}),
 		sendDone:           make(chan struct{}),
+		received:           make(map[core_model.ResourceType]map[string]drift.ReceivedResource),
+	}
+	for _, opt := range opts {
+		opt(stream)
 	}
 
 	go func() {
@@ -223,9 +241,19 @@ func (s *stream) Receive() (UpstreamResponse, error) {
 	}
 	// when there isn't nonce it means it's the first request
 	isInitialRequest := !s.initialRequestDone[rs.GetItemType()]
+	resources := make(map[string]drift.ReceivedResource, len(rs.GetItems()))
+	for _, r := range rs.GetItems() {
+		name := fmt.Sprintf("%s.%s", r.GetMeta().GetName(), r.GetMeta().GetMesh())
+		resources[name] = drift.ReceivedResource{
+			Key:     core_model.MetaToResourceKey(r.GetMeta()),
+			Version: nameToVersion[name],
+			Spec:    r.GetSpec(),
+		}
+	}
 	s.latestReceived[rs.GetItemType()] = &latestReceived{
-		nonce:         resp.Nonce,
-		nameToVersion: nameToVersion,
+		nonce:     resp.Nonce,
+		resources: resources,
+		removed:   resp.RemovedResources,
 	}
 	return UpstreamResponse{
 		// Attribute the batch to the connecting peer's declared client-id
@@ -258,17 +286,38 @@ func (s *stream) ACK(resourceType core_model.ResourceType) error {
 	})
 	if err == nil {
 		s.initialRequestDone[resourceType] = true
+		s.recordReceived(resourceType, latestReceived)
 	}
 	return err
 }
 
+// recordReceived records resources of the response. A NACKed response can be stored partially,
+// so it's recorded as well and the resources that were not stored are reported as drift.
+func (s *stream) recordReceived(resourceType core_model.ResourceType, latest *latestReceived) {
+	if s.receivedResources == nil {
+		return
+	}
+	received, ok := s.received[resourceType]
+	if !ok {
+		received = map[string]drift.ReceivedResource{}
+		s.received[resourceType] = received
+	}
+	for name, resource := range latest.resources {
+		received[name] = resource
+	}
+	for _, name := range latest.removed {
+		delete(received, name)
+	}
+	s.receivedResources.Set(s.clientID, resourceType, received)
+}
+
 func (s *stream) NACK(resourceType core_model.ResourceType, err error) error {
 	latestReceived, found := s.latestReceived[resourceType]
 	if !found {
 		return nil
 	}
 	s.initialRequestDone[resourceType] = true
-	return s.send(&envoy_sd.DeltaDiscoveryRequest{
+	sendErr := s.send(&envoy_sd.DeltaDiscoveryRequest{
 		ResponseNonce:          latestReceived.nonce,
 		ResourceNamesSubscribe: []string{"*"},
 		TypeUrl:                string(resourceType),
@@ -279,6 +328,10 @@ func (s *stream) NACK(resourceType core_model.ResourceType, err error) error {
 			Message: fmt.Sprintf("%s", err),
 		},
 	})
+	if sendErr == nil {
+		s.recordReceived(resourceType, latestReceived)
+	}
+	return sendErr
 }
 
 // go-contro-plane cache keeps them as a <resource_name>.<mesh_name>
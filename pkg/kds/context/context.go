@@ -29,6 +29,7 @@ import (
 	"github.com/kumahq/kuma/v3/pkg/core/resources/registry"
 	"github.com/kumahq/kuma/v3/pkg/core/resources/store"
 	"github.com/kumahq/kuma/v3/pkg/kds"
+	"github.com/kumahq/kuma/v3/pkg/kds/drift"
 	"github.com/kumahq/kuma/v3/pkg/kds/hash"
 	kds_reconcile "github.com/kumahq/kuma/v3/pkg/kds/reconcile"
 	"github.com/kumahq/kuma/v3/pkg/kds/service"
@@ -48,11 +49,19 @@ type Context struct {
 	GlobalProvidedFilter kds_reconcile.ResourceFilter
 	ZoneProvidedFilter   kds_reconcile.ResourceFilter
 	GlobalServerFilters  []Filter
+	// GlobalSyncScope restricts resources that global sends to a zone.
+	GlobalSyncScope kds_reconcile.SyncScopeProvider
 
 	GlobalResourceMapper kds_reconcile.ResourceMapper
 	ZoneResourceMapper   kds_reconcile.ResourceMapper
 
-	EnvoyAdminRPCs           service.EnvoyAdminRPCs
+	EnvoyAdminRPCs service.EnvoyAdminRPCs
+	// SentResources tracks resources that global sends to every zone.
+	SentResources *drift.Tracker
+	// ReceivedResources keeps resources that the zone received from global.
+	ReceivedResources *drift.Received
+	// AppliedResources tracks resources from global that the zone holds in its store.
+	AppliedResources         *drift.Tracker
 	ServerStreamInterceptors []grpc.StreamServerInterceptor
 	ServerUnaryInterceptor   []grpc.UnaryServerInterceptor
 }
@@ -122,9 +131,13 @@ func DefaultContext(
 			SkipUnsupportedHostnameGenerator,
 		),
 		ZoneProvidedFilter:   ZoneProvidedFilter,
+		GlobalSyncScope:      ZoneSyncScope(manager),
 		GlobalResourceMapper: CompositeResourceMapper(globalMappers...),
 		ZoneResourceMapper:   CompositeResourceMapper(zoneMappers...),
 		EnvoyAdminRPCs:       service.NewEnvoyAdminRPCs(),
+		SentResources:        drift.NewTracker(),
+		ReceivedResources:    drift.NewReceived(),
+		AppliedResources:     drift.NewTracker(),
 	}
 }
 
@@ -257,6 +270,27 @@ func UpdateResourceMeta(fs ...util.CloneResourceMetaOpt) kds_reconcile.ResourceM
 	}
 }
 
+// ZoneSyncScope returns the scope of resources synced to the zone that is configured in the Zone resource.
+func ZoneSyncScope(rm manager.ReadOnlyResourceManager) kds_reconcile.SyncScopeProvider {
+	return func(ctx context.Context, zoneName string) (kds_reconcile.SyncScope, error) {
+		zone := system.NewZoneResource()
+		if err := rm.Get(ctx, zone, store.GetByKey(zoneName, core_model.NoMesh)); err != nil {
+			if store.IsNotFound(err) {
+				return kds_reconcile.SyncScope{}, nil
+			}
+			return kds_reconcile.SyncScope{}, err
+		}
+		var resourceTypes []core_model.ResourceType
+		for _, typ := range zone.Spec.GetSync().GetResourceTypes() {
+			resourceTypes = append(resourceTypes, core_model.ResourceType(typ))
+		}
+		return kds_reconcile.SyncScope{
+			Meshes:        zone.Spec.GetSync().GetMeshes(),
+			ResourceTypes: resourceTypes,
+		}, nil
+	}
+}
+
 func GlobalProvidedFilter(rm manager.ResourceManager) kds_reconcile.ResourceFilter {
 	return func(ctx context.Context, zoneName string, features kds.Features, r core_model.Resource) bool {
 		// There's explicit flag to disable KDS for a resource
@@ -278,6 +312,10 @@ func GlobalProvidedFilter(rm manager.ResourceManager) kds_reconcile.ResourceFilt
 			if strings.HasPrefix(r.GetMeta().GetName(), system.UserTokenSigningKeyPrefix) {
 				return false
 			}
+			// zones only need IDs of revoked tokens, details of the revocations are read on global
+			if strings.HasPrefix(r.GetMeta().GetName(), system.TokenRevocationDetailsPrefix) {
+				return false
+			}
 		}
 
 		isGlobal := core_model.IsLocallyOriginated(config_core.Global, r.GetMeta().GetLabels())
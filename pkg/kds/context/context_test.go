@@ -218,6 +218,30 @@ var _ = Describe("Context", func() {
 				},
 				expect: true,
 			}),
+			Entry("should not filter out dataplane token revocations", testCase{
+				resource: &core_system.GlobalSecretResource{
+					Meta: &test_model.ResourceMeta{
+						Name: core_system.DataplaneTokenRevocations("default-details"),
+					},
+				},
+				expect: true,
+			}),
+			Entry("should filter out details of user token revocations", testCase{
+				resource: &core_system.GlobalSecretResource{
+					Meta: &test_model.ResourceMeta{
+						Name: core_system.TokenRevocationDetailsPrefix + core_system.UserTokenRevocations,
+					},
+				},
+				expect: false,
+			}),
+			Entry("should filter out details of dataplane token revocations", testCase{
+				resource: &core_system.GlobalSecretResource{
+					Meta: &test_model.ResourceMeta{
+						Name: core_system.TokenRevocationDetailsPrefix + core_system.DataplaneTokenRevocations("default"),
+					},
+				},
+				expect: false,
+			}),
 		)
 
 		zoneLabelsFn := func(zoneName string) map[string]string {
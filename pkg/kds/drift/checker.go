@@ -0,0 +1,96 @@
+package drift
+
+import (
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	mesh_proto "github.com/kumahq/kuma/v3/api/mesh/v1alpha1"
+	system_proto "github.com/kumahq/kuma/v3/api/system/v1alpha1"
+	core_model "github.com/kumahq/kuma/v3/pkg/core/resources/model"
+)
+
+// Checker compares resources that global sends to a zone with resources that the zone applied.
+type Checker struct {
+	sent       *Tracker
+	metrics    *Metrics
+	instanceID string
+
+	sync.Mutex
+	// diverged holds resource types that diverged at the previous check by client.
+	diverged map[string]map[core_model.ResourceType]struct{}
+}
+
+func NewChecker(sent *Tracker, metrics *Metrics, instanceID string) *Checker {
+	c := &Checker{
+		sent:       sent,
+		metrics:    metrics,
+		instanceID: instanceID,
+		diverged:   map[string]map[core_model.ResourceType]struct{}{},
+	}
+	sent.OnClear(c.forget)
+	return c
+}
+
+// Check compares resources of every type and updates metrics of the client.
+// It returns nil when the check can't be done on this instance, because the zone is connected to another instance
+// of Global CP or the zone doesn't report applied resources.
+func (c *Checker) Check(client string, applied map[string]*mesh_proto.ZoneResourceTypeState, now time.Time) *system_proto.KDSResourceSync {
+	sent := c.sent.Get(client)
+	if len(sent) == 0 || len(applied) == 0 {
+		c.forget(client)
+		return nil
+	}
+
+	result := &system_proto.KDSResourceSync{
+		CheckTime:        timestamppb.New(now),
+		GlobalInstanceId: c.instanceID,
+		Types:            map[string]*system_proto.KDSResourceTypeSync{},
+	}
+	diverged := map[core_model.ResourceType]struct{}{}
+	for typ, state := range sent {
+		zoneState := applied[string(typ)]
+		typeSync := &system_proto.KDSResourceTypeSync{
+			GlobalCount:        state.Count,
+			ZoneCount:          zoneState.GetCount(),
+			GlobalVersion:      state.Version,
+			LastAppliedVersion: zoneState.GetVersion(),
+			LastAppliedTime:    zoneState.GetAppliedTime(),
+			InSync:             zoneState.GetVersion() == state.Version,
+			Lag:                durationpb.New(0),
+		}
+		if !typeSync.InSync {
+			typeSync.Lag = durationpb.New(now.Sub(state.UpdatedAt))
+			diverged[typ] = struct{}{}
+		}
+		result.Types[string(typ)] = typeSync
+	}
+	result.DivergedTypes = uint32(len(diverged))
+
+	c.Lock()
+	defer c.Unlock()
+	for typ, typeSync := range result.Types {
+		_, divergedNow := diverged[core_model.ResourceType(typ)]
+		_, divergedBefore := c.diverged[client][core_model.ResourceType(typ)]
+		if divergedNow && !divergedBefore {
+			c.metrics.DivergedTotal.WithLabelValues(client, typ).Inc()
+		}
+		value := 0.0
+		if divergedNow {
+			value = 1
+		}
+		c.metrics.Diverged.WithLabelValues(client, typ).Set(value)
+		c.metrics.Lag.WithLabelValues(client, typ).Set(typeSync.Lag.AsDuration().Seconds())
+	}
+	c.diverged[client] = diverged
+	return result
+}
+
+func (c *Checker) forget(client string) {
+	c.Lock()
+	defer c.Unlock()
+	delete(c.diverged, client)
+	c.metrics.forget(client)
+}
@@ -0,0 +1,104 @@
+package drift_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	mesh_proto "github.com/kumahq/kuma/v3/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/v3/pkg/kds/drift"
+	"github.com/kumahq/kuma/v3/pkg/kds/hash"
+	core_metrics "github.com/kumahq/kuma/v3/pkg/metrics"
+	test_metrics "github.com/kumahq/kuma/v3/pkg/test/metrics"
+)
+
+var _ = Describe("Checker", func() {
+	var sent *drift.Tracker
+	var checker *drift.Checker
+	var metrics core_metrics.Metrics
+
+	t0 := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	versions := map[string]string{"mtp-1.default": "1", "mtp-2.default": "2"}
+
+	BeforeEach(func() {
+		var err error
+		metrics, err = core_metrics.NewMetrics("")
+		Expect(err).ToNot(HaveOccurred())
+		driftMetrics, err := drift.NewMetrics(metrics)
+		Expect(err).ToNot(HaveOccurred())
+		sent = drift.NewTracker()
+		checker = drift.NewChecker(sent, driftMetrics, "global-1")
+	})
+
+	applied := func(versions map[string]string) map[string]*mesh_proto.ZoneResourceTypeState {
+		return map[string]*mesh_proto.ZoneResourceTypeState{
+			"MeshTrafficPermission": {
+				Count:       uint32(len(versions)),
+				Version:     hash.ResourceVersionsHash(versions),
+				AppliedTime: timestamppb.New(t0),
+			},
+		}
+	}
+
+	It("should report zone in sync", func() {
+		// given
+		sent.Set("zone-1", "MeshTrafficPermission", versions, t0)
+
+		// when
+		sync := checker.Check("zone-1", applied(versions), t0.Add(time.Minute))
+
+		// then
+		Expect(sync.GetGlobalInstanceId()).To(Equal("global-1"))
+		Expect(sync.GetDivergedTypes()).To(BeZero())
+		typeSync := sync.GetTypes()["MeshTrafficPermission"]
+		Expect(typeSync.GetInSync()).To(BeTrue())
+		Expect(typeSync.GetGlobalCount()).To(Equal(uint32(2)))
+		Expect(typeSync.GetZoneCount()).To(Equal(uint32(2)))
+		Expect(typeSync.GetLag().AsDuration()).To(BeZero())
+		Expect(test_metrics.FindMetric(metrics, "kds_zone_resource_diverged", "zone_name", "zone-1", "resource_type", "MeshTrafficPermission").GetGauge().GetValue()).To(Equal(0.0))
+	})
+
+	It("should report diverged zone with lag", func() {
+		// given
+		sent.Set("zone-1", "MeshTrafficPermission", versions, t0)
+		stale := map[string]string{"mtp-1.default": "1"}
+
+		// when
+		sync := checker.Check("zone-1", applied(stale), t0.Add(time.Minute))
+		checker.Check("zone-1", applied(stale), t0.Add(2*time.Minute))
+
+		// then
+		Expect(sync.GetDivergedTypes()).To(Equal(uint32(1)))
+		typeSync := sync.GetTypes()["MeshTrafficPermission"]
+		Expect(typeSync.GetInSync()).To(BeFalse())
+		Expect(typeSync.GetZoneCount()).To(Equal(uint32(1)))
+		Expect(typeSync.GetLag().AsDuration()).To(Equal(time.Minute))
+		Expect(test_metrics.FindMetric(metrics, "kds_zone_resource_diverged", "zone_name", "zone-1", "resource_type", "MeshTrafficPermission").GetGauge().GetValue()).To(Equal(1.0))
+		Expect(test_metrics.FindMetric(metrics, "kds_zone_resource_lag_seconds", "zone_name", "zone-1", "resource_type", "MeshTrafficPermission").GetGauge().GetValue()).To(Equal(120.0))
+		// divergence is counted once until the zone is in sync again
+		Expect(test_metrics.FindMetric(metrics, "kds_zone_resource_divergences_total", "zone_name", "zone-1", "resource_type", "MeshTrafficPermission").GetCounter().GetValue()).To(Equal(1.0))
+	})
+
+	It("should not check zone that is not connected to the instance", func() {
+		// when
+		sync := checker.Check("zone-1", applied(versions), t0)
+
+		// then
+		Expect(sync).To(BeNil())
+	})
+
+	It("should forget metrics of the zone when its stream is closed", func() {
+		// given
+		sent.Set("zone-1", "MeshTrafficPermission", versions, t0)
+		checker.Check("zone-1", applied(nil), t0)
+		Expect(test_metrics.FindMetric(metrics, "kds_zone_resource_diverged", "zone_name", "zone-1", "resource_type", "MeshTrafficPermission")).ToNot(BeNil())
+
+		// when
+		sent.Clear("zone-1")
+
+		// then
+		Expect(test_metrics.FindMetric(metrics, "kds_zone_resource_diverged", "zone_name", "zone-1", "resource_type", "MeshTrafficPermission")).To(BeNil())
+	})
+})
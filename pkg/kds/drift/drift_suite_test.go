@@ -0,0 +1,11 @@
+package drift_test
+
+import (
+	"testing"
+
+	"github.com/kumahq/kuma/v3/pkg/test"
+)
+
+func TestDrift(t *testing.T) {
+	test.RunSpecs(t, "Drift Suite")
+}
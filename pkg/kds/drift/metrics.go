@@ -0,0 +1,46 @@
+package drift
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	core_metrics "github.com/kumahq/kuma/v3/pkg/metrics"
+)
+
+type Metrics struct {
+	Diverged      *prometheus.GaugeVec
+	Lag           *prometheus.GaugeVec
+	DivergedTotal *prometheus.CounterVec
+}
+
+func NewMetrics(metrics core_metrics.Metrics) (*Metrics, error) {
+	diverged := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "kds_zone_resource_diverged",
+		Help: "1 if the zone didn't apply the same version of resources of the type as global sends, 0 otherwise.",
+	}, []string{"zone_name", "resource_type"})
+
+	lag := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "kds_zone_resource_lag_seconds",
+		Help: "Time since global changed resources of the type that the zone has not applied yet.",
+	}, []string{"zone_name", "resource_type"})
+
+	divergedTotal := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "kds_zone_resource_divergences_total",
+		Help: "Total number of times the zone was found to diverge from global, by resource type.",
+	}, []string{"zone_name", "resource_type"})
+
+	if err := metrics.BulkRegister(diverged, lag, divergedTotal); err != nil {
+		return nil, err
+	}
+
+	return &Metrics{
+		Diverged:      diverged,
+		Lag:           lag,
+		DivergedTotal: divergedTotal,
+	}, nil
+}
+
+func (m *Metrics) forget(zone string) {
+	labels := prometheus.Labels{"zone_name": zone}
+	m.Diverged.DeletePartialMatch(labels)
+	m.Lag.DeletePartialMatch(labels)
+}
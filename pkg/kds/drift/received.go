@@ -0,0 +1,56 @@
+package drift
+
+import (
+	"maps"
+	"sync"
+
+	core_model "github.com/kumahq/kuma/v3/pkg/core/resources/model"
+)
+
+// ReceivedResource is a resource that a zone received from global.
+type ReceivedResource struct {
+	// Key is the key of the resource as sent by global.
+	Key core_model.ResourceKey
+	// Version is the version of the resource computed by global.
+	Version string
+	Spec    core_model.ResourceSpec
+}
+
+// Received keeps resources that a zone received from global by client, resource type and name sent by global.
+// Receiving a resource doesn't mean it was stored, so it's verified against the store by Verifier.
+type Received struct {
+	sync.RWMutex
+	clients map[string]map[core_model.ResourceType]map[string]ReceivedResource
+}
+
+func NewReceived() *Received {
+	return &Received{
+		clients: map[string]map[core_model.ResourceType]map[string]ReceivedResource{},
+	}
+}
+
+// Set replaces received resources of the type.
+func (r *Received) Set(client string, typ core_model.ResourceType, resources map[string]ReceivedResource) {
+	r.Lock()
+	defer r.Unlock()
+	types, ok := r.clients[client]
+	if !ok {
+		types = map[core_model.ResourceType]map[string]ReceivedResource{}
+		r.clients[client] = types
+	}
+	types[typ] = maps.Clone(resources)
+}
+
+// Get returns received resources of every resource type of the client.
+func (r *Received) Get(client string) map[core_model.ResourceType]map[string]ReceivedResource {
+	r.RLock()
+	defer r.RUnlock()
+	return maps.Clone(r.clients[client])
+}
+
+// Clear forgets the client, e.g. when its stream is closed.
+func (r *Received) Clear(client string) {
+	r.Lock()
+	defer r.Unlock()
+	delete(r.clients, client)
+}
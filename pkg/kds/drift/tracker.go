@@ -0,0 +1,77 @@
+package drift
+
+import (
+	"maps"
+	"sync"
+	"time"
+
+	core_model "github.com/kumahq/kuma/v3/pkg/core/resources/model"
+	"github.com/kumahq/kuma/v3/pkg/kds/hash"
+)
+
+// TypeState summarizes resources of a single type that were sent to or applied by a KDS peer.
+type TypeState struct {
+	Count   uint32
+	Version string
+	// UpdatedAt is the time when the version changed last.
+	UpdatedAt time.Time
+}
+
+// Tracker keeps the state of resources synced with KDS peers by client and resource type.
+// Global tracks resources that it sends to every zone, a zone tracks resources that it applied.
+type Tracker struct {
+	sync.RWMutex
+	clients map[string]map[core_model.ResourceType]TypeState
+	onClear []func(client string)
+}
+
+func NewTracker() *Tracker {
+	return &Tracker{
+		clients: map[string]map[core_model.ResourceType]TypeState{},
+	}
+}
+
+// Set records the versions of resources by name. The update time changes only when the version changes.
+func (t *Tracker) Set(client string, typ core_model.ResourceType, versions map[string]string, now time.Time) {
+	state := TypeState{
+		Count:     uint32(len(versions)),
+		Version:   hash.ResourceVersionsHash(versions),
+		UpdatedAt: now,
+	}
+	t.Lock()
+	defer t.Unlock()
+	types, ok := t.clients[client]
+	if !ok {
+		types = map[core_model.ResourceType]TypeState{}
+		t.clients[client] = types
+	}
+	if previous, ok := types[typ]; ok && previous.Version == state.Version {
+		return
+	}
+	types[typ] = state
+}
+
+// Get returns the state of every resource type of the client.
+func (t *Tracker) Get(client string) map[core_model.ResourceType]TypeState {
+	t.RLock()
+	defer t.RUnlock()
+	return maps.Clone(t.clients[client])
+}
+
+// Clear forgets the client, e.g. when its stream is closed.
+func (t *Tracker) Clear(client string) {
+	t.Lock()
+	delete(t.clients, client)
+	onClear := t.onClear
+	t.Unlock()
+	for _, fn := range onClear {
+		fn(client)
+	}
+}
+
+// OnClear registers a function that is called every time a client is cleared.
+func (t *Tracker) OnClear(fn func(client string)) {
+	t.Lock()
+	defer t.Unlock()
+	t.onClear = append(t.onClear, fn)
+}
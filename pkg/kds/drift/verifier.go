@@ -0,0 +1,82 @@
+package drift
+
+import (
+	"context"
+	"time"
+
+	mesh_proto "github.com/kumahq/kuma/v3/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/manager"
+	core_model "github.com/kumahq/kuma/v3/pkg/core/resources/model"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/registry"
+)
+
+// unexpectedPrefix marks resources from global that the zone holds, but global didn't send.
+// They change the hash of versions, so global reports the type as diverged.
+const unexpectedPrefix = "unexpected:"
+
+// StoreKeyFunc returns the key under which the zone stores a resource received from global.
+type StoreKeyFunc func(typ core_model.ResourceType, key core_model.ResourceKey) (core_model.ResourceKey, error)
+
+// Verifier computes the state of resources from global out of resources that the zone holds in its store.
+// A resource counts as applied only when the store has it with the spec that global sent, so resources that
+// failed to be stored, were stored partially or were changed in the zone are reported as diverged.
+type Verifier struct {
+	received *Received
+	applied  *Tracker
+	manager  manager.ReadOnlyResourceManager
+	storeKey StoreKeyFunc
+}
+
+func NewVerifier(received *Received, applied *Tracker, manager manager.ReadOnlyResourceManager, storeKey StoreKeyFunc) *Verifier {
+	return &Verifier{
+		received: received,
+		applied:  applied,
+		manager:  manager,
+		storeKey: storeKey,
+	}
+}
+
+// Verify updates the applied state of every resource type received by the client and returns it.
+func (v *Verifier) Verify(ctx context.Context, client string, now time.Time) (map[core_model.ResourceType]TypeState, error) {
+	for typ, resources := range v.received.Get(client) {
+		versions, err := v.storedVersions(ctx, typ, resources)
+		if err != nil {
+			return nil, err
+		}
+		v.applied.Set(client, typ, versions, now)
+	}
+	return v.applied.Get(client), nil
+}
+
+func (v *Verifier) storedVersions(ctx context.Context, typ core_model.ResourceType, resources map[string]ReceivedResource) (map[string]string, error) {
+	list, err := registry.Global().NewList(typ)
+	if err != nil {
+		return nil, err
+	}
+	if err := v.manager.List(ctx, list); err != nil {
+		return nil, err
+	}
+	stored := core_model.IndexByKey(list.GetItems())
+
+	versions := map[string]string{}
+	expected := map[core_model.ResourceKey]struct{}{}
+	for name, received := range resources {
+		key, err := v.storeKey(typ, received.Key)
+		if err != nil {
+			return nil, err
+		}
+		expected[key] = struct{}{}
+		if r, ok := stored[key]; ok && core_model.Equal(r.GetSpec(), received.Spec) {
+			versions[name] = received.Version
+		}
+	}
+	for key, r := range stored {
+		if _, ok := expected[key]; ok {
+			continue
+		}
+		if r.GetMeta().GetLabels()[mesh_proto.ResourceOriginLabel] == string(mesh_proto.GlobalResourceOrigin) {
+			versions[unexpectedPrefix+key.Name+"."+key.Mesh] = r.GetMeta().GetVersion()
+		}
+	}
+	return versions, nil
+}
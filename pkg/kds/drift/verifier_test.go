@@ -0,0 +1,111 @@
+package drift_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	mesh_proto "github.com/kumahq/kuma/v3/api/mesh/v1alpha1"
+	core_mesh "github.com/kumahq/kuma/v3/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/manager"
+	core_model "github.com/kumahq/kuma/v3/pkg/core/resources/model"
+	core_store "github.com/kumahq/kuma/v3/pkg/core/resources/store"
+	"github.com/kumahq/kuma/v3/pkg/kds/drift"
+	"github.com/kumahq/kuma/v3/pkg/kds/hash"
+	"github.com/kumahq/kuma/v3/pkg/plugins/resources/memory"
+)
+
+var _ = Describe("Verifier", func() {
+	var ctx context.Context
+	var store core_store.ResourceStore
+	var received *drift.Received
+	var verifier *drift.Verifier
+
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	sameKey := func(_ core_model.ResourceType, key core_model.ResourceKey) (core_model.ResourceKey, error) {
+		return key, nil
+	}
+
+	meshSpec := func(skipInitialPolicies bool) *mesh_proto.Mesh {
+		spec := &mesh_proto.Mesh{}
+		if skipInitialPolicies {
+			spec.SkipCreatingInitialPolicies = []string{"*"}
+		}
+		return spec
+	}
+
+	createMesh := func(name string, spec *mesh_proto.Mesh, labels map[string]string) {
+		mesh := core_mesh.NewMeshResource()
+		mesh.Spec = spec
+		Expect(store.Create(ctx, mesh, core_store.CreateByKey(name, core_model.NoMesh), core_store.CreateWithLabels(labels))).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		store = memory.NewStore()
+		received = drift.NewReceived()
+		verifier = drift.NewVerifier(received, drift.NewTracker(), manager.NewResourceManager(store), sameKey)
+		received.Set("zone-1", core_mesh.MeshType, map[string]drift.ReceivedResource{
+			"mesh-1.": {Key: core_model.ResourceKey{Name: "mesh-1"}, Version: "v1", Spec: meshSpec(true)},
+			"mesh-2.": {Key: core_model.ResourceKey{Name: "mesh-2"}, Version: "v2", Spec: meshSpec(false)},
+		})
+	})
+
+	It("should report resources that the zone stored", func() {
+		// given
+		createMesh("mesh-1", meshSpec(true), nil)
+		createMesh("mesh-2", meshSpec(false), nil)
+
+		// when
+		applied, err := verifier.Verify(ctx, "zone-1", now)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(applied[core_mesh.MeshType].Count).To(Equal(uint32(2)))
+		Expect(applied[core_mesh.MeshType].Version).To(Equal(hash.ResourceVersionsHash(map[string]string{"mesh-1.": "v1", "mesh-2.": "v2"})))
+	})
+
+	It("should not report resources that failed to be stored", func() {
+		// given
+		createMesh("mesh-1", meshSpec(true), nil)
+
+		// when
+		applied, err := verifier.Verify(ctx, "zone-1", now)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(applied[core_mesh.MeshType].Count).To(Equal(uint32(1)))
+		Expect(applied[core_mesh.MeshType].Version).To(Equal(hash.ResourceVersionsHash(map[string]string{"mesh-1.": "v1"})))
+	})
+
+	It("should not report resources changed in the zone", func() {
+		// given
+		createMesh("mesh-1", meshSpec(false), nil)
+		createMesh("mesh-2", meshSpec(false), nil)
+
+		// when
+		applied, err := verifier.Verify(ctx, "zone-1", now)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(applied[core_mesh.MeshType].Version).To(Equal(hash.ResourceVersionsHash(map[string]string{"mesh-2.": "v2"})))
+	})
+
+	It("should report resources from global that global didn't send", func() {
+		// given
+		createMesh("mesh-1", meshSpec(true), nil)
+		createMesh("mesh-2", meshSpec(false), nil)
+		createMesh("mesh-3", meshSpec(false), map[string]string{mesh_proto.ResourceOriginLabel: string(mesh_proto.GlobalResourceOrigin)})
+		createMesh("local", meshSpec(false), map[string]string{mesh_proto.ResourceOriginLabel: string(mesh_proto.ZoneResourceOrigin)})
+
+		// when
+		applied, err := verifier.Verify(ctx, "zone-1", now)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(applied[core_mesh.MeshType].Count).To(Equal(uint32(3)))
+		Expect(applied[core_mesh.MeshType].Version).ToNot(Equal(hash.ResourceVersionsHash(map[string]string{"mesh-1.": "v1", "mesh-2.": "v2"})))
+	})
+})
@@ -9,6 +9,7 @@ import (
 	"github.com/kumahq/kuma/v3/pkg/core"
 	"github.com/kumahq/kuma/v3/pkg/core/runtime"
 	"github.com/kumahq/kuma/v3/pkg/core/runtime/component"
+	"github.com/kumahq/kuma/v3/pkg/kds/drift"
 	"github.com/kumahq/kuma/v3/pkg/kds/mux"
 	kds_server "github.com/kumahq/kuma/v3/pkg/kds/server"
 	"github.com/kumahq/kuma/v3/pkg/kds/service"
@@ -33,6 +34,7 @@ func Setup(rt runtime.Runtime) error {
 		"global",
 		rt.KDSContext().GlobalProvidedFilter,
 		rt.KDSContext().GlobalResourceMapper,
+		rt.KDSContext().GlobalSyncScope,
 		rt.Config().Multizone.Global.KDS.NackBackoff.Duration,
 		rt.Config().Multizone.Global.KDS.EventBasedWatchdog.AsRuntimeConfig(),
 	)
@@ -50,6 +52,12 @@ func Setup(rt runtime.Runtime) error {
 		streamInterceptors = append(streamInterceptors, filter)
 	}
 
+	driftMetrics, err := drift.NewMetrics(rt.Metrics())
+	if err != nil {
+		return err
+	}
+	driftChecker := drift.NewChecker(rt.KDSContext().SentResources, driftMetrics, rt.GetInstanceId())
+
 	if rt.Config().Multizone.Global.KDS.ZoneHealthCheck.Timeout.Duration > time.Duration(0) {
 		zwLog := kdsGlobalLog.WithName("zone-watch")
 		zw, err := mux.NewZoneWatch(
@@ -84,6 +92,7 @@ func Setup(rt runtime.Runtime) error {
 			rt.Config().Store.Upsert,
 			rt.EventBus(),
 			rt.Config().Multizone.Global.KDS.ZoneHealthCheck.PollInterval.Duration,
+			driftChecker,
 		),
 		kdsSyncServer,
 	),
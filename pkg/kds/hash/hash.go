@@ -4,6 +4,8 @@ import (
 	"encoding/hex"
 	"fmt"
 	"hash/fnv"
+	"maps"
+	"slices"
 
 	"k8s.io/apimachinery/pkg/util/rand"
 	k8s_strings "k8s.io/utils/strings"
@@ -30,3 +32,14 @@ func hash(ss []string) string {
 
 	return rand.SafeEncodeString(hex.EncodeToString(b))
 }
+
+// ResourceVersionsHash returns a hash of resource versions by resource name.
+// It doesn't depend on the iteration order, so a peer that holds the same
+// versions of the same resources computes the same hash.
+func ResourceVersionsHash(versions map[string]string) string {
+	values := make([]string, 0, len(versions))
+	for _, name := range slices.Sorted(maps.Keys(versions)) {
+		values = append(values, name+"="+versions[name]+";")
+	}
+	return hash(values)
+}
@@ -19,6 +19,7 @@ import (
 	"google.golang.org/grpc/keepalive"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	mesh_proto "github.com/kumahq/kuma/v3/api/mesh/v1alpha1"
 	"github.com/kumahq/kuma/v3/pkg/config"
@@ -30,6 +31,7 @@ import (
 	"github.com/kumahq/kuma/v3/pkg/core/runtime/component"
 	"github.com/kumahq/kuma/v3/pkg/kds"
 	kds_client "github.com/kumahq/kuma/v3/pkg/kds/client"
+	"github.com/kumahq/kuma/v3/pkg/kds/drift"
 	kds_server "github.com/kumahq/kuma/v3/pkg/kds/server"
 	"github.com/kumahq/kuma/v3/pkg/kds/service"
 	kds_sync_store "github.com/kumahq/kuma/v3/pkg/kds/store"
@@ -163,8 +165,12 @@ func (c *client) startGlobalToZoneSync(ctx context.Context, log logr.Logger, con
 		trySend(ctx, errorCh, err)
 		return
 	}
-	kdsStream := kds_client.NewDeltaKDSStream(stream, c.clientID, c.rt.GetInstanceId(), cfgJson, len(c.typesSentByGlobal))
+	receivedResources := c.rt.KDSContext().ReceivedResources
+	kdsStream := kds_client.NewDeltaKDSStream(stream, c.clientID, c.rt.GetInstanceId(), cfgJson, len(c.typesSentByGlobal), kds_client.WithReceivedResources(receivedResources))
 	defer func() {
+		// resources received on this stream are not reported to global until the next stream receives them again
+		receivedResources.Clear(c.clientID)
+		c.rt.KDSContext().AppliedResources.Clear(c.clientID)
 		if err := kdsStream.CloseSend(); err != nil {
 			log.Error(err, "CloseSend returned an error")
 		}
@@ -310,7 +316,9 @@ func (c *client) startHealthCheck(
 	defer ticker.Stop()
 	for {
 		log.V(1).Info("sending health check")
-		resp, err := client.HealthCheck(ctx, &mesh_proto.ZoneHealthCheckRequest{})
+		resp, err := client.HealthCheck(ctx, &mesh_proto.ZoneHealthCheckRequest{
+			ResourceTypes: c.appliedResourceTypes(ctx, log),
+		})
 		if err != nil && !errors.Is(err, context.Canceled) {
 			if status.Code(err) == codes.Unimplemented {
 				log.Info("health check unimplemented in server, stopping")
@@ -336,6 +344,38 @@ func (c *client) startHealthCheck(
 	}
 }
 
+// appliedResourceTypes returns the state of resources from global that the zone holds in its store,
+// so global can check whether the zone diverged.
+func (c *client) appliedResourceTypes(ctx context.Context, log logr.Logger) map[string]*mesh_proto.ZoneResourceTypeState {
+	verifier := drift.NewVerifier(
+		c.rt.KDSContext().ReceivedResources,
+		c.rt.KDSContext().AppliedResources,
+		c.rt.ReadOnlyResourceManager(),
+		kds_sync_store.ZoneStoreKey(
+			c.rt.Config().Store.Type == store.KubernetesStore,
+			resources_k8s.NewSimpleKubeFactory(),
+			c.rt.Config().Store.Kubernetes.SystemNamespace,
+		),
+	)
+	applied, err := verifier.Verify(ctx, c.clientID, core.Now())
+	if err != nil {
+		log.Error(err, "could not verify resources applied from global, they are not reported")
+		return nil
+	}
+	if len(applied) == 0 {
+		return nil
+	}
+	types := make(map[string]*mesh_proto.ZoneResourceTypeState, len(applied))
+	for typ, state := range applied {
+		types[string(typ)] = &mesh_proto.ZoneResourceTypeState{
+			Count:       state.Count,
+			Version:     state.Version,
+			AppliedTime: timestamppb.New(state.UpdatedAt),
+		}
+	}
+	return types
+}
+
 func (c *client) handleProcessingErrors(
 	ctx context.Context,
 	stream grpc.ClientStream,
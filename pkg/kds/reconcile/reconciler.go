@@ -11,14 +11,17 @@ import (
 	"github.com/pkg/errors"
 
 	config_core "github.com/kumahq/kuma/v3/pkg/config/core"
+	"github.com/kumahq/kuma/v3/pkg/core"
 	core_model "github.com/kumahq/kuma/v3/pkg/core/resources/model"
 	kds_cache "github.com/kumahq/kuma/v3/pkg/kds/cache"
+	"github.com/kumahq/kuma/v3/pkg/kds/drift"
 	"github.com/kumahq/kuma/v3/pkg/multitenant"
 	util_maps "github.com/kumahq/kuma/v3/pkg/util/maps"
 	"github.com/kumahq/kuma/v3/pkg/util/xds"
 )
 
-func NewReconciler(hasher envoy_cache.NodeHash, cache envoy_cache.SnapshotCache, generator SnapshotGenerator, mode config_core.CpMode, statsCallbacks xds.StatsCallbacks, tenants multitenant.Tenants, types []core_model.ResourceType) Reconciler {
+// NewReconciler creates a Reconciler. When sent is not nil, it records versions of resources sent to every node.
+func NewReconciler(hasher envoy_cache.NodeHash, cache envoy_cache.SnapshotCache, generator SnapshotGenerator, mode config_core.CpMode, statsCallbacks xds.StatsCallbacks, tenants multitenant.Tenants, types []core_model.ResourceType, sent *drift.Tracker) Reconciler {
 	return &reconciler{
 		hasher:         hasher,
 		cache:          cache,
@@ -27,6 +30,7 @@ func NewReconciler(hasher envoy_cache.NodeHash, cache envoy_cache.SnapshotCache,
 		statsCallbacks: statsCallbacks,
 		tenants:        tenants,
 		providedTypes:  types,
+		sent:           sent,
 	}
 }
 
@@ -37,6 +41,7 @@ type reconciler struct {
 	mode           config_core.CpMode
 	statsCallbacks xds.StatsCallbacks
 	tenants        multitenant.Tenants
+	sent           *drift.Tracker
 
 	lock          sync.Mutex
 	providedTypes []core_model.ResourceType
@@ -55,6 +60,9 @@ func (r *reconciler) Clear(node *envoy_core.Node) error {
 		return nil // GetSnapshot returns an error if there is no snapshot. We don't need to error here
 	}
 	r.cache.ClearSnapshot(id)
+	if r.sent != nil {
+		r.sent.Clear(id)
+	}
 	if snapshot == nil {
 		return nil
 	}
@@ -104,11 +112,26 @@ func (r *reconciler) Reconcile(ctx context.Context, node *envoy_core.Node, chang
 	if changed := r.changedTypes(old, n); len(changed) > 0 {
 		r.logChanges(logger, changed, node)
 		r.meterConfigReadyForDelivery(changed, node.Id)
-		return r.cache.SetSnapshot(ctx, id, n), true
+		if err := r.cache.SetSnapshot(ctx, id, n); err != nil {
+			return err, true
+		}
+		r.recordSent(id, n)
+		return nil, true
 	}
+	r.recordSent(id, n)
 	return nil, false
 }
 
+func (r *reconciler) recordSent(id string, snapshot envoy_cache.ResourceSnapshot) {
+	if r.sent == nil {
+		return
+	}
+	now := core.Now()
+	for _, typ := range r.providedTypes {
+		r.sent.Set(id, typ, snapshot.GetVersionMap(string(typ)), now)
+	}
+}
+
 func (r *reconciler) changedTypes(old, n envoy_cache.ResourceSnapshot) []core_model.ResourceType {
 	var changed []core_model.ResourceType
 	for _, resType := range r.providedTypes {
@@ -14,6 +14,8 @@ import (
 	core_manager "github.com/kumahq/kuma/v3/pkg/core/resources/manager"
 	core_model "github.com/kumahq/kuma/v3/pkg/core/resources/model"
 	core_store "github.com/kumahq/kuma/v3/pkg/core/resources/store"
+	"github.com/kumahq/kuma/v3/pkg/kds/drift"
+	"github.com/kumahq/kuma/v3/pkg/kds/hash"
 	"github.com/kumahq/kuma/v3/pkg/kds/reconcile"
 	"github.com/kumahq/kuma/v3/pkg/kds/server"
 	core_metrics "github.com/kumahq/kuma/v3/pkg/metrics"
@@ -27,6 +29,7 @@ var _ = Describe("Reconciler", func() {
 	var reconciler reconcile.Reconciler
 	var store core_store.ResourceStore
 	var snapshotCache envoy_cache.SnapshotCache
+	var sent *drift.Tracker
 
 	node := &envoy_core.Node{
 		Id: "a",
@@ -37,16 +40,17 @@ var _ = Describe("Reconciler", func() {
 
 	BeforeEach(func() {
 		store = memory.NewStore()
-		generator := reconcile.NewSnapshotGenerator(core_manager.NewResourceManager(store), reconcile.Any, reconcile.NoopResourceMapper)
+		generator := reconcile.NewSnapshotGenerator(core_manager.NewResourceManager(store), reconcile.Any, reconcile.NoopResourceMapper, nil)
 		hasher := &server.Hasher{}
 		snapshotCache = envoy_cache.NewSnapshotCache(false, hasher, util_xds.NewLogger(logr.Discard()))
 		metrics, err := core_metrics.NewMetrics("zone-1")
 		Expect(err).ToNot(HaveOccurred())
 		statsCallbacks, err := util_xds.NewStatsCallbacks(metrics, "kds_delta", util_xds.NoopVersionExtractor)
 		Expect(err).ToNot(HaveOccurred())
+		sent = drift.NewTracker()
 		reconciler = reconcile.NewReconciler(hasher, snapshotCache, generator, config_core.Zone, statsCallbacks, multitenant.SingleTenant, []core_model.ResourceType{
 			core_mesh.MeshType,
-		})
+		}, sent)
 	})
 
 	It("should reconcile snapshot in snapshot cache", func() {
@@ -73,4 +77,27 @@ var _ = Describe("Reconciler", func() {
 		Expect(err).ToNot(HaveOccurred())
 		Expect(snapshot).To(BeIdenticalTo(newSnapshot))
 	})
+
+	It("should record versions of sent resources", func() {
+		// given
+		Expect(store.Create(context.Background(), samples.MeshDefault(), core_store.CreateByKey(core_model.DefaultMesh, core_model.NoMesh))).To(Succeed())
+
+		// when
+		err, _ := reconciler.Reconcile(context.Background(), node, changedTypes, logr.Discard())
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		snapshot, err := snapshotCache.GetSnapshot(node.Id)
+		Expect(err).ToNot(HaveOccurred())
+		state := sent.Get(node.Id)
+		Expect(state).To(HaveKey(core_mesh.MeshType))
+		Expect(state[core_mesh.MeshType].Count).To(Equal(uint32(1)))
+		Expect(state[core_mesh.MeshType].Version).To(Equal(hash.ResourceVersionsHash(snapshot.GetVersionMap(string(core_mesh.MeshType)))))
+
+		// when
+		Expect(reconciler.Clear(node)).To(Succeed())
+
+		// then
+		Expect(sent.Get(node.Id)).To(BeEmpty())
+	})
 })
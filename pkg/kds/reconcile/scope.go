@@ -0,0 +1,45 @@
+package reconcile
+
+import (
+	"context"
+	"slices"
+
+	core_mesh "github.com/kumahq/kuma/v3/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/model"
+)
+
+// SyncScope restricts resources that are synced to a KDS peer.
+// Empty lists don't restrict anything.
+type SyncScope struct {
+	// Meshes which resources are synced.
+	Meshes []string
+	// ResourceTypes of mesh-scoped resources that are synced. Resources not scoped to a mesh are always synced.
+	ResourceTypes []model.ResourceType
+}
+
+// SyncScopeProvider returns the scope of resources synced to the peer identified by clusterID.
+// It's evaluated once per snapshot, so it's cheaper than checking the scope in a ResourceFilter.
+type SyncScopeProvider func(ctx context.Context, clusterID string) (SyncScope, error)
+
+// IncludesType returns false when no resources of the type are synced.
+func (s SyncScope) IncludesType(desc model.ResourceTypeDescriptor) bool {
+	if desc.Scope != model.ScopeMesh || len(s.ResourceTypes) == 0 {
+		return true
+	}
+	return slices.Contains(s.ResourceTypes, desc.Name)
+}
+
+// IncludesResource returns false when the resource belongs to a mesh that is not synced.
+func (s SyncScope) IncludesResource(r model.Resource) bool {
+	if len(s.Meshes) == 0 {
+		return true
+	}
+	switch {
+	case r.Descriptor().Name == core_mesh.MeshType:
+		return slices.Contains(s.Meshes, r.GetMeta().GetName())
+	case r.Descriptor().Scope == model.ScopeMesh:
+		return slices.Contains(s.Meshes, r.GetMeta().GetMesh())
+	default:
+		return true
+	}
+}
@@ -7,6 +7,7 @@ import (
 	envoy_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	envoy_types "github.com/envoyproxy/go-control-plane/pkg/cache/types"
 	envoy_cache "github.com/envoyproxy/go-control-plane/pkg/cache/v3"
+	"github.com/pkg/errors"
 
 	config_store "github.com/kumahq/kuma/v3/pkg/config/core/resources/store"
 	core_manager "github.com/kumahq/kuma/v3/pkg/core/resources/manager"
@@ -77,11 +78,14 @@ func If(condition func(model.Resource) bool, m ResourceMapper) ResourceMapper {
 	}
 }
 
-func NewSnapshotGenerator(resourceManager core_manager.ReadOnlyResourceManager, filter ResourceFilter, mapper ResourceMapper) SnapshotGenerator {
+// NewSnapshotGenerator creates a SnapshotGenerator. When scope is not nil, only resources in the scope of a node are
+// included in its snapshot.
+func NewSnapshotGenerator(resourceManager core_manager.ReadOnlyResourceManager, filter ResourceFilter, mapper ResourceMapper, scope SyncScopeProvider) SnapshotGenerator {
 	return &snapshotGenerator{
 		resourceManager: resourceManager,
 		resourceFilter:  filter,
 		resourceMapper:  mapper,
+		scopeProvider:   scope,
 	}
 }
 
@@ -89,6 +93,7 @@ type snapshotGenerator struct {
 	resourceManager core_manager.ReadOnlyResourceManager
 	resourceFilter  ResourceFilter
 	resourceMapper  ResourceMapper
+	scopeProvider   SyncScopeProvider
 }
 
 func (s *snapshotGenerator) GenerateSnapshot(
@@ -97,8 +102,24 @@ func (s *snapshotGenerator) GenerateSnapshot(
 	builder kds_cache.SnapshotBuilder,
 	resTypes map[model.ResourceType]struct{},
 ) (envoy_cache.ResourceSnapshot, error) {
+	var scope SyncScope
+	if s.scopeProvider != nil {
+		var err error
+		if scope, err = s.scopeProvider(ctx, node.GetId()); err != nil {
+			return nil, errors.Wrap(err, "could not get scope of resources")
+		}
+	}
 	for typ := range resTypes {
-		resources, err := s.getResources(ctx, typ, node)
+		desc, err := registry.Global().DescriptorFor(typ)
+		if err != nil {
+			return nil, err
+		}
+		if !scope.IncludesType(desc) {
+			// set no resources, so the ones that were synced before are removed
+			builder = builder.With(typ, nil)
+			continue
+		}
+		resources, err := s.getResources(ctx, typ, node, scope)
 		if err != nil {
 			return nil, err
 		}
@@ -108,7 +129,7 @@ func (s *snapshotGenerator) GenerateSnapshot(
 	return builder.Build(""), nil
 }
 
-func (s *snapshotGenerator) getResources(ctx context.Context, typ model.ResourceType, node *envoy_core.Node) ([]envoy_types.Resource, error) {
+func (s *snapshotGenerator) getResources(ctx context.Context, typ model.ResourceType, node *envoy_core.Node, scope SyncScope) ([]envoy_types.Resource, error) {
 	rlist, err := registry.Global().NewList(typ)
 	if err != nil {
 		return nil, err
@@ -117,7 +138,7 @@ func (s *snapshotGenerator) getResources(ctx context.Context, typ model.Resource
 		return nil, err
 	}
 
-	resources, err := s.mapper(s.filter(ctx, rlist, node), node)
+	resources, err := s.mapper(s.filter(ctx, rlist, node, scope), node)
 	if err != nil {
 		return nil, err
 	}
@@ -125,12 +146,12 @@ func (s *snapshotGenerator) getResources(ctx context.Context, typ model.Resource
 	return util.ToEnvoyResources(resources)
 }
 
-func (s *snapshotGenerator) filter(ctx context.Context, rs model.ResourceList, node *envoy_core.Node) model.ResourceList {
+func (s *snapshotGenerator) filter(ctx context.Context, rs model.ResourceList, node *envoy_core.Node, scope SyncScope) model.ResourceList {
 	features := getFeatures(node)
 
 	rv := registry.Global().MustNewList(rs.GetItemType())
 	for _, r := range rs.GetItems() {
-		if s.resourceFilter(ctx, node.GetId(), features, r) {
+		if scope.IncludesResource(r) && s.resourceFilter(ctx, node.GetId(), features, r) {
 			_ = rv.AddItem(r)
 		}
 	}
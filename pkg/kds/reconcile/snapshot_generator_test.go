@@ -0,0 +1,86 @@
+package reconcile_test
+
+import (
+	"context"
+	"maps"
+	"slices"
+
+	envoy_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	core_mesh "github.com/kumahq/kuma/v3/pkg/core/resources/apis/mesh"
+	meshservice_api "github.com/kumahq/kuma/v3/pkg/core/resources/apis/meshservice/api/v1alpha1"
+	core_manager "github.com/kumahq/kuma/v3/pkg/core/resources/manager"
+	core_model "github.com/kumahq/kuma/v3/pkg/core/resources/model"
+	core_store "github.com/kumahq/kuma/v3/pkg/core/resources/store"
+	kds_cache "github.com/kumahq/kuma/v3/pkg/kds/cache"
+	"github.com/kumahq/kuma/v3/pkg/kds/reconcile"
+	"github.com/kumahq/kuma/v3/pkg/plugins/resources/memory"
+	"github.com/kumahq/kuma/v3/pkg/test/resources/builders"
+	"github.com/kumahq/kuma/v3/pkg/test/resources/samples"
+)
+
+var _ = Describe("SnapshotGenerator", func() {
+	var store core_store.ResourceStore
+
+	node := &envoy_core.Node{
+		Id: "zone-1",
+	}
+	types := map[core_model.ResourceType]struct{}{
+		core_mesh.MeshType:              {},
+		core_mesh.DataplaneType:         {},
+		meshservice_api.MeshServiceType: {},
+	}
+
+	BeforeEach(func() {
+		store = memory.NewStore()
+		for _, mesh := range []string{"mesh-1", "mesh-2"} {
+			Expect(builders.Mesh().WithName(mesh).Create(store)).To(Succeed())
+			Expect(samples.DataplaneBackendBuilder().WithMesh(mesh).Create(store)).To(Succeed())
+			Expect(samples.MeshServiceBackendBuilder().WithMesh(mesh).Create(store)).To(Succeed())
+		}
+	})
+
+	generate := func(scope reconcile.SyncScopeProvider) map[core_model.ResourceType]int {
+		generator := reconcile.NewSnapshotGenerator(core_manager.NewResourceManager(store), reconcile.Any, reconcile.NoopResourceMapper, scope)
+		snapshot, err := generator.GenerateSnapshot(context.Background(), node, kds_cache.NewSnapshotBuilder(slices.Collect(maps.Keys(types))), types)
+		Expect(err).ToNot(HaveOccurred())
+		counts := map[core_model.ResourceType]int{}
+		for typ := range types {
+			counts[typ] = len(snapshot.GetResources(string(typ)))
+		}
+		return counts
+	}
+
+	It("should include all resources without scope", func() {
+		Expect(generate(nil)).To(Equal(map[core_model.ResourceType]int{
+			core_mesh.MeshType:              2,
+			core_mesh.DataplaneType:         2,
+			meshservice_api.MeshServiceType: 2,
+		}))
+	})
+
+	It("should include only resources in the scope of the node", func() {
+		// given
+		var clusterID string
+		scope := func(_ context.Context, id string) (reconcile.SyncScope, error) {
+			clusterID = id
+			return reconcile.SyncScope{
+				Meshes:        []string{"mesh-1"},
+				ResourceTypes: []core_model.ResourceType{meshservice_api.MeshServiceType},
+			}, nil
+		}
+
+		// when
+		counts := generate(scope)
+
+		// then
+		Expect(clusterID).To(Equal("zone-1"))
+		Expect(counts).To(Equal(map[core_model.ResourceType]int{
+			core_mesh.MeshType:              1,
+			core_mesh.DataplaneType:         0,
+			meshservice_api.MeshServiceType: 1,
+		}))
+	})
+})
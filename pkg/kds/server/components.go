@@ -14,10 +14,12 @@ import (
 	"google.golang.org/protobuf/types/known/structpb"
 
 	system_proto "github.com/kumahq/kuma/v3/api/system/v1alpha1"
+	config_core "github.com/kumahq/kuma/v3/pkg/config/core"
 	"github.com/kumahq/kuma/v3/pkg/config/multizone"
 	"github.com/kumahq/kuma/v3/pkg/core/resources/model"
 	core_runtime "github.com/kumahq/kuma/v3/pkg/core/runtime"
 	"github.com/kumahq/kuma/v3/pkg/events"
+	"github.com/kumahq/kuma/v3/pkg/kds/drift"
 	kds_reconcile "github.com/kumahq/kuma/v3/pkg/kds/reconcile"
 	"github.com/kumahq/kuma/v3/pkg/kds/status"
 	"github.com/kumahq/kuma/v3/pkg/kds/util"
@@ -34,18 +36,19 @@ func New(
 	serverID string,
 	filter kds_reconcile.ResourceFilter,
 	mapper kds_reconcile.ResourceMapper,
+	scope kds_reconcile.SyncScopeProvider,
 	nackBackoff time.Duration,
 	eventBasedWatchdogCfg multizone.EventBasedWatchdogConfig,
 ) (delta.Server, *Metrics, error) {
 	hasher, cache := newKDSContext(log)
-	generator := kds_reconcile.NewSnapshotGenerator(rt.ReadOnlyResourceManager(), filter, mapper)
+	generator := kds_reconcile.NewSnapshotGenerator(rt.ReadOnlyResourceManager(), filter, mapper, scope)
 	statsCallbacks, err := util_xds.NewStatsCallbacks(rt.Metrics(), "kds_delta", kdsVersionExtractor)
 	if err != nil {
 		return nil, nil, err
 	}
 	syncTracker, kdsMetrics, err := newSyncTracker(
 		log,
-		kds_reconcile.NewReconciler(hasher, cache, generator, rt.GetMode(), statsCallbacks, rt.Tenants(), providedTypes),
+		kds_reconcile.NewReconciler(hasher, cache, generator, rt.GetMode(), statsCallbacks, rt.Tenants(), providedTypes, sentTracker(rt)),
 		rt.Metrics(),
 		rt.EventBus(),
 		eventBasedWatchdogCfg,
@@ -70,6 +73,14 @@ func New(
 	return delta.NewServer(context.Background(), cache, callbacks, delta.WithDistinctResourceTypes(1000)), kdsMetrics, nil
 }
 
+// sentTracker returns the tracker of resources sent to zones. Only global checks whether zones applied them.
+func sentTracker(rt core_runtime.Runtime) *drift.Tracker {
+	if rt.GetMode() != config_core.Global || rt.KDSContext() == nil {
+		return nil
+	}
+	return rt.KDSContext().SentResources
+}
+
 func newSyncTracker(
 	log logr.Logger,
 	reconciler kds_reconcile.Reconciler,
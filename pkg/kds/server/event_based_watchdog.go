@@ -11,6 +11,7 @@ import (
 	"github.com/go-logr/logr"
 
 	"github.com/kumahq/kuma/v3/pkg/core"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/apis/system"
 	"github.com/kumahq/kuma/v3/pkg/core/resources/model"
 	"github.com/kumahq/kuma/v3/pkg/events"
 	kds_reconcile "github.com/kumahq/kuma/v3/pkg/kds/reconcile"
@@ -35,7 +36,7 @@ func (e *EventBasedWatchdog) Start(ctx context.Context) {
 		switch ev := event.(type) {
 		case events.ResourceChangedEvent:
 			_, ok := e.ProvidedTypes[ev.Type]
-			return ok && ev.TenantID == tenantID
+			return (ok || e.isNodeZone(ev)) && ev.TenantID == tenantID
 		case events.TriggerKDSResyncEvent:
 			return ev.NodeID == e.Node.Id
 		}
@@ -91,6 +92,13 @@ func (e *EventBasedWatchdog) Start(ctx context.Context) {
 		case event := <-listener.Recv():
 			switch ev := event.(type) {
 			case events.ResourceChangedEvent:
+				if e.isNodeZone(ev) {
+					// the zone may restrict which resources it receives, so every type has to be reconciled again
+					e.Log.V(1).Info("schedule full resync", "event", "ZoneChanged")
+					changedTypes = maps.Clone(e.ProvidedTypes)
+					reasons[ReasonEvent] = struct{}{}
+					continue
+				}
 				e.Log.V(1).Info("schedule sync for type", "typ", ev.Type, "event", "ResourceChanged")
 				changedTypes[ev.Type] = struct{}{}
 				reasons[ReasonEvent] = struct{}{}
@@ -102,3 +110,8 @@ func (e *EventBasedWatchdog) Start(ctx context.Context) {
 		}
 	}
 }
+
+// isNodeZone returns true when the event is a change of the Zone resource of the node.
+func (e *EventBasedWatchdog) isNodeZone(ev events.ResourceChangedEvent) bool {
+	return ev.Type == system.ZoneType && ev.Key.Name == e.Node.Id
+}
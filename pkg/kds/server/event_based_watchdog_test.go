@@ -11,6 +11,7 @@ import (
 
 	"github.com/kumahq/kuma/v3/pkg/core/resources/apis/mesh"
 	meshexternalservice_api "github.com/kumahq/kuma/v3/pkg/core/resources/apis/meshexternalservice/api/v1alpha1"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/apis/system"
 	core_model "github.com/kumahq/kuma/v3/pkg/core/resources/model"
 	"github.com/kumahq/kuma/v3/pkg/events"
 	kds_reconcile "github.com/kumahq/kuma/v3/pkg/kds/reconcile"
@@ -151,6 +152,25 @@ var _ = Describe("Event Based Watchdog", func() {
 		}, "10s", "50ms").Should(Succeed())
 	})
 
+	It("should reconcile all types when the zone of the node changes", func() {
+		// when
+		eventBus.Send(events.ResourceChangedEvent{
+			Type: system.ZoneType,
+			Key:  core_model.ResourceKey{Name: "2"},
+		})
+		eventBus.Send(events.ResourceChangedEvent{
+			Type: system.ZoneType,
+			Key:  core_model.ResourceKey{Name: "1"},
+		})
+		// Send is not blocking so there is no guarantee that we execute flush before watchdog consumed events
+		time.Sleep(500 * time.Millisecond)
+		flushCh <- time.Now()
+
+		// then
+		changedResTypes := <-reconciler.changedResTypes
+		Expect(changedResTypes).To(Equal(watchdog.ProvidedTypes))
+	})
+
 	It("should not re-arm a delayed full resync ticker after shutdown", func() {
 		ticker, cleanup := newDelayedFullResyncTicker(20*time.Millisecond, 40*time.Millisecond)
 		defer cleanup()
@@ -27,6 +27,7 @@ import (
 	core_store "github.com/kumahq/kuma/v3/pkg/core/resources/store"
 	"github.com/kumahq/kuma/v3/pkg/events"
 	"github.com/kumahq/kuma/v3/pkg/kds"
+	"github.com/kumahq/kuma/v3/pkg/kds/drift"
 	"github.com/kumahq/kuma/v3/pkg/kds/util"
 	kuma_log "github.com/kumahq/kuma/v3/pkg/log"
 	"github.com/kumahq/kuma/v3/pkg/multitenant"
@@ -49,11 +50,12 @@ type GlobalKDSServiceServer struct {
 	upsertCfg               config_store.UpsertConfig
 	eventBus                events.EventBus
 	zoneHealthCheckInterval time.Duration
+	driftChecker            *drift.Checker
 	mesh_proto.UnimplementedGlobalKDSServiceServer
 	context context.Context
 }
 
-func NewGlobalKDSServiceServer(ctx context.Context, envoyAdminRPCs EnvoyAdminRPCs, resManager manager.ResourceManager, instanceID string, filters []StreamInterceptor, extensions context.Context, upsertCfg config_store.UpsertConfig, eventBus events.EventBus, zoneHealthCheckInterval time.Duration) *GlobalKDSServiceServer {
+func NewGlobalKDSServiceServer(ctx context.Context, envoyAdminRPCs EnvoyAdminRPCs, resManager manager.ResourceManager, instanceID string, filters []StreamInterceptor, extensions context.Context, upsertCfg config_store.UpsertConfig, eventBus events.EventBus, zoneHealthCheckInterval time.Duration, driftChecker *drift.Checker) *GlobalKDSServiceServer {
 	return &GlobalKDSServiceServer{
 		context:                 ctx,
 		envoyAdminRPCs:          envoyAdminRPCs,
@@ -64,6 +66,7 @@ func NewGlobalKDSServiceServer(ctx context.Context, envoyAdminRPCs EnvoyAdminRPC
 		upsertCfg:               upsertCfg,
 		eventBus:                eventBus,
 		zoneHealthCheckInterval: zoneHealthCheckInterval,
+		driftChecker:            driftChecker,
 	}
 }
 
@@ -87,7 +90,7 @@ func (g *GlobalKDSServiceServer) StreamClusters(stream mesh_proto.GlobalKDSServi
 	})
 }
 
-func (g *GlobalKDSServiceServer) HealthCheck(ctx context.Context, _ *mesh_proto.ZoneHealthCheckRequest) (*mesh_proto.ZoneHealthCheckResponse, error) {
+func (g *GlobalKDSServiceServer) HealthCheck(ctx context.Context, req *mesh_proto.ZoneHealthCheckRequest) (*mesh_proto.ZoneHealthCheckResponse, error) {
 	zone, err := util.ClientIDFromIncomingCtx(ctx)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
@@ -96,6 +99,14 @@ func (g *GlobalKDSServiceServer) HealthCheck(ctx context.Context, _ *mesh_proto.
 	tenantZoneID := TenantZoneClientIDFromCtx(ctx, zone)
 	log := log.WithValues("clientID", tenantZoneID.String())
 
+	var resourceSync *system_proto.KDSResourceSync
+	if g.driftChecker != nil {
+		resourceSync = g.driftChecker.Check(tenantZoneID.String(), req.GetResourceTypes(), core.Now())
+	}
+	if resourceSync.GetDivergedTypes() > 0 {
+		log.Info("zone diverged from global", "divergedTypes", resourceSync.GetDivergedTypes())
+	}
+
 	insight := system.NewZoneInsightResource()
 	if err := manager.Upsert(ctx, g.resManager, model.ResourceKey{Name: zone, Mesh: model.NoMesh}, insight, func(resource model.Resource) error {
 		if insight.Spec.HealthCheck == nil {
@@ -103,6 +114,10 @@ func (g *GlobalKDSServiceServer) HealthCheck(ctx context.Context, _ *mesh_proto.
 		}
 
 		insight.Spec.HealthCheck.Time = timestamppb.Now()
+		// the check is done only by the instance that holds the stream to the zone, don't override its result
+		if resourceSync != nil {
+			insight.Spec.ResourceSync = resourceSync
+		}
 		return nil
 	}, manager.WithConflictRetry(
 		g.upsertCfg.ConflictRetryBaseBackoff.Duration, g.upsertCfg.ConflictRetryMaxTimes, g.upsertCfg.ConflictRetryJitterPercent,
@@ -16,6 +16,7 @@ import (
 	mesh_proto "github.com/kumahq/kuma/v3/api/mesh/v1alpha1"
 	config_core "github.com/kumahq/kuma/v3/pkg/config/core"
 	"github.com/kumahq/kuma/v3/pkg/core"
+	"github.com/kumahq/kuma/v3/pkg/core/audit"
 	core_mesh "github.com/kumahq/kuma/v3/pkg/core/resources/apis/mesh"
 	"github.com/kumahq/kuma/v3/pkg/core/resources/apis/system"
 	core_model "github.com/kumahq/kuma/v3/pkg/core/resources/model"
@@ -24,6 +25,7 @@ import (
 	"github.com/kumahq/kuma/v3/pkg/core/user"
 	"github.com/kumahq/kuma/v3/pkg/kds"
 	kds_client "github.com/kumahq/kuma/v3/pkg/kds/client"
+	"github.com/kumahq/kuma/v3/pkg/kds/drift"
 	"github.com/kumahq/kuma/v3/pkg/kds/util"
 	kuma_log "github.com/kumahq/kuma/v3/pkg/log"
 	core_metrics "github.com/kumahq/kuma/v3/pkg/metrics"
@@ -138,6 +140,8 @@ func NewResourceSyncer(
 type OnUpdate struct {
 	r    core_model.Resource
 	opts []store.UpdateOptionsFunc
+	// before is the spec of the downstream resource, r carries the spec from the upstream
+	before core_model.ResourceSpec
 }
 
 func (s *syncResourceStore) Sync(syncCtx context.Context, upstreamResponse kds_client.UpstreamResponse, fs ...SyncOptionFunc) (error, error) {
@@ -147,6 +151,10 @@ func (s *syncResourceStore) Sync(syncCtx context.Context, upstreamResponse kds_c
 	}()
 	opts := NewSyncOptions(fs...)
 	ctx := user.Ctx(syncCtx, user.ControlPlane)
+	// read only types like insights are statuses changed all the time by the system, auditing them would flood the audit log
+	if desc, err := registry.Global().DescriptorFor(upstreamResponse.Type); err == nil && !desc.ReadOnly {
+		ctx = audit.WithSource(ctx, audit.SourceKDS)
+	}
 	log := s.log.WithValues("type", upstreamResponse.Type)
 	log = kuma_log.AddFieldsFromCtx(log, ctx, s.extensions)
 	upstream := upstreamResponse.AddedResources
@@ -254,7 +262,7 @@ func (s *syncResourceStore) Sync(syncCtx context.Context, upstreamResponse kds_c
 					continue
 				}
 			}
-			onUpdate = append(onUpdate, OnUpdate{r: r, opts: []store.UpdateOptionsFunc{store.UpdateWithLabels(newLabels)}})
+			onUpdate = append(onUpdate, OnUpdate{r: r, opts: []store.UpdateOptionsFunc{store.UpdateWithLabels(newLabels)}, before: existing.GetSpec()})
 		}
 	}
 
@@ -316,7 +324,7 @@ func (s *syncResourceStore) Sync(syncCtx context.Context, upstreamResponse kds_c
 			// some stores manage ModificationTime time on they own (Kubernetes), in order to be consistent
 			// we set ModificationTime when we add to downstream store. This time is almost the same with ModificationTime
 			// from upstream store, because we update downstream only when resource have changed in upstream
-			if err := s.resourceStore.Update(ctx, upd.r, append(upd.opts, store.ModifiedAt(time.Now()))...); err != nil {
+			if err := s.resourceStore.Update(audit.WithSpecBefore(ctx, upd.before), upd.r, append(upd.opts, store.ModifiedAt(time.Now()))...); err != nil {
 				if !store.IsConflict(err) {
 					return err
 				}
@@ -348,7 +356,8 @@ func (s *syncResourceStore) retryConflictedUpdates(ctx context.Context, pending
 	}
 	backoff := retry.WithMaxRetries(updateConflictRetries, retry.WithFullJitter(retry.NewConstant(updateConflictBackoff)))
 	err := retry.Do(ctx, backoff, func(ctx context.Context) error {
-		for _, upd := range pending {
+		for i := range pending {
+			upd := &pending[i]
 			log.Info("resource was modified in another place while syncing, retrying with a fresh copy",
 				"name", upd.r.GetMeta().GetName(), "mesh", upd.r.GetMeta().GetMesh())
 			if err := s.refreshForUpdate(ctx, upd, opts); err != nil {
@@ -359,7 +368,7 @@ func (s *syncResourceStore) retryConflictedUpdates(ctx context.Context, pending
 		var lastConflict error
 		if err := store.InTx(ctx, s.transactions, func(ctx context.Context) error {
 			for _, upd := range pending {
-				if err := s.resourceStore.Update(ctx, upd.r, append(upd.opts, store.ModifiedAt(time.Now()))...); err != nil {
+				if err := s.resourceStore.Update(audit.WithSpecBefore(ctx, upd.before), upd.r, append(upd.opts, store.ModifiedAt(time.Now()))...); err != nil {
 					if !store.IsConflict(err) {
 						return err
 					}
@@ -388,7 +397,7 @@ func (s *syncResourceStore) retryConflictedUpdates(ctx context.Context, pending
 // change onto it, so the retry carries the current version. Status comes from the
 // fresh copy for the same reason Sync preserves it: on the Zone it belongs to
 // local components, not to the upstream.
-func (s *syncResourceStore) refreshForUpdate(ctx context.Context, upd OnUpdate, opts *SyncOption) error {
+func (s *syncResourceStore) refreshForUpdate(ctx context.Context, upd *OnUpdate, opts *SyncOption) error {
 	fresh, err := registry.Global().NewObject(upd.r.Descriptor().Name)
 	if err != nil {
 		return err
@@ -398,6 +407,7 @@ func (s *syncResourceStore) refreshForUpdate(ctx context.Context, upd OnUpdate,
 		return err
 	}
 	upd.r.SetMeta(fresh.GetMeta())
+	upd.before = fresh.GetSpec()
 	if upd.r.Descriptor().HasStatus && opts.IgnoreStatusChange {
 		return upd.r.SetStatus(fresh.GetStatus())
 	}
@@ -455,6 +465,31 @@ func ZoneSyncCallback(ctx context.Context, syncer ResourceSyncer, k8sStore bool,
 	}
 }
 
+// ZoneStoreKey returns the function which maps the key of a resource received from global
+// to the key under which ZoneSyncCallback stores the resource.
+func ZoneStoreKey(k8sStore bool, kubeFactory resources_k8s.KubeFactory, systemNamespace string) drift.StoreKeyFunc {
+	return func(typ core_model.ResourceType, key core_model.ResourceKey) (core_model.ResourceKey, error) {
+		if !k8sStore {
+			return key, nil
+		}
+		desc, err := registry.Global().DescriptorFor(typ)
+		if err != nil {
+			return core_model.ResourceKey{}, err
+		}
+		if desc.SkipKDSHash {
+			return key, nil
+		}
+		kubeObject, err := kubeFactory.NewObject(desc.NewObject())
+		if err != nil {
+			return core_model.ResourceKey{}, errors.Wrap(err, "could not convert object")
+		}
+		if kubeObject.Scope() == k8s_model.ScopeNamespace {
+			key.Name = fmt.Sprintf("%s.%s", key.Name, systemNamespace)
+		}
+		return key, nil
+	}
+}
+
 func GlobalSyncCallback(
 	ctx context.Context,
 	syncer ResourceSyncer,
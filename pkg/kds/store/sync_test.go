@@ -10,6 +10,7 @@ import (
 
 	mesh_proto "github.com/kumahq/kuma/v3/api/mesh/v1alpha1"
 	"github.com/kumahq/kuma/v3/pkg/core"
+	"github.com/kumahq/kuma/v3/pkg/core/audit"
 	core_meta "github.com/kumahq/kuma/v3/pkg/core/metadata"
 	"github.com/kumahq/kuma/v3/pkg/core/resources/apis/mesh"
 	meshservice_api "github.com/kumahq/kuma/v3/pkg/core/resources/apis/meshservice/api/v1alpha1"
@@ -590,3 +591,72 @@ var _ = Describe("SyncResourceStoreDelta write conflicts on zone-owned status",
 		Expect(actual.Status.VIPs).To(Equal([]meshservice_api.VIP{{IP: "10.0.0.2"}}))
 	})
 })
+
+var _ = Describe("SyncResourceStoreDelta audit", func() {
+	var syncer kds_sync_store.ResourceSyncer
+	var recorder *audit.Recorder
+
+	BeforeEach(func() {
+		metrics, err := core_metrics.NewMetrics("")
+		Expect(err).ToNot(HaveOccurred())
+		recorder, err = audit.NewRecorder(10, 10, nil, metrics)
+		Expect(err).ToNot(HaveOccurred())
+		syncer, err = kds_sync_store.NewResourceSyncer(core.Log, audit.NewStore(memory.NewStore(), recorder), store.NoTransactions{}, metrics, context.Background())
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should record changes of resources and skip insights", func() {
+		// given
+		meshes := &mesh.MeshResourceList{}
+		Expect(meshes.AddItem(meshBuilder(1))).To(Succeed())
+		insights := &mesh.DataplaneInsightResourceList{}
+		Expect(insights.AddItem(&mesh.DataplaneInsightResource{
+			Meta: &model2.ResourceMeta{Name: "dp-1", Mesh: "mesh-1"},
+			Spec: &mesh_proto.DataplaneInsight{},
+		})).To(Succeed())
+
+		// when
+		for _, upstream := range []model.ResourceList{meshes, insights} {
+			err, nackError := syncer.Sync(context.Background(), kds_client.UpstreamResponse{
+				Type:           upstream.GetItemType(),
+				AddedResources: upstream,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(nackError).ToNot(HaveOccurred())
+		}
+
+		// then
+		entries := recorder.Recent(audit.Query{})
+		Expect(entries).To(HaveLen(1))
+		Expect(entries[0].Type).To(Equal(mesh.MeshType))
+		Expect(entries[0].Source).To(Equal(audit.SourceKDS))
+		Expect(entries[0].Operation).To(Equal(audit.OperationCreate))
+	})
+
+	It("should record hashes of the spec before and after an update", func() {
+		// given
+		sync := func(m *mesh.MeshResource) {
+			meshes := &mesh.MeshResourceList{}
+			Expect(meshes.AddItem(m)).To(Succeed())
+			err, nackError := syncer.Sync(context.Background(), kds_client.UpstreamResponse{
+				Type:           mesh.MeshType,
+				AddedResources: meshes,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(nackError).ToNot(HaveOccurred())
+		}
+		sync(meshBuilder(1))
+
+		// when
+		updated := meshBuilder(1)
+		updated.Spec.SkipCreatingInitialPolicies = []string{"*"}
+		sync(updated)
+
+		// then
+		entries := recorder.Recent(audit.Query{})
+		Expect(entries).To(HaveLen(2))
+		Expect(entries[0].Operation).To(Equal(audit.OperationUpdate))
+		Expect(entries[0].BeforeHash).To(Equal(audit.HashSpec(meshBuilder(1).Spec)))
+		Expect(entries[0].AfterHash).To(Equal(audit.HashSpec(updated.Spec)))
+	})
+})
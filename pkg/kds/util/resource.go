@@ -38,7 +38,7 @@ func ToDeltaCoreResourceList(response *envoy_sd.DeltaDiscoveryResponse) (core_mo
 			return nil, nil, err
 		}
 		krs = append(krs, kr)
-		resourceVersions[kr.GetMeta().GetName()] = r.Version
+		resourceVersions[kds_cache.GetResourceName(kr)] = r.Version
 	}
 	list, err := toResources(core_model.ResourceType(response.TypeUrl), krs)
 	if err != nil {
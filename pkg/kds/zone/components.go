@@ -32,6 +32,7 @@ func Setup(rt core_runtime.Runtime) error {
 		zone,
 		kdsCtx.ZoneProvidedFilter,
 		kdsCtx.ZoneResourceMapper,
+		nil,
 		rt.Config().Multizone.Zone.KDS.NackBackoff.Duration,
 		rt.Config().Multizone.Zone.KDS.EventBasedWatchdog.AsRuntimeConfig(),
 	)
@@ -35,6 +35,9 @@ func NewLeaderElector(b *core_runtime.Builder) (component.LeaderElector, error)
 		return elector, nil
 	case store.MemoryStore:
 		return leader_memory.NewAlwaysLeaderElector(), nil
+	// the embedded database file is locked by a single instance, so this instance is always the leader
+	case store.EmbeddedStore:
+		return leader_memory.NewAlwaysLeaderElector(), nil
 	// In case of Kubernetes, Leader Elector is embedded in a Kubernetes ComponentManager
 	default:
 		return nil, errors.Errorf("no election leader for storage of type %s", b.Config().Store.Type)
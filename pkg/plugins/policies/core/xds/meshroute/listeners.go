@@ -1,6 +1,8 @@
 package meshroute
 
 import (
+	"fmt"
+
 	common_api "github.com/kumahq/kuma/v3/api/common/v1alpha1"
 	mesh_proto "github.com/kumahq/kuma/v3/api/mesh/v1alpha1"
 	"github.com/kumahq/kuma/v3/pkg/core/kri"
@@ -62,6 +64,30 @@ type DestinationService struct {
 	Protocol            core_meta.Protocol
 	DestinationResource string
 	KumaServiceTagValue string
+	// Hostnames restricts the traffic of the outbound to these hostnames by SNI or Host header.
+	// It's empty unless the destination matches wildcard hostnames.
+	Hostnames []string
+	// TLSOriginated is true when the proxy originates TLS to the destination.
+	TLSOriginated bool
+}
+
+// VirtualHostDomains returns domains of the outbound virtual host, empty means every domain.
+// A Host header can contain the port, so every hostname is also matched with the port of the outbound.
+func (ds *DestinationService) VirtualHostDomains() []string {
+	var domains []string
+	for _, hostname := range ds.Hostnames {
+		domains = append(domains, hostname, fmt.Sprintf("%s:%d", hostname, ds.Outbound.GetPort()))
+	}
+	return domains
+}
+
+// SNIHostnames returns hostnames that can be matched by SNI of the outbound traffic.
+// When the proxy originates TLS, the application sends plaintext traffic without SNI.
+func (ds *DestinationService) SNIHostnames() []string {
+	if ds.TLSOriginated {
+		return nil
+	}
+	return ds.Hostnames
 }
 
 // OutboundListenerTags returns the outbound listener's io.kuma.tags: the
@@ -140,6 +166,8 @@ func CollectServices(proxy *core_xds.Proxy, meshCtx xds_context.MeshContext) []D
 				Protocol:            protocol,
 				DestinationResource: outbound.Resource.String(),
 				KumaServiceTagValue: kumaServiceTagValue(svc),
+				Hostnames:           matchedHostnames(svc),
+				TLSOriginated:       isTLSOriginated(svc),
 			},
 		)
 	}
@@ -147,6 +175,20 @@ func CollectServices(proxy *core_xds.Proxy, meshCtx xds_context.MeshContext) []D
 	return result
 }
 
+func matchedHostnames(dest core.Destination) []string {
+	if withHostnames, ok := dest.(interface{ MatchedHostnames() []string }); ok {
+		return withHostnames.MatchedHostnames()
+	}
+	return nil
+}
+
+func isTLSOriginated(dest core.Destination) bool {
+	if withTLS, ok := dest.(interface{ IsTLSOriginated() bool }); ok {
+		return withTLS.IsTLSOriginated()
+	}
+	return false
+}
+
 func kumaServiceTagValue(dest core.Destination) string {
 	if serviceTag := dest.GetMeta().GetLabels()[mesh_proto.ServiceTag]; serviceTag != "" {
 		return serviceTag
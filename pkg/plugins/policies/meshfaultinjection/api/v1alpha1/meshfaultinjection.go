@@ -6,6 +6,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/intstr"
 
 	common_api "github.com/kumahq/kuma/v3/api/common/v1alpha1"
+	meshhttproute_api "github.com/kumahq/kuma/v3/pkg/plugins/policies/meshhttproute/api/v1alpha1"
 )
 
 // MeshFaultInjection allows you to test the resiliency of your services by injecting faults like delays, connection aborts, and response bandwidth limits into the traffic. This is useful for chaos testing and validating that your applications handle failures gracefully.
@@ -55,6 +56,15 @@ type FaultInjectionConf struct {
 	// ResponseBandwidth defines a configuration to limit the speed of
 	// responding to the requests
 	ResponseBandwidth *ResponseBandwidthConf `json:"responseBandwidth,omitempty"`
+	// Matches limits the faults to requests matching at least one of the
+	// matches. Matches have the same format as in MeshHTTPRoute. When not
+	// defined, faults are injected into all requests.
+	// +kubebuilder:validation:MaxItems=16
+	Matches *[]meshhttproute_api.Match `json:"matches,omitempty"`
+	// MaxActiveFaults is the maximum number of faults that can be active at
+	// the same time. When not defined, the number of active faults is not
+	// limited.
+	MaxActiveFaults *uint32 `json:"maxActiveFaults,omitempty"`
 }
 
 type AbortConf struct {
@@ -7,6 +7,7 @@ import (
 	core_model "github.com/kumahq/kuma/v3/pkg/core/resources/model"
 	"github.com/kumahq/kuma/v3/pkg/core/validators"
 	"github.com/kumahq/kuma/v3/pkg/plugins/policies/core/rules/inbound"
+	meshhttproute_api "github.com/kumahq/kuma/v3/pkg/plugins/policies/meshhttproute/api/v1alpha1"
 	"github.com/kumahq/kuma/v3/pkg/util/pointer"
 )
 
@@ -103,6 +104,14 @@ func validateDefault(path validators.PathBuilder, conf Conf) validators.Validati
 			verr.Add(validators.ValidateBandwidth(path.Field("limit"), fault.ResponseBandwidth.Limit))
 			verr.Add(validators.ValidatePercentage(path.Field("percentage"), &fault.ResponseBandwidth.Percentage, true))
 		}
+		if fault.Matches != nil {
+			path := path.Index(idx).Field("matches")
+			if len(*fault.Matches) == 0 {
+				verr.AddViolationAt(path, validators.MustNotBeEmpty)
+			}
+			verr.AddErrorAt(path, meshhttproute_api.ValidateMatches(*fault.Matches))
+		}
+		verr.Add(validators.ValidateIntegerGreaterThanZeroOrNil(path.Index(idx).Field("maxActiveFaults"), fault.MaxActiveFaults))
 	}
 	return verr
 }
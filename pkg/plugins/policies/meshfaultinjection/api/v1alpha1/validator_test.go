@@ -81,6 +81,28 @@ rules:
         - responseBandwidth:
             limit: 1000Mbps
             percentage: 50
+`),
+		Entry("accepts faults scoped to matching requests", `
+type: MeshFaultInjection
+mesh: mesh-1
+name: fi1
+targetRef:
+  kind: Mesh
+rules:
+  - default:
+      http:
+        - abort:
+            httpStatus: 503
+            percentage: 100
+          maxActiveFaults: 10
+          matches:
+            - headers:
+                - name: x-chaos
+                  value: "on"
+              method: POST
+            - path:
+                type: PathPrefix
+                value: /synthetic
 `),
 	)
 
@@ -136,6 +158,50 @@ rules:
       - responseBandwidth:
           limit: 1000
           percentage: 1111
+`),
+		ErrorCases("incorrect matches and max active faults",
+			[]validators.Violation{
+				{
+					Field:   `spec.rules[0].default.http[0].matches`,
+					Message: `must not be empty`,
+				},
+				{
+					Field:   `spec.rules[0].default.http[0].maxActiveFaults`,
+					Message: `must be greater than 0`,
+				},
+				{
+					Field:   `spec.rules[0].default.http[1].matches[0].path.value`,
+					Message: `must be an absolute path`,
+				},
+				{
+					Field:   `spec.rules[0].default.http[1].matches[0].headers[0].value`,
+					Message: `must not be defined`,
+				},
+			}, `
+type: MeshFaultInjection
+mesh: mesh-1
+name: fi1
+targetRef:
+  kind: Mesh
+rules:
+  - default:
+      http:
+      - abort:
+          httpStatus: 503
+          percentage: 50
+        matches: []
+        maxActiveFaults: 0
+      - delay:
+          value: 1s
+          percentage: 50
+        matches:
+          - path:
+              type: Exact
+              value: synthetic
+            headers:
+              - name: x-chaos
+                type: Present
+                value: "on"
 `),
 		ErrorCases("matches sni incorrect",
 			[]validators.Violation{
@@ -6,6 +6,7 @@ package v1alpha1
 
 import (
 	commonv1alpha1 "github.com/kumahq/kuma/v3/api/common/v1alpha1"
+	apiv1alpha1 "github.com/kumahq/kuma/v3/pkg/plugins/policies/meshhttproute/api/v1alpha1"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -85,6 +86,22 @@ func (in *FaultInjectionConf) DeepCopyInto(out *FaultInjectionConf) {
 		*out = new(ResponseBandwidthConf)
 		**out = **in
 	}
+	if in.Matches != nil {
+		in, out := &in.Matches, &out.Matches
+		*out = new([]apiv1alpha1.Match)
+		if **in != nil {
+			in, out := *in, *out
+			*out = make([]apiv1alpha1.Match, len(*in))
+			for i := range *in {
+				(*in)[i].DeepCopyInto(&(*out)[i])
+			}
+		}
+	}
+	if in.MaxActiveFaults != nil {
+		in, out := &in.MaxActiveFaults, &out.MaxActiveFaults
+		*out = new(uint32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FaultInjectionConf.
@@ -21,6 +21,7 @@ import (
 	plugins_xds "github.com/kumahq/kuma/v3/pkg/plugins/policies/core/xds"
 	api "github.com/kumahq/kuma/v3/pkg/plugins/policies/meshfaultinjection/api/v1alpha1"
 	plugin "github.com/kumahq/kuma/v3/pkg/plugins/policies/meshfaultinjection/plugin/v1alpha1"
+	meshhttproute_api "github.com/kumahq/kuma/v3/pkg/plugins/policies/meshhttproute/api/v1alpha1"
 	"github.com/kumahq/kuma/v3/pkg/test"
 	test_matchers "github.com/kumahq/kuma/v3/pkg/test/matchers"
 	"github.com/kumahq/kuma/v3/pkg/test/resources/builders"
@@ -28,6 +29,7 @@ import (
 	"github.com/kumahq/kuma/v3/pkg/test/resources/samples"
 	xds_builders "github.com/kumahq/kuma/v3/pkg/test/xds/builders"
 	xds_samples "github.com/kumahq/kuma/v3/pkg/test/xds/samples"
+	"github.com/kumahq/kuma/v3/pkg/util/pointer"
 	util_proto "github.com/kumahq/kuma/v3/pkg/util/proto"
 	envoy_common "github.com/kumahq/kuma/v3/pkg/xds/envoy"
 	"github.com/kumahq/kuma/v3/pkg/xds/envoy/listeners"
@@ -200,6 +202,67 @@ var _ = Describe("MeshFaultInjection", func() {
 			},
 			expectedListeners: []string{"basic_listener_1_rules.golden.yaml", "basic_listener_2_rules.golden.yaml"},
 		}),
+		Entry("faults scoped to matching requests", sidecarTestCase{
+			resources: []*core_xds.Resource{
+				{
+					Name:   inboundName17777,
+					Origin: metadata.OriginInbound,
+					Resource: listeners.NewListenerBuilder(envoy_common.APIV3, inboundName17777).
+						Configure(listeners.InboundListener("127.0.0.1", 17777, core_xds.SocketAddressProtocolTCP, true)).
+						Configure(listeners.FilterChain(listeners.NewFilterChainBuilder(envoy_common.APIV3, envoy_common.AnonymousResource).
+							Configure(listeners.HttpConnectionManager(inboundName17777, false, nil, true)).
+							Configure(
+								listeners.HttpInboundRoute(
+									inboundName17777,
+									inboundName17777,
+									plugins_xds.NewClusterBuilder().WithName(inboundName17777).Build(),
+								),
+							),
+						)).MustBuild(),
+				},
+			},
+			policies: core_rules.FromRules{
+				InboundRules: map[core_rules.InboundListener][]*inbound.Rule{
+					{Address: "127.0.0.1", Port: 17777}: {
+						{
+							Conf: api.Conf{
+								Http: &[]api.FaultInjectionConf{
+									{
+										Abort: &api.AbortConf{
+											HttpStatus: int32(503),
+											Percentage: intstr.FromInt32(100),
+										},
+										MaxActiveFaults: pointer.To(uint32(5)),
+										Matches: &[]meshhttproute_api.Match{
+											{
+												Headers: &[]common_api.HeaderMatch{{
+													Name:  "x-chaos",
+													Value: "on",
+												}},
+												Method: pointer.To(meshhttproute_api.Method("POST")),
+											},
+											{
+												Path: &meshhttproute_api.PathMatch{
+													Type:  meshhttproute_api.PathPrefix,
+													Value: "/synthetic",
+												},
+												QueryParams: &[]meshhttproute_api.QueryParamsMatch{{
+													Type:  meshhttproute_api.ExactQueryMatch,
+													Name:  "chaos",
+													Value: "true",
+												}},
+											},
+										},
+									},
+								},
+							},
+							Origin: policyOrigin("mfi-1"),
+						},
+					},
+				},
+			},
+			expectedListeners: []string{"request_matches.listener.golden.yaml"},
+		}),
 	)
 
 	It("should generate proper Envoy config for zone egress listener with rules[].matches[].sni", func() {
@@ -1,6 +1,7 @@
 package xds
 
 import (
+	matcher_config "github.com/cncf/xds/go/xds/type/matcher/v3"
 	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
 	envoy_filter_fault "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/common/fault/v3"
 	envoy_http_fault "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/fault/v3"
@@ -45,17 +46,15 @@ func (c *Configurer) addFaultFilters(hcm *envoy_hcm.HttpConnectionManager) error
 		if rule.Match != nil {
 			matches = []common_api.Match{pointer.Deref(rule.Match)}
 		}
-		matcher := bldrs_matchers.Matcher(
-			bldrs_matchers.NewMatcherBuilder().Configure(
-				bldrs_matchers.FieldMatcher(
-					bldrs_matchers.NewFieldMatcher().Configure(
-						bldrs_matchers.NotMatches(
-							matches,
-							bldrs_matchers.NewOnMatch().Configure(bldrs_matchers.SkipFilterAction()),
-						),
-					),
-				)),
-		)
+		sourceFieldMatcher, err := bldrs_matchers.NewFieldMatcher().Configure(
+			bldrs_matchers.NotMatches(
+				matches,
+				bldrs_matchers.NewOnMatch().Configure(bldrs_matchers.SkipFilterAction()),
+			),
+		).Build()
+		if err != nil {
+			return err
+		}
 
 		for _, fault := range pointer.Deref(matchesConf.Http) {
 			faultConfig, _ := configureFault(fault)
@@ -64,6 +63,19 @@ func (c *Configurer) addFaultFilters(hcm *envoy_hcm.HttpConnectionManager) error
 				return err
 			}
 
+			requestFieldMatcher, err := notRequestMatchesFieldMatcher(pointer.Deref(fault.Matches))
+			if err != nil {
+				return err
+			}
+			matcher := bldrs_matchers.Matcher(
+				bldrs_matchers.NewMatcherBuilder().Configure(
+					bldrs_matchers.MatchersList([]*matcher_config.Matcher_MatcherList_FieldMatcher{
+						sourceFieldMatcher,
+						requestFieldMatcher,
+					}),
+				),
+			)
+
 			extensionWithMatcher, err := bldrs_matchers.NewExtensionWithMatcher().
 				Configure(matcher).
 				Configure(bldrs_matchers.Filter("envoy.filters.http.fault", faultFilter)).
@@ -107,6 +119,10 @@ func configureFault(fault policies_api.FaultInjectionConf) (*envoy_http_fault.HT
 	}
 	faultConfig.ResponseRateLimit = rrl
 
+	if fault.MaxActiveFaults != nil {
+		faultConfig.MaxActiveFaults = util_proto.UInt32(*fault.MaxActiveFaults)
+	}
+
 	return faultConfig, nil
 }
 
@@ -0,0 +1,180 @@
+package xds
+
+import (
+	"regexp"
+
+	matcher_config "github.com/cncf/xds/go/xds/type/matcher/v3"
+
+	common_api "github.com/kumahq/kuma/v3/api/common/v1alpha1"
+	bldrs_common "github.com/kumahq/kuma/v3/pkg/envoy/builders/common"
+	bldrs_matchers "github.com/kumahq/kuma/v3/pkg/envoy/builders/xds/matchers"
+	meshhttproute_api "github.com/kumahq/kuma/v3/pkg/plugins/policies/meshhttproute/api/v1alpha1"
+	"github.com/kumahq/kuma/v3/pkg/util/pointer"
+)
+
+// queryStringRegex matches the optional query string of the ":path"
+// pseudo-header, so path matches behave the same as in MeshHTTPRoute.
+const queryStringRegex = `(\?.*)?`
+
+// notRequestMatchesFieldMatcher returns a field matcher that skips the fault
+// filter for requests not matching any of the matches. It returns nil when
+// there are no matches, so faults are injected into all requests.
+func notRequestMatchesFieldMatcher(matches []meshhttproute_api.Match) (*matcher_config.Matcher_MatcherList_FieldMatcher, error) {
+	if len(matches) == 0 {
+		return nil, nil
+	}
+
+	var predicates []*matcher_config.Matcher_MatcherList_Predicate
+	for _, match := range matches {
+		matchPredicates, err := requestMatchPredicates(match)
+		if err != nil {
+			return nil, err
+		}
+		if len(matchPredicates) == 0 {
+			// an empty match matches every request
+			return nil, nil
+		}
+		predicate, err := bldrs_matchers.NewPredicate().Configure(bldrs_matchers.AndPredicate(matchPredicates)).Build()
+		if err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, predicate)
+	}
+
+	combined, err := bldrs_matchers.NewPredicate().Configure(bldrs_matchers.CombinedPredicate(predicates)).Build()
+	if err != nil {
+		return nil, err
+	}
+	notCombined, err := bldrs_matchers.NewPredicate().Configure(bldrs_matchers.NotPredicate(combined)).Build()
+	if err != nil {
+		return nil, err
+	}
+	onMatch, err := bldrs_matchers.NewOnMatch().Configure(bldrs_matchers.SkipFilterAction()).Build()
+	if err != nil {
+		return nil, err
+	}
+
+	return &matcher_config.Matcher_MatcherList_FieldMatcher{
+		Predicate: notCombined,
+		OnMatch:   onMatch,
+	}, nil
+}
+
+func requestMatchPredicates(match meshhttproute_api.Match) ([]*matcher_config.Matcher_MatcherList_Predicate, error) {
+	var predicates []*matcher_config.Matcher_MatcherList_Predicate
+	add := func(configurer bldrs_common.Configurer[matcher_config.Matcher_MatcherList_Predicate]) error {
+		predicate, err := bldrs_matchers.NewPredicate().Configure(configurer).Build()
+		if err != nil {
+			return err
+		}
+		predicates = append(predicates, predicate)
+		return nil
+	}
+
+	if match.Path != nil {
+		if err := add(bldrs_matchers.HttpRequestHeaderPredicate(":path", pathMatcher(*match.Path))); err != nil {
+			return nil, err
+		}
+	}
+	if match.Method != nil {
+		if err := add(bldrs_matchers.HttpRequestHeaderPredicate(":method", exactMatcher(string(*match.Method)))); err != nil {
+			return nil, err
+		}
+	}
+	for _, header := range pointer.Deref(match.Headers) {
+		predicate, err := headerPredicate(header)
+		if err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, predicate)
+	}
+	for _, param := range pointer.Deref(match.QueryParams) {
+		stringMatcher := exactMatcher(param.Value)
+		if param.Type == meshhttproute_api.RegularExpressionQueryMatch {
+			stringMatcher = regexMatcher(param.Value)
+		}
+		if err := add(bldrs_matchers.HttpRequestQueryParamPredicate(param.Name, stringMatcher)); err != nil {
+			return nil, err
+		}
+	}
+
+	return predicates, nil
+}
+
+func pathMatcher(match meshhttproute_api.PathMatch) *matcher_config.StringMatcher {
+	switch match.Type {
+	case meshhttproute_api.PathPrefix:
+		if match.Value == "/" {
+			return &matcher_config.StringMatcher{
+				MatchPattern: &matcher_config.StringMatcher_Prefix{Prefix: "/"},
+			}
+		}
+		// only a `/`-separated prefix or an entire path is matched
+		return regexMatcher(regexp.QuoteMeta(match.Value) + `([/?].*)?`)
+	case meshhttproute_api.RegularExpression:
+		return regexMatcher(`(?:` + match.Value + `)` + queryStringRegex)
+	default:
+		return regexMatcher(regexp.QuoteMeta(match.Value) + queryStringRegex)
+	}
+}
+
+func headerPredicate(header common_api.HeaderMatch) (*matcher_config.Matcher_MatcherList_Predicate, error) {
+	name := string(header.Name)
+	value := string(header.Value)
+
+	var stringMatcher *matcher_config.StringMatcher
+	switch pointer.DerefOr(header.Type, common_api.HeaderMatchExact) {
+	case common_api.HeaderMatchRegularExpression:
+		stringMatcher = regexMatcher(value)
+	case common_api.HeaderMatchPrefix:
+		if value == "" {
+			// the prefix matcher doesn't like empty string prefixes
+			stringMatcher = presentMatcher()
+		} else {
+			stringMatcher = &matcher_config.StringMatcher{
+				MatchPattern: &matcher_config.StringMatcher_Prefix{Prefix: value},
+			}
+		}
+	case common_api.HeaderMatchPresent:
+		stringMatcher = presentMatcher()
+	case common_api.HeaderMatchAbsent:
+		present, err := bldrs_matchers.NewPredicate().
+			Configure(bldrs_matchers.HttpRequestHeaderPredicate(name, presentMatcher())).
+			Build()
+		if err != nil {
+			return nil, err
+		}
+		return bldrs_matchers.NewPredicate().Configure(bldrs_matchers.NotPredicate(present)).Build()
+	default:
+		stringMatcher = exactMatcher(value)
+	}
+
+	return bldrs_matchers.NewPredicate().
+		Configure(bldrs_matchers.HttpRequestHeaderPredicate(name, stringMatcher)).
+		Build()
+}
+
+func exactMatcher(value string) *matcher_config.StringMatcher {
+	return &matcher_config.StringMatcher{
+		MatchPattern: &matcher_config.StringMatcher_Exact{Exact: value},
+	}
+}
+
+// presentMatcher matches any value, the input has no value when the header
+// is not present.
+func presentMatcher() *matcher_config.StringMatcher {
+	return regexMatcher(".*")
+}
+
+func regexMatcher(regex string) *matcher_config.StringMatcher {
+	return &matcher_config.StringMatcher{
+		MatchPattern: &matcher_config.StringMatcher_SafeRegex{
+			SafeRegex: &matcher_config.RegexMatcher{
+				EngineType: &matcher_config.RegexMatcher_GoogleRe2{
+					GoogleRe2: &matcher_config.RegexMatcher_GoogleRE2{},
+				},
+				Regex: regex,
+			},
+		},
+	}
+}
@@ -73,7 +73,7 @@ func validateRules(rules []Rule) validators.ValidationError {
 
 	for i, rule := range rules {
 		path := validators.Root().Index(i)
-		errs.AddErrorAt(path.Field("matches"), validateMatches(rule.Matches))
+		errs.AddErrorAt(path.Field("matches"), ValidateMatches(rule.Matches))
 		errs.AddErrorAt(path.Field("default").Field("filters"), validateFilters(rule.Default.Filters, rule.Matches))
 		errs.AddErrorAt(path.Field("default").Field("backendRefs"), validateBackendRefs(
 			pointer.Deref(rule.Default.BackendRefs),
@@ -92,7 +92,9 @@ func validateHostnames(hostnames []string) validators.ValidationError {
 	return errs
 }
 
-func validateMatches(matches []Match) validators.ValidationError {
+// ValidateMatches validates HTTP matches. It's exported so that other policies
+// using the same matches can validate them the same way.
+func ValidateMatches(matches []Match) validators.ValidationError {
 	var errs validators.ValidationError
 
 	for i, match := range matches {
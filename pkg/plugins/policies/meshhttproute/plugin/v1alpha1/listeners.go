@@ -54,6 +54,7 @@ func GenerateOutboundListener(
 	route := &xds.HttpOutboundRouteConfigurer{
 		RouteConfigName: routeConfigName,
 		VirtualHostName: virtualHostName,
+		Domains:         svc.VirtualHostDomains(),
 		Routes:          routes,
 		DpTags:          originDPPTags,
 	}
@@ -30,14 +30,17 @@ type OutboundRoute struct {
 type HttpOutboundRouteConfigurer struct {
 	RouteConfigName string
 	VirtualHostName string
-	Routes          []OutboundRoute
-	DpTags          mesh_proto.MultiValueTagSet
+	// Domains of the virtual host, empty means every domain.
+	Domains []string
+	Routes  []OutboundRoute
+	DpTags  mesh_proto.MultiValueTagSet
 }
 
 var _ envoy_listeners_v3.FilterChainConfigurer = &HttpOutboundRouteConfigurer{}
 
 func (c *HttpOutboundRouteConfigurer) Configure(filterChain *envoy_listener.FilterChain) error {
-	virtualHostBuilder := envoy_virtual_hosts.NewVirtualHostBuilder(envoy_common.APIV3, c.VirtualHostName)
+	virtualHostBuilder := envoy_virtual_hosts.NewVirtualHostBuilder(envoy_common.APIV3, c.VirtualHostName).
+		Configure(envoy_virtual_hosts.DomainNames(c.Domains...))
 	for _, route := range c.Routes {
 		route := envoy_virtual_hosts.AddVirtualHostConfigurer(
 			&RoutesConfigurer{
@@ -36,6 +36,10 @@ type Modification struct {
 	// VirtualHost is a modification of Envoy's VirtualHost
 	// referenced in HTTP Connection Manager in a Listener resource.
 	VirtualHost *VirtualHostMod `json:"virtualHost,omitempty"`
+	// Route is a modification of Envoy's Route of a VirtualHost.
+	Route *RouteMod `json:"route,omitempty"`
+	// Secret is a modification of Envoy's Secret resource.
+	Secret *SecretMod `json:"secret,omitempty"`
 }
 
 // ModOperation is modification operation on Envoy's resource.
@@ -64,6 +68,21 @@ const (
 	ModOpAddAfter ModOperation = "AddAfter"
 )
 
+// PatchStrategy is a strategy of merging Value into an existing resource.
+type PatchStrategy string
+
+const (
+	// PatchStrategyMerge merges Value using protobuf merge semantics.
+	// Items of repeated fields are appended to the existing items.
+	PatchStrategyMerge PatchStrategy = "Merge"
+	// PatchStrategyStrategicMerge merges items of repeated fields with the same name
+	// (for example clusters, filters, virtual hosts or routes) and appends only items
+	// that don't exist yet. Other repeated fields are replaced. Messages packed in typed
+	// configs of the same type are merged instead of being replaced.
+	// The result doesn't depend on the order of items generated by Kuma.
+	PatchStrategyStrategicMerge PatchStrategy = "StrategicMerge"
+)
+
 // ClusterMod is a modification of Envoy's Cluster resource.
 type ClusterMod struct {
 	// Match is a set of conditions that have to be matched for modification operation to happen.
@@ -73,6 +92,9 @@ type ClusterMod struct {
 	Operation ModOperation `json:"operation"`
 	// Value of xDS resource in YAML format to add or patch.
 	Value *string `json:"value,omitempty"`
+	// Strategy of merging Value into the matched resource. Merge by default.
+	// +kubebuilder:validation:Enum=Merge;StrategicMerge
+	Strategy *PatchStrategy `json:"strategy,omitempty"`
 	// JsonPatches specifies list of jsonpatches to apply to on Envoy's Cluster
 	// resource
 	JsonPatches *[]common_api.JsonPatchBlock `json:"jsonPatches,omitempty"`
@@ -96,6 +118,10 @@ type ClusterMatch struct {
 	Origin *string `json:"origin,omitempty"`
 	// Name of the cluster to match.
 	Name *string `json:"name,omitempty"`
+	// Expression is a CEL expression that has to evaluate to true for the cluster to match.
+	// The cluster is available as "cluster" and its origin as "origin".
+	// For example: cluster.name.startsWith("outbound")
+	Expression *string `json:"expression,omitempty"`
 }
 
 // ListenerMod is a modification of Envoy's Listener resource.
@@ -107,6 +133,9 @@ type ListenerMod struct {
 	Operation ModOperation `json:"operation"`
 	// Value of xDS resource in YAML format to add or patch.
 	Value *string `json:"value,omitempty"`
+	// Strategy of merging Value into the matched resource. Merge by default.
+	// +kubebuilder:validation:Enum=Merge;StrategicMerge
+	Strategy *PatchStrategy `json:"strategy,omitempty"`
 	// JsonPatches specifies list of jsonpatches to apply to on Envoy's Listener
 	// resource
 	JsonPatches *[]common_api.JsonPatchBlock `json:"jsonPatches,omitempty"`
@@ -132,6 +161,10 @@ type ListenerMatch struct {
 	Name *string `json:"name,omitempty"`
 	// Tags available in Listener#Metadata#FilterMetadata[io.kuma.tags]
 	Tags *map[string]string `json:"tags,omitempty"`
+	// Expression is a CEL expression that has to evaluate to true for the listener to match.
+	// The listener is available as "listener" and its origin as "origin".
+	// For example: listener.address.socket_address.port_value == 8080
+	Expression *string `json:"expression,omitempty"`
 }
 
 // NetworkFilterMod is a modification of Envoy Listener's filter.
@@ -143,6 +176,9 @@ type NetworkFilterMod struct {
 	Operation ModOperation `json:"operation"`
 	// Value of xDS resource in YAML format to add or patch.
 	Value *string `json:"value,omitempty"`
+	// Strategy of merging Value into the matched resource. Merge by default.
+	// +kubebuilder:validation:Enum=Merge;StrategicMerge
+	Strategy *PatchStrategy `json:"strategy,omitempty"`
 	// JsonPatches specifies list of jsonpatches to apply to on Envoy Listener's
 	// filter.
 	JsonPatches *[]common_api.JsonPatchBlock `json:"jsonPatches,omitempty"`
@@ -170,6 +206,10 @@ type NetworkFilterMatch struct {
 	ListenerName *string `json:"listenerName,omitempty"`
 	// Listener tags available in Listener#Metadata#FilterMetadata[io.kuma.tags]
 	ListenerTags *map[string]string `json:"listenerTags,omitempty"`
+	// Expression is a CEL expression that has to evaluate to true for the network filter to match.
+	// The filter is available as "filter", its listener as "listener" and the origin of the listener as "origin".
+	// For example: filter.name.endsWith("tcp_proxy") && listener.name.startsWith("outbound")
+	Expression *string `json:"expression,omitempty"`
 }
 
 // HTTPFilterMod is a modification of Envoy HTTP Filter
@@ -182,6 +222,9 @@ type HTTPFilterMod struct {
 	Operation ModOperation `json:"operation"`
 	// Value of xDS resource in YAML format to add or patch.
 	Value *string `json:"value,omitempty"`
+	// Strategy of merging Value into the matched resource. Merge by default.
+	// +kubebuilder:validation:Enum=Merge;StrategicMerge
+	Strategy *PatchStrategy `json:"strategy,omitempty"`
 	// JsonPatches specifies list of jsonpatches to apply to on Envoy's
 	// HTTP Filter available in HTTP Connection Manager in a Listener resource.
 	JsonPatches *[]common_api.JsonPatchBlock `json:"jsonPatches,omitempty"`
@@ -209,6 +252,10 @@ type HTTPFilterMatch struct {
 	ListenerName *string `json:"listenerName,omitempty"`
 	// Listener tags available in Listener#Metadata#FilterMetadata[io.kuma.tags]
 	ListenerTags *map[string]string `json:"listenerTags,omitempty"`
+	// Expression is a CEL expression that has to evaluate to true for the HTTP filter to match.
+	// The filter is available as "filter", its listener as "listener" and the origin of the listener as "origin".
+	// For example: filter.name.startsWith("envoy.filters.http.") && listener.name.startsWith("inbound")
+	Expression *string `json:"expression,omitempty"`
 }
 
 // VirtualHostMod is a modification of Envoy's VirtualHost
@@ -222,6 +269,9 @@ type VirtualHostMod struct {
 	Operation ModOperation `json:"operation"`
 	// Value of xDS resource in YAML format to add or patch.
 	Value *string `json:"value,omitempty"`
+	// Strategy of merging Value into the matched resource. Merge by default.
+	// +kubebuilder:validation:Enum=Merge;StrategicMerge
+	Strategy *PatchStrategy `json:"strategy,omitempty"`
 	// JsonPatches specifies list of jsonpatches to apply to on Envoy's
 	// VirtualHost resource
 	JsonPatches *[]common_api.JsonPatchBlock `json:"jsonPatches,omitempty"`
@@ -247,4 +297,97 @@ type VirtualHostMatch struct {
 	Name *string `json:"name,omitempty"`
 	// Name of the RouteConfiguration resource to match.
 	RouteConfigurationName *string `json:"routeConfigurationName,omitempty"`
+	// Expression is a CEL expression that has to evaluate to true for the VirtualHost to match.
+	// The VirtualHost is available as "virtualHost", its RouteConfiguration as "routeConfiguration"
+	// and the origin of the listener as "origin".
+	// For example: virtualHost.domains.exists(d, d.endsWith(".mesh"))
+	Expression *string `json:"expression,omitempty"`
+}
+
+// RouteMod is a modification of Envoy's Route of a VirtualHost
+// referenced in HTTP Connection Manager in a Listener resource.
+type RouteMod struct {
+	// Match is a set of conditions that have to be matched for modification operation to happen.
+	Match *RouteMatch `json:"match,omitempty"`
+	// Operation to execute on matched route.
+	// +kubebuilder:validation:Enum=Remove;Patch;AddFirst;AddBefore;AddAfter;AddLast
+	Operation ModOperation `json:"operation"`
+	// Value of xDS resource in YAML format to add or patch.
+	Value *string `json:"value,omitempty"`
+	// Strategy of merging Value into the matched resource. Merge by default.
+	// +kubebuilder:validation:Enum=Merge;StrategicMerge
+	Strategy *PatchStrategy `json:"strategy,omitempty"`
+	// JsonPatches specifies list of jsonpatches to apply to on Envoy's
+	// Route resource
+	JsonPatches *[]common_api.JsonPatchBlock `json:"jsonPatches,omitempty"`
+}
+
+// RouteMatch is a set of conditions that have to be matched for modification operation to happen.
+type RouteMatch struct {
+	// Origin is the name of the component or plugin that generated the resource.
+	//
+	// Here is the list of well-known origins:
+	// inbound - resources generated for handling incoming traffic.
+	// outbound - resources generated for handling outgoing traffic.
+	// transparent - resources generated for transparent proxy functionality.
+	// prometheus - resources generated when Prometheus metrics are enabled.
+	// direct-access - resources generated for Direct Access functionality.
+	// ingress - resources generated for Zone Ingress.
+	// egress - resources generated for Zone Egress.
+	//
+	// The list is not complete, because policy plugins can introduce new resources.
+	// For example MeshTrace plugin can create Cluster with "mesh-trace" origin.
+	Origin *string `json:"origin,omitempty"`
+	// Name of the Route to match.
+	Name *string `json:"name,omitempty"`
+	// Name of the VirtualHost to match.
+	VirtualHostName *string `json:"virtualHostName,omitempty"`
+	// Name of the RouteConfiguration resource to match.
+	RouteConfigurationName *string `json:"routeConfigurationName,omitempty"`
+	// Expression is a CEL expression that has to evaluate to true for the Route to match.
+	// The Route is available as "route", its VirtualHost as "virtualHost", its RouteConfiguration
+	// as "routeConfiguration" and the origin of the listener or RouteConfiguration as "origin".
+	// For example: route.match.prefix == "/api" && virtualHost.name.startsWith("backend")
+	Expression *string `json:"expression,omitempty"`
+}
+
+// SecretMod is a modification of Envoy's Secret resource.
+type SecretMod struct {
+	// Match is a set of conditions that have to be matched for modification operation to happen.
+	Match *SecretMatch `json:"match,omitempty"`
+	// Operation to execute on matched secret.
+	// +kubebuilder:validation:Enum=Add;Remove;Patch
+	Operation ModOperation `json:"operation"`
+	// Value of xDS resource in YAML format to add or patch.
+	Value *string `json:"value,omitempty"`
+	// Strategy of merging Value into the matched resource. Merge by default.
+	// +kubebuilder:validation:Enum=Merge;StrategicMerge
+	Strategy *PatchStrategy `json:"strategy,omitempty"`
+	// JsonPatches specifies list of jsonpatches to apply to on Envoy's Secret
+	// resource
+	JsonPatches *[]common_api.JsonPatchBlock `json:"jsonPatches,omitempty"`
+}
+
+// SecretMatch is a set of conditions on secret resource.
+type SecretMatch struct {
+	// Origin is the name of the component or plugin that generated the resource.
+	//
+	// Here is the list of well-known origins:
+	// inbound - resources generated for handling incoming traffic.
+	// outbound - resources generated for handling outgoing traffic.
+	// transparent - resources generated for transparent proxy functionality.
+	// prometheus - resources generated when Prometheus metrics are enabled.
+	// direct-access - resources generated for Direct Access functionality.
+	// ingress - resources generated for Zone Ingress.
+	// egress - resources generated for Zone Egress.
+	//
+	// The list is not complete, because policy plugins can introduce new resources.
+	// For example MeshTrace plugin can create Cluster with "mesh-trace" origin.
+	Origin *string `json:"origin,omitempty"`
+	// Name of the secret to match.
+	Name *string `json:"name,omitempty"`
+	// Expression is a CEL expression that has to evaluate to true for the secret to match.
+	// The secret is available as "secret" and its origin as "origin".
+	// For example: secret.name.startsWith("mesh_ca")
+	Expression *string `json:"expression,omitempty"`
 }
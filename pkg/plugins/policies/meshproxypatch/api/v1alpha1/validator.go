@@ -8,6 +8,7 @@ import (
 	envoy_listener_v3 "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
 	envoy_route_v3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	envoy_hcm_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoy_tls_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
 	"google.golang.org/protobuf/proto"
 
 	common_api "github.com/kumahq/kuma/v3/api/common/v1alpha1"
@@ -16,12 +17,15 @@ import (
 	core_model "github.com/kumahq/kuma/v3/pkg/core/resources/model"
 	"github.com/kumahq/kuma/v3/pkg/core/validators"
 	jsonpatch_validators "github.com/kumahq/kuma/v3/pkg/plugins/policies/core/jsonpatch/validators"
+	"github.com/kumahq/kuma/v3/pkg/plugins/policies/meshproxypatch/expression"
 	"github.com/kumahq/kuma/v3/pkg/util/pointer"
 )
 
 const (
 	NameFilterBeforeErr = "must be defined. You need to pick a filter before which this one will be added"
 	NameFilterAfterErr  = "must be defined. You need to pick a filter after which this one will be added"
+	NameRouteBeforeErr  = "must be defined. You need to pick a route before which this one will be added"
+	NameRouteAfterErr   = "must be defined. You need to pick a route after which this one will be added"
 )
 
 func (r *MeshProxyPatchResource) validate() error {
@@ -71,6 +75,8 @@ func validateDefault(conf Conf) validators.ValidationError {
 			modification.VirtualHost != nil,
 			modification.NetworkFilter != nil,
 			modification.HTTPFilter != nil,
+			modification.Route != nil,
+			modification.Secret != nil,
 		} {
 			if m {
 				modificationsAmount++
@@ -96,6 +102,10 @@ func validateDefault(conf Conf) validators.ValidationError {
 			verr.AddErrorAt(path, validateNetworkFilterMod(*modification.NetworkFilter))
 		case modification.HTTPFilter != nil:
 			verr.AddErrorAt(path, validateHTTPFilterMod(*modification.HTTPFilter))
+		case modification.Route != nil:
+			verr.AddErrorAt(path, validateRouteMod(*modification.Route))
+		case modification.Secret != nil:
+			verr.AddErrorAt(path, validateSecretMod(*modification.Secret))
 		}
 	}
 
@@ -105,6 +115,10 @@ func validateDefault(conf Conf) validators.ValidationError {
 func validateClusterMod(mod ClusterMod) validators.ValidationError {
 	var verr validators.ValidationError
 	path := validators.RootedAt("cluster")
+	if mod.Match != nil {
+		verr.Add(validateExpression(path.Field("match").Field("expression"), expression.Cluster, mod.Match.Expression))
+	}
+	verr.Add(validateStrategy(path, mod.Operation, mod.Strategy, pointer.Deref(mod.JsonPatches)))
 	switch mod.Operation {
 	case ModOpAdd:
 		if mod.Match != nil {
@@ -126,6 +140,10 @@ func validateClusterMod(mod ClusterMod) validators.ValidationError {
 func validateListenerMod(mod ListenerMod) validators.ValidationError {
 	var verr validators.ValidationError
 	path := validators.RootedAt("listener")
+	if mod.Match != nil {
+		verr.Add(validateExpression(path.Field("match").Field("expression"), expression.Listener, mod.Match.Expression))
+	}
+	verr.Add(validateStrategy(path, mod.Operation, mod.Strategy, pointer.Deref(mod.JsonPatches)))
 	switch mod.Operation {
 	case ModOpAdd:
 		if mod.Match != nil {
@@ -147,11 +165,18 @@ func validateListenerMod(mod ListenerMod) validators.ValidationError {
 func validateVirtualHostMod(mod VirtualHostMod) validators.ValidationError {
 	var verr validators.ValidationError
 	path := validators.RootedAt("virtualHost")
+	if mod.Match != nil {
+		verr.Add(validateExpression(path.Field("match").Field("expression"), expression.VirtualHost, mod.Match.Expression))
+	}
+	verr.Add(validateStrategy(path, mod.Operation, mod.Strategy, pointer.Deref(mod.JsonPatches)))
 	switch mod.Operation {
 	case ModOpAdd:
 		if mod.Match != nil && mod.Match.Name != nil {
 			verr.AddViolationAt(path.Field("match").Field("name"), validators.MustNotBeDefined)
 		}
+		if mod.Match != nil && mod.Match.Expression != nil {
+			verr.AddViolationAt(path.Field("match").Field("expression"), validators.MustNotBeDefined)
+		}
 		verr.Add(validateResourceValue(path.Field("value"), mod.Value, &envoy_route_v3.VirtualHost{}))
 	case ModOpPatch:
 		verr.Add(validatePatch(path, mod.Value, pointer.Deref(mod.JsonPatches), &envoy_route_v3.VirtualHost{}))
@@ -168,21 +193,28 @@ func validateVirtualHostMod(mod VirtualHostMod) validators.ValidationError {
 func validateHTTPFilterMod(mod HTTPFilterMod) validators.ValidationError {
 	verr := validators.ValidationError{}
 	path := validators.RootedAt("httpFilter")
+	if mod.Match != nil {
+		verr.Add(validateExpression(path.Field("match").Field("expression"), expression.HTTPFilter, mod.Match.Expression))
+	}
+	verr.Add(validateStrategy(path, mod.Operation, mod.Strategy, pointer.Deref(mod.JsonPatches)))
 	switch mod.Operation {
 	case ModOpAddFirst, ModOpAddLast:
+		if mod.Match != nil && mod.Match.Expression != nil {
+			verr.AddViolationAt(path.Field("match").Field("expression"), validators.MustNotBeDefined)
+		}
 		verr.Add(validateResourceValue(path.Field("value"), mod.Value, &envoy_hcm_v3.HttpFilter{}))
 	case ModOpAddBefore:
-		if mod.Match == nil || mod.Match.Name == nil {
+		if mod.Match == nil || (mod.Match.Name == nil && mod.Match.Expression == nil) {
 			verr.AddViolationAt(path.Field("match").Field("name"), NameFilterBeforeErr)
 		}
 		verr.Add(validateResourceValue(path.Field("value"), mod.Value, &envoy_hcm_v3.HttpFilter{}))
 	case ModOpAddAfter:
-		if mod.Match == nil || mod.Match.Name == nil {
+		if mod.Match == nil || (mod.Match.Name == nil && mod.Match.Expression == nil) {
 			verr.AddViolationAt(path.Field("match").Field("name"), NameFilterAfterErr)
 		}
 		verr.Add(validateResourceValue(path.Field("value"), mod.Value, &envoy_hcm_v3.HttpFilter{}))
 	case ModOpPatch:
-		if mod.Match == nil || mod.Match.Name == nil {
+		if mod.Match == nil || (mod.Match.Name == nil && mod.Match.Expression == nil) {
 			verr.AddViolationAt(path.Field("match").Field("name"), validators.MustBeDefined)
 		}
 		verr.Add(validatePatch(path, mod.Value, pointer.Deref(mod.JsonPatches), &envoy_hcm_v3.HttpFilter{}))
@@ -199,21 +231,28 @@ func validateHTTPFilterMod(mod HTTPFilterMod) validators.ValidationError {
 func validateNetworkFilterMod(mod NetworkFilterMod) validators.ValidationError {
 	verr := validators.ValidationError{}
 	path := validators.RootedAt("networkFilter")
+	if mod.Match != nil {
+		verr.Add(validateExpression(path.Field("match").Field("expression"), expression.NetworkFilter, mod.Match.Expression))
+	}
+	verr.Add(validateStrategy(path, mod.Operation, mod.Strategy, pointer.Deref(mod.JsonPatches)))
 	switch mod.Operation {
 	case ModOpAddFirst, ModOpAddLast:
+		if mod.Match != nil && mod.Match.Expression != nil {
+			verr.AddViolationAt(path.Field("match").Field("expression"), validators.MustNotBeDefined)
+		}
 		verr.Add(validateResourceValue(path.Field("value"), mod.Value, &envoy_listener_v3.Filter{}))
 	case ModOpAddBefore:
-		if mod.Match == nil || mod.Match.Name == nil {
+		if mod.Match == nil || (mod.Match.Name == nil && mod.Match.Expression == nil) {
 			verr.AddViolationAt(path.Field("match").Field("name"), NameFilterBeforeErr)
 		}
 		verr.Add(validateResourceValue(path.Field("value"), mod.Value, &envoy_listener_v3.Filter{}))
 	case ModOpAddAfter:
-		if mod.Match == nil || mod.Match.Name == nil {
+		if mod.Match == nil || (mod.Match.Name == nil && mod.Match.Expression == nil) {
 			verr.AddViolationAt(path.Field("match").Field("name"), NameFilterAfterErr)
 		}
 		verr.Add(validateResourceValue(path.Field("value"), mod.Value, &envoy_listener_v3.Filter{}))
 	case ModOpPatch:
-		if mod.Match == nil || mod.Match.Name == nil {
+		if mod.Match == nil || (mod.Match.Name == nil && mod.Match.Expression == nil) {
 			verr.AddViolationAt(path.Field("match").Field("name"), validators.MustBeDefined)
 		}
 		verr.Add(validatePatch(path, mod.Value, pointer.Deref(mod.JsonPatches), &envoy_listener_v3.Filter{}))
@@ -227,6 +266,94 @@ func validateNetworkFilterMod(mod NetworkFilterMod) validators.ValidationError {
 	return verr
 }
 
+func validateRouteMod(mod RouteMod) validators.ValidationError {
+	verr := validators.ValidationError{}
+	path := validators.RootedAt("route")
+	if mod.Match != nil {
+		verr.Add(validateExpression(path.Field("match").Field("expression"), expression.Route, mod.Match.Expression))
+	}
+	verr.Add(validateStrategy(path, mod.Operation, mod.Strategy, pointer.Deref(mod.JsonPatches)))
+	switch mod.Operation {
+	case ModOpAddFirst, ModOpAddLast:
+		if mod.Match != nil && mod.Match.Name != nil {
+			verr.AddViolationAt(path.Field("match").Field("name"), validators.MustNotBeDefined)
+		}
+		if mod.Match != nil && mod.Match.Expression != nil {
+			verr.AddViolationAt(path.Field("match").Field("expression"), validators.MustNotBeDefined)
+		}
+		verr.Add(validateResourceValue(path.Field("value"), mod.Value, &envoy_route_v3.Route{}))
+	case ModOpAddBefore:
+		if mod.Match == nil || (mod.Match.Name == nil && mod.Match.Expression == nil) {
+			verr.AddViolationAt(path.Field("match").Field("name"), NameRouteBeforeErr)
+		}
+		verr.Add(validateResourceValue(path.Field("value"), mod.Value, &envoy_route_v3.Route{}))
+	case ModOpAddAfter:
+		if mod.Match == nil || (mod.Match.Name == nil && mod.Match.Expression == nil) {
+			verr.AddViolationAt(path.Field("match").Field("name"), NameRouteAfterErr)
+		}
+		verr.Add(validateResourceValue(path.Field("value"), mod.Value, &envoy_route_v3.Route{}))
+	case ModOpPatch:
+		verr.Add(validatePatch(path, mod.Value, pointer.Deref(mod.JsonPatches), &envoy_route_v3.Route{}))
+	case ModOpRemove:
+		if mod.Value != nil {
+			verr.AddViolationAt(path.Field("value"), validators.MustNotBeDefined)
+		}
+	default:
+		verr.AddViolationAt(path.Field("operation"), availableOperationsMsg(ModOpAddFirst, ModOpAddLast, ModOpAddBefore, ModOpAddAfter, ModOpPatch, ModOpRemove))
+	}
+	return verr
+}
+
+func validateSecretMod(mod SecretMod) validators.ValidationError {
+	var verr validators.ValidationError
+	path := validators.RootedAt("secret")
+	if mod.Match != nil {
+		verr.Add(validateExpression(path.Field("match").Field("expression"), expression.Secret, mod.Match.Expression))
+	}
+	verr.Add(validateStrategy(path, mod.Operation, mod.Strategy, pointer.Deref(mod.JsonPatches)))
+	switch mod.Operation {
+	case ModOpAdd:
+		if mod.Match != nil {
+			verr.AddViolationAt(path.Field("match"), validators.MustNotBeDefined)
+		}
+		verr.Add(validateResourceValue(path.Field("value"), mod.Value, &envoy_tls_v3.Secret{}))
+	case ModOpPatch:
+		verr.Add(validatePatch(path, mod.Value, pointer.Deref(mod.JsonPatches), &envoy_tls_v3.Secret{}))
+	case ModOpRemove:
+		if mod.Value != nil {
+			verr.AddViolationAt(path.Field("value"), validators.MustNotBeDefined)
+		}
+	default:
+		verr.AddViolationAt(path.Field("operation"), availableOperationsMsg(ModOpAdd, ModOpPatch, ModOpRemove))
+	}
+	return verr
+}
+
+func validateExpression(path validators.PathBuilder, kind expression.Kind, expr *string) validators.ValidationError {
+	var verr validators.ValidationError
+	if expr == nil {
+		return verr
+	}
+	if _, err := expression.Compile(kind, *expr); err != nil {
+		verr.AddViolationAt(path, fmt.Sprintf("invalid expression: %s", err.Error()))
+	}
+	return verr
+}
+
+func validateStrategy(path validators.PathBuilder, operation ModOperation, strategy *PatchStrategy, jsonPatches []common_api.JsonPatchBlock) validators.ValidationError {
+	var verr validators.ValidationError
+	if strategy == nil {
+		return verr
+	}
+	switch {
+	case operation != ModOpPatch || len(jsonPatches) > 0:
+		verr.AddViolationAt(path.Field("strategy"), "must only be defined when patching with value")
+	case *strategy != PatchStrategyMerge && *strategy != PatchStrategyStrategicMerge:
+		verr.AddViolationAt(path.Field("strategy"), fmt.Sprintf("invalid strategy. Available strategies: %q, %q", PatchStrategyMerge, PatchStrategyStrategicMerge))
+	}
+	return verr
+}
+
 func validateResourceValue(path validators.PathBuilder, value *string, res proto.Message) validators.ValidationError {
 	var verr validators.ValidationError
 	if value != nil {
@@ -287,6 +287,49 @@ default:
         typedConfig:
           '@type': type.googleapis.com/envoy.extensions.filters.http.router.v3.Router
           dynamicStats: false
+    `),
+			Entry("modifications with expressions and strategic merge", `
+targetRef:
+  kind: Mesh
+default:
+  appendModifications:
+  - cluster:
+      operation: Patch
+      match:
+        expression: cluster.name.startsWith("outbound") && origin == "outbound"
+      strategy: StrategicMerge
+      value: |
+        connectTimeout: 5s
+  - httpFilter:
+      operation: AddBefore
+      match:
+        expression: filter.name == "envoy.filters.http.router"
+      value: |
+        name: envoy.filters.http.buffer
+        typedConfig:
+          '@type': type.googleapis.com/envoy.extensions.filters.http.buffer.v3.Buffer
+          maxRequestBytes: 1024
+  - route:
+      operation: AddFirst
+      match:
+        virtualHostName: backend
+      value: |
+        name: health
+        match:
+          prefix: /health
+        directResponse:
+          status: 200
+  - route:
+      operation: Patch
+      match:
+        expression: route.match.prefix == "/api"
+      value: |
+        route:
+          timeout: 10s
+  - secret:
+      operation: Remove
+      match:
+        expression: secret.name.startsWith("mesh_ca")
     `),
 		)
 
@@ -582,6 +625,96 @@ default:
                   message: must not be defined
                 - field: spec.default.appendModifications[4].virtualHost
                   message: 'virtualHost must have only one type defined: value, jsonPatches'
+                `,
+			}),
+			Entry("invalid expressions and strategies", testCase{
+				inputYaml: `
+targetRef:
+  kind: Mesh
+default:
+  appendModifications:
+  - cluster:
+      operation: Remove
+      match:
+        expression: listener.name == "xyz"
+  - listener:
+      operation: Patch
+      match:
+        expression: listener.name
+      strategy: StrategicMerge
+      jsonPatches:
+      - op: remove
+        path: /name
+  - networkFilter:
+      operation: AddFirst
+      match:
+        expression: filter.name == "xyz"
+      value: |
+        name: envoy.filters.network.tcp_proxy
+        typedConfig:
+          '@type': type.googleapis.com/envoy.extensions.filters.network.tcp_proxy.v3.TcpProxy
+          cluster: backend
+  - virtualHost:
+      operation: Patch
+      strategy: Unknown
+      value: '{}'
+`,
+				expected: `
+                violations:
+                - field: spec.default.appendModifications[0].cluster.match.expression
+                  message: "invalid expression: ERROR: <input>:1:1: undeclared reference to 'listener' (in container '')\n | listener.name == \"xyz\"\n | ^"
+                - field: spec.default.appendModifications[1].listener.match.expression
+                  message: 'invalid expression: expression must evaluate to bool, got string'
+                - field: spec.default.appendModifications[1].listener.strategy
+                  message: must only be defined when patching with value
+                - field: spec.default.appendModifications[2].networkFilter.match.expression
+                  message: must not be defined
+                - field: spec.default.appendModifications[3].virtualHost.strategy
+                  message: 'invalid strategy. Available strategies: "Merge", "StrategicMerge"'
+                `,
+			}),
+			Entry("invalid route and secret modifications", testCase{
+				inputYaml: `
+targetRef:
+  kind: Mesh
+default:
+  appendModifications:
+  - route:
+      operation: AddBefore
+      value: |
+        name: health
+  - route:
+      operation: AddFirst
+      match:
+        name: xyz
+      value: '{'
+  - route:
+      operation: Add
+  - secret:
+      operation: Add
+      match:
+        name: xyz
+      value: |
+        name: my-secret
+  - secret:
+      operation: Patch
+`,
+				expected: `
+                violations:
+                - field: spec.default.appendModifications[0].route.match.name
+                  message: must be defined. You need to pick a route before which this one will be added
+                - field: spec.default.appendModifications[0].route.value
+                  message: 'native Envoy resource is not valid: invalid Route.Match: value is required'
+                - field: spec.default.appendModifications[1].route.match.name
+                  message: must not be defined
+                - field: spec.default.appendModifications[1].route.value
+                  message: 'native Envoy resource is not valid: unexpected EOF'
+                - field: spec.default.appendModifications[2].route.operation
+                  message: 'invalid operation. Available operations: "AddFirst", "AddLast", "AddBefore", "AddAfter", "Patch", "Remove"'
+                - field: spec.default.appendModifications[3].secret.match
+                  message: must not be defined
+                - field: spec.default.appendModifications[4].secret
+                  message: 'secret must have exactly one defined: value, jsonPatches'
                 `,
 			}),
 			Entry("invalid target ref", testCase{
@@ -21,6 +21,11 @@ func (in *ClusterMatch) DeepCopyInto(out *ClusterMatch) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Expression != nil {
+		in, out := &in.Expression, &out.Expression
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterMatch.
@@ -46,6 +51,11 @@ func (in *ClusterMod) DeepCopyInto(out *ClusterMod) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Strategy != nil {
+		in, out := &in.Strategy, &out.Strategy
+		*out = new(PatchStrategy)
+		**out = **in
+	}
 	if in.JsonPatches != nil {
 		in, out := &in.JsonPatches, &out.JsonPatches
 		*out = new([]commonv1alpha1.JsonPatchBlock)
@@ -124,6 +134,11 @@ func (in *HTTPFilterMatch) DeepCopyInto(out *HTTPFilterMatch) {
 			}
 		}
 	}
+	if in.Expression != nil {
+		in, out := &in.Expression, &out.Expression
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPFilterMatch.
@@ -149,6 +164,11 @@ func (in *HTTPFilterMod) DeepCopyInto(out *HTTPFilterMod) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Strategy != nil {
+		in, out := &in.Strategy, &out.Strategy
+		*out = new(PatchStrategy)
+		**out = **in
+	}
 	if in.JsonPatches != nil {
 		in, out := &in.JsonPatches, &out.JsonPatches
 		*out = new([]commonv1alpha1.JsonPatchBlock)
@@ -196,6 +216,11 @@ func (in *ListenerMatch) DeepCopyInto(out *ListenerMatch) {
 			}
 		}
 	}
+	if in.Expression != nil {
+		in, out := &in.Expression, &out.Expression
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ListenerMatch.
@@ -221,6 +246,11 @@ func (in *ListenerMod) DeepCopyInto(out *ListenerMod) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Strategy != nil {
+		in, out := &in.Strategy, &out.Strategy
+		*out = new(PatchStrategy)
+		**out = **in
+	}
 	if in.JsonPatches != nil {
 		in, out := &in.JsonPatches, &out.JsonPatches
 		*out = new([]commonv1alpha1.JsonPatchBlock)
@@ -293,6 +323,16 @@ func (in *Modification) DeepCopyInto(out *Modification) {
 		*out = new(VirtualHostMod)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Route != nil {
+		in, out := &in.Route, &out.Route
+		*out = new(RouteMod)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Secret != nil {
+		in, out := &in.Secret, &out.Secret
+		*out = new(SecretMod)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Modification.
@@ -334,6 +374,11 @@ func (in *NetworkFilterMatch) DeepCopyInto(out *NetworkFilterMatch) {
 			}
 		}
 	}
+	if in.Expression != nil {
+		in, out := &in.Expression, &out.Expression
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkFilterMatch.
@@ -359,6 +404,11 @@ func (in *NetworkFilterMod) DeepCopyInto(out *NetworkFilterMod) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Strategy != nil {
+		in, out := &in.Strategy, &out.Strategy
+		*out = new(PatchStrategy)
+		**out = **in
+	}
 	if in.JsonPatches != nil {
 		in, out := &in.JsonPatches, &out.JsonPatches
 		*out = new([]commonv1alpha1.JsonPatchBlock)
@@ -382,6 +432,158 @@ func (in *NetworkFilterMod) DeepCopy() *NetworkFilterMod {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteMatch) DeepCopyInto(out *RouteMatch) {
+	*out = *in
+	if in.Origin != nil {
+		in, out := &in.Origin, &out.Origin
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.VirtualHostName != nil {
+		in, out := &in.VirtualHostName, &out.VirtualHostName
+		*out = new(string)
+		**out = **in
+	}
+	if in.RouteConfigurationName != nil {
+		in, out := &in.RouteConfigurationName, &out.RouteConfigurationName
+		*out = new(string)
+		**out = **in
+	}
+	if in.Expression != nil {
+		in, out := &in.Expression, &out.Expression
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteMatch.
+func (in *RouteMatch) DeepCopy() *RouteMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteMod) DeepCopyInto(out *RouteMod) {
+	*out = *in
+	if in.Match != nil {
+		in, out := &in.Match, &out.Match
+		*out = new(RouteMatch)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+	if in.Strategy != nil {
+		in, out := &in.Strategy, &out.Strategy
+		*out = new(PatchStrategy)
+		**out = **in
+	}
+	if in.JsonPatches != nil {
+		in, out := &in.JsonPatches, &out.JsonPatches
+		*out = new([]commonv1alpha1.JsonPatchBlock)
+		if **in != nil {
+			in, out := *in, *out
+			*out = make([]commonv1alpha1.JsonPatchBlock, len(*in))
+			for i := range *in {
+				(*in)[i].DeepCopyInto(&(*out)[i])
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteMod.
+func (in *RouteMod) DeepCopy() *RouteMod {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteMod)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretMatch) DeepCopyInto(out *SecretMatch) {
+	*out = *in
+	if in.Origin != nil {
+		in, out := &in.Origin, &out.Origin
+		*out = new(string)
+		**out = **in
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Expression != nil {
+		in, out := &in.Expression, &out.Expression
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretMatch.
+func (in *SecretMatch) DeepCopy() *SecretMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretMod) DeepCopyInto(out *SecretMod) {
+	*out = *in
+	if in.Match != nil {
+		in, out := &in.Match, &out.Match
+		*out = new(SecretMatch)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+	if in.Strategy != nil {
+		in, out := &in.Strategy, &out.Strategy
+		*out = new(PatchStrategy)
+		**out = **in
+	}
+	if in.JsonPatches != nil {
+		in, out := &in.JsonPatches, &out.JsonPatches
+		*out = new([]commonv1alpha1.JsonPatchBlock)
+		if **in != nil {
+			in, out := *in, *out
+			*out = make([]commonv1alpha1.JsonPatchBlock, len(*in))
+			for i := range *in {
+				(*in)[i].DeepCopyInto(&(*out)[i])
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretMod.
+func (in *SecretMod) DeepCopy() *SecretMod {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretMod)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualHostMatch) DeepCopyInto(out *VirtualHostMatch) {
 	*out = *in
@@ -400,6 +602,11 @@ func (in *VirtualHostMatch) DeepCopyInto(out *VirtualHostMatch) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Expression != nil {
+		in, out := &in.Expression, &out.Expression
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualHostMatch.
@@ -425,6 +632,11 @@ func (in *VirtualHostMod) DeepCopyInto(out *VirtualHostMod) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Strategy != nil {
+		in, out := &in.Strategy, &out.Strategy
+		*out = new(PatchStrategy)
+		**out = **in
+	}
 	if in.JsonPatches != nil {
 		in, out := &in.JsonPatches, &out.JsonPatches
 		*out = new([]commonv1alpha1.JsonPatchBlock)
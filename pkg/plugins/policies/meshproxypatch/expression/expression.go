@@ -0,0 +1,161 @@
+package expression
+
+import (
+	"sync"
+	"time"
+
+	envoy_cluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoy_tls "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	"github.com/goburrow/cache"
+	"github.com/google/cel-go/cel"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+)
+
+// Kind is a kind of Envoy resource that an expression is evaluated against.
+// It determines which variables are available in the expression.
+type Kind string
+
+const (
+	// Cluster expressions have access to "cluster" and "origin".
+	Cluster Kind = "cluster"
+	// Listener expressions have access to "listener" and "origin".
+	Listener Kind = "listener"
+	// NetworkFilter expressions have access to "filter", "listener" and "origin".
+	NetworkFilter Kind = "networkFilter"
+	// HTTPFilter expressions have access to "filter", "listener" and "origin".
+	HTTPFilter Kind = "httpFilter"
+	// VirtualHost expressions have access to "virtualHost", "routeConfiguration" and "origin".
+	VirtualHost Kind = "virtualHost"
+	// Route expressions have access to "route", "virtualHost", "routeConfiguration" and "origin".
+	Route Kind = "route"
+	// Secret expressions have access to "secret" and "origin".
+	Secret Kind = "secret"
+)
+
+const (
+	VarCluster            = "cluster"
+	VarListener           = "listener"
+	VarFilter             = "filter"
+	VarVirtualHost        = "virtualHost"
+	VarRouteConfiguration = "routeConfiguration"
+	VarRoute              = "route"
+	VarSecret             = "secret"
+	VarOrigin             = "origin"
+)
+
+// costLimit protects the control plane from expressions that are expensive to evaluate,
+// because they are evaluated for every matching resource of every proxy.
+const costLimit = 100_000
+
+// Vars are values of the variables available in an expression.
+type Vars map[string]any
+
+var declarations = map[Kind][]cel.EnvOption{
+	Cluster: {
+		variable(VarCluster, &envoy_cluster.Cluster{}),
+	},
+	Listener: {
+		variable(VarListener, &envoy_listener.Listener{}),
+	},
+	NetworkFilter: {
+		variable(VarFilter, &envoy_listener.Filter{}),
+		variable(VarListener, &envoy_listener.Listener{}),
+	},
+	HTTPFilter: {
+		variable(VarFilter, &envoy_hcm.HttpFilter{}),
+		variable(VarListener, &envoy_listener.Listener{}),
+	},
+	VirtualHost: {
+		variable(VarVirtualHost, &envoy_route.VirtualHost{}),
+		variable(VarRouteConfiguration, &envoy_route.RouteConfiguration{}),
+	},
+	Route: {
+		variable(VarRoute, &envoy_route.Route{}),
+		variable(VarVirtualHost, &envoy_route.VirtualHost{}),
+		variable(VarRouteConfiguration, &envoy_route.RouteConfiguration{}),
+	},
+	Secret: {
+		variable(VarSecret, &envoy_tls.Secret{}),
+	},
+}
+
+func variable(name string, msg proto.Message) cel.EnvOption {
+	return func(env *cel.Env) (*cel.Env, error) {
+		env, err := cel.Types(msg)(env)
+		if err != nil {
+			return nil, err
+		}
+		return cel.Variable(name, cel.ObjectType(string(msg.ProtoReflect().Descriptor().FullName())))(env)
+	}
+}
+
+var envs = sync.OnceValues(func() (map[Kind]*cel.Env, error) {
+	envs := map[Kind]*cel.Env{}
+	for kind, opts := range declarations {
+		env, err := cel.NewEnv(append([]cel.EnvOption{cel.Variable(VarOrigin, cel.StringType)}, opts...)...)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not create environment for %s expressions", kind)
+		}
+		envs[kind] = env
+	}
+	return envs, nil
+})
+
+// programs caches compiled programs, because expressions are evaluated on every generation of the proxy config.
+var programs = cache.New(
+	cache.WithMaximumSize(1000),
+	cache.WithExpireAfterAccess(10*time.Minute),
+)
+
+// Program is a compiled expression.
+type Program struct {
+	program cel.Program
+}
+
+// Compile compiles the CEL expression that is evaluated against Envoy resources of the kind.
+// The expression has to evaluate to a bool.
+func Compile(kind Kind, expression string) (*Program, error) {
+	key := string(kind) + "/" + expression
+	if program, ok := programs.GetIfPresent(key); ok {
+		return program.(*Program), nil
+	}
+	envs, err := envs()
+	if err != nil {
+		return nil, err
+	}
+	env, ok := envs[kind]
+	if !ok {
+		return nil, errors.Errorf("unsupported kind %q", kind)
+	}
+	ast, issues := env.Compile(expression)
+	if issues.Err() != nil {
+		return nil, issues.Err()
+	}
+	if !ast.OutputType().IsExactType(cel.BoolType) {
+		return nil, errors.Errorf("expression must evaluate to bool, got %s", ast.OutputType())
+	}
+	program, err := env.Program(ast, cel.CostLimit(costLimit))
+	if err != nil {
+		return nil, err
+	}
+	p := &Program{program: program}
+	programs.Put(key, p)
+	return p, nil
+}
+
+// Matches evaluates the expression. All variables of the kind have to be set.
+func (p *Program) Matches(vars Vars) (bool, error) {
+	out, _, err := p.program.Eval(map[string]any(vars))
+	if err != nil {
+		return false, errors.Wrap(err, "could not evaluate expression")
+	}
+	matches, ok := out.Value().(bool)
+	if !ok {
+		return false, errors.Errorf("expression evaluated to %v instead of bool", out.Value())
+	}
+	return matches, nil
+}
@@ -0,0 +1,11 @@
+package expression_test
+
+import (
+	"testing"
+
+	"github.com/kumahq/kuma/v3/pkg/test"
+)
+
+func TestExpression(t *testing.T) {
+	test.RunSpecs(t, "MeshProxyPatch Expression Suite")
+}
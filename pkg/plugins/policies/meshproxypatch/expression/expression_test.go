@@ -0,0 +1,76 @@
+package expression_test
+
+import (
+	envoy_cluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	envoy_route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/kumahq/kuma/v3/pkg/plugins/policies/meshproxypatch/expression"
+)
+
+var _ = Describe("Expression", func() {
+	cluster := &envoy_cluster.Cluster{
+		Name:                 "outbound:backend",
+		ClusterDiscoveryType: &envoy_cluster.Cluster_Type{Type: envoy_cluster.Cluster_EDS},
+	}
+
+	type testCase struct {
+		kind       expression.Kind
+		expression string
+		vars       expression.Vars
+		matches    bool
+	}
+
+	DescribeTable("should evaluate expression",
+		func(given testCase) {
+			// when
+			program, err := expression.Compile(given.kind, given.expression)
+			Expect(err).ToNot(HaveOccurred())
+			matches, err := program.Matches(given.vars)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(matches).To(Equal(given.matches))
+		},
+		Entry("cluster name prefix", testCase{
+			kind:       expression.Cluster,
+			expression: `cluster.name.startsWith("outbound:")`,
+			vars:       expression.Vars{"cluster": cluster, "origin": "outbound"},
+			matches:    true,
+		}),
+		Entry("cluster enum field and origin", testCase{
+			kind:       expression.Cluster,
+			expression: `cluster.type == envoy.config.cluster.v3.Cluster.DiscoveryType.STATIC || origin == "inbound"`,
+			vars:       expression.Vars{"cluster": cluster, "origin": "outbound"},
+			matches:    false,
+		}),
+		Entry("route of a virtual host", testCase{
+			kind:       expression.Route,
+			expression: `route.match.prefix == "/api" && virtualHost.name == "backend" && routeConfiguration.name == ""`,
+			vars: expression.Vars{
+				"route": &envoy_route.Route{
+					Match: &envoy_route.RouteMatch{PathSpecifier: &envoy_route.RouteMatch_Prefix{Prefix: "/api"}},
+				},
+				"virtualHost":        &envoy_route.VirtualHost{Name: "backend"},
+				"routeConfiguration": &envoy_route.RouteConfiguration{},
+				"origin":             "outbound",
+			},
+			matches: true,
+		}),
+	)
+
+	DescribeTable("should not compile invalid expression",
+		func(kind expression.Kind, expr string, errMsg string) {
+			// when
+			_, err := expression.Compile(kind, expr)
+
+			// then
+			Expect(err).To(MatchError(ContainSubstring(errMsg)))
+		},
+		Entry("syntax error", expression.Cluster, `cluster.name.startsWith(`, "Syntax error"),
+		Entry("unknown variable", expression.Cluster, `listener.name == "a"`, "undeclared reference to 'listener'"),
+		Entry("unknown field", expression.Cluster, `cluster.nam == "a"`, "undefined field 'nam'"),
+		Entry("non bool expression", expression.Cluster, `cluster.name`, "expression must evaluate to bool, got string"),
+	)
+})
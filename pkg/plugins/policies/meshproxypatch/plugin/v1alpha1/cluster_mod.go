@@ -8,6 +8,7 @@ import (
 	core_xds "github.com/kumahq/kuma/v3/pkg/core/xds"
 	"github.com/kumahq/kuma/v3/pkg/plugins/policies/core/jsonpatch"
 	api "github.com/kumahq/kuma/v3/pkg/plugins/policies/meshproxypatch/api/v1alpha1"
+	"github.com/kumahq/kuma/v3/pkg/plugins/policies/meshproxypatch/expression"
 	"github.com/kumahq/kuma/v3/pkg/util/pointer"
 	util_proto "github.com/kumahq/kuma/v3/pkg/util/proto"
 	"github.com/kumahq/kuma/v3/pkg/xds/generator/metadata"
@@ -27,7 +28,7 @@ func (c *clusterModificator) apply(resources *core_xds.ResourceSet) error {
 	case api.ModOpAdd:
 		c.add(resources, clusterMod)
 	case api.ModOpRemove:
-		c.remove(resources)
+		return c.remove(resources)
 	case api.ModOpPatch:
 		return c.patch(resources, clusterMod)
 	default:
@@ -38,7 +39,11 @@ func (c *clusterModificator) apply(resources *core_xds.ResourceSet) error {
 
 func (c *clusterModificator) patch(resources *core_xds.ResourceSet, clusterMod *envoy_cluster.Cluster) error {
 	for _, cluster := range resources.Resources(envoy_resource.ClusterType) {
-		if c.clusterMatches(cluster) {
+		matches, err := c.clusterMatches(cluster)
+		if err != nil {
+			return err
+		}
+		if matches {
 			if len(pointer.Deref(c.JsonPatches)) > 0 {
 				if err := jsonpatch.MergeJsonPatch(cluster.Resource, pointer.Deref(c.JsonPatches)); err != nil {
 					return err
@@ -47,19 +52,24 @@ func (c *clusterModificator) patch(resources *core_xds.ResourceSet, clusterMod *
 				continue
 			}
 
-			util_proto.Merge(cluster.Resource, clusterMod)
+			merge(c.Strategy, cluster.Resource, clusterMod)
 		}
 	}
 
 	return nil
 }
 
-func (c *clusterModificator) remove(resources *core_xds.ResourceSet) {
+func (c *clusterModificator) remove(resources *core_xds.ResourceSet) error {
 	for name, resource := range resources.Resources(envoy_resource.ClusterType) {
-		if c.clusterMatches(resource) {
+		matches, err := c.clusterMatches(resource)
+		if err != nil {
+			return err
+		}
+		if matches {
 			resources.Remove(envoy_resource.ClusterType, name)
 		}
 	}
+	return nil
 }
 
 func (c *clusterModificator) add(resources *core_xds.ResourceSet, clusterMod *envoy_cluster.Cluster) *core_xds.ResourceSet {
@@ -70,15 +80,18 @@ func (c *clusterModificator) add(resources *core_xds.ResourceSet, clusterMod *en
 	})
 }
 
-func (c *clusterModificator) clusterMatches(cluster *core_xds.Resource) bool {
+func (c *clusterModificator) clusterMatches(cluster *core_xds.Resource) (bool, error) {
 	if c.Match == nil {
-		return true
+		return true, nil
 	}
 	if c.Match.Name != nil && *c.Match.Name != cluster.Name {
-		return false
+		return false, nil
 	}
 	if c.Match.Origin != nil && *c.Match.Origin != string(cluster.Origin) {
-		return false
+		return false, nil
 	}
-	return true
+	return expressionMatches(expression.Cluster, c.Match.Expression, expression.Vars{
+		expression.VarCluster: cluster.Resource,
+		expression.VarOrigin:  string(cluster.Origin),
+	})
 }
@@ -351,5 +351,68 @@ var _ = Describe("Cluster modifications", func() {
                     sni: foo-service{mesh=default}
             `,
 		}),
+		Entry("should patch clusters matched by expression with strategic merge", testCase{
+			clusters: []testCaseCluster{
+				{
+					yaml: `
+                    name: outbound:backend
+                    type: EDS
+                    outlierDetection:
+                      consecutive5xx: 5
+                    healthChecks:
+                    - timeout: 1s
+                      interval: 5s
+                    `,
+					origin: metadata.OriginOutbound,
+				},
+				{
+					yaml: `
+                    name: outbound:db
+                    type: EDS
+                    `,
+					origin: metadata.OriginOutbound,
+				},
+				{
+					yaml: `
+                    name: inbound:backend
+                    type: STATIC
+                    `,
+				},
+			},
+			modifications: []string{
+				`
+                cluster:
+                  operation: Patch
+                  strategy: StrategicMerge
+                  match:
+                    expression: cluster.name.startsWith("outbound:") && has(cluster.outlier_detection.consecutive_5xx)
+                  value: |
+                    healthChecks:
+                    - timeout: 2s
+                      interval: 10s`,
+			},
+			expected: `
+            resources:
+            - name: inbound:backend
+              resource:
+                '@type': type.googleapis.com/envoy.config.cluster.v3.Cluster
+                name: inbound:backend
+                type: STATIC
+            - name: outbound:backend
+              resource:
+                '@type': type.googleapis.com/envoy.config.cluster.v3.Cluster
+                name: outbound:backend
+                type: EDS
+                outlierDetection:
+                  consecutive5xx: 5
+                healthChecks:
+                - timeout: 2s
+                  interval: 10s
+            - name: outbound:db
+              resource:
+                '@type': type.googleapis.com/envoy.config.cluster.v3.Cluster
+                name: outbound:db
+                type: EDS`,
+		}),
 	)
 })
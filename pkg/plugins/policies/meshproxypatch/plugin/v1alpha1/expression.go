@@ -0,0 +1,38 @@
+package v1alpha1
+
+import (
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	api "github.com/kumahq/kuma/v3/pkg/plugins/policies/meshproxypatch/api/v1alpha1"
+	"github.com/kumahq/kuma/v3/pkg/plugins/policies/meshproxypatch/expression"
+	"github.com/kumahq/kuma/v3/pkg/util/pointer"
+	util_proto "github.com/kumahq/kuma/v3/pkg/util/proto"
+)
+
+// expressionMatches returns true when the expression is not defined or evaluates to true.
+func expressionMatches(kind expression.Kind, expr *string, vars expression.Vars) (bool, error) {
+	if expr == nil {
+		return true, nil
+	}
+	program, err := expression.Compile(kind, *expr)
+	if err != nil {
+		return false, err
+	}
+	return program.Matches(vars)
+}
+
+func merge(strategy *api.PatchStrategy, dst, src proto.Message) {
+	if pointer.Deref(strategy) == api.PatchStrategyStrategicMerge {
+		util_proto.StrategicMerge(dst, src)
+		return
+	}
+	util_proto.Merge(dst, src)
+}
+
+func mergeAnys(strategy *api.PatchStrategy, dst, src *anypb.Any) (*anypb.Any, error) {
+	if pointer.Deref(strategy) == api.PatchStrategyStrategicMerge {
+		return util_proto.StrategicMergeAnys(dst, src)
+	}
+	return util_proto.MergeAnys(dst, src)
+}
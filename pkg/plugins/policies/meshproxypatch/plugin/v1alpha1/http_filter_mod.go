@@ -1,8 +1,6 @@
 package v1alpha1
 
 import (
-	"slices"
-
 	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
 	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
 	envoy_resource "github.com/envoyproxy/go-control-plane/pkg/resource/v3"
@@ -13,6 +11,7 @@ import (
 	core_xds "github.com/kumahq/kuma/v3/pkg/core/xds"
 	"github.com/kumahq/kuma/v3/pkg/plugins/policies/core/jsonpatch"
 	api "github.com/kumahq/kuma/v3/pkg/plugins/policies/meshproxypatch/api/v1alpha1"
+	"github.com/kumahq/kuma/v3/pkg/plugins/policies/meshproxypatch/expression"
 	"github.com/kumahq/kuma/v3/pkg/util/pointer"
 	util_proto "github.com/kumahq/kuma/v3/pkg/util/proto"
 	envoy_metadata "github.com/kumahq/kuma/v3/pkg/xds/envoy/metadata/v3"
@@ -34,7 +33,7 @@ func (h *httpFilterModificator) apply(resources *core_xds.ResourceSet) error {
 					if err != nil {
 						return err
 					}
-					if err := h.applyHCMModification(hcm); err != nil {
+					if err := h.applyHCMModification(resource, hcm); err != nil {
 						return err
 					}
 					typedConfig, err := util_proto.MarshalAnyDeterministic(hcm)
@@ -49,7 +48,7 @@ func (h *httpFilterModificator) apply(resources *core_xds.ResourceSet) error {
 	return nil
 }
 
-func (h *httpFilterModificator) applyHCMModification(hcm *envoy_hcm.HttpConnectionManager) error {
+func (h *httpFilterModificator) applyHCMModification(listener *core_xds.Resource, hcm *envoy_hcm.HttpConnectionManager) error {
 	filter := &envoy_hcm.HttpFilter{}
 	if h.Value != nil {
 		if err := util_proto.FromYAML([]byte(*h.Value), filter); err != nil {
@@ -62,13 +61,13 @@ func (h *httpFilterModificator) applyHCMModification(hcm *envoy_hcm.HttpConnecti
 	case api.ModOpAddLast:
 		h.addLast(hcm, filter)
 	case api.ModOpAddAfter:
-		h.addAfter(hcm, filter)
+		return h.addAfter(listener, hcm, filter)
 	case api.ModOpAddBefore:
-		h.addBefore(hcm, filter)
+		return h.addBefore(listener, hcm, filter)
 	case api.ModOpRemove:
-		h.remove(hcm)
+		return h.remove(listener, hcm)
 	case api.ModOpPatch:
-		if err := h.patch(hcm, filter); err != nil {
+		if err := h.patch(listener, hcm, filter); err != nil {
 			return errors.Wrap(err, "could not patch the resource")
 		}
 	default:
@@ -77,18 +76,21 @@ func (h *httpFilterModificator) applyHCMModification(hcm *envoy_hcm.HttpConnecti
 	return nil
 }
 
-func (h *httpFilterModificator) patch(hcm *envoy_hcm.HttpConnectionManager, filterPatch *envoy_hcm.HttpFilter) error {
+func (h *httpFilterModificator) patch(listener *core_xds.Resource, hcm *envoy_hcm.HttpConnectionManager, filterPatch *envoy_hcm.HttpFilter) error {
 	for _, filter := range hcm.HttpFilters {
-		if !h.filterMatches(filter) {
+		matches, err := h.filterMatches(listener, filter)
+		if err != nil {
+			return err
+		}
+		if !matches {
 			continue
 		}
 		var merged *anypb.Any
-		var err error
 
 		if len(pointer.Deref(h.JsonPatches)) > 0 {
 			merged, err = jsonpatch.MergeJsonPatchAny(filter.GetTypedConfig(), pointer.Deref(h.JsonPatches))
 		} else {
-			merged, err = util_proto.MergeAnys(filter.GetTypedConfig(), filterPatch.GetTypedConfig())
+			merged, err = mergeAnys(h.Strategy, filter.GetTypedConfig(), filterPatch.GetTypedConfig())
 		}
 
 		if err != nil {
@@ -102,28 +104,45 @@ func (h *httpFilterModificator) patch(hcm *envoy_hcm.HttpConnectionManager, filt
 	return nil
 }
 
-func (h *httpFilterModificator) remove(hcm *envoy_hcm.HttpConnectionManager) {
-	hcm.HttpFilters = slices.DeleteFunc(hcm.HttpFilters, func(filter *envoy_hcm.HttpFilter) bool {
-		return h.filterMatches(filter)
-	})
+func (h *httpFilterModificator) remove(listener *core_xds.Resource, hcm *envoy_hcm.HttpConnectionManager) error {
+	filters := make([]*envoy_hcm.HttpFilter, 0, len(hcm.HttpFilters))
+	for _, filter := range hcm.HttpFilters {
+		matches, err := h.filterMatches(listener, filter)
+		if err != nil {
+			return err
+		}
+		if !matches {
+			filters = append(filters, filter)
+		}
+	}
+	hcm.HttpFilters = filters
+	return nil
 }
 
-func (h *httpFilterModificator) addBefore(hcm *envoy_hcm.HttpConnectionManager, filterMod *envoy_hcm.HttpFilter) {
-	idx := h.indexOfMatchedFilter(hcm)
+func (h *httpFilterModificator) addBefore(listener *core_xds.Resource, hcm *envoy_hcm.HttpConnectionManager, filterMod *envoy_hcm.HttpFilter) error {
+	idx, err := h.indexOfMatchedFilter(listener, hcm)
+	if err != nil {
+		return err
+	}
 	if idx != -1 {
 		hcm.HttpFilters = append(hcm.HttpFilters, nil)
 		copy(hcm.HttpFilters[idx+1:], hcm.HttpFilters[idx:])
 		hcm.HttpFilters[idx] = filterMod
 	}
+	return nil
 }
 
-func (h *httpFilterModificator) addAfter(hcm *envoy_hcm.HttpConnectionManager, filterMod *envoy_hcm.HttpFilter) {
-	idx := h.indexOfMatchedFilter(hcm)
+func (h *httpFilterModificator) addAfter(listener *core_xds.Resource, hcm *envoy_hcm.HttpConnectionManager, filterMod *envoy_hcm.HttpFilter) error {
+	idx, err := h.indexOfMatchedFilter(listener, hcm)
+	if err != nil {
+		return err
+	}
 	if idx != -1 {
 		hcm.HttpFilters = append(hcm.HttpFilters, nil)
 		copy(hcm.HttpFilters[idx+2:], hcm.HttpFilters[idx+1:])
 		hcm.HttpFilters[idx+1] = filterMod
 	}
+	return nil
 }
 
 func (h *httpFilterModificator) addLast(hcm *envoy_hcm.HttpConnectionManager, filterMod *envoy_hcm.HttpFilter) {
@@ -134,14 +153,18 @@ func (h *httpFilterModificator) addFirst(hcm *envoy_hcm.HttpConnectionManager, f
 	hcm.HttpFilters = append([]*envoy_hcm.HttpFilter{filterMod}, hcm.HttpFilters...)
 }
 
-func (h *httpFilterModificator) filterMatches(filter *envoy_hcm.HttpFilter) bool {
+func (h *httpFilterModificator) filterMatches(listener *core_xds.Resource, filter *envoy_hcm.HttpFilter) (bool, error) {
 	if h.Match == nil {
-		return true
+		return true, nil
 	}
 	if h.Match.Name != nil && *h.Match.Name != filter.Name {
-		return false
+		return false, nil
 	}
-	return true
+	return expressionMatches(expression.HTTPFilter, h.Match.Expression, expression.Vars{
+		expression.VarFilter:   filter,
+		expression.VarListener: listener.Resource,
+		expression.VarOrigin:   string(listener.Origin),
+	})
 }
 
 func (h *httpFilterModificator) listenerMatches(resource *core_xds.Resource) bool {
@@ -165,11 +188,18 @@ func (h *httpFilterModificator) listenerMatches(resource *core_xds.Resource) boo
 	return true
 }
 
-func (h *httpFilterModificator) indexOfMatchedFilter(hcm *envoy_hcm.HttpConnectionManager) int {
+func (h *httpFilterModificator) indexOfMatchedFilter(listener *core_xds.Resource, hcm *envoy_hcm.HttpConnectionManager) (int, error) {
+	if h.Match == nil {
+		return -1, nil
+	}
 	for i, filter := range hcm.HttpFilters {
-		if h.Match != nil && filter.Name == *h.Match.Name {
-			return i
+		matches, err := h.filterMatches(listener, filter)
+		if err != nil {
+			return -1, err
+		}
+		if matches {
+			return i, nil
 		}
 	}
-	return -1
+	return -1, nil
 }
@@ -9,6 +9,7 @@ import (
 	core_xds "github.com/kumahq/kuma/v3/pkg/core/xds"
 	"github.com/kumahq/kuma/v3/pkg/plugins/policies/core/jsonpatch"
 	api "github.com/kumahq/kuma/v3/pkg/plugins/policies/meshproxypatch/api/v1alpha1"
+	"github.com/kumahq/kuma/v3/pkg/plugins/policies/meshproxypatch/expression"
 	"github.com/kumahq/kuma/v3/pkg/plugins/policies/meshproxypatch/metadata"
 	"github.com/kumahq/kuma/v3/pkg/util/pointer"
 	util_proto "github.com/kumahq/kuma/v3/pkg/util/proto"
@@ -28,7 +29,7 @@ func (l *listenerModificator) apply(resources *core_xds.ResourceSet) error {
 	case api.ModOpAdd:
 		l.add(resources, listener)
 	case api.ModOpRemove:
-		l.remove(resources)
+		return l.remove(resources)
 	case api.ModOpPatch:
 		return l.patch(resources, listener)
 	default:
@@ -39,7 +40,11 @@ func (l *listenerModificator) apply(resources *core_xds.ResourceSet) error {
 
 func (l *listenerModificator) patch(resources *core_xds.ResourceSet, listenerPatch *envoy_listener.Listener) error {
 	for _, listener := range resources.Resources(envoy_resource.ListenerType) {
-		if l.listenerMatches(listener) {
+		matches, err := l.listenerMatches(listener)
+		if err != nil {
+			return err
+		}
+		if matches {
 			if len(pointer.Deref(l.JsonPatches)) > 0 {
 				if err := jsonpatch.MergeJsonPatch(listener.Resource, pointer.Deref(l.JsonPatches)); err != nil {
 					return err
@@ -48,19 +53,24 @@ func (l *listenerModificator) patch(resources *core_xds.ResourceSet, listenerPat
 				continue
 			}
 
-			util_proto.Merge(listener.Resource, listenerPatch)
+			merge(l.Strategy, listener.Resource, listenerPatch)
 		}
 	}
 
 	return nil
 }
 
-func (l *listenerModificator) remove(resources *core_xds.ResourceSet) {
+func (l *listenerModificator) remove(resources *core_xds.ResourceSet) error {
 	for name, resource := range resources.Resources(envoy_resource.ListenerType) {
-		if l.listenerMatches(resource) {
+		matches, err := l.listenerMatches(resource)
+		if err != nil {
+			return err
+		}
+		if matches {
 			resources.Remove(envoy_resource.ListenerType, name)
 		}
 	}
+	return nil
 }
 
 func (l *listenerModificator) add(resources *core_xds.ResourceSet, listener *envoy_listener.Listener) *core_xds.ResourceSet {
@@ -71,23 +81,26 @@ func (l *listenerModificator) add(resources *core_xds.ResourceSet, listener *env
 	})
 }
 
-func (l *listenerModificator) listenerMatches(listener *core_xds.Resource) bool {
+func (l *listenerModificator) listenerMatches(listener *core_xds.Resource) (bool, error) {
 	if l.Match == nil {
-		return true
+		return true, nil
 	}
 	if l.Match.Name != nil && !listenerNameMatches(*l.Match.Name, listener.Name) {
-		return false
+		return false, nil
 	}
 	if l.Match.Origin != nil && *l.Match.Origin != string(listener.Origin) {
-		return false
+		return false, nil
 	}
 	if len(pointer.Deref(l.Match.Tags)) > 0 {
 		if listenerProto, ok := listener.Resource.(*envoy_listener.Listener); ok {
 			listenerTags := envoy_metadata.ExtractTags(listenerProto.Metadata)
 			if !mesh_proto.TagSelector(pointer.Deref(l.Match.Tags)).Matches(listenerTags) {
-				return false
+				return false, nil
 			}
 		}
 	}
-	return true
+	return expressionMatches(expression.Listener, l.Match.Expression, expression.Vars{
+		expression.VarListener: listener.Resource,
+		expression.VarOrigin:   string(listener.Origin),
+	})
 }
@@ -1,8 +1,6 @@
 package v1alpha1
 
 import (
-	"slices"
-
 	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
 	envoy_resource "github.com/envoyproxy/go-control-plane/pkg/resource/v3"
 	"github.com/pkg/errors"
@@ -13,6 +11,7 @@ import (
 	core_xds "github.com/kumahq/kuma/v3/pkg/core/xds"
 	"github.com/kumahq/kuma/v3/pkg/plugins/policies/core/jsonpatch"
 	api "github.com/kumahq/kuma/v3/pkg/plugins/policies/meshproxypatch/api/v1alpha1"
+	"github.com/kumahq/kuma/v3/pkg/plugins/policies/meshproxypatch/expression"
 	"github.com/kumahq/kuma/v3/pkg/util/pointer"
 	util_proto "github.com/kumahq/kuma/v3/pkg/util/proto"
 	envoy_metadata "github.com/kumahq/kuma/v3/pkg/xds/envoy/metadata/v3"
@@ -56,24 +55,28 @@ func (n *networkFilterModificator) apply(resources *core_xds.ResourceSet) error
 		if n.listenerMatches(resource) {
 			listener := resource.Resource.(*envoy_listener.Listener)
 			for _, chain := range listener.FilterChains { // apply on all filter chains. We could introduce filter chain matcher as an improvement.
+				var err error
 				switch n.Operation {
 				case api.ModOpAddFirst:
 					n.addFirst(chain, filter)
 				case api.ModOpAddLast:
 					n.addLast(chain, filter)
 				case api.ModOpAddAfter:
-					n.addAfter(chain, filter)
+					err = n.addAfter(resource, chain, filter)
 				case api.ModOpAddBefore:
-					n.addBefore(chain, filter)
+					err = n.addBefore(resource, chain, filter)
 				case api.ModOpRemove:
-					n.remove(chain)
+					err = n.remove(resource, chain)
 				case api.ModOpPatch:
-					if err := n.patch(chain, filter); err != nil {
+					if err := n.patch(resource, chain, filter); err != nil {
 						return errors.Wrap(err, "could not patch the resource")
 					}
 				default:
 					return errors.Errorf("invalid operation: %s", n.Operation)
 				}
+				if err != nil {
+					return err
+				}
 			}
 		}
 	}
@@ -88,42 +91,62 @@ func (n *networkFilterModificator) addLast(chain *envoy_listener.FilterChain, fi
 	chain.Filters = append(chain.Filters, filter)
 }
 
-func (n *networkFilterModificator) addAfter(chain *envoy_listener.FilterChain, filter *envoy_listener.Filter) {
-	idx := n.indexOfMatchedFilter(chain)
+func (n *networkFilterModificator) addAfter(listener *core_xds.Resource, chain *envoy_listener.FilterChain, filter *envoy_listener.Filter) error {
+	idx, err := n.indexOfMatchedFilter(listener, chain)
+	if err != nil {
+		return err
+	}
 	if idx != -1 {
 		chain.Filters = append(chain.Filters, nil)
 		copy(chain.Filters[idx+2:], chain.Filters[idx+1:])
 		chain.Filters[idx+1] = filter
 	}
+	return nil
 }
 
-func (n *networkFilterModificator) addBefore(chain *envoy_listener.FilterChain, filter *envoy_listener.Filter) {
-	idx := n.indexOfMatchedFilter(chain)
+func (n *networkFilterModificator) addBefore(listener *core_xds.Resource, chain *envoy_listener.FilterChain, filter *envoy_listener.Filter) error {
+	idx, err := n.indexOfMatchedFilter(listener, chain)
+	if err != nil {
+		return err
+	}
 	if idx != -1 {
 		chain.Filters = append(chain.Filters, nil)
 		copy(chain.Filters[idx+1:], chain.Filters[idx:])
 		chain.Filters[idx] = filter
 	}
+	return nil
 }
 
-func (n *networkFilterModificator) remove(chain *envoy_listener.FilterChain) {
-	chain.Filters = slices.DeleteFunc(chain.Filters, func(filter *envoy_listener.Filter) bool {
-		return n.filterMatches(filter)
-	})
+func (n *networkFilterModificator) remove(listener *core_xds.Resource, chain *envoy_listener.FilterChain) error {
+	filters := make([]*envoy_listener.Filter, 0, len(chain.Filters))
+	for _, filter := range chain.Filters {
+		matches, err := n.filterMatches(listener, filter)
+		if err != nil {
+			return err
+		}
+		if !matches {
+			filters = append(filters, filter)
+		}
+	}
+	chain.Filters = filters
+	return nil
 }
 
-func (n *networkFilterModificator) patch(chain *envoy_listener.FilterChain, filterPatch *envoy_listener.Filter) error {
+func (n *networkFilterModificator) patch(listener *core_xds.Resource, chain *envoy_listener.FilterChain, filterPatch *envoy_listener.Filter) error {
 	for _, filter := range chain.Filters {
-		if !n.filterMatches(filter) {
+		matches, err := n.filterMatches(listener, filter)
+		if err != nil {
+			return err
+		}
+		if !matches {
 			continue
 		}
 		var merged *anypb.Any
-		var err error
 
 		if len(pointer.Deref(n.JsonPatches)) > 0 {
 			merged, err = jsonpatch.MergeJsonPatchAny(filter.GetTypedConfig(), pointer.Deref(n.JsonPatches))
 		} else {
-			merged, err = util_proto.MergeAnys(filter.GetTypedConfig(), filterPatch.GetTypedConfig())
+			merged, err = mergeAnys(n.Strategy, filter.GetTypedConfig(), filterPatch.GetTypedConfig())
 		}
 
 		if err != nil {
@@ -137,14 +160,18 @@ func (n *networkFilterModificator) patch(chain *envoy_listener.FilterChain, filt
 	return nil
 }
 
-func (n *networkFilterModificator) filterMatches(filter *envoy_listener.Filter) bool {
+func (n *networkFilterModificator) filterMatches(listener *core_xds.Resource, filter *envoy_listener.Filter) (bool, error) {
 	if n.Match == nil {
-		return true
+		return true, nil
 	}
 	if n.Match.Name != nil && *n.Match.Name != filter.Name {
-		return false
+		return false, nil
 	}
-	return true
+	return expressionMatches(expression.NetworkFilter, n.Match.Expression, expression.Vars{
+		expression.VarFilter:   filter,
+		expression.VarListener: listener.Resource,
+		expression.VarOrigin:   string(listener.Origin),
+	})
 }
 
 func (n *networkFilterModificator) listenerMatches(resource *core_xds.Resource) bool {
@@ -168,11 +195,18 @@ func (n *networkFilterModificator) listenerMatches(resource *core_xds.Resource)
 	return true
 }
 
-func (n *networkFilterModificator) indexOfMatchedFilter(chain *envoy_listener.FilterChain) int {
+func (n *networkFilterModificator) indexOfMatchedFilter(listener *core_xds.Resource, chain *envoy_listener.FilterChain) (int, error) {
+	if n.Match == nil {
+		return -1, nil
+	}
 	for i, filter := range chain.Filters {
-		if n.Match != nil && filter.Name == *n.Match.Name {
-			return i
+		matches, err := n.filterMatches(listener, filter)
+		if err != nil {
+			return -1, err
+		}
+		if matches {
+			return i, nil
 		}
 	}
-	return -1
+	return -1, nil
 }
@@ -58,6 +58,12 @@ func ApplyMods(resources *core_xds.ResourceSet, modifications []api.Modification
 		case modification.VirtualHost != nil:
 			mod := virtualHostModificator(*modification.VirtualHost)
 			modificator = &mod
+		case modification.Route != nil:
+			mod := routeModificator(*modification.Route)
+			modificator = &mod
+		case modification.Secret != nil:
+			mod := secretModificator(*modification.Secret)
+			modificator = &mod
 		default:
 			return errors.Errorf("invalid modification")
 		}
@@ -0,0 +1,169 @@
+package v1alpha1
+
+import (
+	envoy_route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	"github.com/pkg/errors"
+
+	core_xds "github.com/kumahq/kuma/v3/pkg/core/xds"
+	"github.com/kumahq/kuma/v3/pkg/plugins/policies/core/jsonpatch"
+	api "github.com/kumahq/kuma/v3/pkg/plugins/policies/meshproxypatch/api/v1alpha1"
+	"github.com/kumahq/kuma/v3/pkg/plugins/policies/meshproxypatch/expression"
+	"github.com/kumahq/kuma/v3/pkg/util/pointer"
+	util_proto "github.com/kumahq/kuma/v3/pkg/util/proto"
+)
+
+// routeModificator assumes that the routes are specified as `routeConfig` in Listeners, not through RDS
+// like virtualHostModificator does.
+type routeModificator api.RouteMod
+
+func (r *routeModificator) apply(resources *core_xds.ResourceSet) error {
+	route := &envoy_route.Route{}
+	if r.Value != nil {
+		if err := util_proto.FromYAML([]byte(*r.Value), route); err != nil {
+			return err
+		}
+	}
+
+	var origin *string
+	if r.Match != nil {
+		origin = r.Match.Origin
+	}
+	return modifyRouteConfigs(resources, origin, func(listener *core_xds.Resource, routeCfg *envoy_route.RouteConfiguration) error {
+		if r.Match != nil && r.Match.RouteConfigurationName != nil && *r.Match.RouteConfigurationName != routeCfg.Name {
+			return nil
+		}
+		for _, vHost := range routeCfg.VirtualHosts {
+			if r.Match != nil && r.Match.VirtualHostName != nil && *r.Match.VirtualHostName != vHost.Name {
+				continue
+			}
+			if err := r.applyVirtualHostModification(listener, routeCfg, vHost, route); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (r *routeModificator) applyVirtualHostModification(
+	listener *core_xds.Resource,
+	routeCfg *envoy_route.RouteConfiguration,
+	vHost *envoy_route.VirtualHost,
+	route *envoy_route.Route,
+) error {
+	switch r.Operation {
+	case api.ModOpAddFirst:
+		vHost.Routes = append([]*envoy_route.Route{route}, vHost.Routes...)
+	case api.ModOpAddLast:
+		vHost.Routes = append(vHost.Routes, route)
+	case api.ModOpAddBefore:
+		return r.addBefore(listener, routeCfg, vHost, route)
+	case api.ModOpAddAfter:
+		return r.addAfter(listener, routeCfg, vHost, route)
+	case api.ModOpRemove:
+		return r.remove(listener, routeCfg, vHost)
+	case api.ModOpPatch:
+		if err := r.patch(listener, routeCfg, vHost, route); err != nil {
+			return errors.Wrap(err, "could not patch the resource")
+		}
+	default:
+		return errors.Errorf("invalid operation: %s", r.Operation)
+	}
+	return nil
+}
+
+func (r *routeModificator) addBefore(listener *core_xds.Resource, routeCfg *envoy_route.RouteConfiguration, vHost *envoy_route.VirtualHost, route *envoy_route.Route) error {
+	idx, err := r.indexOfMatchedRoute(listener, routeCfg, vHost)
+	if err != nil {
+		return err
+	}
+	if idx != -1 {
+		vHost.Routes = append(vHost.Routes, nil)
+		copy(vHost.Routes[idx+1:], vHost.Routes[idx:])
+		vHost.Routes[idx] = route
+	}
+	return nil
+}
+
+func (r *routeModificator) addAfter(listener *core_xds.Resource, routeCfg *envoy_route.RouteConfiguration, vHost *envoy_route.VirtualHost, route *envoy_route.Route) error {
+	idx, err := r.indexOfMatchedRoute(listener, routeCfg, vHost)
+	if err != nil {
+		return err
+	}
+	if idx != -1 {
+		vHost.Routes = append(vHost.Routes, nil)
+		copy(vHost.Routes[idx+2:], vHost.Routes[idx+1:])
+		vHost.Routes[idx+1] = route
+	}
+	return nil
+}
+
+func (r *routeModificator) remove(listener *core_xds.Resource, routeCfg *envoy_route.RouteConfiguration, vHost *envoy_route.VirtualHost) error {
+	routes := make([]*envoy_route.Route, 0, len(vHost.Routes))
+	for _, route := range vHost.Routes {
+		matches, err := r.routeMatches(listener, routeCfg, vHost, route)
+		if err != nil {
+			return err
+		}
+		if !matches {
+			routes = append(routes, route)
+		}
+	}
+	vHost.Routes = routes
+	return nil
+}
+
+func (r *routeModificator) patch(listener *core_xds.Resource, routeCfg *envoy_route.RouteConfiguration, vHost *envoy_route.VirtualHost, routePatch *envoy_route.Route) error {
+	for _, route := range vHost.Routes {
+		matches, err := r.routeMatches(listener, routeCfg, vHost, route)
+		if err != nil {
+			return err
+		}
+		if !matches {
+			continue
+		}
+		if len(pointer.Deref(r.JsonPatches)) > 0 {
+			if err := jsonpatch.MergeJsonPatch(route, pointer.Deref(r.JsonPatches)); err != nil {
+				return err
+			}
+			continue
+		}
+		merge(r.Strategy, route, routePatch)
+	}
+	return nil
+}
+
+func (r *routeModificator) routeMatches(
+	listener *core_xds.Resource,
+	routeCfg *envoy_route.RouteConfiguration,
+	vHost *envoy_route.VirtualHost,
+	route *envoy_route.Route,
+) (bool, error) {
+	if r.Match == nil {
+		return true, nil
+	}
+	if r.Match.Name != nil && *r.Match.Name != route.Name {
+		return false, nil
+	}
+	return expressionMatches(expression.Route, r.Match.Expression, expression.Vars{
+		expression.VarRoute:              route,
+		expression.VarVirtualHost:        vHost,
+		expression.VarRouteConfiguration: routeCfg,
+		expression.VarOrigin:             string(listener.Origin),
+	})
+}
+
+func (r *routeModificator) indexOfMatchedRoute(listener *core_xds.Resource, routeCfg *envoy_route.RouteConfiguration, vHost *envoy_route.VirtualHost) (int, error) {
+	if r.Match == nil {
+		return -1, nil
+	}
+	for i, route := range vHost.Routes {
+		matches, err := r.routeMatches(listener, routeCfg, vHost, route)
+		if err != nil {
+			return -1, err
+		}
+		if matches {
+			return i, nil
+		}
+	}
+	return -1, nil
+}
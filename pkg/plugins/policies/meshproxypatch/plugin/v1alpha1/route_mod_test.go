@@ -0,0 +1,286 @@
+package v1alpha1_test
+
+import (
+	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/yaml"
+
+	core_xds "github.com/kumahq/kuma/v3/pkg/core/xds"
+	api "github.com/kumahq/kuma/v3/pkg/plugins/policies/meshproxypatch/api/v1alpha1"
+	plugin "github.com/kumahq/kuma/v3/pkg/plugins/policies/meshproxypatch/plugin/v1alpha1"
+	util_proto "github.com/kumahq/kuma/v3/pkg/util/proto"
+	"github.com/kumahq/kuma/v3/pkg/xds/generator/metadata"
+)
+
+var _ = Describe("Route modifications", func() {
+	listener := `
+        name: outbound:192.168.0.1:8080
+        address:
+          socketAddress:
+            address: 192.168.0.1
+            portValue: 8080
+        filterChains:
+        - filters:
+          - name: envoy.filters.network.http_connection_manager
+            typedConfig:
+              '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+              statPrefix: backend
+              httpFilters:
+              - name: envoy.filters.http.router
+              routeConfig:
+                name: outbound:backend
+                virtualHosts:
+                - name: backend
+                  domains:
+                  - '*'
+                  routes:
+                  - name: api
+                    match:
+                      prefix: /api
+                    route:
+                      cluster: backend-api
+                  - name: default
+                    match:
+                      prefix: /
+                    route:
+                      cluster: backend
+`
+
+	type testCase struct {
+		modifications []string
+		expected      string
+	}
+
+	DescribeTable("should apply modifications",
+		func(given testCase) {
+			// given
+			set := core_xds.NewResourceSet()
+			l := &envoy_listener.Listener{}
+			Expect(util_proto.FromYAML([]byte(listener), l)).To(Succeed())
+			set.Add(&core_xds.Resource{
+				Name:     l.Name,
+				Origin:   metadata.OriginOutbound,
+				Resource: l,
+			})
+
+			var mods []api.Modification
+			for _, modificationYAML := range given.modifications {
+				modification := api.Modification{}
+				err := yaml.Unmarshal([]byte(modificationYAML), &modification)
+				Expect(err).ToNot(HaveOccurred())
+				mods = append(mods, modification)
+			}
+
+			// when
+			err := plugin.ApplyMods(set, mods)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			resp, err := set.List().ToDeltaDiscoveryResponse()
+			Expect(err).ToNot(HaveOccurred())
+			actual, err := util_proto.ToYAML(resp)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(actual).To(MatchYAML(given.expected))
+		},
+		Entry("should add route first", testCase{
+			modifications: []string{`
+                route:
+                  operation: AddFirst
+                  match:
+                    virtualHostName: backend
+                  value: |
+                    name: health
+                    match:
+                      path: /health
+                    directResponse:
+                      status: 200`,
+			},
+			expected: `
+            resources:
+            - name: outbound:192.168.0.1:8080
+              resource:
+                '@type': type.googleapis.com/envoy.config.listener.v3.Listener
+                name: outbound:192.168.0.1:8080
+                address:
+                  socketAddress:
+                    address: 192.168.0.1
+                    portValue: 8080
+                filterChains:
+                - filters:
+                  - name: envoy.filters.network.http_connection_manager
+                    typedConfig:
+                      '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                      statPrefix: backend
+                      httpFilters:
+                      - name: envoy.filters.http.router
+                      routeConfig:
+                        name: outbound:backend
+                        virtualHosts:
+                        - name: backend
+                          domains:
+                          - '*'
+                          routes:
+                          - name: health
+                            match:
+                              path: /health
+                            directResponse:
+                              status: 200
+                          - name: api
+                            match:
+                              prefix: /api
+                            route:
+                              cluster: backend-api
+                          - name: default
+                            match:
+                              prefix: /
+                            route:
+                              cluster: backend`,
+		}),
+		Entry("should add route after route matched by expression", testCase{
+			modifications: []string{`
+                route:
+                  operation: AddAfter
+                  match:
+                    expression: route.match.prefix == "/api" && virtualHost.name == "backend" && origin == "outbound"
+                  value: |
+                    name: web
+                    match:
+                      prefix: /web
+                    route:
+                      cluster: backend-web`,
+			},
+			expected: `
+            resources:
+            - name: outbound:192.168.0.1:8080
+              resource:
+                '@type': type.googleapis.com/envoy.config.listener.v3.Listener
+                name: outbound:192.168.0.1:8080
+                address:
+                  socketAddress:
+                    address: 192.168.0.1
+                    portValue: 8080
+                filterChains:
+                - filters:
+                  - name: envoy.filters.network.http_connection_manager
+                    typedConfig:
+                      '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                      statPrefix: backend
+                      httpFilters:
+                      - name: envoy.filters.http.router
+                      routeConfig:
+                        name: outbound:backend
+                        virtualHosts:
+                        - name: backend
+                          domains:
+                          - '*'
+                          routes:
+                          - name: api
+                            match:
+                              prefix: /api
+                            route:
+                              cluster: backend-api
+                          - name: web
+                            match:
+                              prefix: /web
+                            route:
+                              cluster: backend-web
+                          - name: default
+                            match:
+                              prefix: /
+                            route:
+                              cluster: backend`,
+		}),
+		Entry("should patch and remove routes", testCase{
+			modifications: []string{
+				`
+                route:
+                  operation: Patch
+                  match:
+                    name: default
+                  value: |
+                    route:
+                      timeout: 10s`,
+				`
+                route:
+                  operation: Remove
+                  match:
+                    expression: route.route.cluster.endsWith("-api")`,
+			},
+			expected: `
+            resources:
+            - name: outbound:192.168.0.1:8080
+              resource:
+                '@type': type.googleapis.com/envoy.config.listener.v3.Listener
+                name: outbound:192.168.0.1:8080
+                address:
+                  socketAddress:
+                    address: 192.168.0.1
+                    portValue: 8080
+                filterChains:
+                - filters:
+                  - name: envoy.filters.network.http_connection_manager
+                    typedConfig:
+                      '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                      statPrefix: backend
+                      httpFilters:
+                      - name: envoy.filters.http.router
+                      routeConfig:
+                        name: outbound:backend
+                        virtualHosts:
+                        - name: backend
+                          domains:
+                          - '*'
+                          routes:
+                          - name: default
+                            match:
+                              prefix: /
+                            route:
+                              cluster: backend
+                              timeout: 10s`,
+		}),
+		Entry("should not modify routes of other virtual hosts", testCase{
+			modifications: []string{`
+                route:
+                  operation: Remove
+                  match:
+                    virtualHostName: frontend`,
+			},
+			expected: `
+            resources:
+            - name: outbound:192.168.0.1:8080
+              resource:
+                '@type': type.googleapis.com/envoy.config.listener.v3.Listener
+                name: outbound:192.168.0.1:8080
+                address:
+                  socketAddress:
+                    address: 192.168.0.1
+                    portValue: 8080
+                filterChains:
+                - filters:
+                  - name: envoy.filters.network.http_connection_manager
+                    typedConfig:
+                      '@type': type.googleapis.com/envoy.extensions.filters.network.http_connection_manager.v3.HttpConnectionManager
+                      statPrefix: backend
+                      httpFilters:
+                      - name: envoy.filters.http.router
+                      routeConfig:
+                        name: outbound:backend
+                        virtualHosts:
+                        - name: backend
+                          domains:
+                          - '*'
+                          routes:
+                          - name: api
+                            match:
+                              prefix: /api
+                            route:
+                              cluster: backend-api
+                          - name: default
+                            match:
+                              prefix: /
+                            route:
+                              cluster: backend`,
+		}),
+	)
+})
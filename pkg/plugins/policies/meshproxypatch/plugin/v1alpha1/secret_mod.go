@@ -0,0 +1,95 @@
+package v1alpha1
+
+import (
+	envoy_tls "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	envoy_resource "github.com/envoyproxy/go-control-plane/pkg/resource/v3"
+	"github.com/pkg/errors"
+
+	core_xds "github.com/kumahq/kuma/v3/pkg/core/xds"
+	"github.com/kumahq/kuma/v3/pkg/plugins/policies/core/jsonpatch"
+	api "github.com/kumahq/kuma/v3/pkg/plugins/policies/meshproxypatch/api/v1alpha1"
+	"github.com/kumahq/kuma/v3/pkg/plugins/policies/meshproxypatch/expression"
+	"github.com/kumahq/kuma/v3/pkg/plugins/policies/meshproxypatch/metadata"
+	"github.com/kumahq/kuma/v3/pkg/util/pointer"
+	util_proto "github.com/kumahq/kuma/v3/pkg/util/proto"
+)
+
+type secretModificator api.SecretMod
+
+func (s *secretModificator) apply(resources *core_xds.ResourceSet) error {
+	secretMod := &envoy_tls.Secret{}
+	if s.Value != nil {
+		if err := util_proto.FromYAML([]byte(*s.Value), secretMod); err != nil {
+			return err
+		}
+	}
+
+	switch s.Operation {
+	case api.ModOpAdd:
+		s.add(resources, secretMod)
+	case api.ModOpRemove:
+		return s.remove(resources)
+	case api.ModOpPatch:
+		return s.patch(resources, secretMod)
+	default:
+		return errors.Errorf("invalid operation: %s", s.Operation)
+	}
+	return nil
+}
+
+func (s *secretModificator) patch(resources *core_xds.ResourceSet, secretMod *envoy_tls.Secret) error {
+	for _, secret := range resources.Resources(envoy_resource.SecretType) {
+		matches, err := s.secretMatches(secret)
+		if err != nil {
+			return err
+		}
+		if !matches {
+			continue
+		}
+		if len(pointer.Deref(s.JsonPatches)) > 0 {
+			if err := jsonpatch.MergeJsonPatch(secret.Resource, pointer.Deref(s.JsonPatches)); err != nil {
+				return err
+			}
+			continue
+		}
+		merge(s.Strategy, secret.Resource, secretMod)
+	}
+	return nil
+}
+
+func (s *secretModificator) remove(resources *core_xds.ResourceSet) error {
+	for name, resource := range resources.Resources(envoy_resource.SecretType) {
+		matches, err := s.secretMatches(resource)
+		if err != nil {
+			return err
+		}
+		if matches {
+			resources.Remove(envoy_resource.SecretType, name)
+		}
+	}
+	return nil
+}
+
+func (s *secretModificator) add(resources *core_xds.ResourceSet, secret *envoy_tls.Secret) *core_xds.ResourceSet {
+	return resources.Add(&core_xds.Resource{
+		Name:     secret.Name,
+		Origin:   metadata.OriginMeshProxyPatch,
+		Resource: secret,
+	})
+}
+
+func (s *secretModificator) secretMatches(secret *core_xds.Resource) (bool, error) {
+	if s.Match == nil {
+		return true, nil
+	}
+	if s.Match.Name != nil && *s.Match.Name != secret.Name {
+		return false, nil
+	}
+	if s.Match.Origin != nil && *s.Match.Origin != string(secret.Origin) {
+		return false, nil
+	}
+	return expressionMatches(expression.Secret, s.Match.Expression, expression.Vars{
+		expression.VarSecret: secret.Resource,
+		expression.VarOrigin: string(secret.Origin),
+	})
+}
@@ -0,0 +1,122 @@
+package v1alpha1_test
+
+import (
+	envoy_tls "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/yaml"
+
+	core_xds "github.com/kumahq/kuma/v3/pkg/core/xds"
+	"github.com/kumahq/kuma/v3/pkg/core/xds/origin"
+	api "github.com/kumahq/kuma/v3/pkg/plugins/policies/meshproxypatch/api/v1alpha1"
+	plugin "github.com/kumahq/kuma/v3/pkg/plugins/policies/meshproxypatch/plugin/v1alpha1"
+	util_proto "github.com/kumahq/kuma/v3/pkg/util/proto"
+)
+
+var _ = Describe("Secret modifications", func() {
+	type testCase struct {
+		secrets       []string
+		modifications []string
+		expected      string
+	}
+
+	DescribeTable("should apply modifications",
+		func(given testCase) {
+			// given
+			set := core_xds.NewResourceSet()
+			for _, secretYAML := range given.secrets {
+				secret := &envoy_tls.Secret{}
+				Expect(util_proto.FromYAML([]byte(secretYAML), secret)).To(Succeed())
+				set.Add(&core_xds.Resource{
+					Name:     secret.Name,
+					Origin:   origin.Origin("secrets"),
+					Resource: secret,
+				})
+			}
+
+			var mods []api.Modification
+			for _, modificationYAML := range given.modifications {
+				modification := api.Modification{}
+				err := yaml.Unmarshal([]byte(modificationYAML), &modification)
+				Expect(err).ToNot(HaveOccurred())
+				mods = append(mods, modification)
+			}
+
+			// when
+			err := plugin.ApplyMods(set, mods)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			resp, err := set.List().ToDeltaDiscoveryResponse()
+			Expect(err).ToNot(HaveOccurred())
+			actual, err := util_proto.ToYAML(resp)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(actual).To(MatchYAML(given.expected))
+		},
+		Entry("should add secret", testCase{
+			modifications: []string{`
+                secret:
+                  operation: Add
+                  value: |
+                    name: extra_ca
+                    validationContext:
+                      trustedCa:
+                        inlineString: ca`,
+			},
+			expected: `
+            resources:
+            - name: extra_ca
+              resource:
+                '@type': type.googleapis.com/envoy.extensions.transport_sockets.tls.v3.Secret
+                name: extra_ca
+                validationContext:
+                  trustedCa:
+                    inlineString: ca`,
+		}),
+		Entry("should patch and remove secrets", testCase{
+			secrets: []string{
+				`
+                name: mesh_ca:secret:default
+                validationContext:
+                  trustedCa:
+                    inlineString: ca`,
+				`
+                name: identity_cert:secret:default
+                tlsCertificate:
+                  certificateChain:
+                    inlineString: cert`,
+			},
+			modifications: []string{
+				`
+                secret:
+                  operation: Patch
+                  match:
+                    expression: has(secret.validation_context) && origin == "secrets"
+                  value: |
+                    validationContext:
+                      matchTypedSubjectAltNames:
+                      - sanType: URI
+                        matcher:
+                          prefix: spiffe://default/`,
+				`
+                secret:
+                  operation: Remove
+                  match:
+                    name: identity_cert:secret:default`,
+			},
+			expected: `
+            resources:
+            - name: mesh_ca:secret:default
+              resource:
+                '@type': type.googleapis.com/envoy.extensions.transport_sockets.tls.v3.Secret
+                name: mesh_ca:secret:default
+                validationContext:
+                  trustedCa:
+                    inlineString: ca
+                  matchTypedSubjectAltNames:
+                  - sanType: URI
+                    matcher:
+                      prefix: spiffe://default/`,
+		}),
+	)
+})
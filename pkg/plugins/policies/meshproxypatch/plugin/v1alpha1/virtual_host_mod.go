@@ -1,8 +1,6 @@
 package v1alpha1
 
 import (
-	"slices"
-
 	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
 	envoy_route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
@@ -12,6 +10,7 @@ import (
 	core_xds "github.com/kumahq/kuma/v3/pkg/core/xds"
 	"github.com/kumahq/kuma/v3/pkg/plugins/policies/core/jsonpatch"
 	api "github.com/kumahq/kuma/v3/pkg/plugins/policies/meshproxypatch/api/v1alpha1"
+	"github.com/kumahq/kuma/v3/pkg/plugins/policies/meshproxypatch/expression"
 	"github.com/kumahq/kuma/v3/pkg/util/pointer"
 	util_proto "github.com/kumahq/kuma/v3/pkg/util/proto"
 )
@@ -28,40 +27,16 @@ func (c *virtualHostModificator) apply(resources *core_xds.ResourceSet) error {
 		}
 	}
 
-	for _, resource := range resources.Resources(envoy_resource.ListenerType) {
-		listener := resource.Resource.(*envoy_listener.Listener)
-		if !c.originMatches(resource) {
-			continue
-		}
-		for _, chain := range listener.FilterChains { // apply on all filter chains. We could introduce filter chain matcher as an improvement.
-			for _, networkFilter := range chain.Filters {
-				if networkFilter.Name != "envoy.filters.network.http_connection_manager" {
-					continue
-				}
-				hcm := &envoy_hcm.HttpConnectionManager{}
-				err := util_proto.UnmarshalAnyTo(networkFilter.ConfigType.(*envoy_listener.Filter_TypedConfig).TypedConfig, hcm)
-				if err != nil {
-					return err
-				}
-				if err := c.applyHCMModification(hcm, virtualHost); err != nil {
-					return err
-				}
-				typedConfig, err := util_proto.MarshalAnyDeterministic(hcm)
-				if err != nil {
-					return err
-				}
-				networkFilter.ConfigType.(*envoy_listener.Filter_TypedConfig).TypedConfig = typedConfig
-			}
-		}
+	var origin *string
+	if c.Match != nil {
+		origin = c.Match.Origin
 	}
-	return nil
+	return modifyRouteConfigs(resources, origin, func(listener *core_xds.Resource, routeCfg *envoy_route.RouteConfiguration) error {
+		return c.applyRouteConfigModification(listener, routeCfg, virtualHost)
+	})
 }
 
-func (c *virtualHostModificator) applyHCMModification(hcm *envoy_hcm.HttpConnectionManager, virtualHost *envoy_route.VirtualHost) error {
-	routeCfg := hcm.GetRouteConfig()
-	if routeCfg == nil {
-		return nil // ignore HCMs without embedded routes
-	}
+func (c *virtualHostModificator) applyRouteConfigModification(listener *core_xds.Resource, routeCfg *envoy_route.RouteConfiguration, virtualHost *envoy_route.VirtualHost) error {
 	if !c.routeConfigurationMatches(routeCfg) {
 		return nil
 	}
@@ -69,18 +44,22 @@ func (c *virtualHostModificator) applyHCMModification(hcm *envoy_hcm.HttpConnect
 	case api.ModOpAdd:
 		c.add(routeCfg, virtualHost)
 	case api.ModOpRemove:
-		c.remove(routeCfg)
+		return c.remove(listener, routeCfg)
 	case api.ModOpPatch:
-		return c.patch(routeCfg, virtualHost)
+		return c.patch(listener, routeCfg, virtualHost)
 	default:
 		return errors.Errorf("invalid operation: %s", c.Operation)
 	}
 	return nil
 }
 
-func (c *virtualHostModificator) patch(routeCfg *envoy_route.RouteConfiguration, vHostPatch *envoy_route.VirtualHost) error {
+func (c *virtualHostModificator) patch(listener *core_xds.Resource, routeCfg *envoy_route.RouteConfiguration, vHostPatch *envoy_route.VirtualHost) error {
 	for _, vHost := range routeCfg.VirtualHosts {
-		if c.virtualHostMatches(vHost) {
+		matches, err := c.virtualHostMatches(listener, routeCfg, vHost)
+		if err != nil {
+			return err
+		}
+		if matches {
 			if len(pointer.Deref(c.JsonPatches)) > 0 {
 				if err := jsonpatch.MergeJsonPatch(vHost, pointer.Deref(c.JsonPatches)); err != nil {
 					return err
@@ -89,38 +68,44 @@ func (c *virtualHostModificator) patch(routeCfg *envoy_route.RouteConfiguration,
 				continue
 			}
 
-			util_proto.Merge(vHost, vHostPatch)
+			merge(c.Strategy, vHost, vHostPatch)
 		}
 	}
 
 	return nil
 }
 
-func (c *virtualHostModificator) remove(routeCfg *envoy_route.RouteConfiguration) {
-	routeCfg.VirtualHosts = slices.DeleteFunc(routeCfg.VirtualHosts, func(vHost *envoy_route.VirtualHost) bool {
-		return c.virtualHostMatches(vHost)
-	})
+func (c *virtualHostModificator) remove(listener *core_xds.Resource, routeCfg *envoy_route.RouteConfiguration) error {
+	vHosts := make([]*envoy_route.VirtualHost, 0, len(routeCfg.VirtualHosts))
+	for _, vHost := range routeCfg.VirtualHosts {
+		matches, err := c.virtualHostMatches(listener, routeCfg, vHost)
+		if err != nil {
+			return err
+		}
+		if !matches {
+			vHosts = append(vHosts, vHost)
+		}
+	}
+	routeCfg.VirtualHosts = vHosts
+	return nil
 }
 
 func (c *virtualHostModificator) add(routeCfg *envoy_route.RouteConfiguration, vHost *envoy_route.VirtualHost) {
 	routeCfg.VirtualHosts = append(routeCfg.VirtualHosts, vHost)
 }
 
-func (c *virtualHostModificator) virtualHostMatches(vHost *envoy_route.VirtualHost) bool {
+func (c *virtualHostModificator) virtualHostMatches(listener *core_xds.Resource, routeCfg *envoy_route.RouteConfiguration, vHost *envoy_route.VirtualHost) (bool, error) {
 	if c.Match == nil {
-		return true
+		return true, nil
 	}
 	if c.Match.Name != nil && *c.Match.Name != vHost.Name {
-		return false
+		return false, nil
 	}
-	return true
-}
-
-func (c *virtualHostModificator) originMatches(routeCfg *core_xds.Resource) bool {
-	if c.Match == nil {
-		return true
-	}
-	return c.Match.Origin == nil || (*c.Match.Origin == string(routeCfg.Origin))
+	return expressionMatches(expression.VirtualHost, c.Match.Expression, expression.Vars{
+		expression.VarVirtualHost:        vHost,
+		expression.VarRouteConfiguration: routeCfg,
+		expression.VarOrigin:             string(listener.Origin),
+	})
 }
 
 func (c *virtualHostModificator) routeConfigurationMatches(routeCfg *envoy_route.RouteConfiguration) bool {
@@ -132,3 +117,43 @@ func (c *virtualHostModificator) routeConfigurationMatches(routeCfg *envoy_route
 	}
 	return true
 }
+
+// modifyRouteConfigs calls fn for every RouteConfiguration embedded in HTTP Connection Managers
+// of listeners of the origin, and stores the modified HTTP Connection Managers back in the listeners.
+func modifyRouteConfigs(
+	resources *core_xds.ResourceSet,
+	origin *string,
+	fn func(listener *core_xds.Resource, routeCfg *envoy_route.RouteConfiguration) error,
+) error {
+	for _, resource := range resources.Resources(envoy_resource.ListenerType) {
+		if origin != nil && *origin != string(resource.Origin) {
+			continue
+		}
+		listener := resource.Resource.(*envoy_listener.Listener)
+		for _, chain := range listener.FilterChains { // apply on all filter chains. We could introduce filter chain matcher as an improvement.
+			for _, networkFilter := range chain.Filters {
+				if networkFilter.Name != "envoy.filters.network.http_connection_manager" {
+					continue
+				}
+				hcm := &envoy_hcm.HttpConnectionManager{}
+				err := util_proto.UnmarshalAnyTo(networkFilter.ConfigType.(*envoy_listener.Filter_TypedConfig).TypedConfig, hcm)
+				if err != nil {
+					return err
+				}
+				routeCfg := hcm.GetRouteConfig()
+				if routeCfg == nil {
+					continue // ignore HCMs without embedded routes
+				}
+				if err := fn(resource, routeCfg); err != nil {
+					return err
+				}
+				typedConfig, err := util_proto.MarshalAnyDeterministic(hcm)
+				if err != nil {
+					return err
+				}
+				networkFilter.ConfigType.(*envoy_listener.Filter_TypedConfig).TypedConfig = typedConfig
+			}
+		}
+	}
+	return nil
+}
@@ -7,7 +7,7 @@ import (
 	common_api "github.com/kumahq/kuma/v3/api/common/v1alpha1"
 )
 
-// MeshRateLimit protects services from being overwhelmed by limiting the rate of incoming requests or connections. It supports local rate limiting for both HTTP (requests per interval) and TCP (connections per interval) traffic with customizable response codes and headers for rate-limited requests.
+// MeshRateLimit protects services from being overwhelmed by limiting the rate of incoming requests or connections. It supports local rate limiting for both HTTP (requests per interval) and TCP (connections per interval) traffic with customizable response codes and headers for rate-limited requests, and global HTTP rate limiting shared by all proxies through an external rate limit service.
 // +kuma:policy:order=900
 type MeshRateLimit struct {
 	// TargetRef is a reference to the resource the policy takes an effect on.
@@ -38,6 +38,9 @@ type To struct {
 
 type Conf struct {
 	Local *Local `json:"local,omitempty"`
+	// Global defines rate limiting shared by all proxies, enforced by an
+	// external rate limit service.
+	Global *Global `json:"global,omitempty"`
 }
 
 // LocalConf defines local http or/and tcp rate limit configuration
@@ -101,3 +104,70 @@ type Rate struct {
 	// The interval the number of units is accounted for.
 	Interval k8s.Duration `json:"interval"`
 }
+
+// Global defines configuration of global HTTP rate limiting
+// https://www.envoyproxy.io/docs/envoy/latest/configuration/http/http_filters/rate_limit_filter
+type Global struct {
+	// Define if rate limiting should be disabled.
+	Disabled *bool `json:"disabled,omitempty"`
+	// BackendRef is a reference to the rate limit service that decides if a
+	// request is rate limited. The service has to implement the
+	// envoy.service.ratelimit.v3.RateLimitService gRPC API. Requests to it are
+	// sent through the outbound of the service, so global rate limiting is not
+	// configured on proxies that can't reach it.
+	// +kuma:non-mergeable-struct
+	BackendRef *common_api.BackendRef `json:"backendRef,omitempty"`
+	// Domain of the rate limit service configuration the descriptors are
+	// matched against.
+	Domain *string `json:"domain,omitempty"`
+	// Descriptors sent to the rate limit service for each request. A request is
+	// rate limited if any of the descriptors is over its limit.
+	// +kubebuilder:validation:MaxItems=16
+	Descriptors *[]Descriptor `json:"descriptors,omitempty"`
+	// Timeout of a request to the rate limit service.
+	// Default: 20ms
+	Timeout *k8s.Duration `json:"timeout,omitempty"`
+	// FailOpen defines if requests are allowed when the rate limit service
+	// can't be reached or returns an error.
+	// Default: true
+	FailOpen *bool `json:"failOpen,omitempty"`
+	// Describes the actions to take on a rate limit event
+	OnRateLimit *OnRateLimit `json:"onRateLimit,omitempty"`
+}
+
+// Descriptor is a list of entries identifying a rate limit in the
+// rate limit service configuration.
+type Descriptor struct {
+	// Entries of the descriptor. If the value of any entry can't be
+	// determined for a request, the descriptor is not sent.
+	// +kubebuilder:validation:MinItems=1
+	Entries []DescriptorEntry `json:"entries"`
+}
+
+// +kubebuilder:validation:Enum=Tag;Header;SourceKRI
+type DescriptorEntryType string
+
+const (
+	// TagDescriptorEntry is the value of a tag of the inbound the request is
+	// sent to: a label of its Dataplane or the kuma.io/protocol of the inbound.
+	// Zone ingress and egress have no inbounds, so it's a label of their
+	// Dataplane.
+	TagDescriptorEntry DescriptorEntryType = "Tag"
+	// HeaderDescriptorEntry is the value of a request header.
+	HeaderDescriptorEntry DescriptorEntryType = "Header"
+	// SourceKRIDescriptorEntry identifies the Workload of the client sending
+	// the request. Envoy sends the SPIFFE ID of the client mTLS certificate and
+	// the rate limit service resolves it to the KRI of the Workload, so the
+	// descriptor is not sent for requests without a client certificate.
+	SourceKRIDescriptorEntry DescriptorEntryType = "SourceKRI"
+)
+
+type DescriptorEntry struct {
+	// Type of the entry, one of Tag, Header or SourceKRI.
+	Type DescriptorEntryType `json:"type"`
+	// Name of the tag or the header. Required for the Tag and Header types.
+	Name *string `json:"name,omitempty"`
+	// Key of the entry sent to the rate limit service. Defaults to the name
+	// for the Tag and Header types and to "source_kri" for SourceKRI.
+	Key *string `json:"key,omitempty"`
+}
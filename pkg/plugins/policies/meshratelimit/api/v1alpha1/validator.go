@@ -104,6 +104,9 @@ func validateSourceConditionedRule(path validators.PathBuilder, conf Conf) valid
 	if pointer.Deref(conf.Local).TCP != nil {
 		verr.AddViolationAt(path.Field("local").Field("tcp"), msg)
 	}
+	if conf.Global != nil {
+		verr.AddViolationAt(path.Field("global"), msg)
+	}
 	return verr
 }
 
@@ -128,25 +131,98 @@ func validateTo(topTargetRef common_api.TargetRef, to []To) validators.Validatio
 
 func validateDefault(path validators.PathBuilder, conf Conf) validators.ValidationError {
 	var verr validators.ValidationError
-	path = path.Field("local")
 
-	if conf.Local == nil {
-		verr.AddViolationAt(path, validators.MustBeDefined)
+	if conf.Local == nil && conf.Global == nil {
+		verr.AddViolationAt(path, validators.MustHaveAtLeastOne("local", "global"))
 		return verr
 	}
 
-	if conf.Local.TCP == nil && conf.Local.HTTP == nil {
+	if conf.Local != nil {
+		verr.Add(validateLocal(path.Field("local"), conf.Local))
+	}
+
+	if conf.Global != nil {
+		verr.Add(validateGlobal(path.Field("global"), conf.Global))
+	}
+
+	return verr
+}
+
+func validateLocal(path validators.PathBuilder, local *Local) validators.ValidationError {
+	var verr validators.ValidationError
+
+	if local.TCP == nil && local.HTTP == nil {
 		verr.AddViolationAt(path, validators.MustHaveAtLeastOne("tcp", "http"))
 	}
 
-	if conf.Local.HTTP != nil {
-		verr.Add(validateLocalHttp(path.Field("http"), conf.Local.HTTP))
+	if local.HTTP != nil {
+		verr.Add(validateLocalHttp(path.Field("http"), local.HTTP))
+	}
+
+	if local.TCP != nil {
+		verr.Add(validateLocalTcp(path.Field("tcp"), local.TCP))
 	}
 
-	if conf.Local.TCP != nil {
-		verr.Add(validateLocalTcp(path.Field("tcp"), conf.Local.TCP))
+	return verr
+}
+
+func validateGlobal(path validators.PathBuilder, global *Global) validators.ValidationError {
+	var verr validators.ValidationError
+	if pointer.Deref(global.Disabled) {
+		return verr
 	}
+	if global.BackendRef == nil {
+		verr.AddViolationAt(path.Field("backendRef"), validators.MustBeDefined)
+	} else {
+		verr.AddErrorAt(path.Field("backendRef"), mesh.ValidateTargetRef(global.BackendRef.TargetRef, &mesh.ValidateTargetRefOpts{
+			SupportedKinds: []common_api.TargetRefKind{
+				common_api.MeshService,
+				common_api.MeshExternalService,
+				common_api.MeshMultiZoneService,
+			},
+			IsBackendRef: true,
+		}))
+		verr.AddErrorAt(path.Field("backendRef"), validators.ValidateBackendRef(*global.BackendRef))
+	}
+	if pointer.Deref(global.Domain) == "" {
+		verr.AddViolationAt(path.Field("domain"), validators.MustBeDefined)
+	}
+	if len(pointer.Deref(global.Descriptors)) == 0 {
+		verr.AddViolationAt(path.Field("descriptors"), validators.MustNotBeEmpty)
+	}
+	for idx, descriptor := range pointer.Deref(global.Descriptors) {
+		verr.Add(validateDescriptor(path.Field("descriptors").Index(idx), descriptor))
+	}
+	verr.Add(validators.ValidateDurationGreaterThanZeroOrNil(path.Field("timeout"), global.Timeout))
+	if global.OnRateLimit != nil {
+		verr.Add(validators.ValidateIntegerGreaterThanZeroOrNil(path.Field("onRateLimit").Field("status"), global.OnRateLimit.Status))
+	}
+	return verr
+}
 
+func validateDescriptor(path validators.PathBuilder, descriptor Descriptor) validators.ValidationError {
+	var verr validators.ValidationError
+	if len(descriptor.Entries) == 0 {
+		verr.AddViolationAt(path.Field("entries"), validators.MustNotBeEmpty)
+	}
+	for idx, entry := range descriptor.Entries {
+		entryPath := path.Field("entries").Index(idx)
+		switch entry.Type {
+		case TagDescriptorEntry, HeaderDescriptorEntry:
+			if pointer.Deref(entry.Name) == "" {
+				verr.AddViolationAt(entryPath.Field("name"), validators.MustBeDefined)
+			}
+		case SourceKRIDescriptorEntry:
+			if entry.Name != nil {
+				verr.AddViolationAt(entryPath.Field("name"), validators.MustNotBeDefined)
+			}
+		default:
+			verr.AddViolationAt(entryPath.Field("type"), validators.MustBeOneOf("type", string(TagDescriptorEntry), string(HeaderDescriptorEntry), string(SourceKRIDescriptorEntry)))
+		}
+		if entry.Key != nil && *entry.Key == "" {
+			verr.AddViolationAt(entryPath.Field("key"), validators.MustNotBeEmpty)
+		}
+	}
 	return verr
 }
 
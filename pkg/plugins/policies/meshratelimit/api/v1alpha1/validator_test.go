@@ -46,6 +46,39 @@ rules:
           connectionRate:
             num: 100
             interval: 100ms`),
+			Entry("global", `
+targetRef:
+  kind: Dataplane
+rules:
+  - default:
+      global:
+        backendRef:
+          kind: MeshService
+          labels:
+            kuma.io/display-name: ratelimit
+            k8s.kuma.io/namespace: kuma-system
+          port: 8081
+        domain: kuma
+        timeout: 50ms
+        failOpen: false
+        descriptors:
+          - entries:
+              - type: Tag
+                name: kuma.io/service
+              - type: Header
+                name: x-tenant
+                key: tenant
+          - entries:
+              - type: SourceKRI
+        onRateLimit:
+          status: 429`),
+			Entry("global disabled", `
+targetRef:
+  kind: Dataplane
+rules:
+  - default:
+      global:
+        disabled: true`),
 			Entry("full example, only http", `
 targetRef:
   kind: Dataplane
@@ -176,8 +209,61 @@ rules:
 - default: {}`,
 				expected: `
 violations:
-  - field: spec.rules[0].default.local
-    message: must be defined`,
+  - field: spec.rules[0].default
+    message: 'must have at least one defined: local, global'`,
+			}),
+			Entry("invalid global", testCase{
+				inputYaml: `
+targetRef:
+  kind: Dataplane
+rules:
+- default:
+    global:
+      backendRef:
+        kind: Mesh
+      timeout: 0s
+      descriptors:
+        - entries: []
+        - entries:
+            - type: Tag
+            - type: SourceKRI
+              name: kuma.io/service
+            - type: Header
+              name: x-tenant
+              key: ""`,
+				expected: `
+violations:
+  - field: spec.rules[0].default.global.backendRef.kind
+    message: value 'Mesh' is not supported
+  - field: spec.rules[0].default.global.domain
+    message: must be defined
+  - field: spec.rules[0].default.global.descriptors[0].entries
+    message: must not be empty
+  - field: spec.rules[0].default.global.descriptors[1].entries[0].name
+    message: must be defined
+  - field: spec.rules[0].default.global.descriptors[1].entries[1].name
+    message: must not be defined
+  - field: spec.rules[0].default.global.descriptors[1].entries[2].key
+    message: must not be empty
+  - field: spec.rules[0].default.global.timeout
+    message: must be greater than zero when defined`,
+			}),
+			Entry("global in a rule matching spiffeID", testCase{
+				inputYaml: `
+targetRef:
+  kind: Dataplane
+rules:
+- matches:
+    - spiffeID:
+        type: Exact
+        value: spiffe://default/backend
+  default:
+    global:
+      disabled: true`,
+				expected: `
+violations:
+  - field: spec.rules[0].default.global
+    message: can't be specified when matches contain spiffeID because this field cannot be conditioned on source identity`,
 			}),
 			Entry("sectionName with outbound policy", testCase{
 				inputYaml: `
@@ -6,6 +6,7 @@ package v1alpha1
 
 import (
 	commonv1alpha1 "github.com/kumahq/kuma/v3/api/common/v1alpha1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -16,6 +17,11 @@ func (in *Conf) DeepCopyInto(out *Conf) {
 		*out = new(Local)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Global != nil {
+		in, out := &in.Global, &out.Global
+		*out = new(Global)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Conf.
@@ -28,6 +34,109 @@ func (in *Conf) DeepCopy() *Conf {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Descriptor) DeepCopyInto(out *Descriptor) {
+	*out = *in
+	if in.Entries != nil {
+		in, out := &in.Entries, &out.Entries
+		*out = make([]DescriptorEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Descriptor.
+func (in *Descriptor) DeepCopy() *Descriptor {
+	if in == nil {
+		return nil
+	}
+	out := new(Descriptor)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DescriptorEntry) DeepCopyInto(out *DescriptorEntry) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DescriptorEntry.
+func (in *DescriptorEntry) DeepCopy() *DescriptorEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(DescriptorEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Global) DeepCopyInto(out *Global) {
+	*out = *in
+	if in.Disabled != nil {
+		in, out := &in.Disabled, &out.Disabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.BackendRef != nil {
+		in, out := &in.BackendRef, &out.BackendRef
+		*out = new(commonv1alpha1.BackendRef)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Domain != nil {
+		in, out := &in.Domain, &out.Domain
+		*out = new(string)
+		**out = **in
+	}
+	if in.Descriptors != nil {
+		in, out := &in.Descriptors, &out.Descriptors
+		*out = new([]Descriptor)
+		if **in != nil {
+			in, out := *in, *out
+			*out = make([]Descriptor, len(*in))
+			for i := range *in {
+				(*in)[i].DeepCopyInto(&(*out)[i])
+			}
+		}
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.FailOpen != nil {
+		in, out := &in.FailOpen, &out.FailOpen
+		*out = new(bool)
+		**out = **in
+	}
+	if in.OnRateLimit != nil {
+		in, out := &in.OnRateLimit, &out.OnRateLimit
+		*out = new(OnRateLimit)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Global.
+func (in *Global) DeepCopy() *Global {
+	if in == nil {
+		return nil
+	}
+	out := new(Global)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HeaderKeyValue) DeepCopyInto(out *HeaderKeyValue) {
 	*out = *in
@@ -0,0 +1,78 @@
+package v1alpha1
+
+import (
+	envoy_resource "github.com/envoyproxy/go-control-plane/pkg/resource/v3"
+
+	common_api "github.com/kumahq/kuma/v3/api/common/v1alpha1"
+	"github.com/kumahq/kuma/v3/pkg/core/kri"
+	core_meta "github.com/kumahq/kuma/v3/pkg/core/metadata"
+	core_xds "github.com/kumahq/kuma/v3/pkg/core/xds"
+	rules_inbound "github.com/kumahq/kuma/v3/pkg/plugins/policies/core/rules/inbound"
+	"github.com/kumahq/kuma/v3/pkg/plugins/policies/core/rules/resolve"
+	"github.com/kumahq/kuma/v3/pkg/plugins/policies/core/xds/meshroute"
+	api "github.com/kumahq/kuma/v3/pkg/plugins/policies/meshratelimit/api/v1alpha1"
+	plugin_xds "github.com/kumahq/kuma/v3/pkg/plugins/policies/meshratelimit/plugin/xds"
+	xds_context "github.com/kumahq/kuma/v3/pkg/xds/context"
+)
+
+// globalResolver resolves the parts of the global rate limit configuration
+// that depend on other resources of the mesh.
+type globalResolver struct {
+	rs      *core_xds.ResourceSet
+	meshCtx xds_context.MeshContext
+}
+
+func newGlobalResolver(rs *core_xds.ResourceSet, meshCtx xds_context.MeshContext) *globalResolver {
+	return &globalResolver{
+		rs:      rs,
+		meshCtx: meshCtx,
+	}
+}
+
+// values returns the values of the global rate limit configuration of conf.
+// rules are the rules conf was merged from.
+func (r *globalResolver) values(conf api.Conf, rules []*rules_inbound.Rule, tags map[string]string) plugin_xds.GlobalValues {
+	values := plugin_xds.GlobalValues{
+		Tags: tags,
+	}
+	global, ok := plugin_xds.GlobalRateLimitEnabled(conf)
+	if !ok {
+		return values
+	}
+	values.Cluster = r.cluster(*global.BackendRef, backendRefOrigin(rules))
+	return values
+}
+
+// cluster returns the cluster of the outbound of the rate limit service, or
+// an empty string if the proxy can't reach it over gRPC.
+func (r *globalResolver) cluster(ref common_api.BackendRef, origin kri.Identifier) string {
+	resolved, ok := resolve.BackendRef(origin, ref, r.meshCtx.ResolveResourceIdentifier)
+	if !ok {
+		return ""
+	}
+	backendRef := resolved.RealResourceBackendRef()
+	_, port, ok := meshroute.DestinationPortFromRef(r.meshCtx, backendRef)
+	if !ok || port.GetProtocol() != core_meta.ProtocolGRPC {
+		return ""
+	}
+	// outbounds are identified by the name of the port, the reference can use its number
+	name := kri.WithSectionName(kri.NoSectionName(backendRef.Resource), port.GetName()).String()
+	if _, ok := r.rs.Resources(envoy_resource.ClusterType)[name]; !ok {
+		return ""
+	}
+	return name
+}
+
+// backendRefOrigin returns the policy that set the backend of the rules
+// merged into one conf. The backend isn't mergeable, so it's the last one.
+func backendRefOrigin(rules []*rules_inbound.Rule) kri.Identifier {
+	var origin kri.Identifier
+	for _, rule := range rules {
+		conf, ok := rule.Conf.(api.Conf)
+		if !ok || conf.Global == nil || conf.Global.BackendRef == nil {
+			continue
+		}
+		origin = kri.FromResourceMeta(rule.Origin.Resource, api.MeshRateLimitType)
+	}
+	return origin
+}
@@ -43,21 +43,19 @@ func (p plugin) Apply(rs *core_xds.ResourceSet, ctx xds_context.Context, proxy *
 	}
 
 	listeners := xds.GatherListeners(rs)
+	global := newGlobalResolver(rs, ctx.Mesh)
 
-	if err := applyToInbounds(policies.FromRules, listeners.Inbound, proxy); err != nil {
+	if err := applyToInbounds(policies.FromRules, listeners.Inbound, proxy, global); err != nil {
 		return err
 	}
-	if err := applyToZoneProxyListeners(policies, listeners, proxy); err != nil {
-		return err
-	}
-
-	return nil
+	return applyToZoneProxyListeners(policies, listeners, proxy, global)
 }
 
 func applyToInbounds(
 	fromRules core_rules.FromRules,
 	inboundListeners map[core_rules.InboundListener]*envoy_listener.Listener,
 	proxy *core_xds.Proxy,
+	global *globalResolver,
 ) error {
 	return xds.ForEachInbound[api.Conf](proxy.Dataplane, fromRules, func(m xds.InboundMatch[api.Conf]) error {
 		listener, ok := inboundListeners[m.Listener]
@@ -71,6 +69,9 @@ func applyToInbounds(
 			Rules:            m.Rules,
 			SkipCommonConfig: !applyCommonConf,
 		}
+		if applyCommonConf {
+			configurer.GlobalValues = global.values(m.Conf, catchAllInboundRules(m.Rules), proxy.Dataplane.InboundTags(m.Inbound))
+		}
 		return configurer.ConfigureListener(listener)
 	})
 }
@@ -79,6 +80,7 @@ func applyToZoneProxyListeners(
 	policies core_xds.TypedMatchingPolicies,
 	listeners xds.Listeners,
 	proxy *core_xds.Proxy,
+	global *globalResolver,
 ) error {
 	networking := proxy.Dataplane.Spec.GetNetworking()
 	if !networking.HasZoneProxyListeners() {
@@ -111,7 +113,9 @@ func applyToZoneProxyListeners(
 			continue
 		}
 
-		if err := applyToZoneProxyListener(envoyListener, inboundRules); err != nil {
+		// zone proxy listeners have no inbound, so tags come from the labels of the Dataplane
+		tags := proxy.Dataplane.GetMeta().GetLabels()
+		if err := applyToZoneProxyListener(envoyListener, inboundRules, tags, global); err != nil {
 			return err
 		}
 	}
@@ -122,6 +126,8 @@ func applyToZoneProxyListeners(
 func applyToZoneProxyListener(
 	listener *envoy_listener.Listener,
 	inboundRules []*rules_inbound.Rule,
+	tags map[string]string,
+	global *globalResolver,
 ) error {
 	commonConf := rules_inbound.MatchesAllIncomingTraffic[api.Conf](inboundRules)
 	applyCommonConf := hasCatchAllInboundRule(inboundRules)
@@ -133,7 +139,12 @@ func applyToZoneProxyListener(
 			return err
 		}
 		if ok {
-			if err := plugin_xds.ConfigureFilterChain(baseConf, filterChain); err != nil {
+			var rules []*rules_inbound.Rule
+			if applyCommonConf {
+				rules = catchAllInboundRules(inboundRules)
+			}
+			values := global.values(baseConf, append(rules, matchedRules...), tags)
+			if err := plugin_xds.ConfigureFilterChain(baseConf, values, filterChain); err != nil {
 				return err
 			}
 		}
@@ -173,6 +184,16 @@ func hasCatchAllInboundRule(rules []*rules_inbound.Rule) bool {
 	return false
 }
 
+func catchAllInboundRules(rules []*rules_inbound.Rule) []*rules_inbound.Rule {
+	var catchAll []*rules_inbound.Rule
+	for _, rule := range rules {
+		if rule.Match == nil {
+			catchAll = append(catchAll, rule)
+		}
+	}
+	return catchAll
+}
+
 func zoneProxyFilterChainRules(inboundRules []*rules_inbound.Rule, filterChain *envoy_listener.FilterChain) []*rules_inbound.Rule {
 	var matched []*rules_inbound.Rule
 	for _, rule := range inboundRules {
@@ -228,7 +249,7 @@ func mergeZoneProxyRuleConfs(rules []*rules_inbound.Rule) (api.Conf, bool, error
 func mergeRateLimitConfs(confs ...api.Conf) (api.Conf, bool, error) {
 	mergedInputs := make([]any, 0, len(confs))
 	for _, conf := range confs {
-		if conf.Local == nil {
+		if conf.Local == nil && conf.Global == nil {
 			continue
 		}
 		mergedInputs = append(mergedInputs, conf)
@@ -5,6 +5,7 @@ import (
 	"path/filepath"
 	"time"
 
+	envoy_cluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
 	envoy_extensions_filters_http_local_ratelimit_v3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/local_ratelimit/v3"
 	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
@@ -14,6 +15,8 @@ import (
 
 	common_api "github.com/kumahq/kuma/v3/api/common/v1alpha1"
 	mesh_proto "github.com/kumahq/kuma/v3/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/v3/pkg/core/kri"
+	core_meta "github.com/kumahq/kuma/v3/pkg/core/metadata"
 	"github.com/kumahq/kuma/v3/pkg/core/naming"
 	core_plugins "github.com/kumahq/kuma/v3/pkg/core/plugins"
 	core_mesh "github.com/kumahq/kuma/v3/pkg/core/resources/apis/mesh"
@@ -48,6 +51,7 @@ var _ = Describe("MeshRateLimit", func() {
 		resources         []*core_xds.Resource
 		fromRules         core_rules.FromRules
 		expectedListeners []string
+		expectedClusters  []string
 	}
 	DescribeTable("should generate proper Envoy config",
 		func(given sidecarTestCase) {
@@ -66,7 +70,8 @@ var _ = Describe("MeshRateLimit", func() {
 							builders.Inbound().
 								WithAddress("127.0.0.1").
 								WithPort(17777).
-								WithService("backend"),
+								WithService("backend").
+								WithProtocol("http"),
 						).
 						AddInbound(
 							builders.Inbound().
@@ -89,6 +94,10 @@ var _ = Describe("MeshRateLimit", func() {
 			for i, expected := range given.expectedListeners {
 				Expect(util_proto.ToYAML(resourceSet.ListOf(envoy_resource.ListenerType)[i].Resource)).To(test_matchers.MatchGoldenYAML(filepath.Join("testdata", expected)))
 			}
+			Expect(resourceSet.ListOf(envoy_resource.ClusterType)).To(HaveLen(len(given.expectedClusters)))
+			for i, expected := range given.expectedClusters {
+				Expect(util_proto.ToYAML(resourceSet.ListOf(envoy_resource.ClusterType)[i].Resource)).To(test_matchers.MatchGoldenYAML(filepath.Join("testdata", expected)))
+			}
 		},
 		Entry("basic listener: 2 inbounds one http and second tcp", sidecarTestCase{
 			resources: []*core_xds.Resource{
@@ -336,6 +345,197 @@ var _ = Describe("MeshRateLimit", func() {
 		}),
 	)
 
+	It("should generate global rate limit config with the outbound of the rate limit service", func() {
+		// given
+		ratelimit := builders.MeshService().
+			WithName("ratelimit").
+			WithLabels(map[string]string{mesh_proto.DisplayName: "ratelimit"}).
+			WithZone("zone-1").
+			AddIntPortWithName(8081, 8081, core_meta.ProtocolGRPC, "grpc").
+			Build()
+		clusterName := kri.WithSectionName(kri.From(ratelimit), "grpc").String()
+		context := *xds_builders.Context().
+			WithZone("zone-1").
+			WithMeshLocalResources([]core_model.Resource{ratelimit}).
+			Build()
+
+		resourceSet := core_xds.NewResourceSet()
+		resourceSet.Add(&core_xds.Resource{
+			Name:   inboundName17777,
+			Origin: metadata.OriginInbound,
+			Resource: NewListenerBuilder(envoy_common.APIV3, inboundName17777).
+				Configure(InboundListener("127.0.0.1", 17777, core_xds.SocketAddressProtocolTCP, true)).
+				Configure(FilterChain(NewFilterChainBuilder(envoy_common.APIV3, envoy_common.AnonymousResource).
+					Configure(HttpConnectionManager(inboundName17777, false, nil, true)).
+					Configure(
+						HttpInboundRoute(
+							inboundName17777,
+							inboundName17777,
+							plugins_xds.NewClusterBuilder().WithName(inboundName17777).Build(),
+						),
+					),
+				)).MustBuild(),
+		}, &core_xds.Resource{
+			Name:     clusterName,
+			Origin:   metadata.OriginOutbound,
+			Resource: &envoy_cluster.Cluster{Name: clusterName},
+		})
+
+		proxy := xds_builders.Proxy().
+			WithZone("zone-1").
+			WithDataplane(
+				builders.Dataplane().
+					WithName("backend").
+					WithMesh("default").
+					WithAddress("127.0.0.1").
+					AddInbound(
+						builders.Inbound().
+							WithAddress("127.0.0.1").
+							WithPort(17777).
+							WithService("backend").
+							WithProtocol("http"),
+					),
+			).
+			WithPolicies(
+				xds_builders.MatchedPolicies().
+					WithFromPolicy(api.MeshRateLimitType, core_rules.FromRules{
+						InboundRules: map[core_rules.InboundListener][]*inbound.Rule{
+							{Address: "127.0.0.1", Port: 17777}: {{
+								Conf: api.Conf{
+									Local: &api.Local{
+										HTTP: &api.LocalHTTP{
+											RequestRate: &api.Rate{Num: 100, Interval: *test.ParseDuration("10s")},
+										},
+									},
+									Global: &api.Global{
+										BackendRef: &common_api.BackendRef{
+											TargetRef: common_api.TargetRef{
+												Kind:   common_api.MeshService,
+												Labels: &map[string]string{mesh_proto.DisplayName: "ratelimit"},
+											},
+											Port: pointer.To(uint32(8081)),
+										},
+										Domain: pointer.To("kuma"),
+										Descriptors: &[]api.Descriptor{
+											{
+												Entries: []api.DescriptorEntry{
+													{Type: api.SourceKRIDescriptorEntry},
+													{Type: api.HeaderDescriptorEntry, Name: pointer.To("x-user-id"), Key: pointer.To("user")},
+												},
+											},
+											{
+												Entries: []api.DescriptorEntry{
+													{Type: api.TagDescriptorEntry, Name: pointer.To("kuma.io/protocol")},
+												},
+											},
+										},
+										Timeout:  test.ParseDuration("100ms"),
+										FailOpen: pointer.To(false),
+										OnRateLimit: &api.OnRateLimit{
+											Status: pointer.To(uint32(429)),
+											Headers: &api.HeaderModifier{
+												Set: &[]api.HeaderKeyValue{{
+													Name:  "x-kuma-rate-limited",
+													Value: "true",
+												}},
+											},
+										},
+									},
+								},
+							}},
+						},
+					}),
+			).
+			Build()
+
+		// when
+		Expect(plugin.NewPlugin().(core_plugins.PolicyPlugin).Apply(resourceSet, context, proxy)).To(Succeed())
+
+		// then
+		Expect(util_proto.ToYAML(resourceSet.ListOf(envoy_resource.ListenerType)[0].Resource)).To(test_matchers.MatchGoldenYAML(filepath.Join("testdata", "global_listener.golden.yaml")))
+		Expect(resourceSet.ListOf(envoy_resource.ClusterType)).To(HaveLen(1))
+	})
+
+	It("should not generate global rate limit config when the rate limit service is not reachable", func() {
+		// given
+		context := *xds_builders.Context().
+			WithMeshLocalResources([]core_model.Resource{
+				builders.MeshService().
+					WithName("ratelimit").
+					WithLabels(map[string]string{mesh_proto.DisplayName: "ratelimit"}).
+					AddIntPortWithName(8081, 8081, core_meta.ProtocolGRPC, "grpc").
+					Build(),
+			}).
+			Build()
+		resourceSet := core_xds.NewResourceSet()
+		resourceSet.Add(&core_xds.Resource{
+			Name:   inboundName17777,
+			Origin: metadata.OriginInbound,
+			Resource: NewListenerBuilder(envoy_common.APIV3, inboundName17777).
+				Configure(InboundListener("127.0.0.1", 17777, core_xds.SocketAddressProtocolTCP, true)).
+				Configure(FilterChain(NewFilterChainBuilder(envoy_common.APIV3, envoy_common.AnonymousResource).
+					Configure(HttpConnectionManager(inboundName17777, false, nil, true)).
+					Configure(
+						HttpInboundRoute(
+							inboundName17777,
+							inboundName17777,
+							plugins_xds.NewClusterBuilder().WithName(inboundName17777).Build(),
+						),
+					),
+				)).MustBuild(),
+		})
+		proxy := xds_builders.Proxy().
+			WithDataplane(
+				builders.Dataplane().
+					WithName("backend").
+					WithMesh("default").
+					WithAddress("127.0.0.1").
+					AddInbound(
+						builders.Inbound().
+							WithAddress("127.0.0.1").
+							WithPort(17777).
+							WithService("backend").
+							WithProtocol("http"),
+					),
+			).
+			WithPolicies(
+				xds_builders.MatchedPolicies().
+					WithFromPolicy(api.MeshRateLimitType, core_rules.FromRules{
+						InboundRules: map[core_rules.InboundListener][]*inbound.Rule{
+							{Address: "127.0.0.1", Port: 17777}: {{
+								Conf: api.Conf{
+									Global: &api.Global{
+										BackendRef: &common_api.BackendRef{
+											TargetRef: common_api.TargetRef{
+												Kind:   common_api.MeshService,
+												Labels: &map[string]string{mesh_proto.DisplayName: "ratelimit"},
+											},
+											Port: pointer.To(uint32(8081)),
+										},
+										Descriptors: &[]api.Descriptor{{
+											Entries: []api.DescriptorEntry{
+												{Type: api.HeaderDescriptorEntry, Name: pointer.To("x-user-id")},
+											},
+										}},
+									},
+								},
+							}},
+						},
+					}),
+			).
+			Build()
+
+		// when
+		Expect(plugin.NewPlugin().(core_plugins.PolicyPlugin).Apply(resourceSet, context, proxy)).To(Succeed())
+
+		// then there is no outbound of the rate limit service, so requests are not sent to it
+		hcm := &envoy_hcm.HttpConnectionManager{}
+		filterChain := resourceSet.ListOf(envoy_resource.ListenerType)[0].Resource.(*envoy_listener.Listener).GetFilterChains()[0]
+		Expect(util_proto.UnmarshalAnyTo(filterChain.GetFilters()[0].GetTypedConfig(), hcm)).To(Succeed())
+		Expect(hcm.GetHttpFilters()).To(HaveLen(1))
+		Expect(hcm.GetHttpFilters()[0].GetName()).To(Equal("envoy.filters.http.router"))
+	})
+
 	It("should generate proper Envoy config for zone egress listener with rules[].matches[].sni", func() {
 		name := naming.ContextualZoneEgressListenerName("ze-port")
 		resourceSet := core_xds.NewResourceSet()
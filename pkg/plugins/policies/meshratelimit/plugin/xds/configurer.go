@@ -41,6 +41,7 @@ type ListenerConfigurer struct {
 	Conf             api.Conf
 	Rules            []*rules_inbound.Rule
 	SkipCommonConfig bool
+	GlobalValues     GlobalValues
 }
 
 func (lc *ListenerConfigurer) ConfigureListener(listener *envoy_listener.Listener) error {
@@ -50,7 +51,7 @@ func (lc *ListenerConfigurer) ConfigureListener(listener *envoy_listener.Listene
 
 	for _, filterChain := range listener.FilterChains {
 		if !lc.SkipCommonConfig {
-			if err := ConfigureFilterChain(lc.Conf, filterChain); err != nil {
+			if err := ConfigureFilterChain(lc.Conf, lc.GlobalValues, filterChain); err != nil {
 				return err
 			}
 		}
@@ -62,7 +63,12 @@ func (lc *ListenerConfigurer) ConfigureListener(listener *envoy_listener.Listene
 	return nil
 }
 
-func ConfigureFilterChain(conf api.Conf, filterChain *envoy_listener.FilterChain) error {
+func ConfigureFilterChain(conf api.Conf, values GlobalValues, filterChain *envoy_listener.FilterChain) error {
+	if global, ok := GlobalRateLimitEnabled(conf); ok {
+		if err := configureGlobalHttpListener(filterChain, global, values); err != nil {
+			return err
+		}
+	}
 	if conf.Local == nil {
 		return nil
 	}
@@ -5,6 +5,7 @@ import (
 
 	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
 	envoy_route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_ratelimit "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ratelimit/v3"
 	envoy_router "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/router/v3"
 	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
 	. "github.com/onsi/ginkgo/v2"
@@ -85,6 +86,56 @@ var _ = Describe("MeshRateLimit configurer", func() {
 		router := &envoy_router.Router{}
 		Expect(util_proto.UnmarshalAnyTo(hcmFilter.GetTypedConfig(), router)).To(Succeed())
 	})
+
+	It("should skip global descriptors with a tag the Dataplane does not have", func() {
+		filterChain := httpFilterChainWithSingleRoute()
+		conf := api.Conf{
+			Global: &api.Global{
+				BackendRef: &common_api.BackendRef{TargetRef: common_api.TargetRef{Kind: common_api.MeshService, Labels: &map[string]string{"kuma.io/display-name": "ratelimit"}}},
+				Domain:     pointer.To("kuma"),
+				Descriptors: &[]api.Descriptor{
+					{Entries: []api.DescriptorEntry{{Type: api.TagDescriptorEntry, Name: pointer.To("version")}}},
+					{Entries: []api.DescriptorEntry{{Type: api.TagDescriptorEntry, Name: pointer.To("team")}}},
+				},
+			},
+		}
+		values := GlobalValues{Cluster: "ratelimit", Tags: map[string]string{"team": "payments"}}
+
+		Expect(ConfigureFilterChain(conf, values, filterChain)).To(Succeed())
+
+		hcm := httpConnectionManagerFromFilterChain(filterChain)
+		Expect(hcm.GetHttpFilters()).To(HaveLen(2))
+		Expect(hcm.GetHttpFilters()[0].GetName()).To(Equal("envoy.filters.http.ratelimit"))
+		rateLimit := &envoy_ratelimit.RateLimit{}
+		Expect(util_proto.UnmarshalAnyTo(hcm.GetHttpFilters()[0].GetTypedConfig(), rateLimit)).To(Succeed())
+		Expect(rateLimit.GetFailureModeDeny()).To(BeFalse())
+		Expect(rateLimit.GetRateLimits()).To(HaveLen(1))
+		genericKey := rateLimit.GetRateLimits()[0].GetActions()[0].GetGenericKey()
+		Expect(genericKey.GetDescriptorKey()).To(Equal("team"))
+		Expect(genericKey.GetDescriptorValue()).To(Equal("payments"))
+	})
+
+	It("should not add the global rate limit filter when it is disabled", func() {
+		filterChain := httpFilterChainWithSingleRoute()
+		conf := api.Conf{
+			Global: &api.Global{
+				Disabled:   pointer.To(true),
+				BackendRef: &common_api.BackendRef{TargetRef: common_api.TargetRef{Kind: common_api.MeshService, Labels: &map[string]string{"kuma.io/display-name": "ratelimit"}}},
+				Domain:     pointer.To("kuma"),
+				Descriptors: &[]api.Descriptor{
+					{Entries: []api.DescriptorEntry{{Type: api.SourceKRIDescriptorEntry}}},
+				},
+			},
+		}
+
+		values := GlobalValues{Cluster: "ratelimit"}
+
+		Expect(ConfigureFilterChain(conf, values, filterChain)).To(Succeed())
+
+		hcm := httpConnectionManagerFromFilterChain(filterChain)
+		Expect(hcm.GetHttpFilters()).To(HaveLen(1))
+		Expect(hcm.GetHttpFilters()[0].GetName()).To(Equal("envoy.filters.http.router"))
+	})
 })
 
 func httpFilterChainWithSingleRoute() *envoy_listener.FilterChain {
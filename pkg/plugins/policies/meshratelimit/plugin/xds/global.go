@@ -0,0 +1,191 @@
+package xds
+
+import (
+	envoy_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_ratelimit_config "github.com/envoyproxy/go-control-plane/envoy/config/ratelimit/v3"
+	envoy_route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_ratelimit "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ratelimit/v3"
+	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	envoy_type_v3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"github.com/pkg/errors"
+
+	api "github.com/kumahq/kuma/v3/pkg/plugins/policies/meshratelimit/api/v1alpha1"
+	"github.com/kumahq/kuma/v3/pkg/util/pointer"
+	util_proto "github.com/kumahq/kuma/v3/pkg/util/proto"
+	listeners_v3 "github.com/kumahq/kuma/v3/pkg/xds/envoy/listeners/v3"
+)
+
+const (
+	httpGlobalRateLimitFilterName = "envoy.filters.http.ratelimit"
+	defaultSourceKRIDescriptorKey = "source_kri"
+	// peerURISANFormat is formatted by Envoy to the URI SAN of the client
+	// certificate, which is the SPIFFE ID of the Dataplane sending the request
+	// over mTLS. It's empty for plaintext requests.
+	peerURISANFormat = "%DOWNSTREAM_PEER_URI_SAN%"
+)
+
+// GlobalValues are the values of the global rate limit configuration that
+// are known when generating the configuration of a listener.
+type GlobalValues struct {
+	// Cluster is the cluster of the outbound of the rate limit service. Global
+	// rate limiting is not configured without it.
+	Cluster string
+	// Tags are the tags of the inbound of the listener, or the labels of the
+	// Dataplane for zone proxy listeners which have no inbound.
+	Tags map[string]string
+}
+
+// GlobalRateLimitEnabled returns the global rate limit configuration if it
+// has to be applied.
+func GlobalRateLimitEnabled(conf api.Conf) (*api.Global, bool) {
+	if conf.Global == nil || pointer.Deref(conf.Global.Disabled) || conf.Global.BackendRef == nil {
+		return nil, false
+	}
+	return conf.Global, true
+}
+
+func configureGlobalHttpListener(filterChain *envoy_listener.FilterChain, conf *api.Global, values GlobalValues) error {
+	if values.Cluster == "" {
+		return nil
+	}
+	rateLimits := globalRateLimitDescriptors(pointer.Deref(conf.Descriptors), values)
+	if len(rateLimits) == 0 {
+		return nil
+	}
+
+	config := &envoy_ratelimit.RateLimit{
+		Domain:          pointer.Deref(conf.Domain),
+		StatPrefix:      "global_rate_limit",
+		FailureModeDeny: !pointer.DerefOr(conf.FailOpen, true),
+		RateLimitService: &envoy_ratelimit_config.RateLimitServiceConfig{
+			GrpcService: &envoy_core.GrpcService{
+				TargetSpecifier: &envoy_core.GrpcService_EnvoyGrpc_{
+					EnvoyGrpc: &envoy_core.GrpcService_EnvoyGrpc{
+						ClusterName: values.Cluster,
+					},
+				},
+			},
+			TransportApiVersion: envoy_core.ApiVersion_V3,
+		},
+		RateLimits: rateLimits,
+	}
+	if conf.Timeout != nil {
+		config.Timeout = util_proto.Duration(conf.Timeout.Duration)
+	}
+	if conf.OnRateLimit != nil {
+		if status := pointer.Deref(conf.OnRateLimit.Status); status != 0 {
+			config.RateLimitedStatus = &envoy_type_v3.HttpStatus{
+				Code: envoy_type_v3.StatusCode(status),
+			}
+		}
+		config.ResponseHeadersToAdd = onRateLimitHeaders(conf.OnRateLimit.Headers)
+	}
+
+	typedConfig, err := util_proto.MarshalAnyDeterministic(config)
+	if err != nil {
+		return err
+	}
+	httpFilter := &envoy_hcm.HttpFilter{
+		Name: httpGlobalRateLimitFilterName,
+		ConfigType: &envoy_hcm.HttpFilter_TypedConfig{
+			TypedConfig: typedConfig,
+		},
+	}
+
+	if err := listeners_v3.UpdateHTTPConnectionManager(filterChain, func(hcm *envoy_hcm.HttpConnectionManager) error {
+		return upsertHTTPFilter(hcm, httpFilter)
+	}); err != nil && !errors.Is(err, &listeners_v3.UnexpectedFilterConfigTypeError{}) {
+		return err
+	}
+
+	return nil
+}
+
+// globalRateLimitDescriptors converts descriptors to rate limit actions.
+// A descriptor with a tag the Dataplane does not have is skipped, the same way
+// Envoy skips a descriptor when a request has no value for one of its entries.
+func globalRateLimitDescriptors(descriptors []api.Descriptor, values GlobalValues) []*envoy_route.RateLimit {
+	var rateLimits []*envoy_route.RateLimit
+	for _, descriptor := range descriptors {
+		var actions []*envoy_route.RateLimit_Action
+		for _, entry := range descriptor.Entries {
+			action, ok := globalRateLimitAction(entry, values)
+			if !ok {
+				actions = nil
+				break
+			}
+			actions = append(actions, action)
+		}
+		if len(actions) == 0 {
+			continue
+		}
+		rateLimits = append(rateLimits, &envoy_route.RateLimit{Actions: actions})
+	}
+	return rateLimits
+}
+
+func globalRateLimitAction(entry api.DescriptorEntry, values GlobalValues) (*envoy_route.RateLimit_Action, bool) {
+	name := pointer.Deref(entry.Name)
+	switch entry.Type {
+	case api.TagDescriptorEntry:
+		value, ok := values.Tags[name]
+		if !ok {
+			return nil, false
+		}
+		return genericKeyAction(pointer.DerefOr(entry.Key, name), value), true
+	case api.HeaderDescriptorEntry:
+		return &envoy_route.RateLimit_Action{
+			ActionSpecifier: &envoy_route.RateLimit_Action_RequestHeaders_{
+				RequestHeaders: &envoy_route.RateLimit_Action_RequestHeaders{
+					HeaderName:    name,
+					DescriptorKey: pointer.DerefOr(entry.Key, name),
+				},
+			},
+		}, true
+	case api.SourceKRIDescriptorEntry:
+		// the client is only known when the request is received, so Envoy sends
+		// its SPIFFE ID and the rate limit service resolves it to the KRI
+		return genericKeyAction(pointer.DerefOr(entry.Key, defaultSourceKRIDescriptorKey), peerURISANFormat), true
+	default:
+		return nil, false
+	}
+}
+
+func genericKeyAction(key, value string) *envoy_route.RateLimit_Action {
+	return &envoy_route.RateLimit_Action{
+		ActionSpecifier: &envoy_route.RateLimit_Action_GenericKey_{
+			GenericKey: &envoy_route.RateLimit_Action_GenericKey{
+				DescriptorKey:   key,
+				DescriptorValue: value,
+			},
+		},
+	}
+}
+
+func onRateLimitHeaders(headers *api.HeaderModifier) []*envoy_core.HeaderValueOption {
+	if headers == nil {
+		return nil
+	}
+
+	var options []*envoy_core.HeaderValueOption
+	for _, header := range pointer.Deref(headers.Add) {
+		options = append(options, &envoy_core.HeaderValueOption{
+			Header: &envoy_core.HeaderValue{
+				Key:   string(header.Name),
+				Value: string(header.Value),
+			},
+			AppendAction: envoy_core.HeaderValueOption_APPEND_IF_EXISTS_OR_ADD,
+		})
+	}
+	for _, header := range pointer.Deref(headers.Set) {
+		options = append(options, &envoy_core.HeaderValueOption{
+			Header: &envoy_core.HeaderValue{
+				Key:   string(header.Name),
+				Value: string(header.Value),
+			},
+			AppendAction: envoy_core.HeaderValueOption_OVERWRITE_IF_EXISTS_OR_ADD,
+		})
+	}
+	return options
+}
@@ -0,0 +1,264 @@
+package xds
+
+import (
+	"context"
+	"net"
+	"sync"
+
+	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	envoy_common_ratelimit "github.com/envoyproxy/go-control-plane/envoy/extensions/common/ratelimit/v3"
+	envoy_ratelimit "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ratelimit/v3"
+	envoy_service_ratelimit "github.com/envoyproxy/go-control-plane/envoy/service/ratelimit/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	common_api "github.com/kumahq/kuma/v3/api/common/v1alpha1"
+	api "github.com/kumahq/kuma/v3/pkg/plugins/policies/meshratelimit/api/v1alpha1"
+	"github.com/kumahq/kuma/v3/pkg/util/pointer"
+	util_proto "github.com/kumahq/kuma/v3/pkg/util/proto"
+)
+
+var _ = Describe("Global rate limit", func() {
+	const webKRI = "kri_wl_default_zone-1_kuma-demo_web_"
+	const noisyKRI = "kri_wl_default_zone-1_kuma-demo_noisy_"
+
+	var rls *stubRateLimitService
+	var server *grpc.Server
+	var client envoy_service_ratelimit.RateLimitServiceClient
+
+	values := GlobalValues{
+		Cluster: "kri_msvc_default_zone-1_kuma-system_ratelimit_grpc",
+		Tags:    map[string]string{"team": "payments"},
+	}
+
+	BeforeEach(func() {
+		lis, err := net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).ToNot(HaveOccurred())
+
+		rls = &stubRateLimitService{
+			sourceKRIs: map[string]string{
+				"spiffe://default/ns/kuma-demo/sa/web":   webKRI,
+				"spiffe://default/ns/kuma-demo/sa/noisy": noisyKRI,
+			},
+			overLimit: noisyKRI,
+		}
+		server = grpc.NewServer()
+		envoy_service_ratelimit.RegisterRateLimitServiceServer(server, rls)
+		go func() { _ = server.Serve(lis) }()
+		DeferCleanup(server.Stop)
+
+		conn, err := grpc.NewClient(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(conn.Close)
+		client = envoy_service_ratelimit.NewRateLimitServiceClient(conn)
+	})
+
+	// isAllowed sends the descriptors of the generated filter for the request
+	// to the rate limit service and decides if the request is allowed, the
+	// same way Envoy does it.
+	isAllowed := func(config *envoy_ratelimit.RateLimit, req stubRequest) bool {
+		var descriptors []*envoy_common_ratelimit.RateLimitDescriptor
+		for _, rateLimit := range config.GetRateLimits() {
+			if descriptor, ok := req.descriptor(rateLimit.GetActions()); ok {
+				descriptors = append(descriptors, descriptor)
+			}
+		}
+		resp, err := client.ShouldRateLimit(context.Background(), &envoy_service_ratelimit.RateLimitRequest{
+			Domain:      config.GetDomain(),
+			Descriptors: descriptors,
+		})
+		if err != nil {
+			return !config.GetFailureModeDeny()
+		}
+		return resp.GetOverallCode() == envoy_service_ratelimit.RateLimitResponse_OK
+	}
+
+	globalConf := func(failOpen *bool) api.Conf {
+		return api.Conf{
+			Global: &api.Global{
+				BackendRef: &common_api.BackendRef{
+					TargetRef: common_api.TargetRef{Kind: common_api.MeshService, Labels: &map[string]string{"kuma.io/display-name": "ratelimit"}},
+					Port:      pointer.To(uint32(8081)),
+				},
+				Domain:   pointer.To("kuma"),
+				FailOpen: failOpen,
+				Descriptors: &[]api.Descriptor{
+					{Entries: []api.DescriptorEntry{
+						{Type: api.SourceKRIDescriptorEntry},
+						{Type: api.TagDescriptorEntry, Name: pointer.To("team")},
+					}},
+					{Entries: []api.DescriptorEntry{
+						{Type: api.HeaderDescriptorEntry, Name: pointer.To("x-user-id"), Key: pointer.To("user")},
+					}},
+				},
+			},
+		}
+	}
+
+	It("should send descriptors the rate limit service resolves to the KRI of the client", func() {
+		// given
+		filterChain := httpFilterChainWithSingleRoute()
+		Expect(ConfigureFilterChain(globalConf(nil), values, filterChain)).To(Succeed())
+		config := globalRateLimitConfig(filterChain)
+		Expect(config.GetRateLimitService().GetGrpcService().GetEnvoyGrpc().GetClusterName()).To(Equal(values.Cluster))
+
+		// when
+		web := isAllowed(config, stubRequest{
+			peerURISAN: "spiffe://default/ns/kuma-demo/sa/web",
+			headers:    map[string]string{"x-user-id": "alice"},
+		})
+		noisy := isAllowed(config, stubRequest{
+			peerURISAN: "spiffe://default/ns/kuma-demo/sa/noisy",
+		})
+		unknown := isAllowed(config, stubRequest{
+			peerURISAN: "spiffe://other/ns/kuma-demo/sa/web",
+		})
+		plaintext := isAllowed(config, stubRequest{
+			headers: map[string]string{"x-user-id": "bob"},
+		})
+
+		// then
+		Expect(web).To(BeTrue())
+		Expect(noisy).To(BeFalse())
+		Expect(unknown).To(BeTrue())
+		Expect(plaintext).To(BeTrue())
+		Expect(rls.received()).To(Equal([]receivedRequest{
+			{domain: "kuma", descriptors: [][]string{
+				{"source_kri=spiffe://default/ns/kuma-demo/sa/web", "team=payments"},
+				{"user=alice"},
+			}},
+			{domain: "kuma", descriptors: [][]string{
+				{"source_kri=spiffe://default/ns/kuma-demo/sa/noisy", "team=payments"},
+			}},
+			{domain: "kuma", descriptors: [][]string{
+				{"source_kri=spiffe://other/ns/kuma-demo/sa/web", "team=payments"},
+			}},
+			{domain: "kuma", descriptors: [][]string{
+				{"user=bob"},
+			}},
+		}))
+	})
+
+	DescribeTable("should handle the rate limit service being unavailable",
+		func(failOpen *bool, allowed bool) {
+			// given
+			filterChain := httpFilterChainWithSingleRoute()
+			Expect(ConfigureFilterChain(globalConf(failOpen), values, filterChain)).To(Succeed())
+			config := globalRateLimitConfig(filterChain)
+			server.Stop()
+
+			// when
+			web := isAllowed(config, stubRequest{
+				peerURISAN: "spiffe://default/ns/kuma-demo/sa/web",
+			})
+
+			// then
+			Expect(web).To(Equal(allowed))
+			Expect(rls.received()).To(BeEmpty())
+		},
+		Entry("fail open by default", nil, true),
+		Entry("fail open", pointer.To(true), true),
+		Entry("fail closed", pointer.To(false), false),
+	)
+})
+
+func globalRateLimitConfig(filterChain *envoy_listener.FilterChain) *envoy_ratelimit.RateLimit {
+	hcm := httpConnectionManagerFromFilterChain(filterChain)
+	for _, filter := range hcm.GetHttpFilters() {
+		if filter.GetName() == httpGlobalRateLimitFilterName {
+			config := &envoy_ratelimit.RateLimit{}
+			Expect(util_proto.UnmarshalAnyTo(filter.GetTypedConfig(), config)).To(Succeed())
+			return config
+		}
+	}
+	Fail("global rate limit filter not found")
+	return nil
+}
+
+// stubRequest is a request received by Envoy. It evaluates the rate limit
+// actions generated by the plugin the way Envoy does.
+type stubRequest struct {
+	peerURISAN string
+	headers    map[string]string
+}
+
+func (r stubRequest) descriptor(actions []*envoy_route.RateLimit_Action) (*envoy_common_ratelimit.RateLimitDescriptor, bool) {
+	descriptor := &envoy_common_ratelimit.RateLimitDescriptor{}
+	for _, action := range actions {
+		var key, value string
+		switch {
+		case action.GetGenericKey() != nil:
+			key, value = action.GetGenericKey().GetDescriptorKey(), r.format(action.GetGenericKey().GetDescriptorValue())
+		case action.GetRequestHeaders() != nil:
+			key, value = action.GetRequestHeaders().GetDescriptorKey(), r.headers[action.GetRequestHeaders().GetHeaderName()]
+		default:
+			Fail("unsupported rate limit action")
+		}
+		if value == "" {
+			return nil, false
+		}
+		descriptor.Entries = append(descriptor.Entries, &envoy_common_ratelimit.RateLimitDescriptor_Entry{Key: key, Value: value})
+	}
+	return descriptor, true
+}
+
+// format formats the descriptor value. The peer certificate is only
+// available on mTLS connections, so the value is empty for plaintext requests
+// and the descriptor is skipped.
+func (r stubRequest) format(value string) string {
+	if value == peerURISANFormat {
+		return r.peerURISAN
+	}
+	return value
+}
+
+type receivedRequest struct {
+	domain      string
+	descriptors [][]string
+}
+
+// stubRateLimitService records the requests, resolves the SPIFFE IDs of the
+// source_kri entries to KRIs and limits every request with a descriptor entry
+// equal to overLimit.
+type stubRateLimitService struct {
+	envoy_service_ratelimit.UnimplementedRateLimitServiceServer
+
+	sourceKRIs map[string]string
+	overLimit  string
+
+	sync.Mutex
+	requests []receivedRequest
+}
+
+func (s *stubRateLimitService) ShouldRateLimit(_ context.Context, req *envoy_service_ratelimit.RateLimitRequest) (*envoy_service_ratelimit.RateLimitResponse, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	code := envoy_service_ratelimit.RateLimitResponse_OK
+	received := receivedRequest{domain: req.GetDomain()}
+	for _, descriptor := range req.GetDescriptors() {
+		var entries []string
+		for _, entry := range descriptor.GetEntries() {
+			value := entry.GetValue()
+			if entry.GetKey() == defaultSourceKRIDescriptorKey {
+				value = s.sourceKRIs[value]
+			}
+			if value == s.overLimit {
+				code = envoy_service_ratelimit.RateLimitResponse_OVER_LIMIT
+			}
+			entries = append(entries, entry.GetKey()+"="+entry.GetValue())
+		}
+		received.descriptors = append(received.descriptors, entries)
+	}
+	s.requests = append(s.requests, received)
+	return &envoy_service_ratelimit.RateLimitResponse{OverallCode: code}, nil
+}
+
+func (s *stubRateLimitService) received() []receivedRequest {
+	s.Lock()
+	defer s.Unlock()
+	return s.requests
+}
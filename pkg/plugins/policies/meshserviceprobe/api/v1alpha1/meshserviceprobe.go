@@ -0,0 +1,88 @@
+// +kubebuilder:object:generate=true
+package v1alpha1
+
+import (
+	k8s "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	common_api "github.com/kumahq/kuma/v3/api/common/v1alpha1"
+)
+
+// MeshServiceProbe configures probes that proxies send to the applications next to them through the Health Discovery Service (HDS). Results of the probes set the readiness of the inbounds and are reported in the DataplaneInsight, which gives Universal deployments without kubelet probes liveness data of their workloads.
+// +kuma:policy:order=1600
+type MeshServiceProbe struct {
+	// TargetRef is a reference to the resource the policy takes an effect on.
+	// The resource could be either a real store object or virtual resource
+	// defined in place.
+	TargetRef *common_api.TopLevelTargetRef `json:"targetRef,omitempty"`
+
+	// Rules defines the probes of the inbounds. Probes can't be conditioned on
+	// the incoming traffic, so rules have no matches.
+	Rules *[]Rule `json:"rules,omitempty"`
+}
+
+type Rule struct {
+	// Default contains configuration of the probe of the inbound
+	Default Conf `json:"default,omitempty"`
+}
+
+type Conf struct {
+	// Interval between consecutive probes.
+	// If not specified then the interval from the control plane configuration
+	// (dpServer.hds.checkDefaults.interval) is used.
+	Interval *k8s.Duration `json:"interval,omitempty"`
+	// Maximum time to wait for a response of the application.
+	// If not specified then the timeout from the control plane configuration
+	// (dpServer.hds.checkDefaults.timeout) is used.
+	Timeout *k8s.Duration `json:"timeout,omitempty"`
+	// Number of consecutive failed probes before the inbound is considered
+	// not ready.
+	// If not specified then the threshold from the control plane configuration
+	// (dpServer.hds.checkDefaults.unhealthyThreshold) is used.
+	UnhealthyThreshold *int32 `json:"unhealthyThreshold,omitempty"`
+	// Number of consecutive successful probes before the inbound is
+	// considered ready.
+	// If not specified then the threshold from the control plane configuration
+	// (dpServer.hds.checkDefaults.healthyThreshold) is used.
+	HealthyThreshold *int32 `json:"healthyThreshold,omitempty"`
+	// Type of the probe. TCP probe only establishes a connection with the
+	// application. If not specified then TCP is used.
+	Type *ProbeType `json:"type,omitempty"`
+	// Http configures the probe when type is HTTP.
+	Http *HttpProbe `json:"http,omitempty"`
+	// Grpc configures the probe when type is GRPC.
+	Grpc *GrpcProbe `json:"grpc,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=TCP;HTTP;GRPC
+type ProbeType string
+
+const (
+	TcpProbeType  ProbeType = "TCP"
+	HttpProbeType ProbeType = "HTTP"
+	GrpcProbeType ProbeType = "GRPC"
+)
+
+// HttpProbe sends an HTTP GET request to the application and checks the
+// status of the response.
+type HttpProbe struct {
+	// The HTTP path which will be requested by the probe (ie. /healthz).
+	// If not specified then the default value is "/"
+	Path *string `json:"path,omitempty"`
+	// The value of the Host header, by default name of the cluster of the
+	// inbound
+	Host *string `json:"host,omitempty"`
+	// List of HTTP response statuses which are considered healthy.
+	// If not specified then only 200 is considered healthy.
+	ExpectedStatuses *[]int32 `json:"expectedStatuses,omitempty"`
+}
+
+// GrpcProbe calls the grpc.health.v1.Health/Check method of the application.
+// The application has to support HTTP/2.
+type GrpcProbe struct {
+	// Service name sent in the health check request. If not specified then
+	// the overall health of the server is checked.
+	ServiceName *string `json:"serviceName,omitempty"`
+	// The value of the :authority header, by default name of the cluster of
+	// the inbound
+	Authority *string `json:"authority,omitempty"`
+}
@@ -0,0 +1,11 @@
+package v1alpha1_test
+
+import (
+	"testing"
+
+	"github.com/kumahq/kuma/v3/pkg/test"
+)
+
+func TestCommonAPI(t *testing.T) {
+	test.RunSpecs(t, "MeshServiceProbe Suite")
+}
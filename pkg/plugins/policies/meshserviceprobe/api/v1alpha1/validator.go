@@ -0,0 +1,81 @@
+package v1alpha1
+
+import (
+	"fmt"
+	"strings"
+
+	common_api "github.com/kumahq/kuma/v3/api/common/v1alpha1"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/v3/pkg/core/validators"
+	"github.com/kumahq/kuma/v3/pkg/plugins/policies/core/rules/inbound"
+	"github.com/kumahq/kuma/v3/pkg/util/pointer"
+)
+
+func (r *MeshServiceProbeResource) validate() error {
+	var verr validators.ValidationError
+	path := validators.RootedAt("spec")
+	verr.AddErrorAt(path.Field("targetRef"), r.validateTop(r.Spec.TargetRef, inbound.AffectsInbounds(r.Spec)))
+	if len(pointer.Deref(r.Spec.Rules)) == 0 {
+		verr.AddViolationAt(path.Field("rules"), validators.MustNotBeEmpty)
+	}
+	verr.AddErrorAt(path, validateRules(pointer.Deref(r.Spec.Rules)))
+	return verr.OrNil()
+}
+
+func (r *MeshServiceProbeResource) validateTop(targetRef *common_api.TopLevelTargetRef, isInboundPolicy bool) validators.ValidationError {
+	if targetRef == nil {
+		return validators.ValidationError{}
+	}
+	return mesh.ValidateTargetRef(targetRef.ToTargetRef(), &mesh.ValidateTargetRefOpts{
+		SupportedKinds: []common_api.TargetRefKind{
+			common_api.Mesh,
+			common_api.Dataplane,
+		},
+		IsInboundPolicy: isInboundPolicy,
+	})
+}
+
+func validateRules(rules []Rule) validators.ValidationError {
+	var verr validators.ValidationError
+	for idx, rule := range rules {
+		path := validators.RootedAt("rules").Index(idx)
+		verr.Add(validateDefault(path.Field("default"), rule.Default))
+	}
+	return verr
+}
+
+func validateDefault(path validators.PathBuilder, conf Conf) validators.ValidationError {
+	var verr validators.ValidationError
+	verr.Add(validators.ValidateDurationGreaterThanZeroOrNil(path.Field("interval"), conf.Interval))
+	verr.Add(validators.ValidateDurationGreaterThanZeroOrNil(path.Field("timeout"), conf.Timeout))
+	verr.Add(validators.ValidateValueGreaterThanZeroOrNil(path.Field("unhealthyThreshold"), conf.UnhealthyThreshold))
+	verr.Add(validators.ValidateValueGreaterThanZeroOrNil(path.Field("healthyThreshold"), conf.HealthyThreshold))
+
+	probeType := pointer.DerefOr(conf.Type, TcpProbeType)
+	switch probeType {
+	case TcpProbeType, HttpProbeType, GrpcProbeType:
+	default:
+		verr.AddViolationAt(path.Field("type"), fmt.Sprintf("unrecognized type %q, supported values are: TCP, HTTP, GRPC", probeType))
+	}
+	if conf.Http != nil {
+		if probeType != HttpProbeType {
+			verr.AddViolationAt(path.Field("http"), "can only be defined when type is HTTP")
+		}
+		verr.Add(validateHttp(path.Field("http"), conf.Http))
+	}
+	if conf.Grpc != nil && probeType != GrpcProbeType {
+		verr.AddViolationAt(path.Field("grpc"), "can only be defined when type is GRPC")
+	}
+	return verr
+}
+
+func validateHttp(path validators.PathBuilder, http *HttpProbe) validators.ValidationError {
+	var verr validators.ValidationError
+	if http.Path != nil && !strings.HasPrefix(*http.Path, "/") {
+		verr.AddViolationAt(path.Field("path"), "must start with /")
+	}
+	for i, status := range pointer.Deref(http.ExpectedStatuses) {
+		verr.Add(validators.ValidateStatusCode(path.Field("expectedStatuses").Index(i), status))
+	}
+	return verr
+}
@@ -0,0 +1,171 @@
+package v1alpha1_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/yaml"
+
+	core_model "github.com/kumahq/kuma/v3/pkg/core/resources/model"
+	. "github.com/kumahq/kuma/v3/pkg/plugins/policies/meshserviceprobe/api/v1alpha1"
+)
+
+var _ = Describe("MeshServiceProbe", func() {
+	Describe("Validate()", func() {
+		DescribeTable("should pass validation",
+			func(mspYAML string) {
+				// setup
+				meshServiceProbe := NewMeshServiceProbeResource()
+
+				// when
+				err := core_model.FromYAML([]byte(mspYAML), &meshServiceProbe.Spec)
+				Expect(err).ToNot(HaveOccurred())
+				// and
+				verr := meshServiceProbe.Validate()
+
+				// then
+				Expect(verr).ToNot(HaveOccurred())
+			},
+			Entry("tcp probe with defaults", `
+targetRef:
+  kind: Mesh
+rules:
+  - default: {}
+`),
+			Entry("http probe of an inbound", `
+targetRef:
+  kind: Dataplane
+  labels:
+    app: backend
+  sectionName: http
+rules:
+  - default:
+      interval: 5s
+      timeout: 1s
+      healthyThreshold: 1
+      unhealthyThreshold: 3
+      type: HTTP
+      http:
+        path: /healthz
+        host: backend.local
+        expectedStatuses: [200, 204]
+`),
+			Entry("grpc probe", `
+targetRef:
+  kind: Mesh
+rules:
+  - default:
+      type: GRPC
+      grpc:
+        serviceName: backend.v1.Backend
+        authority: backend.local
+`),
+		)
+
+		type testCase struct {
+			inputYaml string
+			expected  string
+		}
+
+		DescribeTable("should validate all fields and return as much individual errors as possible",
+			func(given testCase) {
+				// setup
+				meshServiceProbe := NewMeshServiceProbeResource()
+
+				// when
+				err := core_model.FromYAML([]byte(given.inputYaml), &meshServiceProbe.Spec)
+				Expect(err).ToNot(HaveOccurred())
+				// and
+				verr := meshServiceProbe.Validate()
+				actual, err := yaml.Marshal(verr)
+				Expect(err).ToNot(HaveOccurred())
+
+				// then
+				Expect(actual).To(MatchYAML(given.expected))
+			},
+			Entry("empty 'rules' array", testCase{
+				inputYaml: `
+targetRef:
+  kind: Mesh
+`,
+				expected: `
+violations:
+  - field: spec.rules
+    message: must not be empty`,
+			}),
+			Entry("unsupported kind in top targetRef", testCase{
+				inputYaml: `
+targetRef:
+  kind: MeshService
+  name: backend
+rules:
+  - default: {}
+`,
+				expected: `
+violations:
+  - field: spec.targetRef.kind
+    message: value 'MeshService' is not supported`,
+			}),
+			Entry("invalid values", testCase{
+				inputYaml: `
+targetRef:
+  kind: Mesh
+rules:
+  - default:
+      interval: 0s
+      timeout: 0s
+      healthyThreshold: 0
+      unhealthyThreshold: 0
+      type: UDP
+`,
+				expected: `
+violations:
+  - field: spec.rules[0].default.interval
+    message: must be greater than zero when defined
+  - field: spec.rules[0].default.timeout
+    message: must be greater than zero when defined
+  - field: spec.rules[0].default.unhealthyThreshold
+    message: must be greater than zero when defined
+  - field: spec.rules[0].default.healthyThreshold
+    message: must be greater than zero when defined
+  - field: spec.rules[0].default.type
+    message: 'unrecognized type "UDP", supported values are: TCP, HTTP, GRPC'`,
+			}),
+			Entry("invalid http probe", testCase{
+				inputYaml: `
+targetRef:
+  kind: Mesh
+rules:
+  - default:
+      type: HTTP
+      http:
+        path: healthz
+        expectedStatuses: [99]
+`,
+				expected: `
+violations:
+  - field: spec.rules[0].default.http.path
+    message: must start with /
+  - field: spec.rules[0].default.http.expectedStatuses[0]
+    message: must be in inclusive range [100, 599]`,
+			}),
+			Entry("checker not matching the type", testCase{
+				inputYaml: `
+targetRef:
+  kind: Mesh
+rules:
+  - default:
+      http:
+        path: /healthz
+      grpc:
+        serviceName: backend.v1.Backend
+`,
+				expected: `
+violations:
+  - field: spec.rules[0].default.http
+    message: can only be defined when type is HTTP
+  - field: spec.rules[0].default.grpc
+    message: can only be defined when type is GRPC`,
+			}),
+		)
+	})
+})
@@ -0,0 +1,31 @@
+// Generated by tools/policy-gen. DO NOT EDIT.
+// Run "make generate" to update this file.
+
+package v1alpha1
+
+import (
+	common_api "github.com/kumahq/kuma/v3/api/common/v1alpha1"
+	"github.com/kumahq/kuma/v3/pkg/plugins/policies/core/rules/inbound"
+	"github.com/kumahq/kuma/v3/pkg/util/pointer"
+)
+
+func (x *MeshServiceProbe) GetTargetRef() common_api.TargetRef {
+	return x.TargetRef.ToTargetRef()
+}
+
+func (x *Rule) GetDefault() interface{} {
+	return x.Default
+}
+
+func (x *Rule) GetMatches() []common_api.Match {
+	return []common_api.Match{}
+}
+
+func (x *MeshServiceProbe) GetRules() []inbound.RuleEntry {
+	var result []inbound.RuleEntry
+	for _, itm := range pointer.Deref(x.Rules) {
+		item := itm
+		result = append(result, &item)
+	}
+	return result
+}
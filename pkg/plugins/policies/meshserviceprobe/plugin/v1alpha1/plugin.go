@@ -0,0 +1,24 @@
+package v1alpha1
+
+import (
+	core_plugins "github.com/kumahq/kuma/v3/pkg/core/plugins"
+	core_xds "github.com/kumahq/kuma/v3/pkg/core/xds"
+	api "github.com/kumahq/kuma/v3/pkg/plugins/policies/meshserviceprobe/api/v1alpha1"
+	xds_context "github.com/kumahq/kuma/v3/pkg/xds/context"
+)
+
+var _ core_plugins.PolicyPlugin = &plugin{}
+
+type plugin struct{}
+
+func (p plugin) Order() int { return api.MeshServiceProbeResourceTypeDescriptor.Order }
+
+func NewPlugin() core_plugins.Plugin {
+	return &plugin{}
+}
+
+// Apply doesn't change the xDS resources. The probes are sent to the proxy
+// over the Health Discovery Service, see pkg/hds/tracker.
+func (p plugin) Apply(*core_xds.ResourceSet, xds_context.Context, *core_xds.Proxy) error {
+	return nil
+}
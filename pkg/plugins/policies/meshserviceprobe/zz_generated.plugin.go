@@ -0,0 +1,15 @@
+package meshserviceprobe
+
+import (
+	"github.com/kumahq/kuma/v3/pkg/core/plugins"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/registry"
+	api_v1alpha1 "github.com/kumahq/kuma/v3/pkg/plugins/policies/meshserviceprobe/api/v1alpha1"
+	k8s_v1alpha1 "github.com/kumahq/kuma/v3/pkg/plugins/policies/meshserviceprobe/k8s/v1alpha1"
+	plugin_v1alpha1 "github.com/kumahq/kuma/v3/pkg/plugins/policies/meshserviceprobe/plugin/v1alpha1"
+)
+
+func InitPlugin() {
+	registry.AddKubeScheme(k8s_v1alpha1.AddToScheme)
+	registry.RegisterType(api_v1alpha1.MeshServiceProbeResourceTypeDescriptor)
+	plugins.Register(plugins.PluginName(api_v1alpha1.MeshServiceProbeResourceTypeDescriptor.KumactlArg), plugin_v1alpha1.NewPlugin())
+}
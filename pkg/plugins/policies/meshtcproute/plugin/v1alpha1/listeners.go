@@ -36,7 +36,9 @@ func GenerateOutboundListener(
 
 	tags := svc.OutboundListenerTags()
 
+	sniHostnames := svc.SNIHostnames()
 	filterChain := envoy_listeners.NewFilterChainBuilder(proxy.APIVersion, envoy_common.AnonymousResource).
+		ConfigureIf(len(sniHostnames) > 0, envoy_listeners.MatchServerNames(sniHostnames...)).
 		Configure(envoy_listeners.TCPProxy(tcpProxyStatPrefix, splits...))
 
 	listener := envoy_listeners.NewListenerBuilder(proxy.APIVersion, listenerName).
@@ -45,6 +47,10 @@ func GenerateOutboundListener(
 		Configure(envoy_listeners.TransparentProxying(transparentProxy)).
 		Configure(envoy_listeners.TagsMetadata(tags)).
 		Configure(envoy_listeners.FilterChain(filterChain))
+	if len(sniHostnames) > 0 {
+		// wildcard hostnames are matched by SNI when the application originates TLS
+		listener.Configure(envoy_listeners.TLSInspector())
+	}
 
 	resource, err := listener.Build()
 	if err != nil {
@@ -569,6 +569,61 @@ var _ = Describe("MeshTCPRoute", func() {
 				proxy:      proxy,
 			}
 		}()),
+		Entry("wildcard-meshexternalservice", func() outboundsTestCase {
+			meshExtSvc := builders.MeshExternalService().
+				WithName("example").
+				WithKumaVIP("10.20.20.1").
+				WithWildcardHostname("*.example.com").
+				WithProtocol(core_meta.ProtocolTCP).
+				Build()
+
+			dp, proxy, backendMeshSvc := dppForMeshExternalService(meshExtSvc)
+			mc := meshContextForMeshExternalService(dp.Build(), backendDataplane(), meshExtSvc, zoneEgressDataplane(), backendMeshSvc)
+
+			return outboundsTestCase{
+				xdsContext: *xds_builders.Context().WithMeshContext(mc).Build(),
+				proxy:      proxy,
+			}
+		}()),
+		Entry("wildcard-meshexternalservice-tls-origination", func() outboundsTestCase {
+			// the application sends plaintext traffic without SNI, so it can't be matched by the hostname
+			meshExtSvc := builders.MeshExternalService().
+				WithName("example").
+				WithKumaVIP("10.20.20.1").
+				WithWildcardHostname("*.example.com").
+				WithTLSOrigination().
+				Build()
+
+			dp, proxy, backendMeshSvc := dppForMeshExternalService(meshExtSvc)
+			mc := meshContextForMeshExternalService(dp.Build(), backendDataplane(), meshExtSvc, zoneEgressDataplane(), backendMeshSvc)
+
+			proxy.Policies = core_xds.MatchedPolicies{
+				Dynamic: core_xds.PluginOriginatedPolicies{},
+			}
+			proxy.Policies.Dynamic[api.MeshTCPRouteType] = core_xds.TypedMatchingPolicies{
+				Type: api.MeshTCPRouteType,
+				ToRules: core_rules.ToRules{
+					ResourceRules: map[kri.Identifier]outbound.ResourceRule{
+						backendMeshExternalServiceIdentifier: test_policies.NewOutboundRule(nil, api.Rule{
+							Default: api.RuleConf{
+								BackendRefs: &[]common_api.BackendRef{
+									{
+										TargetRef: builders.TargetRefMeshExternalService("example"),
+										Weight:    pointer.To(uint(100)),
+										Port:      pointer.To(uint32(9000)),
+									},
+								},
+							},
+						}),
+					},
+				},
+			}
+
+			return outboundsTestCase{
+				xdsContext: *xds_builders.Context().WithMeshContext(mc).Build(),
+				proxy:      proxy,
+			}
+		}()),
 		Entry("basic-no-policies", func() outboundsTestCase {
 			meshSvc := meshservice_api.MeshServiceResource{
 				Meta: &test_model.ResourceMeta{Name: "backend", Mesh: "default"},
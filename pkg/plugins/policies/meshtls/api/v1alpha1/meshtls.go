@@ -6,7 +6,7 @@ import (
 	common_tls "github.com/kumahq/kuma/v3/api/common/v1alpha1/tls"
 )
 
-// MeshTLS configures TLS and mutual TLS (mTLS) settings for secure communication between services in the mesh. It allows you to enforce encryption, configure TLS versions and cipher suites, and control whether mTLS is required (strict mode) or optional (permissive mode) for inbound traffic.
+// MeshTLS configures TLS and mutual TLS (mTLS) settings for secure communication between services in the mesh. It allows you to enforce encryption, configure TLS versions, cipher suites and ALPN protocols, and control whether mTLS is required (strict mode) or optional (permissive mode) for inbound traffic.
 // +kuma:policy:singular_display_name=Mesh TLS
 // +kuma:policy:skip_get_default=true
 // +kuma:policy:order=300
@@ -21,7 +21,9 @@ type MeshTLS struct {
 }
 
 type Rule struct {
-	// Default contains configuration of the inbound tls
+	// Default contains configuration of the inbound tls. A policy targeting
+	// a Dataplane with a sectionName overrides the configuration for a single
+	// inbound.
 	Default Conf `json:"default,omitempty"`
 }
 
@@ -39,9 +41,18 @@ type Conf struct {
 	// Version section for providing version specification.
 	TlsVersion *common_tls.Version `json:"tlsVersion,omitempty"`
 
-	// TlsCiphers section for providing ciphers specification.
+	// TlsCiphers section for providing ciphers specification. Ciphers only
+	// apply to TLS 1.2 and lower, Envoy doesn't allow configuring TLS 1.3
+	// cipher suites.
 	TlsCiphers *[]common_tls.TlsCipher `json:"tlsCiphers,omitempty"`
 
+	// AlpnProtocols is the list of ALPN protocols, in order of preference,
+	// that inbound listeners expose on mTLS connections. Kuma's own protocol
+	// is always exposed as well, so connections between proxies of the mesh
+	// are not affected.
+	// +kubebuilder:validation:MaxItems=8
+	AlpnProtocols *[]string `json:"alpnProtocols,omitempty"`
+
 	// Mode defines the behavior of inbound listeners with regard to traffic encryption.
 	Mode *Mode `json:"mode,omitempty"`
 }
@@ -1,6 +1,7 @@
 package v1alpha1
 
 import (
+	"fmt"
 	"slices"
 
 	common_api "github.com/kumahq/kuma/v3/api/common/v1alpha1"
@@ -15,8 +16,7 @@ func (r *MeshTLSResource) validate() error {
 	var verr validators.ValidationError
 	path := validators.RootedAt("spec")
 	verr.AddErrorAt(path.Field("targetRef"), r.validateTop(r.Spec.TargetRef, inbound.AffectsInbounds(r.Spec)))
-	topLevel := r.Spec.TargetRef.ToTargetRef()
-	verr.AddErrorAt(path.Field("rules"), validateRules(pointer.Deref(r.Spec.Rules), topLevel.Kind))
+	verr.AddErrorAt(path.Field("rules"), validateRules(pointer.Deref(r.Spec.Rules)))
 	return verr.OrNil()
 }
 
@@ -34,16 +34,16 @@ func (r *MeshTLSResource) validateTop(targetRef *common_api.TopLevelTargetRef, i
 	return targetRefErr
 }
 
-func validateRules(rules []Rule, topLevelTargetRef common_api.TargetRefKind) validators.ValidationError {
+func validateRules(rules []Rule) validators.ValidationError {
 	var verr validators.ValidationError
 	for idx, rulesItem := range rules {
 		path := validators.Root().Index(idx)
-		verr.Add(validateDefault(path.Field("default"), rulesItem.Default, topLevelTargetRef))
+		verr.Add(validateDefault(path.Field("default"), rulesItem.Default))
 	}
 	return verr
 }
 
-func validateDefault(path validators.PathBuilder, conf Conf, topLevelTargetRef common_api.TargetRefKind) validators.ValidationError {
+func validateDefault(path validators.PathBuilder, conf Conf) validators.ValidationError {
 	var verr validators.ValidationError
 
 	if conf.Mode != nil {
@@ -52,20 +52,55 @@ func validateDefault(path validators.PathBuilder, conf Conf, topLevelTargetRef c
 		}
 	}
 
-	if len(pointer.Deref(conf.TlsCiphers)) > 0 && topLevelTargetRef != common_api.Mesh {
-		verr.AddViolationAt(path.Field("tlsCiphers"), "tlsCiphers can only be defined with top level targetRef kind: Mesh")
-	} else if !containsAll(common_tls.AllCiphers, pointer.Deref(conf.TlsCiphers)) {
+	ciphers := pointer.Deref(conf.TlsCiphers)
+	if !containsAll(common_tls.AllCiphers, ciphers) {
 		verr.AddErrorAt(path.Field("tlsCiphers"), validators.MakeFieldMustBeOneOfErr("tlsCiphers", common_tls.AllCiphers...))
 	}
 
 	if conf.TlsVersion != nil {
-		if topLevelTargetRef != common_api.Mesh {
-			verr.AddViolationAt(path.Field("tlsVersion"), "tlsVersion can only be defined with top level targetRef kind: Mesh")
-		} else {
-			verr.AddErrorAt(path.Field("tlsVersion"), common_tls.ValidateVersion(conf.TlsVersion))
+		verr.AddErrorAt(path.Field("tlsVersion"), common_tls.ValidateVersion(conf.TlsVersion))
+		if len(ciphers) > 0 {
+			verr.Add(validateCiphersForVersion(path.Field("tlsCiphers"), *conf.TlsVersion))
 		}
 	}
 
+	verr.Add(validateAlpnProtocols(path.Field("alpnProtocols"), pointer.Deref(conf.AlpnProtocols)))
+
+	return verr
+}
+
+// validateCiphersForVersion checks that the ciphers can be negotiated with
+// the TLS versions allowed. All supported ciphers are TLS 1.2 ciphers and
+// Envoy ignores configured ciphers for TLS 1.3.
+func validateCiphersForVersion(path validators.PathBuilder, version common_tls.Version) validators.ValidationError {
+	var verr validators.ValidationError
+	switch {
+	case version.Min != nil && *version.Min == common_tls.TLSVersion13:
+		verr.AddViolationAt(path, "must not be defined when tlsVersion.min is TLS13, cipher suites of TLS 1.3 can't be configured")
+	case version.Max != nil && slices.Contains([]common_tls.TlsVersion{common_tls.TLSVersion10, common_tls.TLSVersion11}, *version.Max):
+		verr.AddViolationAt(path, "must not be defined when tlsVersion.max is lower than TLS12, supported ciphers require TLS 1.2")
+	}
+	return verr
+}
+
+func validateAlpnProtocols(path validators.PathBuilder, protocols []string) validators.ValidationError {
+	var verr validators.ValidationError
+	seen := map[string]struct{}{}
+	for idx, protocol := range protocols {
+		path := path.Index(idx)
+		switch {
+		case protocol == "":
+			verr.AddViolationAt(path, validators.MustNotBeEmpty)
+		case len(protocol) > 255:
+			verr.AddViolationAt(path, "must not be longer than 255 bytes")
+		case protocol == common_tls.KumaALPNProtocol:
+			verr.AddViolationAt(path, fmt.Sprintf("%q is reserved for connections between proxies of the mesh", protocol))
+		}
+		if _, ok := seen[protocol]; ok {
+			verr.AddViolationAt(path, "must be unique")
+		}
+		seen[protocol] = struct{}{}
+	}
 	return verr
 }
 
@@ -72,6 +72,14 @@ var _ = Describe("MeshTLS", func() {
 				name: "meshtls-6",
 				file: "invalid-top-level-dataplane",
 			}),
+			Entry("ciphers not supported by the tls version and invalid alpn protocols", testCase{
+				name: "meshtls-7",
+				file: "invalid-ciphers-and-alpn",
+			}),
+			Entry("per-inbound override", testCase{
+				name: "meshtls-8",
+				file: "valid-inbound-override",
+			}),
 		)
 	})
 })
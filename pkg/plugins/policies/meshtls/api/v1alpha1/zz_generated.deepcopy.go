@@ -26,6 +26,15 @@ func (in *Conf) DeepCopyInto(out *Conf) {
 			copy(*out, *in)
 		}
 	}
+	if in.AlpnProtocols != nil {
+		in, out := &in.AlpnProtocols, &out.AlpnProtocols
+		*out = new([]string)
+		if **in != nil {
+			in, out := *in, *out
+			*out = make([]string, len(*in))
+			copy(*out, *in)
+		}
+	}
 	if in.Mode != nil {
 		in, out := &in.Mode, &out.Mode
 		*out = new(Mode)
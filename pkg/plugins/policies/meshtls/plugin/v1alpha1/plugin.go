@@ -16,6 +16,7 @@ import (
 	core_meta "github.com/kumahq/kuma/v3/pkg/core/metadata"
 	"github.com/kumahq/kuma/v3/pkg/core/naming"
 	core_plugins "github.com/kumahq/kuma/v3/pkg/core/plugins"
+	core_model "github.com/kumahq/kuma/v3/pkg/core/resources/model"
 	core_xds "github.com/kumahq/kuma/v3/pkg/core/xds"
 	xds_types "github.com/kumahq/kuma/v3/pkg/core/xds/types"
 	bldrs_common "github.com/kumahq/kuma/v3/pkg/envoy/builders/common"
@@ -138,14 +139,8 @@ func applyToRealResources(
 	fromRules core_rules.FromRules,
 	rs *core_xds.ResourceSet,
 ) error {
+	conf := commonInboundConf(fromRules)
 	return policies_xds.ForEachOrigin(rs, func(_ kri.Identifier, resType core_xds.ResourcesByType) error {
-		// there is only one rule always because we're in `Mesh/Mesh`
-		var conf api.Conf
-		for _, r := range fromRules.InboundRules {
-			conf = rules_inbound.MatchesAllIncomingTraffic[api.Conf](r)
-			break
-		}
-
 		for _, cluster := range resType[envoy_resource.ClusterType] {
 			if err := configureTLSParams(conf, cluster.Resource.(*envoy_cluster.Cluster)); err != nil {
 				return err
@@ -156,6 +151,44 @@ func applyToRealResources(
 	}, core_xds.NonMeshExternalService)
 }
 
+// commonInboundConf merges the rules that apply to all inbounds of the proxy.
+// Rules of policies overriding the configuration of a single inbound with
+// a sectionName only configure that inbound, not the proxy's clusters.
+func commonInboundConf(fromRules core_rules.FromRules) api.Conf {
+	type ruleKey struct {
+		core_model.ResourceKey
+		ruleIndex int
+	}
+	keyOf := func(rule *rules_inbound.Rule) ruleKey {
+		return ruleKey{
+			ResourceKey: core_model.MetaToResourceKey(rule.Origin.Resource),
+			ruleIndex:   rule.Origin.RuleIndex,
+		}
+	}
+
+	// rules are sorted the same way for every inbound, so the result doesn't
+	// depend on the inbound we start with
+	var common []*rules_inbound.Rule
+	first := true
+	for _, rules := range fromRules.InboundRules {
+		if first {
+			common = rules
+			first = false
+			continue
+		}
+		keys := map[ruleKey]struct{}{}
+		for _, rule := range rules {
+			keys[keyOf(rule)] = struct{}{}
+		}
+		common = util_slices.Filter(common, func(rule *rules_inbound.Rule) bool {
+			_, ok := keys[keyOf(rule)]
+			return ok
+		})
+	}
+
+	return rules_inbound.MatchesAllIncomingTraffic[api.Conf](common)
+}
+
 func configureTLSParams(conf api.Conf, cluster *envoy_cluster.Cluster) error {
 	if cluster.TransportSocket.GetName() != wellknown.TransportSocketTLS {
 		// we only want to configure TLS Version on listeners protected by Kuma's TLS
@@ -332,6 +365,7 @@ func downstreamTLSContext(xdsCtx xds_context.Context, proxy *core_xds.Proxy, con
 			bldrs_tls.DownstreamCommonTlsContext(
 				bldrs_tls.NewCommonTlsContext().
 					Configure(bldrs_common.IfNotNil(conf.TlsCiphers, bldrs_tls.CipherSuites)).
+					Configure(bldrs_common.IfNotNil(conf.AlpnProtocols, bldrs_tls.AlpnProtocols)).
 					Configure(bldrs_common.If(tlsVersion.Min != nil, bldrs_tls.TlsMinVersion(tlsVersion.Min))).
 					Configure(bldrs_common.If(tlsVersion.Max != nil, bldrs_tls.TlsMaxVersion(tlsVersion.Max))).
 					Configure(
@@ -5,6 +5,7 @@ import (
 	"maps"
 	"os"
 	"path"
+	"slices"
 
 	envoy_cluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	envoy_tls "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
@@ -105,6 +106,9 @@ var _ = Describe("MeshTLS", func() {
 		noPolicy bool
 		// ipFamilyMode of the transparent proxy, "ipv4" when empty
 		ipFamilyMode string
+		// inboundOverride applies "<caseName>.override.policy.yaml" on top
+		// of the policy, only to the inbound on port 17778
+		inboundOverride bool
 	}
 	DescribeTable("should generate proper Envoy config",
 		func(given testCase) {
@@ -120,6 +124,16 @@ var _ = Describe("MeshTLS", func() {
 			if !given.noPolicy {
 				fromRules = getRulesAsFromRules(pointer.Deref(getPolicy(given.caseName).Spec.Rules))
 			}
+			if given.inboundOverride {
+				override := getPolicy(given.caseName + ".override")
+				listener := core_rules.InboundListener{Address: "127.0.0.1", Port: 17778}
+				for idx, rule := range pointer.Deref(override.Spec.Rules) {
+					fromRules.InboundRules[listener] = append(slices.Clone(fromRules.InboundRules[listener]), &inbound.Rule{
+						Conf:   rule.Default,
+						Origin: common.Origin{Resource: override.GetMeta(), RuleIndex: idx},
+					})
+				}
+			}
 
 			ipFamilyMode := given.ipFamilyMode
 			if ipFamilyMode == "" {
@@ -284,6 +298,17 @@ var _ = Describe("MeshTLS", func() {
 			workloadIdentity: workloadIdentity(),
 			ipFamilyMode:     "dualstack",
 		}),
+		Entry("strict with ALPN protocols = kuma protocol is exposed as well", testCase{
+			caseName:         "strict-with-alpn",
+			meshBuilder:      samples.MeshDefaultBuilder(),
+			workloadIdentity: workloadIdentity(),
+		}),
+		Entry("inbound override = only the overridden inbound gets its TLS params", testCase{
+			caseName:         "strict-with-inbound-override",
+			meshBuilder:      samples.MeshDefaultBuilder(),
+			workloadIdentity: workloadIdentity(),
+			inboundOverride:  true,
+		}),
 	)
 })
 
@@ -363,7 +388,7 @@ func getPolicy(caseName string) *api.MeshTLSResource {
 	Expect(err).ToNot(HaveOccurred())
 
 	meshTLS.SetMeta(&test_model.ResourceMeta{
-		Name: "name",
+		Name: caseName,
 		Mesh: core_model.DefaultMesh,
 	})
 	// and
@@ -15,6 +15,7 @@ import (
 	"github.com/kumahq/kuma/v3/pkg/plugins/policies/meshproxypatch"
 	"github.com/kumahq/kuma/v3/pkg/plugins/policies/meshratelimit"
 	"github.com/kumahq/kuma/v3/pkg/plugins/policies/meshretry"
+	"github.com/kumahq/kuma/v3/pkg/plugins/policies/meshserviceprobe"
 	"github.com/kumahq/kuma/v3/pkg/plugins/policies/meshtcproute"
 	"github.com/kumahq/kuma/v3/pkg/plugins/policies/meshtimeout"
 	"github.com/kumahq/kuma/v3/pkg/plugins/policies/meshtls"
@@ -34,6 +35,7 @@ var NameToModule = map[string]*plugins.PluginInitializer{
 	"meshproxypatches":            {InitFn: meshproxypatch.InitPlugin, Initialized: false},
 	"meshratelimits":              {InitFn: meshratelimit.InitPlugin, Initialized: false},
 	"meshretries":                 {InitFn: meshretry.InitPlugin, Initialized: false},
+	"meshserviceprobes":           {InitFn: meshserviceprobe.InitPlugin, Initialized: false},
 	"meshtcproutes":               {InitFn: meshtcproute.InitPlugin, Initialized: false},
 	"meshtimeouts":                {InitFn: meshtimeout.InitPlugin, Initialized: false},
 	"meshtlses":                   {InitFn: meshtls.InitPlugin, Initialized: false},
@@ -0,0 +1,11 @@
+package embedded_test
+
+import (
+	"testing"
+
+	"github.com/kumahq/kuma/v3/pkg/test"
+)
+
+func TestEmbeddedStore(t *testing.T) {
+	test.RunSpecs(t, "Embedded ResourceStore Suite")
+}
@@ -0,0 +1,60 @@
+package embedded
+
+import (
+	"github.com/pkg/errors"
+	bolt "go.etcd.io/bbolt"
+
+	config "github.com/kumahq/kuma/v3/pkg/config/plugins/resources/embedded"
+	"github.com/kumahq/kuma/v3/pkg/core"
+	core_plugins "github.com/kumahq/kuma/v3/pkg/core/plugins"
+	core_store "github.com/kumahq/kuma/v3/pkg/core/resources/store"
+	"github.com/kumahq/kuma/v3/pkg/events"
+)
+
+var (
+	log                                  = core.Log.WithName("plugins").WithName("resources").WithName("embedded")
+	_   core_plugins.ResourceStorePlugin = &plugin{}
+)
+
+type plugin struct{}
+
+func init() {
+	core_plugins.Register(core_plugins.Embedded, &plugin{})
+}
+
+func (p *plugin) NewResourceStore(pc core_plugins.PluginContext, pluginConfig core_plugins.PluginConfig) (core_store.ResourceStore, core_store.Transactions, error) {
+	cfg, ok := pluginConfig.(*config.EmbeddedStoreConfig)
+	if !ok {
+		return nil, nil, errors.New("invalid type of the config. Passed config should be a EmbeddedStoreConfig")
+	}
+	log.Info("kuma-cp runs with an embedded database. Keep in mind that an embedded database cannot be used with multiple instances of the control plane.", "path", cfg.Path)
+	s, err := NewStore(*cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+	return s, core_store.NoTransactions{}, nil
+}
+
+func (p *plugin) Migrate(pc core_plugins.PluginContext, pluginConfig core_plugins.PluginConfig) (core_plugins.DbVersion, error) {
+	cfg, ok := pluginConfig.(*config.EmbeddedStoreConfig)
+	if !ok {
+		return 0, errors.New("invalid type of the config. Passed config should be a EmbeddedStoreConfig")
+	}
+	db, err := open(*cfg)
+	if err != nil {
+		return 0, err
+	}
+	defer db.Close()
+	// the layout of the database file is created when the store starts, so there is nothing to migrate
+	var version int
+	err = db.View(func(tx *bolt.Tx) error {
+		version, err = readSchemaVersion(tx)
+		return err
+	})
+	return core_plugins.DbVersion(version), err
+}
+
+func (p *plugin) EventListener(pc core_plugins.PluginContext, writer events.Emitter) error {
+	pc.ResourceStore().DefaultResourceStore().(*embeddedStore).SetEventWriter(writer)
+	return nil
+}
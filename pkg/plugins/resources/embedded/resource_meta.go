@@ -0,0 +1,46 @@
+package embedded
+
+import (
+	"time"
+
+	core_model "github.com/kumahq/kuma/v3/pkg/core/resources/model"
+)
+
+type resourceMeta struct {
+	Name             string
+	Version          string
+	Mesh             string
+	CreationTime     time.Time
+	ModificationTime time.Time
+	Labels           map[string]string
+}
+
+var _ core_model.ResourceMeta = &resourceMeta{}
+
+func (r *resourceMeta) GetName() string {
+	return r.Name
+}
+
+func (r *resourceMeta) GetNameExtensions() core_model.ResourceNameExtensions {
+	return core_model.ResourceNameExtensionsUnsupported
+}
+
+func (r *resourceMeta) GetVersion() string {
+	return r.Version
+}
+
+func (r *resourceMeta) GetMesh() string {
+	return r.Mesh
+}
+
+func (r *resourceMeta) GetCreationTime() time.Time {
+	return r.CreationTime
+}
+
+func (r *resourceMeta) GetModificationTime() time.Time {
+	return r.ModificationTime
+}
+
+func (r *resourceMeta) GetLabels() map[string]string {
+	return r.Labels
+}
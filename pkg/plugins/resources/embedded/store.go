@@ -0,0 +1,482 @@
+package embedded
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"maps"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	bolt "go.etcd.io/bbolt"
+
+	config "github.com/kumahq/kuma/v3/pkg/config/plugins/resources/embedded"
+	core_model "github.com/kumahq/kuma/v3/pkg/core/resources/model"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/store"
+	"github.com/kumahq/kuma/v3/pkg/events"
+)
+
+const (
+	// schemaVersion is the version of the layout of the database file.
+	schemaVersion = 1
+	separator     = "\x00"
+)
+
+var (
+	metaBucket      = []byte("meta")
+	resourcesBucket = []byte("resources")
+	ownersBucket    = []byte("owners")
+	versionKey      = []byte("version")
+)
+
+// record is a storage representation of a resource.
+type record struct {
+	Version          uint64            `json:"version"`
+	Spec             string            `json:"spec"`
+	Status           string            `json:"status,omitempty"`
+	Labels           map[string]string `json:"labels,omitempty"`
+	CreationTime     time.Time         `json:"creationTime"`
+	ModificationTime time.Time         `json:"modificationTime"`
+	Owner            *recordKey        `json:"owner,omitempty"`
+}
+
+type recordKey struct {
+	Type string `json:"type"`
+	Name string `json:"name"`
+	Mesh string `json:"mesh"`
+}
+
+// key orders resources by name and mesh, the same way as Postgres store does.
+func (k recordKey) key() []byte {
+	return []byte(k.Name + separator + k.Mesh)
+}
+
+func (k recordKey) ownerPrefix() []byte {
+	return []byte(k.Type + separator + k.Name + separator + k.Mesh + separator)
+}
+
+func parseRecordKey(resourceType string, key []byte) recordKey {
+	name, mesh, _ := strings.Cut(string(key), separator)
+	return recordKey{Type: resourceType, Name: name, Mesh: mesh}
+}
+
+// ownerIndexKey links an owner with its child, so children can be deleted together with the owner.
+func ownerIndexKey(owner recordKey, child recordKey) []byte {
+	return append(owner.ownerPrefix(), []byte(child.Type+separator+child.Name+separator+child.Mesh)...)
+}
+
+func parseOwnerIndexKey(key []byte) recordKey {
+	parts := strings.SplitN(string(key), separator, 6)
+	return recordKey{Type: parts[3], Name: parts[4], Mesh: parts[5]}
+}
+
+var _ store.ResourceStore = &embeddedStore{}
+
+// embeddedStore keeps resources in a single file on the local disk.
+// Every write is executed in a separate transaction that is synced to the disk before it returns.
+type embeddedStore struct {
+	db *bolt.DB
+
+	mu          sync.RWMutex
+	eventWriter events.Emitter
+}
+
+func NewStore(cfg config.EmbeddedStoreConfig) (store.ResourceStore, error) {
+	db, err := open(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if err := db.Update(func(tx *bolt.Tx) error {
+		version, err := readSchemaVersion(tx)
+		if err != nil {
+			return err
+		}
+		if version > schemaVersion {
+			return errors.Errorf("database file was created by a newer version of kuma-cp: schema version %d, supported version %d", version, schemaVersion)
+		}
+		return initSchema(tx)
+	}); err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+	return &embeddedStore{db: db}, nil
+}
+
+func open(cfg config.EmbeddedStoreConfig) (*bolt.DB, error) {
+	if err := os.MkdirAll(filepath.Dir(cfg.Path), 0o700); err != nil {
+		return nil, errors.Wrapf(err, "could not create a directory for the database file %s", cfg.Path)
+	}
+	db, err := bolt.Open(cfg.Path, 0o600, &bolt.Options{Timeout: cfg.LockTimeout.Duration})
+	if err != nil {
+		if errors.Is(err, bolt.ErrTimeout) {
+			return nil, errors.Errorf("could not acquire the lock on the database file %s. Keep in mind that an embedded database cannot be used with multiple instances of the control plane", cfg.Path)
+		}
+		return nil, errors.Wrapf(err, "could not open the database file %s", cfg.Path)
+	}
+	return db, nil
+}
+
+func readSchemaVersion(tx *bolt.Tx) (int, error) {
+	bucket := tx.Bucket(metaBucket)
+	if bucket == nil {
+		return 0, nil
+	}
+	value := bucket.Get(versionKey)
+	if value == nil {
+		return 0, nil
+	}
+	version, err := strconv.Atoi(string(value))
+	if err != nil {
+		return 0, errors.Wrap(err, "invalid schema version of the database file")
+	}
+	return version, nil
+}
+
+func initSchema(tx *bolt.Tx) error {
+	for _, name := range [][]byte{metaBucket, resourcesBucket, ownersBucket} {
+		if _, err := tx.CreateBucketIfNotExists(name); err != nil {
+			return err
+		}
+	}
+	return tx.Bucket(metaBucket).Put(versionKey, []byte(strconv.Itoa(schemaVersion)))
+}
+
+func (s *embeddedStore) SetEventWriter(writer events.Emitter) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.eventWriter = writer
+}
+
+func (s *embeddedStore) emit(changes []events.ResourceChangedEvent) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.eventWriter == nil || len(changes) == 0 {
+		return
+	}
+	writer := s.eventWriter
+	go func() {
+		for _, change := range changes {
+			writer.Send(change)
+		}
+	}()
+}
+
+func (s *embeddedStore) Create(_ context.Context, r core_model.Resource, fs ...store.CreateOptionsFunc) error {
+	opts := store.NewCreateOptions(fs...)
+	if opts.Name == "" && opts.Mesh == "" {
+		return errors.New("you must pass store.CreateBy or store.CreateByKey as a parameter")
+	}
+	key := recordKey{Type: string(r.Descriptor().Name), Name: opts.Name, Mesh: opts.Mesh}
+
+	spec, err := core_model.ToJSON(r.GetSpec())
+	if err != nil {
+		return errors.Wrap(err, "failed to convert spec to json")
+	}
+	status, err := statusToJSON(r)
+	if err != nil {
+		return err
+	}
+	rec := record{
+		Version:          1,
+		Spec:             string(spec),
+		Status:           status,
+		Labels:           maps.Clone(opts.Labels),
+		CreationTime:     opts.CreationTime.UTC(),
+		ModificationTime: opts.CreationTime.UTC(),
+	}
+	if opts.Owner != nil {
+		rec.Owner = &recordKey{
+			Type: string(opts.Owner.Descriptor().Name),
+			Name: opts.Owner.GetMeta().GetName(),
+			Mesh: opts.Owner.GetMeta().GetMesh(),
+		}
+	}
+
+	err = s.db.Update(func(tx *bolt.Tx) error {
+		bucket, err := tx.Bucket(resourcesBucket).CreateBucketIfNotExists([]byte(key.Type))
+		if err != nil {
+			return err
+		}
+		if bucket.Get(key.key()) != nil {
+			return store.ErrorResourceAlreadyExists(r.Descriptor().Name, opts.Name, opts.Mesh)
+		}
+		if rec.Owner != nil {
+			if getRecordBytes(tx, *rec.Owner) == nil {
+				return store.ErrorResourceNotFound(opts.Owner.Descriptor().Name, rec.Owner.Name, rec.Owner.Mesh)
+			}
+			if err := tx.Bucket(ownersBucket).Put(ownerIndexKey(*rec.Owner, key), nil); err != nil {
+				return err
+			}
+		}
+		return putRecord(bucket, key, rec)
+	})
+	if err != nil {
+		return err
+	}
+
+	r.SetMeta(toMeta(key, rec))
+	s.emit([]events.ResourceChangedEvent{{
+		Operation: events.Create,
+		Type:      r.Descriptor().Name,
+		Key:       core_model.ResourceKey{Mesh: opts.Mesh, Name: opts.Name},
+	}})
+	return nil
+}
+
+func (s *embeddedStore) Update(_ context.Context, r core_model.Resource, fs ...store.UpdateOptionsFunc) error {
+	opts := store.NewUpdateOptions(fs...)
+	key := recordKey{Type: string(r.Descriptor().Name), Name: r.GetMeta().GetName(), Mesh: r.GetMeta().GetMesh()}
+
+	version, err := strconv.ParseUint(r.GetMeta().GetVersion(), 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "failed to convert meta version to int")
+	}
+	spec, err := core_model.ToJSON(r.GetSpec())
+	if err != nil {
+		return errors.Wrap(err, "failed to convert spec to json")
+	}
+	status, err := statusToJSON(r)
+	if err != nil {
+		return err
+	}
+
+	var rec record
+	err = s.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(resourcesBucket).Bucket([]byte(key.Type))
+		var value []byte
+		if bucket != nil {
+			value = bucket.Get(key.key())
+		}
+		if value == nil {
+			return store.ErrorResourceConflict(r.Descriptor().Name, key.Name, key.Mesh)
+		}
+		if err := json.Unmarshal(value, &rec); err != nil {
+			return errors.Wrap(err, "failed to convert json to record")
+		}
+		// optimistic concurrency: the resource has to be updated from the latest version
+		if rec.Version != version {
+			return store.ErrorResourceConflict(r.Descriptor().Name, key.Name, key.Mesh)
+		}
+		rec.Version++
+		rec.Spec = string(spec)
+		rec.Status = status
+		rec.ModificationTime = opts.ModificationTime.UTC()
+		if opts.ModifyLabels {
+			rec.Labels = maps.Clone(opts.Labels)
+		}
+		return putRecord(bucket, key, rec)
+	})
+	if err != nil {
+		return err
+	}
+
+	r.SetMeta(toMeta(key, rec))
+	s.emit([]events.ResourceChangedEvent{{
+		Operation: events.Update,
+		Type:      r.Descriptor().Name,
+		Key:       core_model.ResourceKey{Mesh: key.Mesh, Name: key.Name},
+	}})
+	return nil
+}
+
+func (s *embeddedStore) Delete(_ context.Context, r core_model.Resource, fs ...store.DeleteOptionsFunc) error {
+	opts := store.NewDeleteOptions(fs...)
+	key := recordKey{Type: string(r.Descriptor().Name), Name: opts.Name, Mesh: opts.Mesh}
+
+	var deleted []recordKey
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		if getRecordBytes(tx, key) == nil {
+			return store.ErrorResourceNotFound(r.Descriptor().Name, opts.Name, opts.Mesh)
+		}
+		var err error
+		deleted, err = deleteRecord(tx, key, deleted)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	changes := make([]events.ResourceChangedEvent, 0, len(deleted))
+	for _, key := range deleted {
+		changes = append(changes, events.ResourceChangedEvent{
+			Operation: events.Delete,
+			Type:      core_model.ResourceType(key.Type),
+			Key:       core_model.ResourceKey{Mesh: key.Mesh, Name: key.Name},
+		})
+	}
+	s.emit(changes)
+	return nil
+}
+
+// deleteRecord deletes the record together with all its children, the same way as a cascade foreign key in Postgres.
+func deleteRecord(tx *bolt.Tx, key recordKey, deleted []recordKey) ([]recordKey, error) {
+	bucket := tx.Bucket(resourcesBucket).Bucket([]byte(key.Type))
+	if bucket == nil {
+		return deleted, nil
+	}
+	value := bucket.Get(key.key())
+	if value == nil {
+		return deleted, nil // resource was already deleted
+	}
+	var rec record
+	if err := json.Unmarshal(value, &rec); err != nil {
+		return nil, errors.Wrap(err, "failed to convert json to record")
+	}
+	if err := bucket.Delete(key.key()); err != nil {
+		return nil, err
+	}
+	deleted = append(deleted, key)
+
+	owners := tx.Bucket(ownersBucket)
+	if rec.Owner != nil {
+		if err := owners.Delete(ownerIndexKey(*rec.Owner, key)); err != nil {
+			return nil, err
+		}
+	}
+	var children []recordKey
+	cursor := owners.Cursor()
+	prefix := key.ownerPrefix()
+	for k, _ := cursor.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, _ = cursor.Next() {
+		children = append(children, parseOwnerIndexKey(k))
+	}
+	for _, child := range children {
+		var err error
+		if deleted, err = deleteRecord(tx, child, deleted); err != nil {
+			return nil, err
+		}
+	}
+	return deleted, nil
+}
+
+func (s *embeddedStore) Get(_ context.Context, r core_model.Resource, fs ...store.GetOptionsFunc) error {
+	opts := store.NewGetOptions(fs...)
+	key := recordKey{Type: string(r.Descriptor().Name), Name: opts.Name, Mesh: opts.Mesh}
+
+	var value []byte
+	if err := s.db.View(func(tx *bolt.Tx) error {
+		value = bytes.Clone(getRecordBytes(tx, key))
+		return nil
+	}); err != nil {
+		return err
+	}
+	if value == nil {
+		return store.ErrorResourceNotFound(r.Descriptor().Name, opts.Name, opts.Mesh)
+	}
+	if err := unmarshalRecord(key, value, r); err != nil {
+		return err
+	}
+	if opts.Version != "" && r.GetMeta().GetVersion() != opts.Version {
+		return store.ErrorResourceConflict(r.Descriptor().Name, opts.Name, opts.Mesh)
+	}
+	return nil
+}
+
+func (s *embeddedStore) List(_ context.Context, rs core_model.ResourceList, fs ...store.ListOptionsFunc) error {
+	opts := store.NewListOptions(fs...)
+	resourceType := string(rs.GetItemType())
+
+	total := 0
+	err := s.db.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(resourcesBucket).Bucket([]byte(resourceType))
+		if bucket == nil {
+			return nil
+		}
+		return bucket.ForEach(func(k, v []byte) error {
+			key := parseRecordKey(resourceType, k)
+			if opts.Mesh != "" && key.Mesh != opts.Mesh {
+				return nil
+			}
+			if opts.NameContains != "" && !strings.Contains(key.Name, opts.NameContains) {
+				return nil
+			}
+			if len(opts.ResourceKeys) > 0 {
+				if _, ok := opts.ResourceKeys[core_model.ResourceKey{Mesh: key.Mesh, Name: key.Name}]; !ok {
+					return nil
+				}
+			}
+			item := rs.NewItem()
+			if err := unmarshalRecord(key, v, item); err != nil {
+				return err
+			}
+			if err := rs.AddItem(item); err != nil {
+				return err
+			}
+			total++
+			return nil
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	rs.GetPagination().SetTotal(uint32(total))
+	return nil
+}
+
+func (s *embeddedStore) Close() error {
+	return s.db.Close()
+}
+
+func getRecordBytes(tx *bolt.Tx, key recordKey) []byte {
+	bucket := tx.Bucket(resourcesBucket).Bucket([]byte(key.Type))
+	if bucket == nil {
+		return nil
+	}
+	return bucket.Get(key.key())
+}
+
+func putRecord(bucket *bolt.Bucket, key recordKey, rec record) error {
+	value, err := json.Marshal(rec)
+	if err != nil {
+		return errors.Wrap(err, "failed to convert record to json")
+	}
+	return bucket.Put(key.key(), value)
+}
+
+func unmarshalRecord(key recordKey, value []byte, r core_model.Resource) error {
+	var rec record
+	if err := json.Unmarshal(value, &rec); err != nil {
+		return errors.Wrap(err, "failed to convert json to record")
+	}
+	if err := core_model.FromJSON([]byte(rec.Spec), r.GetSpec()); err != nil {
+		return errors.Wrap(err, "failed to convert json to spec")
+	}
+	if r.Descriptor().HasStatus && rec.Status != "" {
+		if err := core_model.FromJSON([]byte(rec.Status), r.GetStatus()); err != nil {
+			return errors.Wrap(err, "failed to convert json to status")
+		}
+	}
+	r.SetMeta(toMeta(key, rec))
+	return nil
+}
+
+func statusToJSON(r core_model.Resource) (string, error) {
+	if !r.Descriptor().HasStatus {
+		return "", nil
+	}
+	status, err := core_model.ToJSON(r.GetStatus())
+	if err != nil {
+		return "", errors.Wrap(err, "failed to convert status to json")
+	}
+	return string(status), nil
+}
+
+func toMeta(key recordKey, rec record) *resourceMeta {
+	labels := maps.Clone(rec.Labels)
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	return &resourceMeta{
+		Name:             key.Name,
+		Mesh:             key.Mesh,
+		Version:          strconv.FormatUint(rec.Version, 10),
+		CreationTime:     rec.CreationTime.Local(),
+		ModificationTime: rec.ModificationTime.Local(),
+		Labels:           labels,
+	}
+}
@@ -0,0 +1,26 @@
+package embedded_test
+
+import (
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	config "github.com/kumahq/kuma/v3/pkg/config/plugins/resources/embedded"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/store"
+	"github.com/kumahq/kuma/v3/pkg/plugins/resources/embedded"
+	test_store "github.com/kumahq/kuma/v3/pkg/test/store"
+)
+
+var _ = Describe("EmbeddedStore template", func() {
+	createStore := func() store.ResourceStore {
+		cfg := config.DefaultEmbeddedStoreConfig()
+		cfg.Path = filepath.Join(GinkgoT().TempDir(), "store.db")
+		s, err := embedded.NewStore(*cfg)
+		Expect(err).ToNot(HaveOccurred())
+		return s
+	}
+
+	test_store.ExecuteStoreTests(createStore, "embedded")
+	test_store.ExecuteOwnerTests(createStore, "embedded")
+})
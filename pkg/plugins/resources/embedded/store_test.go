@@ -0,0 +1,96 @@
+package embedded_test
+
+import (
+	"context"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	config "github.com/kumahq/kuma/v3/pkg/config/plugins/resources/embedded"
+	core_mesh "github.com/kumahq/kuma/v3/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/model"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/store"
+	"github.com/kumahq/kuma/v3/pkg/events"
+	core_metrics "github.com/kumahq/kuma/v3/pkg/metrics"
+	"github.com/kumahq/kuma/v3/pkg/plugins/resources/embedded"
+	"github.com/kumahq/kuma/v3/pkg/test/resources/builders"
+)
+
+var _ = Describe("EmbeddedStore", func() {
+	var cfg config.EmbeddedStoreConfig
+
+	BeforeEach(func() {
+		cfg = *config.DefaultEmbeddedStoreConfig()
+		cfg.Path = filepath.Join(GinkgoT().TempDir(), "kuma-cp", "store.db")
+		cfg.LockTimeout.Duration = 100 * time.Millisecond
+	})
+
+	It("should keep resources between restarts", func() {
+		// given
+		s, err := embedded.NewStore(cfg)
+		Expect(err).ToNot(HaveOccurred())
+		err = s.Create(context.Background(), builders.Mesh().WithName("demo").Build(), store.CreateByKey("demo", model.NoMesh), store.CreateWithLabels(map[string]string{"team": "a"}))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(s.(store.ClosableResourceStore).Close()).To(Succeed())
+
+		// when
+		s, err = embedded.NewStore(cfg)
+		Expect(err).ToNot(HaveOccurred())
+		defer s.(store.ClosableResourceStore).Close()
+		mesh := core_mesh.NewMeshResource()
+		err = s.Get(context.Background(), mesh, store.GetByKey("demo", model.NoMesh))
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(mesh.GetMeta().GetVersion()).To(Equal("1"))
+		Expect(mesh.GetMeta().GetLabels()).To(Equal(map[string]string{"team": "a"}))
+	})
+
+	It("should not allow two instances to use the same file", func() {
+		// given
+		s, err := embedded.NewStore(cfg)
+		Expect(err).ToNot(HaveOccurred())
+		defer s.(store.ClosableResourceStore).Close()
+
+		// when
+		_, err = embedded.NewStore(cfg)
+
+		// then
+		Expect(err).To(MatchError(ContainSubstring("could not acquire the lock on the database file")))
+	})
+
+	It("should emit events for deleted children", func() {
+		// given
+		s, err := embedded.NewStore(cfg)
+		Expect(err).ToNot(HaveOccurred())
+		defer s.(store.ClosableResourceStore).Close()
+		metrics, err := core_metrics.NewMetrics("")
+		Expect(err).ToNot(HaveOccurred())
+		eventBus, err := events.NewEventBus(10, metrics)
+		Expect(err).ToNot(HaveOccurred())
+		listener := eventBus.Subscribe()
+		defer listener.Close()
+		s.(interface{ SetEventWriter(events.Emitter) }).SetEventWriter(eventBus)
+
+		mesh := builders.Mesh().WithName("demo").Build()
+		Expect(s.Create(context.Background(), mesh, store.CreateByKey("demo", model.NoMesh))).To(Succeed())
+		Eventually(listener.Recv()).Should(Receive())
+		err = s.Create(context.Background(), core_mesh.NewDataplaneInsightResource(), store.CreateByKey("route", "demo"), store.CreatedAt(time.Now()), store.CreateWithOwner(mesh))
+		Expect(err).ToNot(HaveOccurred())
+		Eventually(listener.Recv()).Should(Receive())
+
+		// when
+		Expect(s.Delete(context.Background(), mesh, store.DeleteByKey("demo", model.NoMesh))).To(Succeed())
+
+		// then
+		var deleted []model.ResourceType
+		for range 2 {
+			var event events.Event
+			Eventually(listener.Recv()).Should(Receive(&event))
+			deleted = append(deleted, event.(events.ResourceChangedEvent).Type)
+		}
+		Expect(deleted).To(ConsistOf(core_mesh.MeshType, core_mesh.DataplaneInsightType))
+	})
+})
@@ -80,7 +80,11 @@ func (s *remoteStore) upsert(ctx context.Context, res model.Resource, meta rest_
 	if err != nil {
 		return err
 	}
-	req, err := http.NewRequestWithContext(ctx, http.MethodPut, resourceApi.Item(meta.Mesh, meta.Name), bytes.NewReader(b))
+	uri := resourceApi.Item(meta.Mesh, meta.Name)
+	if force, _ := ctx.Value(ForceConflicts).(bool); force {
+		uri += "?force=true"
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, uri, bytes.NewReader(b))
 	if err != nil {
 		return err
 	}
@@ -190,6 +194,12 @@ func (s *remoteStore) List(ctx context.Context, rs model.ResourceList, fs ...sto
 	if opts.PageSize != 0 {
 		query.Add("size", strconv.Itoa(opts.PageSize))
 	}
+	if opts.LabelSelector != "" {
+		query.Add("labelSelector", opts.LabelSelector)
+	}
+	if opts.FieldSelector != "" {
+		query.Add("fieldSelector", opts.FieldSelector)
+	}
 	req.URL.RawQuery = query.Encode()
 
 	log.V(1).Info("doing request to control-plane", "method", req.Method, "url", req.URL.String())
@@ -229,3 +239,6 @@ func (s *remoteStore) doRequest(ctx context.Context, req *http.Request) (int, []
 type contextKey string
 
 var WarningsCallback = contextKey("warningsCallback")
+
+// ForceConflicts set to true in the context makes Create and Update overwrite resources applied by other field managers.
+var ForceConflicts = contextKey("forceConflicts")
@@ -311,6 +311,25 @@ var _ = Describe("RemoteStore", func() {
 			Expect(err).ToNot(HaveOccurred())
 		})
 
+		It("should force conflicts with field managers", func() {
+			// setup
+			store := setupStore("create_update.json", func(req *http.Request) {
+				Expect(req.URL.Path).To(Equal("/meshes/default/meshexternalservices/res-1"))
+				Expect(req.URL.Query().Get("force")).To(Equal("true"))
+			})
+
+			// when
+			resource := *newMeshExternalService("res-1", "default")
+			resource.Meta = &model.ResourceMeta{
+				Mesh: "default",
+				Name: "res-1",
+			}
+			err := store.Update(context.WithValue(context.Background(), remote.ForceConflicts, true), &resource)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+		})
+
 		It("should send proper mesh json", func() {
 			// setup
 			meshName := "someMesh"
@@ -453,6 +472,28 @@ var _ = Describe("RemoteStore", func() {
 			Expect(rs.Items[0].Meta.GetModificationTime()).Should(Equal(modificationTime))
 		})
 
+		It("should list known resources using selectors", func() {
+			// given
+			store := setupStore("list-pagination.json", func(req *http.Request) {
+				Expect(req.URL.Path).To(Equal("/meshes/demo/meshexternalservices"))
+				Expect(req.URL.Query().Get("labelSelector")).To(Equal("kuma.io/zone=east,kuma.io/origin!=global"))
+				Expect(req.URL.Query().Get("fieldSelector")).To(Equal("name=one"))
+			})
+
+			// when
+			rs := meshexternalservice_api.MeshExternalServiceResourceList{}
+			err := store.List(context.Background(), &rs,
+				core_store.ListByMesh("demo"),
+				core_store.ListByLabelSelector("kuma.io/zone=east,kuma.io/origin!=global"),
+				core_store.ListByFieldSelector("name=one"),
+			)
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rs.Items).To(HaveLen(1))
+			Expect(rs.Items[0].Meta.GetName()).To(Equal("one"))
+		})
+
 		It("should list meshes", func() {
 			// given
 			store := setupStore("list-meshes.json", func(req *http.Request) {
@@ -0,0 +1,204 @@
+package gatewayapi
+
+import (
+	"context"
+	"fmt"
+
+	kube_core "k8s.io/api/core/v1"
+	kube_apierrs "k8s.io/apimachinery/pkg/api/errors"
+	kube_schema "k8s.io/apimachinery/pkg/runtime/schema"
+	kube_types "k8s.io/apimachinery/pkg/types"
+	kube_client "sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayapi "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	common_api "github.com/kumahq/kuma/v3/api/common/v1alpha1"
+	mesh_proto "github.com/kumahq/kuma/v3/api/mesh/v1alpha1"
+	meshservice_k8s "github.com/kumahq/kuma/v3/pkg/core/resources/apis/meshservice/k8s/v1alpha1"
+	"github.com/kumahq/kuma/v3/pkg/util/pointer"
+)
+
+// backendRefResolver converts backendRefs of a route of the given kind to
+// Kuma targetRefs. The route kind is needed to check ReferenceGrants of
+// cross namespace references.
+type backendRefResolver struct {
+	client    kube_client.Reader
+	routeKind gatewayapi.Kind
+}
+
+func (b backendRefResolver) resolve(
+	ctx context.Context, objectNamespace string, ref gatewayapi.BackendObjectReference,
+) (common_api.TargetRef, *ResolvedRefsConditionFalse, error) {
+	details, ok := backendObjectReferenceInfo(objectNamespace, ref)
+	refNamespace := objectNamespace
+	if ok {
+		refNamespace = details.Namespace
+	}
+
+	unresolvedTargetRef := common_api.TargetRef{
+		Kind: common_api.MeshService,
+		Labels: &map[string]string{
+			mesh_proto.DisplayName:      string(ref.Name),
+			mesh_proto.KubeNamespaceTag: refNamespace,
+		},
+	}
+	if !ok {
+		return unresolvedTargetRef,
+			&ResolvedRefsConditionFalse{
+				Reason:  string(gatewayapi.RouteReasonInvalidKind),
+				Message: "backend reference must be Service or MeshService",
+			},
+			nil
+	}
+
+	namespacedName := kube_types.NamespacedName{Namespace: details.Namespace, Name: details.Name}
+	gk := kube_schema.GroupKind{Kind: details.Kind, Group: details.Group}
+
+	if details.Namespace != objectNamespace {
+		allowed, err := b.grantAllows(ctx, objectNamespace, details)
+		if err != nil {
+			return common_api.TargetRef{}, nil, err
+		}
+		if !allowed {
+			return common_api.TargetRef{},
+				&ResolvedRefsConditionFalse{
+					Reason:  string(gatewayapi.RouteReasonRefNotPermitted),
+					Message: fmt.Sprintf("backend reference to %s %q is not permitted by any ReferenceGrant", gk.String(), namespacedName.String()),
+				},
+				nil
+		}
+	}
+
+	if gk.Kind == "Service" && gk.Group == "" {
+		if ref.Port == nil {
+			return unresolvedTargetRef,
+				&ResolvedRefsConditionFalse{
+					Reason:  string(gatewayapi.RouteReasonInvalidKind),
+					Message: "backend reference to Service must include a port",
+				},
+				nil
+		}
+		// References to Services are required by GAPI to include a port
+		port := *ref.Port
+
+		svc := &kube_core.Service{}
+		if err := b.client.Get(ctx, namespacedName, svc); err != nil {
+			if kube_apierrs.IsNotFound(err) {
+				return unresolvedTargetRef,
+					&ResolvedRefsConditionFalse{
+						Reason:  string(gatewayapi.RouteReasonBackendNotFound),
+						Message: fmt.Sprintf("backend reference references a non-existent Service %q", namespacedName.String()),
+					},
+					nil
+			}
+			return common_api.TargetRef{}, nil, err
+		}
+
+		sectionName := fmt.Sprintf("%d", port)
+		portFound := false
+		for _, svcPort := range svc.Spec.Ports {
+			if svcPort.Port == port {
+				portFound = true
+				if svcPort.Name != "" {
+					sectionName = svcPort.Name
+				}
+				break
+			}
+		}
+
+		targetRef := common_api.TargetRef{
+			Kind: common_api.MeshService,
+			Labels: &map[string]string{
+				mesh_proto.DisplayName:      svc.GetName(),
+				mesh_proto.KubeNamespaceTag: svc.GetNamespace(),
+			},
+			SectionName: pointer.To(sectionName),
+		}
+
+		if !portFound {
+			return targetRef,
+				&ResolvedRefsConditionFalse{
+					Reason:  string(gatewayapi.RouteReasonBackendNotFound),
+					Message: fmt.Sprintf("Service %q does not have a port %d", namespacedName.String(), port),
+				},
+				nil
+		}
+
+		return targetRef, nil, nil
+	}
+
+	if gk.Kind == "MeshService" && gk.Group == meshservice_k8s.GroupVersion.Group {
+		ms := &meshservice_k8s.MeshService{}
+		if err := b.client.Get(ctx, namespacedName, ms); err != nil {
+			if kube_apierrs.IsNotFound(err) {
+				return unresolvedTargetRef,
+					&ResolvedRefsConditionFalse{
+						Reason:  string(gatewayapi.RouteReasonBackendNotFound),
+						Message: fmt.Sprintf("backend reference references a non-existent MeshService %q", namespacedName.String()),
+					},
+					nil
+			}
+			return common_api.TargetRef{}, nil, err
+		}
+
+		labels := meshServiceRefLabels(ms)
+
+		if ref.Port == nil {
+			return common_api.TargetRef{
+				Kind:   common_api.MeshService,
+				Labels: &labels,
+			}, nil, nil
+		}
+
+		port := *ref.Port
+		sectionName := fmt.Sprintf("%d", port)
+		portFound := false
+		if ms.Spec != nil {
+			for _, msPort := range ms.Spec.Ports {
+				if msPort.Port == port {
+					portFound = true
+					sectionName = msPort.GetName()
+					break
+				}
+			}
+		}
+		if !portFound {
+			return common_api.TargetRef{
+					Kind:        common_api.MeshService,
+					Labels:      &labels,
+					SectionName: pointer.To(sectionName),
+				},
+				&ResolvedRefsConditionFalse{
+					Reason:  string(gatewayapi.RouteReasonBackendNotFound),
+					Message: fmt.Sprintf("MeshService %q does not have a port %d", namespacedName.String(), port),
+				},
+				nil
+		}
+
+		return common_api.TargetRef{
+			Kind:        common_api.MeshService,
+			Labels:      &labels,
+			SectionName: pointer.To(sectionName),
+		}, nil, nil
+	}
+
+	return unresolvedTargetRef, nil, nil
+}
+
+func (b backendRefResolver) grantAllows(
+	ctx context.Context,
+	routeNamespace string,
+	backendRef backendObjectReferenceDetails,
+) (bool, error) {
+	var grants gatewayapi.ReferenceGrantList
+	if err := b.client.List(ctx, &grants, kube_client.InNamespace(backendRef.Namespace)); err != nil {
+		return false, err
+	}
+
+	for i := range grants.Items {
+		if backendObjectReferenceMatchesGrant(&grants.Items[i], b.routeKind, routeNamespace, backendRef) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
@@ -1,11 +1,34 @@
 package gatewayapi
 
 import (
+	"strings"
+
 	kube_apimeta "k8s.io/apimachinery/pkg/api/meta"
 	kube_meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kube_types "k8s.io/apimachinery/pkg/types"
 	gatewayapi "sigs.k8s.io/gateway-api/apis/v1beta1"
 )
 
+const (
+	httpRouteKind gatewayapi.Kind = "HTTPRoute"
+	grpcRouteKind gatewayapi.Kind = "GRPCRoute"
+	tcpRouteKind  gatewayapi.Kind = "TCPRoute"
+)
+
+// routeOwner returns the owner of the Kuma routes generated from a route of
+// the given kind. HTTPRoutes own them by their name so the routes generated
+// by previous versions are still recognized. Other kinds are prefixed with
+// the kind, "_" can't be a part of a Kubernetes name so it can't collide.
+func routeOwner(kind gatewayapi.Kind, name kube_types.NamespacedName) kube_types.NamespacedName {
+	if kind == httpRouteKind {
+		return name
+	}
+	return kube_types.NamespacedName{
+		Namespace: name.Namespace,
+		Name:      strings.ToLower(string(kind)) + "_" + name.Name,
+	}
+}
+
 func prepareConditions(conditions []kube_meta.Condition) []kube_meta.Condition {
 	for _, condition := range []kube_meta.Condition{
 		{
@@ -25,3 +48,11 @@ func prepareConditions(conditions []kube_meta.Condition) []kube_meta.Condition {
 
 	return conditions
 }
+
+// addRulesConditions adds the conditions of the route rules to the conditions
+// of every parent and fills in the defaults.
+func addRulesConditions(conditions ParentConditions, rulesConditions []kube_meta.Condition) {
+	for ref, parentConditions := range conditions {
+		conditions[ref] = prepareConditions(append(parentConditions, rulesConditions...))
+	}
+}
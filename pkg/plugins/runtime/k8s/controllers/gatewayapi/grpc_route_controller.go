@@ -0,0 +1,185 @@
+package gatewayapi
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	kube_core "k8s.io/api/core/v1"
+	kube_apierrs "k8s.io/apimachinery/pkg/api/errors"
+	kube_runtime "k8s.io/apimachinery/pkg/runtime"
+	kube_types "k8s.io/apimachinery/pkg/types"
+	kube_ctrl "sigs.k8s.io/controller-runtime"
+	kube_client "sigs.k8s.io/controller-runtime/pkg/client"
+	kube_handler "sigs.k8s.io/controller-runtime/pkg/handler"
+	gatewayapi_v1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayapi "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	meshservice_k8s "github.com/kumahq/kuma/v3/pkg/core/resources/apis/meshservice/k8s/v1alpha1"
+	core_model "github.com/kumahq/kuma/v3/pkg/core/resources/model"
+	meshhttproute_api "github.com/kumahq/kuma/v3/pkg/plugins/policies/meshhttproute/api/v1alpha1"
+	k8s_registry "github.com/kumahq/kuma/v3/pkg/plugins/resources/k8s/native/pkg/registry"
+	"github.com/kumahq/kuma/v3/pkg/plugins/runtime/k8s/controllers/gatewayapi/common"
+	k8s_util "github.com/kumahq/kuma/v3/pkg/plugins/runtime/k8s/util"
+)
+
+// GRPCRouteReconciler reconciles a GatewayAPI GRPCRoute into MeshHTTPRoutes.
+// gRPC runs over HTTP/2, so gRPC methods are matched by the request path.
+type GRPCRouteReconciler struct {
+	kube_client.Client
+	Log logr.Logger
+
+	Scheme          *kube_runtime.Scheme
+	TypeRegistry    k8s_registry.TypeRegistry
+	SystemNamespace string
+}
+
+// Reconcile handles transforming a gateway-api GRPCRoute into Kuma
+// MeshHTTPRoutes and managing the status of the GRPCRoute.
+func (r *GRPCRouteReconciler) Reconcile(ctx context.Context, req kube_ctrl.Request) (kube_ctrl.Result, error) {
+	r.Log.V(1).Info("reconcile", "req", req)
+	owner := routeOwner(grpcRouteKind, req.NamespacedName)
+	grpcRoute := &gatewayapi_v1.GRPCRoute{}
+	if err := r.Get(ctx, req.NamespacedName, grpcRoute); err != nil {
+		if kube_apierrs.IsNotFound(err) {
+			// We don't know the mesh, but we don't need it to delete our
+			// object.
+			if err := common.ReconcileLabelledObject(
+				ctx, r.Log, r.TypeRegistry, r.Client, owner, core_model.NoMesh, &meshhttproute_api.MeshHTTPRoute{}, r.SystemNamespace, nil,
+			); err != nil {
+				return kube_ctrl.Result{}, errors.Wrap(err, "could not delete owned MeshHTTPRoute.kuma.io")
+			}
+
+			return kube_ctrl.Result{}, nil
+		}
+
+		return kube_ctrl.Result{}, err
+	}
+
+	ns := kube_core.Namespace{}
+	if err := r.Get(ctx, kube_types.NamespacedName{Name: grpcRoute.Namespace}, &ns); err != nil {
+		return kube_ctrl.Result{}, errors.Wrap(err, "unable to get Namespace of GRPCRoute")
+	}
+
+	mesh := k8s_util.MeshOfByLabel(grpcRoute, &ns)
+
+	meshRouteSpecs, conditions, err := r.gapiToKumaRoutes(ctx, grpcRoute)
+	if err != nil {
+		return kube_ctrl.Result{}, errors.Wrap(err, "could not generate MeshHTTPRoute.kuma.io resources")
+	}
+
+	if err := common.ReconcileLabelledObject(
+		ctx, r.Log, r.TypeRegistry, r.Client, owner, mesh, &meshhttproute_api.MeshHTTPRoute{}, r.SystemNamespace, meshRouteSpecs,
+	); err != nil {
+		return kube_ctrl.Result{}, errors.Wrap(err, "could not reconcile owned MeshHTTPRoute.kuma.io")
+	}
+
+	updated := grpcRoute.DeepCopy()
+	mergeRouteStatus(&updated.Status.RouteStatus, updated.GetGeneration(), conditions)
+	if err := patchRouteStatus(ctx, r.Client, grpcRoute, updated); err != nil {
+		return kube_ctrl.Result{}, errors.Wrap(err, "unable to update GRPCRoute status")
+	}
+
+	return kube_ctrl.Result{}, nil
+}
+
+// gapiToKumaRoutes returns the MeshHTTPRoutes that should be created for this
+// GRPCRoute along with any statuses to be set on the GRPCRoute.
+// Only unexpected errors are returned as error.
+func (r *GRPCRouteReconciler) gapiToKumaRoutes(
+	ctx context.Context,
+	route *gatewayapi_v1.GRPCRoute,
+) (map[string]core_model.ResourceSpec, ParentConditions, error) {
+	routes := map[string]core_model.ResourceSpec{}
+
+	targets, conditions, err := resolveParentRefs(ctx, r.Client, grpcRouteKind, route, route.Spec.ParentRefs)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(conditions) == 0 {
+		return routes, conditions, nil
+	}
+
+	rules, rulesConditions, err := r.gapiToMeshRules(ctx, route)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, ref := range route.Spec.ParentRefs {
+		if target, ok := targets[ref]; ok {
+			storeMeshHTTPRoute(routes, target.Name, toMeshHTTPRoute(target.TargetRef, target.To, rules))
+		}
+	}
+	addRulesConditions(conditions, rulesConditions)
+
+	return routes, conditions, nil
+}
+
+func (r *GRPCRouteReconciler) backendRefs() backendRefResolver {
+	return backendRefResolver{
+		client:    r.Client,
+		routeKind: grpcRouteKind,
+	}
+}
+
+func backendObjectReferencesOfGRPCRoute(obj kube_client.Object) []gatewayapi.BackendObjectReference {
+	route := obj.(*gatewayapi_v1.GRPCRoute)
+
+	var refs []gatewayapi.BackendObjectReference
+	for _, rule := range route.Spec.Rules {
+		for _, backendRef := range rule.BackendRefs {
+			refs = append(refs, backendRef.BackendObjectReference)
+		}
+		for _, filter := range rule.Filters {
+			if filter.Type == gatewayapi_v1.GRPCRouteFilterRequestMirror {
+				refs = append(refs, filter.RequestMirror.BackendRef)
+			}
+		}
+	}
+	return refs
+}
+
+// meshServicesOfGRPCRoute returns the namespaced names of the MeshServices
+// referenced by the given GRPCRoute.
+func meshServicesOfGRPCRoute(obj kube_client.Object) []string {
+	route := obj.(*gatewayapi_v1.GRPCRoute)
+	return referencedObjectsOfRoute(route.Namespace, route.Spec.ParentRefs, backendObjectReferencesOfGRPCRoute(route), isMeshServiceRef, "MeshService")
+}
+
+// servicesOfGRPCRoute returns the namespaced names of the Services
+// referenced by the given GRPCRoute.
+func servicesOfGRPCRoute(obj kube_client.Object) []string {
+	route := obj.(*gatewayapi_v1.GRPCRoute)
+	return referencedObjectsOfRoute(route.Namespace, route.Spec.ParentRefs, backendObjectReferencesOfGRPCRoute(route), isServiceParentRef, "Service")
+}
+
+func newGRPCRouteList() kube_client.ObjectList {
+	return &gatewayapi_v1.GRPCRouteList{}
+}
+
+func (r *GRPCRouteReconciler) SetupWithManager(mgr kube_ctrl.Manager) error {
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &gatewayapi_v1.GRPCRoute{}, meshServicesOfRouteField, meshServicesOfGRPCRoute); err != nil {
+		return err
+	}
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &gatewayapi_v1.GRPCRoute{}, servicesOfRouteField, servicesOfGRPCRoute); err != nil {
+		return err
+	}
+	return kube_ctrl.NewControllerManagedBy(mgr).
+		Named("kuma-grpc-route-controller").
+		For(&gatewayapi_v1.GRPCRoute{}).
+		Watches(
+			&kube_core.Service{},
+			kube_handler.EnqueueRequestsFromMapFunc(routesReferencingObject(r.Log.WithName("service-to-routes-mapper"), r.Client, newGRPCRouteList, servicesOfRouteField)),
+		).
+		Watches(
+			&meshservice_k8s.MeshService{},
+			kube_handler.EnqueueRequestsFromMapFunc(routesReferencingObject(r.Log.WithName("meshservice-to-routes-mapper"), r.Client, newGRPCRouteList, meshServicesOfRouteField)),
+		).
+		Watches(
+			&gatewayapi.ReferenceGrant{},
+			kube_handler.EnqueueRequestsFromMapFunc(routesForReferenceGrantOf(
+				r.Log.WithName("referencegrant-to-routes-mapper"), r.Client, grpcRouteKind, newGRPCRouteList, backendObjectReferencesOfGRPCRoute,
+			)),
+		).
+		Complete(r)
+}
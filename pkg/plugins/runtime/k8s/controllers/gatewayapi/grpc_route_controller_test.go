@@ -0,0 +1,183 @@
+package gatewayapi
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	kube_core "k8s.io/api/core/v1"
+	kube_apimeta "k8s.io/apimachinery/pkg/api/meta"
+	kube_meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kube_ctrl "sigs.k8s.io/controller-runtime"
+	kube_client "sigs.k8s.io/controller-runtime/pkg/client"
+	kube_client_fake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	gatewayapi_v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	bootstrap_k8s "github.com/kumahq/kuma/v3/pkg/plugins/bootstrap/k8s"
+	"github.com/kumahq/kuma/v3/pkg/plugins/policies/meshhttproute/api/v1alpha1"
+	meshhttproute_k8s "github.com/kumahq/kuma/v3/pkg/plugins/policies/meshhttproute/k8s/v1alpha1"
+	k8s_registry "github.com/kumahq/kuma/v3/pkg/plugins/resources/k8s/native/pkg/registry"
+	"github.com/kumahq/kuma/v3/pkg/util/pointer"
+)
+
+var _ = Describe("GRPCRouteReconciler.Reconcile", func() {
+	const routeNamespace = "kuma-demo"
+
+	serviceParentRef := func(name string) gatewayapi_v1.ParentReference {
+		return gatewayapi_v1.ParentReference{
+			Group: pointer.To(gatewayapi_v1.Group("")),
+			Kind:  pointer.To(gatewayapi_v1.Kind("Service")),
+			Name:  gatewayapi_v1.ObjectName(name),
+		}
+	}
+
+	backend := &kube_core.Service{
+		ObjectMeta: kube_meta.ObjectMeta{Name: "backend", Namespace: routeNamespace},
+		Spec: kube_core.ServiceSpec{
+			ClusterIP: "10.0.0.1",
+			Ports:     []kube_core.ServicePort{{Name: "grpc", Port: 8080}},
+		},
+	}
+
+	var reconciler *GRPCRouteReconciler
+	var newClient func(objs ...kube_client.Object) kube_client.Client
+
+	BeforeEach(func() {
+		scheme, err := bootstrap_k8s.NewScheme()
+		Expect(err).ToNot(HaveOccurred())
+
+		namespace := &kube_core.Namespace{ObjectMeta: kube_meta.ObjectMeta{Name: routeNamespace}}
+
+		newClient = func(objs ...kube_client.Object) kube_client.Client {
+			return kube_client_fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithStatusSubresource(&gatewayapi_v1.GRPCRoute{}).
+				WithObjects(append([]kube_client.Object{namespace}, objs...)...).
+				Build()
+		}
+
+		reconciler = &GRPCRouteReconciler{
+			Log:             logr.Discard(),
+			TypeRegistry:    k8s_registry.Global(),
+			SystemNamespace: "kuma-system",
+		}
+	})
+
+	It("generates a MeshHTTPRoute matching the gRPC method", func() {
+		route := &gatewayapi_v1.GRPCRoute{
+			ObjectMeta: kube_meta.ObjectMeta{Name: "my-route", Namespace: routeNamespace},
+			Spec: gatewayapi_v1.GRPCRouteSpec{
+				CommonRouteSpec: gatewayapi_v1.CommonRouteSpec{
+					ParentRefs: []gatewayapi_v1.ParentReference{serviceParentRef("backend")},
+				},
+				Rules: []gatewayapi_v1.GRPCRouteRule{{
+					Matches: []gatewayapi_v1.GRPCRouteMatch{{
+						Method: &gatewayapi_v1.GRPCMethodMatch{
+							Service: pointer.To("echo.Echo"),
+							Method:  pointer.To("Ping"),
+						},
+					}},
+					BackendRefs: []gatewayapi_v1.GRPCBackendRef{{
+						BackendRef: gatewayapi_v1.BackendRef{
+							BackendObjectReference: gatewayapi_v1.BackendObjectReference{
+								Name: "backend",
+								Port: pointer.To(gatewayapi_v1.PortNumber(8080)),
+							},
+						},
+					}},
+				}},
+			},
+		}
+
+		client := newClient(backend, route)
+		reconciler.Client = client
+
+		_, err := reconciler.Reconcile(context.Background(), kube_ctrl.Request{
+			NamespacedName: kube_client.ObjectKeyFromObject(route),
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		routes := &meshhttproute_k8s.MeshHTTPRouteList{}
+		Expect(client.List(context.Background(), routes)).To(Succeed())
+		Expect(routes.Items).To(HaveLen(1))
+		Expect(routes.Items[0].Name).To(Equal("my-route-kuma-demo-grpcroute-backend.kuma-demo"))
+
+		spec := routes.Items[0].Spec
+		Expect(spec).ToNot(BeNil())
+		Expect(*spec.To).To(HaveLen(1))
+		Expect(*(*spec.To)[0].TargetRef.SectionName).To(Equal("grpc"))
+		rules := (*spec.To)[0].Rules
+		Expect(rules).To(HaveLen(1))
+		Expect(rules[0].Matches).To(ConsistOf(v1alpha1.Match{
+			Path: &v1alpha1.PathMatch{Type: v1alpha1.Exact, Value: "/echo.Echo/Ping"},
+		}))
+		Expect(*rules[0].Default.BackendRefs).To(HaveLen(1))
+
+		var updatedRoute gatewayapi_v1.GRPCRoute
+		Expect(client.Get(context.Background(), kube_client.ObjectKeyFromObject(route), &updatedRoute)).To(Succeed())
+		Expect(updatedRoute.Status.Parents).To(HaveLen(1))
+		accepted := kube_apimeta.FindStatusCondition(updatedRoute.Status.Parents[0].Conditions, string(gatewayapi_v1.RouteConditionAccepted))
+		Expect(accepted).ToNot(BeNil())
+		Expect(accepted.Status).To(Equal(kube_meta.ConditionTrue))
+	})
+
+	It("matches every request when the rule has no matches", func() {
+		route := &gatewayapi_v1.GRPCRoute{
+			ObjectMeta: kube_meta.ObjectMeta{Name: "my-route", Namespace: routeNamespace},
+			Spec: gatewayapi_v1.GRPCRouteSpec{
+				CommonRouteSpec: gatewayapi_v1.CommonRouteSpec{
+					ParentRefs: []gatewayapi_v1.ParentReference{serviceParentRef("backend")},
+				},
+				Rules: []gatewayapi_v1.GRPCRouteRule{{}},
+			},
+		}
+
+		client := newClient(backend, route)
+		reconciler.Client = client
+
+		_, err := reconciler.Reconcile(context.Background(), kube_ctrl.Request{
+			NamespacedName: kube_client.ObjectKeyFromObject(route),
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		routes := &meshhttproute_k8s.MeshHTTPRouteList{}
+		Expect(client.List(context.Background(), routes)).To(Succeed())
+		Expect(routes.Items).To(HaveLen(1))
+		rules := (*routes.Items[0].Spec.To)[0].Rules
+		Expect(rules[0].Matches).To(ConsistOf(v1alpha1.Match{
+			Path: &v1alpha1.PathMatch{Type: v1alpha1.PathPrefix, Value: "/"},
+		}))
+	})
+
+	It("reports Accepted=False when the parent Service does not exist", func() {
+		route := &gatewayapi_v1.GRPCRoute{
+			ObjectMeta: kube_meta.ObjectMeta{Name: "my-route", Namespace: routeNamespace},
+			Spec: gatewayapi_v1.GRPCRouteSpec{
+				CommonRouteSpec: gatewayapi_v1.CommonRouteSpec{
+					ParentRefs: []gatewayapi_v1.ParentReference{serviceParentRef("missing")},
+				},
+			},
+		}
+
+		client := newClient(route)
+		reconciler.Client = client
+
+		_, err := reconciler.Reconcile(context.Background(), kube_ctrl.Request{
+			NamespacedName: kube_client.ObjectKeyFromObject(route),
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		routes := &meshhttproute_k8s.MeshHTTPRouteList{}
+		Expect(client.List(context.Background(), routes)).To(Succeed())
+		Expect(routes.Items).To(BeEmpty())
+
+		var updatedRoute gatewayapi_v1.GRPCRoute
+		Expect(client.Get(context.Background(), kube_client.ObjectKeyFromObject(route), &updatedRoute)).To(Succeed())
+		Expect(updatedRoute.Status.Parents).To(HaveLen(1))
+		accepted := kube_apimeta.FindStatusCondition(updatedRoute.Status.Parents[0].Conditions, string(gatewayapi_v1.RouteConditionAccepted))
+		Expect(accepted).ToNot(BeNil())
+		Expect(accepted.Status).To(Equal(kube_meta.ConditionFalse))
+		Expect(accepted.Reason).To(Equal(string(gatewayapi_v1.RouteReasonNoMatchingParent)))
+	})
+})
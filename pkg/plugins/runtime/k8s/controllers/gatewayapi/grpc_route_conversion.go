@@ -0,0 +1,198 @@
+package gatewayapi
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	kube_apimeta "k8s.io/apimachinery/pkg/api/meta"
+	kube_meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayapi_v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	common_api "github.com/kumahq/kuma/v3/api/common/v1alpha1"
+	"github.com/kumahq/kuma/v3/pkg/plugins/policies/meshhttproute/api/v1alpha1"
+	"github.com/kumahq/kuma/v3/pkg/util/pointer"
+)
+
+// grpcPathSegment matches a single segment of a gRPC request path, that is
+// either a service or a method.
+const grpcPathSegment = "[^/]+"
+
+func (r *GRPCRouteReconciler) gapiToMeshRules(
+	ctx context.Context,
+	route *gatewayapi_v1.GRPCRoute,
+) ([]v1alpha1.Rule, []kube_meta.Condition, error) {
+	var rules []v1alpha1.Rule
+	var conditions []kube_meta.Condition
+
+	for _, rule := range route.Spec.Rules {
+		kumaRule, ruleConditions, err := r.gapiToKumaMeshRule(ctx, route, rule)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for _, condition := range ruleConditions {
+			if kube_apimeta.FindStatusCondition(conditions, condition.Type) == nil {
+				kube_apimeta.SetStatusCondition(&conditions, condition)
+			}
+		}
+
+		rules = append(rules, kumaRule)
+	}
+
+	return rules, conditions, nil
+}
+
+func (r *GRPCRouteReconciler) gapiToKumaMeshRule(
+	ctx context.Context,
+	route *gatewayapi_v1.GRPCRoute,
+	rule gatewayapi_v1.GRPCRouteRule,
+) (v1alpha1.Rule, []kube_meta.Condition, error) {
+	var conditions []kube_meta.Condition
+
+	var matches []v1alpha1.Match
+	var filters []v1alpha1.Filter
+	var backendRefs []common_api.BackendRef
+
+	for _, gapiMatch := range rule.Matches {
+		matches = append(matches, gapiToKumaGRPCMatch(gapiMatch))
+	}
+
+	// A rule without matches matches all gRPC requests, while MeshHTTPRoute
+	// requires at least one match.
+	if len(matches) == 0 {
+		matches = []v1alpha1.Match{{
+			Path: &v1alpha1.PathMatch{
+				Type:  v1alpha1.PathPrefix,
+				Value: "/",
+			},
+		}}
+	}
+
+	for _, gapiFilter := range rule.Filters {
+		httpFilter, ok := grpcToHTTPRouteFilter(gapiFilter)
+		if !ok {
+			continue
+		}
+
+		filter, filterConditions, ok := gapiToKumaMeshFilter(ctx, r.backendRefs(), route.Namespace, httpFilter)
+		if !ok {
+			continue
+		}
+
+		for _, condition := range filterConditions {
+			if kube_apimeta.FindStatusCondition(conditions, condition.Type) == nil {
+				kube_apimeta.SetStatusCondition(&conditions, condition)
+			}
+		}
+
+		if len(filterConditions) == 0 {
+			filters = append(filters, filter)
+		}
+	}
+
+	for _, gapiBackendRef := range rule.BackendRefs {
+		ref, refCondition, err := r.backendRefs().resolve(ctx, route.Namespace, gapiBackendRef.BackendObjectReference)
+		if err != nil {
+			return v1alpha1.Rule{}, nil, err
+		}
+
+		refCondition.AddIfFalseAndNotPresent(&conditions)
+		if refCondition.preventsBackendTarget() {
+			continue
+		}
+
+		backendRefs = append(backendRefs, common_api.BackendRef{
+			TargetRef: ref,
+			Weight:    pointer.To(uint(pointer.DerefOr(gapiBackendRef.Weight, 1))),
+		})
+	}
+
+	return v1alpha1.Rule{
+		Matches: matches,
+		Default: v1alpha1.RuleConf{
+			Filters:     &filters,
+			BackendRefs: &backendRefs,
+		},
+	}, conditions, nil
+}
+
+// gapiToKumaGRPCMatch converts a GRPCRoute match to a MeshHTTPRoute match.
+// gRPC requests are sent to /<service>/<method>, so the method match is
+// converted to a path match.
+func gapiToKumaGRPCMatch(gapiMatch gatewayapi_v1.GRPCRouteMatch) v1alpha1.Match {
+	var match v1alpha1.Match
+
+	if method := gapiMatch.Method; method != nil {
+		service := pointer.Deref(method.Service)
+		name := pointer.Deref(method.Method)
+
+		switch pointer.DerefOr(method.Type, gatewayapi_v1.GRPCMethodMatchExact) {
+		case gatewayapi_v1.GRPCMethodMatchRegularExpression:
+			if service == "" {
+				service = grpcPathSegment
+			}
+			if name == "" {
+				name = grpcPathSegment
+			}
+			match.Path = &v1alpha1.PathMatch{
+				Type:  v1alpha1.RegularExpression,
+				Value: "/" + service + "/" + name,
+			}
+		default:
+			switch {
+			case service != "" && name != "":
+				match.Path = &v1alpha1.PathMatch{
+					Type:  v1alpha1.Exact,
+					Value: "/" + service + "/" + name,
+				}
+			case service != "":
+				match.Path = &v1alpha1.PathMatch{
+					Type:  v1alpha1.PathPrefix,
+					Value: "/" + service,
+				}
+			case name != "":
+				match.Path = &v1alpha1.PathMatch{
+					Type:  v1alpha1.RegularExpression,
+					Value: "/" + grpcPathSegment + "/" + regexp.QuoteMeta(name),
+				}
+			}
+		}
+	}
+
+	for _, gapiHeader := range gapiMatch.Headers {
+		header := common_api.HeaderMatch{
+			Type: pointer.To(common_api.HeaderMatchType(pointer.DerefOr(gapiHeader.Type, gatewayapi_v1.GRPCHeaderMatchExact))),
+			// note that our resources disallow uppercase letters in header names
+			Name:  common_api.HeaderName(strings.ToLower(string(gapiHeader.Name))),
+			Value: common_api.HeaderValue(gapiHeader.Value),
+		}
+		match.Headers = pointer.To(append(pointer.Deref(match.Headers), header))
+	}
+
+	return match
+}
+
+// grpcToHTTPRouteFilter converts the GRPCRoute filters that have an HTTPRoute
+// equivalent, so they can be converted the same way.
+func grpcToHTTPRouteFilter(filter gatewayapi_v1.GRPCRouteFilter) (gatewayapi_v1.HTTPRouteFilter, bool) {
+	switch filter.Type {
+	case gatewayapi_v1.GRPCRouteFilterRequestHeaderModifier:
+		return gatewayapi_v1.HTTPRouteFilter{
+			Type:                  gatewayapi_v1.HTTPRouteFilterRequestHeaderModifier,
+			RequestHeaderModifier: filter.RequestHeaderModifier,
+		}, true
+	case gatewayapi_v1.GRPCRouteFilterResponseHeaderModifier:
+		return gatewayapi_v1.HTTPRouteFilter{
+			Type:                   gatewayapi_v1.HTTPRouteFilterResponseHeaderModifier,
+			ResponseHeaderModifier: filter.ResponseHeaderModifier,
+		}, true
+	case gatewayapi_v1.GRPCRouteFilterRequestMirror:
+		return gatewayapi_v1.HTTPRouteFilter{
+			Type:          gatewayapi_v1.HTTPRouteFilterRequestMirror,
+			RequestMirror: filter.RequestMirror,
+		}, true
+	default:
+		return gatewayapi_v1.HTTPRouteFilter{}, false
+	}
+}
@@ -0,0 +1,74 @@
+package gatewayapi
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	gatewayapi_v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	common_api "github.com/kumahq/kuma/v3/api/common/v1alpha1"
+	"github.com/kumahq/kuma/v3/pkg/plugins/policies/meshhttproute/api/v1alpha1"
+	"github.com/kumahq/kuma/v3/pkg/util/pointer"
+)
+
+var _ = Describe("gapiToKumaGRPCMatch", func() {
+	methodMatch := func(matchType gatewayapi_v1.GRPCMethodMatchType, service, method string) gatewayapi_v1.GRPCRouteMatch {
+		match := &gatewayapi_v1.GRPCMethodMatch{Type: pointer.To(matchType)}
+		if service != "" {
+			match.Service = pointer.To(service)
+		}
+		if method != "" {
+			match.Method = pointer.To(method)
+		}
+		return gatewayapi_v1.GRPCRouteMatch{Method: match}
+	}
+
+	DescribeTable("converts the method match to a path match",
+		func(gapiMatch gatewayapi_v1.GRPCRouteMatch, expected v1alpha1.PathMatch) {
+			match := gapiToKumaGRPCMatch(gapiMatch)
+
+			Expect(match.Path).ToNot(BeNil())
+			Expect(*match.Path).To(Equal(expected))
+		},
+		Entry("exact service and method",
+			methodMatch(gatewayapi_v1.GRPCMethodMatchExact, "foo.Bar", "Get"),
+			v1alpha1.PathMatch{Type: v1alpha1.Exact, Value: "/foo.Bar/Get"},
+		),
+		Entry("exact service only",
+			methodMatch(gatewayapi_v1.GRPCMethodMatchExact, "foo.Bar", ""),
+			v1alpha1.PathMatch{Type: v1alpha1.PathPrefix, Value: "/foo.Bar"},
+		),
+		Entry("exact method only",
+			methodMatch(gatewayapi_v1.GRPCMethodMatchExact, "", "Get.All"),
+			v1alpha1.PathMatch{Type: v1alpha1.RegularExpression, Value: `/[^/]+/Get\.All`},
+		),
+		Entry("regular expression service and method",
+			methodMatch(gatewayapi_v1.GRPCMethodMatchRegularExpression, "foo\\..*", "Get.*"),
+			v1alpha1.PathMatch{Type: v1alpha1.RegularExpression, Value: "/foo\\..*/Get.*"},
+		),
+		Entry("regular expression service only",
+			methodMatch(gatewayapi_v1.GRPCMethodMatchRegularExpression, "foo\\..*", ""),
+			v1alpha1.PathMatch{Type: v1alpha1.RegularExpression, Value: "/foo\\..*/[^/]+"},
+		),
+		Entry("regular expression method only",
+			methodMatch(gatewayapi_v1.GRPCMethodMatchRegularExpression, "", "Get.*"),
+			v1alpha1.PathMatch{Type: v1alpha1.RegularExpression, Value: "/[^/]+/Get.*"},
+		),
+	)
+
+	It("lowercases header names and defaults to exact matching", func() {
+		match := gapiToKumaGRPCMatch(gatewayapi_v1.GRPCRouteMatch{
+			Headers: []gatewayapi_v1.GRPCHeaderMatch{{
+				Name:  "X-Version",
+				Value: "v2",
+			}},
+		})
+
+		Expect(match.Path).To(BeNil())
+		Expect(match.Headers).ToNot(BeNil())
+		Expect(*match.Headers).To(Equal([]common_api.HeaderMatch{{
+			Type:  pointer.To(common_api.HeaderMatchType(gatewayapi_v1.GRPCHeaderMatchExact)),
+			Name:  "x-version",
+			Value: "v2",
+		}}))
+	})
+})
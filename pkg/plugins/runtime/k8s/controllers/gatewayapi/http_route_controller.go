@@ -2,7 +2,6 @@ package gatewayapi
 
 import (
 	"context"
-	"fmt"
 	"reflect"
 	"slices"
 
@@ -25,7 +24,6 @@ import (
 	core_model "github.com/kumahq/kuma/v3/pkg/core/resources/model"
 	meshhttproute_api "github.com/kumahq/kuma/v3/pkg/plugins/policies/meshhttproute/api/v1alpha1"
 	k8s_registry "github.com/kumahq/kuma/v3/pkg/plugins/resources/k8s/native/pkg/registry"
-	"github.com/kumahq/kuma/v3/pkg/plugins/runtime/k8s/controllers/gatewayapi/attachment"
 	"github.com/kumahq/kuma/v3/pkg/plugins/runtime/k8s/controllers/gatewayapi/common"
 	k8s_util "github.com/kumahq/kuma/v3/pkg/plugins/runtime/k8s/util"
 	"github.com/kumahq/kuma/v3/pkg/util/pointer"
@@ -106,10 +104,10 @@ func backendObjectReferencesOfRoute(route *gatewayapi.HTTPRoute) []gatewayapi.Ba
 	return refs
 }
 
-func backendObjectReferenceMatchesGrant(grant *gatewayapi.ReferenceGrant, routeNamespace string, backendRef backendObjectReferenceDetails) bool {
+func backendObjectReferenceMatchesGrant(grant *gatewayapi.ReferenceGrant, routeKind gatewayapi.Kind, routeNamespace string, backendRef backendObjectReferenceDetails) bool {
 	fromMatches := slices.ContainsFunc(grant.Spec.From, func(from gatewayapi.ReferenceGrantFrom) bool {
 		return string(from.Group) == gatewayapi.GroupVersion.Group &&
-			string(from.Kind) == "HTTPRoute" &&
+			from.Kind == routeKind &&
 			string(from.Namespace) == routeNamespace
 	})
 	if !fromMatches {
@@ -181,150 +179,25 @@ func (r *HTTPRouteReconciler) gapiToKumaRoutes(
 ) (map[string]core_model.ResourceSpec, ParentConditions, error) {
 	routes := map[string]core_model.ResourceSpec{}
 
-	// The conditions we accumulate for each ParentRef
-	conditions := ParentConditions{}
-
-	for i, ref := range route.Spec.ParentRefs {
-		refAttachment, refKind, err := attachment.EvaluateParentRefAttachment(ref)
-		if err != nil {
-			return nil, nil, errors.Wrapf(err, "unable to check parent ref %d", i)
-		}
-
-		if refAttachment == attachment.Unknown {
-			continue
-		}
-
-		rules, rulesConditions, err := r.gapiToMeshRules(ctx, route)
-		if err != nil {
-			return nil, nil, err
-		}
-
-		var parentConditions []kube_meta.Condition
-
-		// refAttachment is always Allowed here: Unknown was handled above.
-		switch refKind {
-		case attachment.Service:
-			namespace := route.Namespace
-			if ref.Namespace != nil {
-				namespace = string(*ref.Namespace)
-			}
-
-			var parent kube_core.Service
-			if err := r.Get(ctx, kube_types.NamespacedName{
-				Name:      string(ref.Name),
-				Namespace: namespace,
-			}, &parent); err != nil {
-				if !kube_apierrs.IsNotFound(err) {
-					return nil, nil, err
-				}
-
-				parentConditions = append(parentConditions,
-					kube_meta.Condition{
-						Type:    string(gatewayapi.RouteConditionAccepted),
-						Status:  kube_meta.ConditionFalse,
-						Reason:  string(gatewayapi_v1.RouteReasonNoMatchingParent),
-						Message: fmt.Sprintf("Service %q does not exist", kube_types.NamespacedName{Namespace: namespace, Name: string(ref.Name)}.String()),
-					},
-				)
-
-				conditions[ref] = prepareConditions(append(parentConditions, rulesConditions...))
-				continue
-			}
-
-			if parent.Spec.ClusterIP == kube_core.ClusterIPNone {
-				parentConditions = append(parentConditions,
-					kube_meta.Condition{
-						Type:    string(gatewayapi.RouteConditionAccepted),
-						Status:  kube_meta.ConditionFalse,
-						Reason:  string(gatewayapi_v1.RouteReasonNoMatchingParent),
-						Message: fmt.Sprintf("Service %q has no MeshService to attach to", kube_types.NamespacedName{Namespace: namespace, Name: string(ref.Name)}.String()),
-					},
-				)
-
-				conditions[ref] = prepareConditions(append(parentConditions, rulesConditions...))
-				continue
-			}
-
-			routeSubName := fmt.Sprintf(
-				"%s-%s-%s.%s",
-				route.Name,
-				route.Namespace,
-				parent.GetName(),
-				parent.GetNamespace(),
-			)
-
-			meshRoute, ok := r.gapiServiceToMeshRoute(route.Namespace, rules, &parent, ref.Port, ref.SectionName)
-			if !ok {
-				parentConditions = append(parentConditions,
-					kube_meta.Condition{
-						Type:    string(gatewayapi.RouteConditionAccepted),
-						Status:  kube_meta.ConditionFalse,
-						Reason:  string(gatewayapi_v1.RouteReasonNoMatchingParent),
-						Message: fmt.Sprintf("Service %q has no port matching the parentRef", kube_types.NamespacedName{Namespace: namespace, Name: string(ref.Name)}.String()),
-					},
-				)
-
-				conditions[ref] = prepareConditions(append(parentConditions, rulesConditions...))
-				continue
-			}
-
-			storeMeshHTTPRoute(routes, routeSubName, meshRoute)
-		case attachment.MeshService:
-			namespace := route.Namespace
-			if ref.Namespace != nil {
-				namespace = string(*ref.Namespace)
-			}
-
-			var parent meshservice_k8s.MeshService
-			if err := r.Get(ctx, kube_types.NamespacedName{
-				Name:      string(ref.Name),
-				Namespace: namespace,
-			}, &parent); err != nil {
-				if !kube_apierrs.IsNotFound(err) {
-					return nil, nil, err
-				}
-
-				parentConditions = append(parentConditions,
-					kube_meta.Condition{
-						Type:    string(gatewayapi.RouteConditionAccepted),
-						Status:  kube_meta.ConditionFalse,
-						Reason:  string(gatewayapi_v1.RouteReasonNoMatchingParent),
-						Message: fmt.Sprintf("MeshService %q does not exist", kube_types.NamespacedName{Namespace: namespace, Name: string(ref.Name)}.String()),
-					},
-				)
-
-				conditions[ref] = prepareConditions(append(parentConditions, rulesConditions...))
-				continue
-			}
+	targets, conditions, err := resolveParentRefs(ctx, r.Client, httpRouteKind, route, route.Spec.ParentRefs)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(conditions) == 0 {
+		return routes, conditions, nil
+	}
 
-			routeSubName := fmt.Sprintf(
-				"%s-%s-meshservice-%s.%s",
-				route.Name,
-				route.Namespace,
-				parent.GetName(),
-				parent.GetNamespace(),
-			)
-
-			meshRoute, ok := r.gapiMeshServiceToMeshRoute(route.Namespace, rules, &parent, ref.Port, ref.SectionName)
-			if !ok {
-				parentConditions = append(parentConditions,
-					kube_meta.Condition{
-						Type:    string(gatewayapi.RouteConditionAccepted),
-						Status:  kube_meta.ConditionFalse,
-						Reason:  string(gatewayapi_v1.RouteReasonNoMatchingParent),
-						Message: fmt.Sprintf("MeshService %q has no port matching the parentRef", kube_types.NamespacedName{Namespace: namespace, Name: string(ref.Name)}.String()),
-					},
-				)
-
-				conditions[ref] = prepareConditions(append(parentConditions, rulesConditions...))
-				continue
-			}
+	rules, rulesConditions, err := r.gapiToMeshRules(ctx, route)
+	if err != nil {
+		return nil, nil, err
+	}
 
-			storeMeshHTTPRoute(routes, routeSubName, meshRoute)
+	for _, ref := range route.Spec.ParentRefs {
+		if target, ok := targets[ref]; ok {
+			storeMeshHTTPRoute(routes, target.Name, toMeshHTTPRoute(target.TargetRef, target.To, rules))
 		}
-
-		conditions[ref] = prepareConditions(append(parentConditions, rulesConditions...))
 	}
+	addRulesConditions(conditions, rulesConditions)
 
 	return routes, conditions, nil
 }
@@ -451,7 +324,7 @@ func routesForReferenceGrant(l logr.Logger, client kube_client.Client) kube_hand
 				if !ok || details.Namespace != grant.Namespace || details.Namespace == route.Namespace {
 					continue
 				}
-				if backendObjectReferenceMatchesGrant(grant, route.Namespace, details) {
+				if backendObjectReferenceMatchesGrant(grant, httpRouteKind, route.Namespace, details) {
 					requests = append(requests, kube_reconcile.Request{
 						NamespacedName: kube_client.ObjectKeyFromObject(route),
 					})
@@ -489,35 +362,7 @@ func isServiceParentRef(group *gatewayapi.Group, kind *gatewayapi.Kind) bool {
 // request-mirror backendRefs, defaulting an omitted namespace to the route's.
 func meshServicesOfRoute(obj kube_client.Object) []string {
 	route := obj.(*gatewayapi.HTTPRoute)
-
-	var names []string
-
-	for _, parentRef := range route.Spec.ParentRefs {
-		if !isMeshServiceRef(parentRef.Group, parentRef.Kind) {
-			continue
-		}
-		namespace := route.Namespace
-		if parentRef.Namespace != nil {
-			namespace = string(*parentRef.Namespace)
-		}
-		names = append(
-			names,
-			kube_types.NamespacedName{Namespace: namespace, Name: string(parentRef.Name)}.String(),
-		)
-	}
-
-	for _, backendRef := range backendObjectReferencesOfRoute(route) {
-		details, ok := backendObjectReferenceInfo(route.Namespace, backendRef)
-		if !ok || details.Kind != "MeshService" {
-			continue
-		}
-		names = append(
-			names,
-			kube_types.NamespacedName{Namespace: details.Namespace, Name: details.Name}.String(),
-		)
-	}
-
-	return names
+	return referencedObjectsOfRoute(route.Namespace, route.Spec.ParentRefs, backendObjectReferencesOfRoute(route), isMeshServiceRef, "MeshService")
 }
 
 // servicesOfRoute returns the namespaced names of the Services referenced by
@@ -525,35 +370,7 @@ func meshServicesOfRoute(obj kube_client.Object) []string {
 // defaulting an omitted namespace to the route's.
 func servicesOfRoute(obj kube_client.Object) []string {
 	route := obj.(*gatewayapi.HTTPRoute)
-
-	var names []string
-
-	for _, parentRef := range route.Spec.ParentRefs {
-		if !isServiceParentRef(parentRef.Group, parentRef.Kind) {
-			continue
-		}
-		namespace := route.Namespace
-		if parentRef.Namespace != nil {
-			namespace = string(*parentRef.Namespace)
-		}
-		names = append(
-			names,
-			kube_types.NamespacedName{Namespace: namespace, Name: string(parentRef.Name)}.String(),
-		)
-	}
-
-	for _, backendRef := range backendObjectReferencesOfRoute(route) {
-		details, ok := backendObjectReferenceInfo(route.Namespace, backendRef)
-		if !ok || details.Kind != "Service" {
-			continue
-		}
-		names = append(
-			names,
-			kube_types.NamespacedName{Namespace: details.Namespace, Name: details.Name}.String(),
-		)
-	}
-
-	return names
+	return referencedObjectsOfRoute(route.Namespace, route.Spec.ParentRefs, backendObjectReferencesOfRoute(route), isServiceParentRef, "Service")
 }
 
 func (r *HTTPRouteReconciler) SetupWithManager(mgr kube_ctrl.Manager) error {
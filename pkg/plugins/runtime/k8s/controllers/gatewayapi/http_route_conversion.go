@@ -2,22 +2,16 @@ package gatewayapi
 
 import (
 	"context"
-	"fmt"
 	"strings"
 
 	kube_core "k8s.io/api/core/v1"
-	kube_apierrs "k8s.io/apimachinery/pkg/api/errors"
 	kube_apimeta "k8s.io/apimachinery/pkg/api/meta"
 	kube_meta "k8s.io/apimachinery/pkg/apis/meta/v1"
-	kube_schema "k8s.io/apimachinery/pkg/runtime/schema"
-	kube_types "k8s.io/apimachinery/pkg/types"
-	kube_client "sigs.k8s.io/controller-runtime/pkg/client"
 	gatewayapi_v1 "sigs.k8s.io/gateway-api/apis/v1"
 	gatewayapi "sigs.k8s.io/gateway-api/apis/v1beta1"
 
 	common_api "github.com/kumahq/kuma/v3/api/common/v1alpha1"
 	mesh_proto "github.com/kumahq/kuma/v3/api/mesh/v1alpha1"
-	meshservice_api "github.com/kumahq/kuma/v3/pkg/core/resources/apis/meshservice/api/v1alpha1"
 	meshservice_k8s "github.com/kumahq/kuma/v3/pkg/core/resources/apis/meshservice/k8s/v1alpha1"
 	core_model "github.com/kumahq/kuma/v3/pkg/core/resources/model"
 	"github.com/kumahq/kuma/v3/pkg/plugins/policies/meshhttproute/api/v1alpha1"
@@ -56,60 +50,31 @@ func (r *HTTPRouteReconciler) gapiServiceToMeshRoute(
 	parentPort *gatewayapi_v1.PortNumber,
 	parentSectionName *gatewayapi_v1.SectionName,
 ) (core_model.ResourceSpec, bool) {
-	// consumer route
-	targetRef := common_api.TopLevelTargetRef{
-		Kind: common_api.TopLevelTargetRefKindDataplane,
-		Labels: &map[string]string{
-			mesh_proto.KubeNamespaceTag: routeNamespace,
-		},
-	}
-
-	// producer route
-	if routeNamespace == parent.GetNamespace() {
-		targetRef = common_api.TopLevelTargetRef{
-			Kind: common_api.TopLevelTargetRefKindMesh,
-		}
+	targets, ok := serviceParentTargets(parent, parentPort, parentSectionName)
+	if !ok {
+		return nil, false
 	}
+	return toMeshHTTPRoute(parentTopLevelTargetRef(routeNamespace, parent.GetNamespace()), targets, rules), true
+}
 
+// toMeshHTTPRoute returns the MeshHTTPRoute that applies the rules to every
+// targeted port of the parent.
+func toMeshHTTPRoute(
+	targetRef common_api.TopLevelTargetRef,
+	targets []common_api.OutboundTargetRef,
+	rules []v1alpha1.Rule,
+) *v1alpha1.MeshHTTPRoute {
 	var tos []v1alpha1.To
-
-	for _, port := range parent.Spec.Ports {
-		if parentPort != nil && port.Port != *parentPort {
-			continue
-		}
-
-		// The MeshService section name is the Service port name, falling back to
-		// the stringified port value for unnamed ports (see meshservice_controller).
-		sectionName := port.Name
-		if sectionName == "" {
-			sectionName = fmt.Sprintf("%d", port.Port)
-		}
-
-		if parentSectionName != nil && sectionName != string(*parentSectionName) {
-			continue
-		}
-
+	for _, target := range targets {
 		tos = append(tos, v1alpha1.To{
-			TargetRef: common_api.OutboundTargetRef{
-				Kind: common_api.OutboundTargetRefKindMeshService,
-				Labels: &map[string]string{
-					mesh_proto.DisplayName:      parent.GetName(),
-					mesh_proto.KubeNamespaceTag: parent.GetNamespace(),
-				},
-				SectionName: pointer.To(sectionName),
-			},
-			Rules: rules,
+			TargetRef: target,
+			Rules:     rules,
 		})
 	}
-
-	if len(tos) == 0 && (parentPort != nil || parentSectionName != nil) {
-		return nil, false
-	}
-
 	return &v1alpha1.MeshHTTPRoute{
 		TargetRef: &targetRef,
 		To:        &tos,
-	}, true
+	}
 }
 
 // meshServiceRefLabels returns the labels that identify the destination
@@ -137,62 +102,11 @@ func (r *HTTPRouteReconciler) gapiMeshServiceToMeshRoute(
 	parentPort *gatewayapi_v1.PortNumber,
 	parentSectionName *gatewayapi_v1.SectionName,
 ) (core_model.ResourceSpec, bool) {
-	// consumer route
-	targetRef := common_api.TopLevelTargetRef{
-		Kind: common_api.TopLevelTargetRefKindDataplane,
-		Labels: &map[string]string{
-			mesh_proto.KubeNamespaceTag: routeNamespace,
-		},
-	}
-
-	// producer route
-	if routeNamespace == parent.GetNamespace() {
-		targetRef = common_api.TopLevelTargetRef{
-			Kind: common_api.TopLevelTargetRefKindMesh,
-		}
-	}
-
-	var tos []v1alpha1.To
-
-	var ports []meshservice_api.Port
-	if parent.Spec != nil {
-		for _, port := range parent.Spec.Ports {
-			if parentPort != nil && port.Port != *parentPort {
-				continue
-			}
-			// Port.GetName falls back to the stringified port, so a
-			// sectionName matches a named and an unnamed port alike.
-			if parentSectionName != nil && port.GetName() != string(*parentSectionName) {
-				continue
-			}
-			ports = append(ports, port)
-		}
-	}
-
-	// A parentRef that names a port the MeshService does not have is a user
-	// error worth reporting. A MeshService with no ports at all is not: its
-	// port list is briefly empty while its controller observes endpoints, and
-	// reporting that would flap the route status.
-	if len(ports) == 0 && (parentPort != nil || parentSectionName != nil) {
+	targets, ok := meshServiceParentTargets(parent, parentPort, parentSectionName)
+	if !ok {
 		return nil, false
 	}
-
-	labels := meshServiceRefLabels(parent)
-	for _, port := range ports {
-		tos = append(tos, v1alpha1.To{
-			TargetRef: common_api.OutboundTargetRef{
-				Kind:        common_api.OutboundTargetRefKindMeshService,
-				Labels:      &labels,
-				SectionName: pointer.To(port.GetName()),
-			},
-			Rules: rules,
-		})
-	}
-
-	return &v1alpha1.MeshHTTPRoute{
-		TargetRef: &targetRef,
-		To:        &tos,
-	}, true
+	return toMeshHTTPRoute(parentTopLevelTargetRef(routeNamespace, parent.GetNamespace()), targets, rules), true
 }
 
 func (r *HTTPRouteReconciler) gapiToKumaMeshRule(
@@ -216,7 +130,7 @@ func (r *HTTPRouteReconciler) gapiToKumaMeshRule(
 	}
 
 	for _, gapiFilter := range rule.Filters {
-		filter, filterConditions, ok := r.gapiToKumaMeshFilter(ctx, route.Namespace, gapiFilter)
+		filter, filterConditions, ok := gapiToKumaMeshFilter(ctx, r.backendRefs(), route.Namespace, gapiFilter)
 		if !ok {
 			// TODO use err
 			continue
@@ -349,8 +263,9 @@ func fromGAPIPath(gapiPath gatewayapi.HTTPPathModifier) (v1alpha1.PathRewrite, b
 	}
 }
 
-func (r *HTTPRouteReconciler) gapiToKumaMeshFilter(
+func gapiToKumaMeshFilter(
 	ctx context.Context,
+	refs backendRefResolver,
 	routeNamespace string,
 	gapiFilter gatewayapi.HTTPRouteFilter,
 ) (v1alpha1.Filter, []kube_meta.Condition, bool) {
@@ -431,7 +346,7 @@ func (r *HTTPRouteReconciler) gapiToKumaMeshFilter(
 	case gatewayapi_v1.HTTPRouteFilterRequestMirror:
 		mirror := gapiFilter.RequestMirror
 
-		ref, refCondition, err := r.uncheckedGapiToKumaRef(ctx, routeNamespace, mirror.BackendRef)
+		ref, refCondition, err := refs.resolve(ctx, routeNamespace, mirror.BackendRef)
 		if err != nil {
 			return v1alpha1.Filter{}, nil, false
 		}
@@ -476,177 +391,12 @@ func (c *ResolvedRefsConditionFalse) preventsBackendTarget() bool {
 func (r *HTTPRouteReconciler) uncheckedGapiToKumaRef(
 	ctx context.Context, objectNamespace string, ref gatewayapi.BackendObjectReference,
 ) (common_api.TargetRef, *ResolvedRefsConditionFalse, error) {
-	details, ok := backendObjectReferenceInfo(objectNamespace, ref)
-	refNamespace := objectNamespace
-	if ok {
-		refNamespace = details.Namespace
-	}
-
-	unresolvedTargetRef := common_api.TargetRef{
-		Kind: common_api.MeshService,
-		Labels: &map[string]string{
-			mesh_proto.DisplayName:      string(ref.Name),
-			mesh_proto.KubeNamespaceTag: refNamespace,
-		},
-	}
-	if !ok {
-		return unresolvedTargetRef,
-			&ResolvedRefsConditionFalse{
-				Reason:  string(gatewayapi.RouteReasonInvalidKind),
-				Message: "backend reference must be Service or MeshService",
-			},
-			nil
-	}
-
-	namespacedName := kube_types.NamespacedName{Namespace: details.Namespace, Name: details.Name}
-	gk := kube_schema.GroupKind{Kind: details.Kind, Group: details.Group}
-
-	if details.Namespace != objectNamespace {
-		allowed, err := r.referenceGrantAllowsBackendRef(ctx, objectNamespace, details)
-		if err != nil {
-			return common_api.TargetRef{}, nil, err
-		}
-		if !allowed {
-			return common_api.TargetRef{},
-				&ResolvedRefsConditionFalse{
-					Reason:  string(gatewayapi.RouteReasonRefNotPermitted),
-					Message: fmt.Sprintf("backend reference to %s %q is not permitted by any ReferenceGrant", gk.String(), namespacedName.String()),
-				},
-				nil
-		}
-	}
-
-	if gk.Kind == "Service" && gk.Group == "" {
-		if ref.Port == nil {
-			return unresolvedTargetRef,
-				&ResolvedRefsConditionFalse{
-					Reason:  string(gatewayapi.RouteReasonInvalidKind),
-					Message: "backend reference to Service must include a port",
-				},
-				nil
-		}
-		// References to Services are required by GAPI to include a port
-		port := *ref.Port
-
-		svc := &kube_core.Service{}
-		if err := r.Get(ctx, namespacedName, svc); err != nil {
-			if kube_apierrs.IsNotFound(err) {
-				return unresolvedTargetRef,
-					&ResolvedRefsConditionFalse{
-						Reason:  string(gatewayapi.RouteReasonBackendNotFound),
-						Message: fmt.Sprintf("backend reference references a non-existent Service %q", namespacedName.String()),
-					},
-					nil
-			}
-			return common_api.TargetRef{}, nil, err
-		}
-
-		sectionName := fmt.Sprintf("%d", port)
-		portFound := false
-		for _, svcPort := range svc.Spec.Ports {
-			if svcPort.Port == port {
-				portFound = true
-				if svcPort.Name != "" {
-					sectionName = svcPort.Name
-				}
-				break
-			}
-		}
-
-		targetRef := common_api.TargetRef{
-			Kind: common_api.MeshService,
-			Labels: &map[string]string{
-				mesh_proto.DisplayName:      svc.GetName(),
-				mesh_proto.KubeNamespaceTag: svc.GetNamespace(),
-			},
-			SectionName: pointer.To(sectionName),
-		}
-
-		if !portFound {
-			return targetRef,
-				&ResolvedRefsConditionFalse{
-					Reason:  string(gatewayapi.RouteReasonBackendNotFound),
-					Message: fmt.Sprintf("Service %q does not have a port %d", namespacedName.String(), port),
-				},
-				nil
-		}
-
-		return targetRef, nil, nil
-	}
-
-	if gk.Kind == "MeshService" && gk.Group == meshservice_k8s.GroupVersion.Group {
-		ms := &meshservice_k8s.MeshService{}
-		if err := r.Get(ctx, namespacedName, ms); err != nil {
-			if kube_apierrs.IsNotFound(err) {
-				return unresolvedTargetRef,
-					&ResolvedRefsConditionFalse{
-						Reason:  string(gatewayapi.RouteReasonBackendNotFound),
-						Message: fmt.Sprintf("backend reference references a non-existent MeshService %q", namespacedName.String()),
-					},
-					nil
-			}
-			return common_api.TargetRef{}, nil, err
-		}
-
-		labels := meshServiceRefLabels(ms)
-
-		if ref.Port == nil {
-			return common_api.TargetRef{
-				Kind:   common_api.MeshService,
-				Labels: &labels,
-			}, nil, nil
-		}
-
-		port := *ref.Port
-		sectionName := fmt.Sprintf("%d", port)
-		portFound := false
-		if ms.Spec != nil {
-			for _, msPort := range ms.Spec.Ports {
-				if msPort.Port == port {
-					portFound = true
-					sectionName = msPort.GetName()
-					break
-				}
-			}
-		}
-		if !portFound {
-			return common_api.TargetRef{
-					Kind:        common_api.MeshService,
-					Labels:      &labels,
-					SectionName: pointer.To(sectionName),
-				},
-				&ResolvedRefsConditionFalse{
-					Reason:  string(gatewayapi.RouteReasonBackendNotFound),
-					Message: fmt.Sprintf("MeshService %q does not have a port %d", namespacedName.String(), port),
-				},
-				nil
-		}
-
-		return common_api.TargetRef{
-			Kind:        common_api.MeshService,
-			Labels:      &labels,
-			SectionName: pointer.To(sectionName),
-		}, nil, nil
-	}
-
-	return unresolvedTargetRef, nil, nil
+	return r.backendRefs().resolve(ctx, objectNamespace, ref)
 }
 
-func (r *HTTPRouteReconciler) referenceGrantAllowsBackendRef(
-	ctx context.Context,
-	routeNamespace string,
-	backendRef backendObjectReferenceDetails,
-) (bool, error) {
-	var grants gatewayapi.ReferenceGrantList
-	if err := r.List(ctx, &grants, kube_client.InNamespace(backendRef.Namespace)); err != nil {
-		return false, err
-	}
-
-	for i := range grants.Items {
-		if backendObjectReferenceMatchesGrant(&grants.Items[i], routeNamespace, backendRef) {
-			return true, nil
-		}
+func (r *HTTPRouteReconciler) backendRefs() backendRefResolver {
+	return backendRefResolver{
+		client:    r.Client,
+		routeKind: httpRouteKind,
 	}
-
-	return false, nil
 }
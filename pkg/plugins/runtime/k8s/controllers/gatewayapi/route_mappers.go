@@ -0,0 +1,142 @@
+package gatewayapi
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/go-logr/logr"
+	kube_apimeta "k8s.io/apimachinery/pkg/api/meta"
+	kube_types "k8s.io/apimachinery/pkg/types"
+	kube_client "sigs.k8s.io/controller-runtime/pkg/client"
+	kube_handler "sigs.k8s.io/controller-runtime/pkg/handler"
+	kube_reconcile "sigs.k8s.io/controller-runtime/pkg/reconcile"
+	gatewayapi "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+// routesReferencingObject returns a function that calculates which routes in
+// the list returned by newList might be affected by changes in an object.
+// The routes have to be indexed in field by the namespaced names of the
+// objects they reference.
+func routesReferencingObject(
+	l logr.Logger,
+	client kube_client.Client,
+	newList func() kube_client.ObjectList,
+	field string,
+) kube_handler.MapFunc {
+	return func(ctx context.Context, obj kube_client.Object) []kube_reconcile.Request {
+		routes := newList()
+		if err := client.List(ctx, routes, kube_client.MatchingFields{
+			field: kube_client.ObjectKeyFromObject(obj).String(),
+		}); err != nil {
+			l.Error(err, "unexpected error listing routes", "typ", reflect.TypeOf(routes))
+			return nil
+		}
+
+		var requests []kube_reconcile.Request
+		for _, route := range listedRoutes(l, routes) {
+			requests = append(requests, kube_reconcile.Request{
+				NamespacedName: kube_client.ObjectKeyFromObject(route),
+			})
+		}
+		return requests
+	}
+}
+
+// routesForReferenceGrantOf works like routesForReferenceGrant for routes of
+// the given kind.
+func routesForReferenceGrantOf(
+	l logr.Logger,
+	client kube_client.Client,
+	routeKind gatewayapi.Kind,
+	newList func() kube_client.ObjectList,
+	backendRefsOf func(kube_client.Object) []gatewayapi.BackendObjectReference,
+) kube_handler.MapFunc {
+	return func(ctx context.Context, obj kube_client.Object) []kube_reconcile.Request {
+		grant, ok := obj.(*gatewayapi.ReferenceGrant)
+		if !ok {
+			l.Error(nil, "unexpected error converting object to ReferenceGrant", "typ", reflect.TypeOf(obj))
+			return nil
+		}
+
+		routes := newList()
+		if err := client.List(ctx, routes); err != nil {
+			l.Error(err, "unexpected error listing routes", "typ", reflect.TypeOf(routes))
+			return nil
+		}
+
+		var requests []kube_reconcile.Request
+		for _, route := range listedRoutes(l, routes) {
+			for _, backendRef := range backendRefsOf(route) {
+				details, ok := backendObjectReferenceInfo(route.GetNamespace(), backendRef)
+				if !ok || details.Namespace != grant.Namespace || details.Namespace == route.GetNamespace() {
+					continue
+				}
+				if backendObjectReferenceMatchesGrant(grant, routeKind, route.GetNamespace(), details) {
+					requests = append(requests, kube_reconcile.Request{
+						NamespacedName: kube_client.ObjectKeyFromObject(route),
+					})
+					break
+				}
+			}
+		}
+
+		return requests
+	}
+}
+
+func listedRoutes(l logr.Logger, list kube_client.ObjectList) []kube_client.Object {
+	items, err := kube_apimeta.ExtractList(list)
+	if err != nil {
+		l.Error(err, "unexpected error extracting routes", "typ", reflect.TypeOf(list))
+		return nil
+	}
+
+	var routes []kube_client.Object
+	for _, item := range items {
+		if route, ok := item.(kube_client.Object); ok {
+			routes = append(routes, route)
+		}
+	}
+	return routes
+}
+
+// referencedObjectsOfRoute returns the namespaced names of the objects
+// referenced by the given parentRefs and backendRefs of a route, defaulting
+// an omitted namespace to the route's. isParentRef selects the parentRefs
+// and backendKind the kind of the backendRefs to return.
+func referencedObjectsOfRoute(
+	routeNamespace string,
+	parentRefs []gatewayapi.ParentReference,
+	backendRefs []gatewayapi.BackendObjectReference,
+	isParentRef func(group *gatewayapi.Group, kind *gatewayapi.Kind) bool,
+	backendKind string,
+) []string {
+	var names []string
+
+	for _, parentRef := range parentRefs {
+		if !isParentRef(parentRef.Group, parentRef.Kind) {
+			continue
+		}
+		namespace := routeNamespace
+		if parentRef.Namespace != nil {
+			namespace = string(*parentRef.Namespace)
+		}
+		names = append(
+			names,
+			kube_types.NamespacedName{Namespace: namespace, Name: string(parentRef.Name)}.String(),
+		)
+	}
+
+	for _, backendRef := range backendRefs {
+		details, ok := backendObjectReferenceInfo(routeNamespace, backendRef)
+		if !ok || details.Kind != backendKind {
+			continue
+		}
+		names = append(
+			names,
+			kube_types.NamespacedName{Namespace: details.Namespace, Name: details.Name}.String(),
+		)
+	}
+
+	return names
+}
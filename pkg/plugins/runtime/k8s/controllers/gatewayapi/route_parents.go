@@ -0,0 +1,244 @@
+package gatewayapi
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	kube_core "k8s.io/api/core/v1"
+	kube_apierrs "k8s.io/apimachinery/pkg/api/errors"
+	kube_meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kube_types "k8s.io/apimachinery/pkg/types"
+	kube_client "sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayapi_v1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayapi "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	common_api "github.com/kumahq/kuma/v3/api/common/v1alpha1"
+	mesh_proto "github.com/kumahq/kuma/v3/api/mesh/v1alpha1"
+	meshservice_api "github.com/kumahq/kuma/v3/pkg/core/resources/apis/meshservice/api/v1alpha1"
+	meshservice_k8s "github.com/kumahq/kuma/v3/pkg/core/resources/apis/meshservice/k8s/v1alpha1"
+	"github.com/kumahq/kuma/v3/pkg/plugins/runtime/k8s/controllers/gatewayapi/attachment"
+	"github.com/kumahq/kuma/v3/pkg/util/pointer"
+)
+
+// parentTarget is a parentRef of a route resolved to the targets of the
+// generated Kuma route.
+type parentTarget struct {
+	// Name is the name of the generated Kuma route.
+	Name      string
+	TargetRef common_api.TopLevelTargetRef
+	// To are the ports of the parent the rules of the route apply to.
+	To []common_api.OutboundTargetRef
+}
+
+// resolveParentRefs resolves the parentRefs of a route to the targets of the
+// generated Kuma routes. Parents the route can't attach to are reported only
+// with conditions. ParentRefs we don't know how to attach to are skipped, so
+// they get neither a target nor conditions.
+// Only unexpected errors are returned as error.
+func resolveParentRefs(
+	ctx context.Context,
+	client kube_client.Reader,
+	routeKind gatewayapi.Kind,
+	route kube_client.Object,
+	parentRefs []gatewayapi.ParentReference,
+) (map[gatewayapi.ParentReference]parentTarget, ParentConditions, error) {
+	targets := map[gatewayapi.ParentReference]parentTarget{}
+	conditions := ParentConditions{}
+
+	// Kuma routes generated from HTTPRoutes keep the names from before other
+	// route kinds were supported, the others include the kind to not collide.
+	namePrefix := fmt.Sprintf("%s-%s", route.GetName(), route.GetNamespace())
+	if routeKind != httpRouteKind {
+		namePrefix = fmt.Sprintf("%s-%s", namePrefix, strings.ToLower(string(routeKind)))
+	}
+
+	for i, ref := range parentRefs {
+		refAttachment, refKind, err := attachment.EvaluateParentRefAttachment(ref)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "unable to check parent ref %d", i)
+		}
+
+		if refAttachment == attachment.Unknown {
+			continue
+		}
+
+		namespace := route.GetNamespace()
+		if ref.Namespace != nil {
+			namespace = string(*ref.Namespace)
+		}
+		parentName := kube_types.NamespacedName{Namespace: namespace, Name: string(ref.Name)}
+
+		noMatchingParent := func(format string) {
+			conditions[ref] = []kube_meta.Condition{{
+				Type:    string(gatewayapi.RouteConditionAccepted),
+				Status:  kube_meta.ConditionFalse,
+				Reason:  string(gatewayapi_v1.RouteReasonNoMatchingParent),
+				Message: fmt.Sprintf(format, parentName.String()),
+			}}
+		}
+
+		// refAttachment is always Allowed here: Unknown was handled above.
+		switch refKind {
+		case attachment.Service:
+			var parent kube_core.Service
+			if err := client.Get(ctx, parentName, &parent); err != nil {
+				if !kube_apierrs.IsNotFound(err) {
+					return nil, nil, err
+				}
+				noMatchingParent("Service %q does not exist")
+				continue
+			}
+
+			if parent.Spec.ClusterIP == kube_core.ClusterIPNone {
+				noMatchingParent("Service %q has no MeshService to attach to")
+				continue
+			}
+
+			to, ok := serviceParentTargets(&parent, ref.Port, ref.SectionName)
+			if !ok {
+				noMatchingParent("Service %q has no port matching the parentRef")
+				continue
+			}
+
+			targets[ref] = parentTarget{
+				Name:      fmt.Sprintf("%s-%s.%s", namePrefix, parent.GetName(), parent.GetNamespace()),
+				TargetRef: parentTopLevelTargetRef(route.GetNamespace(), parent.GetNamespace()),
+				To:        to,
+			}
+		case attachment.MeshService:
+			var parent meshservice_k8s.MeshService
+			if err := client.Get(ctx, parentName, &parent); err != nil {
+				if !kube_apierrs.IsNotFound(err) {
+					return nil, nil, err
+				}
+				noMatchingParent("MeshService %q does not exist")
+				continue
+			}
+
+			to, ok := meshServiceParentTargets(&parent, ref.Port, ref.SectionName)
+			if !ok {
+				noMatchingParent("MeshService %q has no port matching the parentRef")
+				continue
+			}
+
+			targets[ref] = parentTarget{
+				Name:      fmt.Sprintf("%s-meshservice-%s.%s", namePrefix, parent.GetName(), parent.GetNamespace()),
+				TargetRef: parentTopLevelTargetRef(route.GetNamespace(), parent.GetNamespace()),
+				To:        to,
+			}
+		}
+
+		conditions[ref] = nil
+	}
+
+	return targets, conditions, nil
+}
+
+// parentTopLevelTargetRef returns the top level targetRef of a route attached
+// to a parent. A route in the namespace of its parent is a producer route and
+// applies to the whole mesh, otherwise it's a consumer route and applies only
+// to the dataplanes in the namespace of the route.
+func parentTopLevelTargetRef(routeNamespace, parentNamespace string) common_api.TopLevelTargetRef {
+	// producer route
+	if routeNamespace == parentNamespace {
+		return common_api.TopLevelTargetRef{
+			Kind: common_api.TopLevelTargetRefKindMesh,
+		}
+	}
+
+	// consumer route
+	return common_api.TopLevelTargetRef{
+		Kind: common_api.TopLevelTargetRefKindDataplane,
+		Labels: &map[string]string{
+			mesh_proto.KubeNamespaceTag: routeNamespace,
+		},
+	}
+}
+
+// serviceParentTargets returns the ports of the MeshService of a Service
+// parentRef. It reports false when the parentRef names a port the Service
+// does not have.
+func serviceParentTargets(
+	parent *kube_core.Service,
+	parentPort *gatewayapi_v1.PortNumber,
+	parentSectionName *gatewayapi_v1.SectionName,
+) ([]common_api.OutboundTargetRef, bool) {
+	var targets []common_api.OutboundTargetRef
+
+	for _, port := range parent.Spec.Ports {
+		if parentPort != nil && port.Port != *parentPort {
+			continue
+		}
+
+		// The MeshService section name is the Service port name, falling back to
+		// the stringified port value for unnamed ports (see meshservice_controller).
+		sectionName := port.Name
+		if sectionName == "" {
+			sectionName = fmt.Sprintf("%d", port.Port)
+		}
+
+		if parentSectionName != nil && sectionName != string(*parentSectionName) {
+			continue
+		}
+
+		targets = append(targets, common_api.OutboundTargetRef{
+			Kind: common_api.OutboundTargetRefKindMeshService,
+			Labels: &map[string]string{
+				mesh_proto.DisplayName:      parent.GetName(),
+				mesh_proto.KubeNamespaceTag: parent.GetNamespace(),
+			},
+			SectionName: pointer.To(sectionName),
+		})
+	}
+
+	if len(targets) == 0 && (parentPort != nil || parentSectionName != nil) {
+		return nil, false
+	}
+
+	return targets, true
+}
+
+// meshServiceParentTargets returns the ports of a MeshService parentRef. It
+// reports false when the parentRef names a port the MeshService does not have.
+func meshServiceParentTargets(
+	parent *meshservice_k8s.MeshService,
+	parentPort *gatewayapi_v1.PortNumber,
+	parentSectionName *gatewayapi_v1.SectionName,
+) ([]common_api.OutboundTargetRef, bool) {
+	var ports []meshservice_api.Port
+	if parent.Spec != nil {
+		for _, port := range parent.Spec.Ports {
+			if parentPort != nil && port.Port != *parentPort {
+				continue
+			}
+			// Port.GetName falls back to the stringified port, so a
+			// sectionName matches a named and an unnamed port alike.
+			if parentSectionName != nil && port.GetName() != string(*parentSectionName) {
+				continue
+			}
+			ports = append(ports, port)
+		}
+	}
+
+	// A parentRef that names a port the MeshService does not have is a user
+	// error worth reporting. A MeshService with no ports at all is not: its
+	// port list is briefly empty while its controller observes endpoints, and
+	// reporting that would flap the route status.
+	if len(ports) == 0 && (parentPort != nil || parentSectionName != nil) {
+		return nil, false
+	}
+
+	var targets []common_api.OutboundTargetRef
+	labels := meshServiceRefLabels(parent)
+	for _, port := range ports {
+		targets = append(targets, common_api.OutboundTargetRef{
+			Kind:        common_api.OutboundTargetRefKindMeshService,
+			Labels:      &labels,
+			SectionName: pointer.To(port.GetName()),
+		})
+	}
+
+	return targets, true
+}
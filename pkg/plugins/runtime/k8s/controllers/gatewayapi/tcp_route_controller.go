@@ -0,0 +1,202 @@
+package gatewayapi
+
+import (
+	"context"
+	"reflect"
+	"slices"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+	kube_core "k8s.io/api/core/v1"
+	kube_apierrs "k8s.io/apimachinery/pkg/api/errors"
+	kube_runtime "k8s.io/apimachinery/pkg/runtime"
+	kube_types "k8s.io/apimachinery/pkg/types"
+	kube_ctrl "sigs.k8s.io/controller-runtime"
+	kube_client "sigs.k8s.io/controller-runtime/pkg/client"
+	kube_handler "sigs.k8s.io/controller-runtime/pkg/handler"
+	gatewayapi_v1 "sigs.k8s.io/gateway-api/apis/v1"
+	gatewayapi "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	meshservice_k8s "github.com/kumahq/kuma/v3/pkg/core/resources/apis/meshservice/k8s/v1alpha1"
+	core_model "github.com/kumahq/kuma/v3/pkg/core/resources/model"
+	meshtcproute_api "github.com/kumahq/kuma/v3/pkg/plugins/policies/meshtcproute/api/v1alpha1"
+	k8s_registry "github.com/kumahq/kuma/v3/pkg/plugins/resources/k8s/native/pkg/registry"
+	"github.com/kumahq/kuma/v3/pkg/plugins/runtime/k8s/controllers/gatewayapi/common"
+	k8s_util "github.com/kumahq/kuma/v3/pkg/plugins/runtime/k8s/util"
+)
+
+// TCPRouteReconciler reconciles a GatewayAPI TCPRoute into MeshTCPRoutes.
+type TCPRouteReconciler struct {
+	kube_client.Client
+	Log logr.Logger
+
+	Scheme          *kube_runtime.Scheme
+	TypeRegistry    k8s_registry.TypeRegistry
+	SystemNamespace string
+}
+
+// Reconcile handles transforming a gateway-api TCPRoute into Kuma
+// MeshTCPRoutes and managing the status of the TCPRoute.
+func (r *TCPRouteReconciler) Reconcile(ctx context.Context, req kube_ctrl.Request) (kube_ctrl.Result, error) {
+	r.Log.V(1).Info("reconcile", "req", req)
+	owner := routeOwner(tcpRouteKind, req.NamespacedName)
+	tcpRoute := &gatewayapi_v1.TCPRoute{}
+	if err := r.Get(ctx, req.NamespacedName, tcpRoute); err != nil {
+		if kube_apierrs.IsNotFound(err) {
+			// We don't know the mesh, but we don't need it to delete our
+			// object.
+			if err := common.ReconcileLabelledObject(
+				ctx, r.Log, r.TypeRegistry, r.Client, owner, core_model.NoMesh, &meshtcproute_api.MeshTCPRoute{}, r.SystemNamespace, nil,
+			); err != nil {
+				return kube_ctrl.Result{}, errors.Wrap(err, "could not delete owned MeshTCPRoute.kuma.io")
+			}
+
+			return kube_ctrl.Result{}, nil
+		}
+
+		return kube_ctrl.Result{}, err
+	}
+
+	ns := kube_core.Namespace{}
+	if err := r.Get(ctx, kube_types.NamespacedName{Name: tcpRoute.Namespace}, &ns); err != nil {
+		return kube_ctrl.Result{}, errors.Wrap(err, "unable to get Namespace of TCPRoute")
+	}
+
+	mesh := k8s_util.MeshOfByLabel(tcpRoute, &ns)
+
+	meshRouteSpecs, conditions, err := r.gapiToKumaRoutes(ctx, tcpRoute)
+	if err != nil {
+		return kube_ctrl.Result{}, errors.Wrap(err, "could not generate MeshTCPRoute.kuma.io resources")
+	}
+
+	if err := common.ReconcileLabelledObject(
+		ctx, r.Log, r.TypeRegistry, r.Client, owner, mesh, &meshtcproute_api.MeshTCPRoute{}, r.SystemNamespace, meshRouteSpecs,
+	); err != nil {
+		return kube_ctrl.Result{}, errors.Wrap(err, "could not reconcile owned MeshTCPRoute.kuma.io")
+	}
+
+	updated := tcpRoute.DeepCopy()
+	mergeRouteStatus(&updated.Status.RouteStatus, updated.GetGeneration(), conditions)
+	if err := patchRouteStatus(ctx, r.Client, tcpRoute, updated); err != nil {
+		return kube_ctrl.Result{}, errors.Wrap(err, "unable to update TCPRoute status")
+	}
+
+	return kube_ctrl.Result{}, nil
+}
+
+// gapiToKumaRoutes returns the MeshTCPRoutes that should be created for this
+// TCPRoute along with any statuses to be set on the TCPRoute.
+// Only unexpected errors are returned as error.
+func (r *TCPRouteReconciler) gapiToKumaRoutes(
+	ctx context.Context,
+	route *gatewayapi_v1.TCPRoute,
+) (map[string]core_model.ResourceSpec, ParentConditions, error) {
+	routes := map[string]core_model.ResourceSpec{}
+
+	targets, conditions, err := resolveParentRefs(ctx, r.Client, tcpRouteKind, route, route.Spec.ParentRefs)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(conditions) == 0 {
+		return routes, conditions, nil
+	}
+
+	rules, rulesConditions, err := r.gapiToMeshRules(ctx, route)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, ref := range route.Spec.ParentRefs {
+		if target, ok := targets[ref]; ok {
+			storeMeshTCPRoute(routes, target.Name, toMeshTCPRoute(target.TargetRef, target.To, rules))
+		}
+	}
+	addRulesConditions(conditions, rulesConditions)
+
+	return routes, conditions, nil
+}
+
+// storeMeshTCPRoute stores the route under the given name. Parent refs that
+// differ only by a port or a section name resolve to the same name, so their
+// targets are merged.
+func storeMeshTCPRoute(routes map[string]core_model.ResourceSpec, name string, route *meshtcproute_api.MeshTCPRoute) {
+	existing, ok := routes[name].(*meshtcproute_api.MeshTCPRoute)
+	if !ok {
+		routes[name] = route
+		return
+	}
+
+	merged := *existing.To
+	for _, candidate := range *route.To {
+		if !slices.ContainsFunc(merged, func(to meshtcproute_api.To) bool {
+			return reflect.DeepEqual(to, candidate)
+		}) {
+			merged = append(merged, candidate)
+		}
+	}
+	existing.To = &merged
+}
+
+func (r *TCPRouteReconciler) backendRefs() backendRefResolver {
+	return backendRefResolver{
+		client:    r.Client,
+		routeKind: tcpRouteKind,
+	}
+}
+
+func backendObjectReferencesOfTCPRoute(obj kube_client.Object) []gatewayapi.BackendObjectReference {
+	route := obj.(*gatewayapi_v1.TCPRoute)
+
+	var refs []gatewayapi.BackendObjectReference
+	for _, rule := range route.Spec.Rules {
+		for _, backendRef := range rule.BackendRefs {
+			refs = append(refs, backendRef.BackendObjectReference)
+		}
+	}
+	return refs
+}
+
+// meshServicesOfTCPRoute returns the namespaced names of the MeshServices
+// referenced by the given TCPRoute.
+func meshServicesOfTCPRoute(obj kube_client.Object) []string {
+	route := obj.(*gatewayapi_v1.TCPRoute)
+	return referencedObjectsOfRoute(route.Namespace, route.Spec.ParentRefs, backendObjectReferencesOfTCPRoute(route), isMeshServiceRef, "MeshService")
+}
+
+// servicesOfTCPRoute returns the namespaced names of the Services referenced
+// by the given TCPRoute.
+func servicesOfTCPRoute(obj kube_client.Object) []string {
+	route := obj.(*gatewayapi_v1.TCPRoute)
+	return referencedObjectsOfRoute(route.Namespace, route.Spec.ParentRefs, backendObjectReferencesOfTCPRoute(route), isServiceParentRef, "Service")
+}
+
+func newTCPRouteList() kube_client.ObjectList {
+	return &gatewayapi_v1.TCPRouteList{}
+}
+
+func (r *TCPRouteReconciler) SetupWithManager(mgr kube_ctrl.Manager) error {
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &gatewayapi_v1.TCPRoute{}, meshServicesOfRouteField, meshServicesOfTCPRoute); err != nil {
+		return err
+	}
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &gatewayapi_v1.TCPRoute{}, servicesOfRouteField, servicesOfTCPRoute); err != nil {
+		return err
+	}
+	return kube_ctrl.NewControllerManagedBy(mgr).
+		Named("kuma-tcp-route-controller").
+		For(&gatewayapi_v1.TCPRoute{}).
+		Watches(
+			&kube_core.Service{},
+			kube_handler.EnqueueRequestsFromMapFunc(routesReferencingObject(r.Log.WithName("service-to-routes-mapper"), r.Client, newTCPRouteList, servicesOfRouteField)),
+		).
+		Watches(
+			&meshservice_k8s.MeshService{},
+			kube_handler.EnqueueRequestsFromMapFunc(routesReferencingObject(r.Log.WithName("meshservice-to-routes-mapper"), r.Client, newTCPRouteList, meshServicesOfRouteField)),
+		).
+		Watches(
+			&gatewayapi.ReferenceGrant{},
+			kube_handler.EnqueueRequestsFromMapFunc(routesForReferenceGrantOf(
+				r.Log.WithName("referencegrant-to-routes-mapper"), r.Client, tcpRouteKind, newTCPRouteList, backendObjectReferencesOfTCPRoute,
+			)),
+		).
+		Complete(r)
+}
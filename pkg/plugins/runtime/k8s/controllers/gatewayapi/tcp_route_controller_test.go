@@ -0,0 +1,176 @@
+package gatewayapi
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	kube_core "k8s.io/api/core/v1"
+	kube_apimeta "k8s.io/apimachinery/pkg/api/meta"
+	kube_meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kube_ctrl "sigs.k8s.io/controller-runtime"
+	kube_client "sigs.k8s.io/controller-runtime/pkg/client"
+	kube_client_fake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	gatewayapi_v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	common_api "github.com/kumahq/kuma/v3/api/common/v1alpha1"
+	bootstrap_k8s "github.com/kumahq/kuma/v3/pkg/plugins/bootstrap/k8s"
+	meshtcproute_k8s "github.com/kumahq/kuma/v3/pkg/plugins/policies/meshtcproute/k8s/v1alpha1"
+	k8s_registry "github.com/kumahq/kuma/v3/pkg/plugins/resources/k8s/native/pkg/registry"
+	"github.com/kumahq/kuma/v3/pkg/util/pointer"
+)
+
+var _ = Describe("TCPRouteReconciler.Reconcile", func() {
+	const routeNamespace = "kuma-demo"
+
+	serviceParentRef := func(name string, port int32) gatewayapi_v1.ParentReference {
+		return gatewayapi_v1.ParentReference{
+			Group: pointer.To(gatewayapi_v1.Group("")),
+			Kind:  pointer.To(gatewayapi_v1.Kind("Service")),
+			Name:  gatewayapi_v1.ObjectName(name),
+			Port:  pointer.To(gatewayapi_v1.PortNumber(port)),
+		}
+	}
+
+	backendRef := func(name string, weight int32) gatewayapi_v1.BackendRef {
+		return gatewayapi_v1.BackendRef{
+			BackendObjectReference: gatewayapi_v1.BackendObjectReference{
+				Name: gatewayapi_v1.ObjectName(name),
+				Port: pointer.To(gatewayapi_v1.PortNumber(5432)),
+			},
+			Weight: pointer.To(weight),
+		}
+	}
+
+	newRoute := func(parentRefs []gatewayapi_v1.ParentReference, backendRefs ...gatewayapi_v1.BackendRef) *gatewayapi_v1.TCPRoute {
+		return &gatewayapi_v1.TCPRoute{
+			ObjectMeta: kube_meta.ObjectMeta{Name: "my-route", Namespace: routeNamespace},
+			Spec: gatewayapi_v1.TCPRouteSpec{
+				CommonRouteSpec: gatewayapi_v1.CommonRouteSpec{ParentRefs: parentRefs},
+				Rules:           []gatewayapi_v1.TCPRouteRule{{BackendRefs: backendRefs}},
+			},
+		}
+	}
+
+	db := &kube_core.Service{
+		ObjectMeta: kube_meta.ObjectMeta{Name: "db", Namespace: routeNamespace},
+		Spec: kube_core.ServiceSpec{
+			ClusterIP: "10.0.0.1",
+			Ports: []kube_core.ServicePort{
+				{Name: "postgres", Port: 5432},
+				{Name: "metrics", Port: 9090},
+			},
+		},
+	}
+
+	var reconciler *TCPRouteReconciler
+	var newClient func(objs ...kube_client.Object) kube_client.Client
+
+	BeforeEach(func() {
+		scheme, err := bootstrap_k8s.NewScheme()
+		Expect(err).ToNot(HaveOccurred())
+
+		namespace := &kube_core.Namespace{ObjectMeta: kube_meta.ObjectMeta{Name: routeNamespace}}
+
+		newClient = func(objs ...kube_client.Object) kube_client.Client {
+			return kube_client_fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithStatusSubresource(&gatewayapi_v1.TCPRoute{}).
+				WithObjects(append([]kube_client.Object{namespace}, objs...)...).
+				Build()
+		}
+
+		reconciler = &TCPRouteReconciler{
+			Log:             logr.Discard(),
+			TypeRegistry:    k8s_registry.Global(),
+			SystemNamespace: "kuma-system",
+		}
+	})
+
+	It("generates a MeshTCPRoute for the parentRef port", func() {
+		canary := db.DeepCopy()
+		canary.Name = "db-canary"
+		route := newRoute(
+			[]gatewayapi_v1.ParentReference{serviceParentRef("db", 5432)},
+			backendRef("db", 90), backendRef("db-canary", 10),
+		)
+
+		client := newClient(db, canary, route)
+		reconciler.Client = client
+
+		_, err := reconciler.Reconcile(context.Background(), kube_ctrl.Request{
+			NamespacedName: kube_client.ObjectKeyFromObject(route),
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		routes := &meshtcproute_k8s.MeshTCPRouteList{}
+		Expect(client.List(context.Background(), routes)).To(Succeed())
+		Expect(routes.Items).To(HaveLen(1))
+		Expect(routes.Items[0].Name).To(Equal("my-route-kuma-demo-tcproute-db.kuma-demo"))
+
+		spec := routes.Items[0].Spec
+		Expect(spec).ToNot(BeNil())
+		Expect(spec.TargetRef.Kind).To(Equal(common_api.TopLevelTargetRefKindMesh))
+		Expect(*spec.To).To(HaveLen(1))
+		to := (*spec.To)[0]
+		Expect(*to.TargetRef.SectionName).To(Equal("postgres"))
+		Expect(to.Rules).To(HaveLen(1))
+		backendRefs := *to.Rules[0].Default.BackendRefs
+		Expect(backendRefs).To(HaveLen(2))
+		Expect(*backendRefs[0].Weight).To(Equal(uint(90)))
+		Expect(*backendRefs[1].Weight).To(Equal(uint(10)))
+
+		var updatedRoute gatewayapi_v1.TCPRoute
+		Expect(client.Get(context.Background(), kube_client.ObjectKeyFromObject(route), &updatedRoute)).To(Succeed())
+		Expect(updatedRoute.Status.Parents).To(HaveLen(1))
+		resolvedRefs := kube_apimeta.FindStatusCondition(updatedRoute.Status.Parents[0].Conditions, string(gatewayapi_v1.RouteConditionResolvedRefs))
+		Expect(resolvedRefs).ToNot(BeNil())
+		Expect(resolvedRefs.Status).To(Equal(kube_meta.ConditionTrue))
+	})
+
+	It("merges parentRefs to different ports of the same Service", func() {
+		route := newRoute(
+			[]gatewayapi_v1.ParentReference{serviceParentRef("db", 5432), serviceParentRef("db", 9090)},
+			backendRef("db", 1),
+		)
+
+		client := newClient(db, route)
+		reconciler.Client = client
+
+		_, err := reconciler.Reconcile(context.Background(), kube_ctrl.Request{
+			NamespacedName: kube_client.ObjectKeyFromObject(route),
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		routes := &meshtcproute_k8s.MeshTCPRouteList{}
+		Expect(client.List(context.Background(), routes)).To(Succeed())
+		Expect(routes.Items).To(HaveLen(1))
+		Expect(*routes.Items[0].Spec.To).To(HaveLen(2))
+		Expect(*(*routes.Items[0].Spec.To)[0].TargetRef.SectionName).To(Equal("postgres"))
+		Expect(*(*routes.Items[0].Spec.To)[1].TargetRef.SectionName).To(Equal("metrics"))
+	})
+
+	It("reports ResolvedRefs=False when a backend does not exist", func() {
+		route := newRoute(
+			[]gatewayapi_v1.ParentReference{serviceParentRef("db", 5432)},
+			backendRef("missing", 1),
+		)
+
+		client := newClient(db, route)
+		reconciler.Client = client
+
+		_, err := reconciler.Reconcile(context.Background(), kube_ctrl.Request{
+			NamespacedName: kube_client.ObjectKeyFromObject(route),
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		var updatedRoute gatewayapi_v1.TCPRoute
+		Expect(client.Get(context.Background(), kube_client.ObjectKeyFromObject(route), &updatedRoute)).To(Succeed())
+		Expect(updatedRoute.Status.Parents).To(HaveLen(1))
+		resolvedRefs := kube_apimeta.FindStatusCondition(updatedRoute.Status.Parents[0].Conditions, string(gatewayapi_v1.RouteConditionResolvedRefs))
+		Expect(resolvedRefs).ToNot(BeNil())
+		Expect(resolvedRefs.Status).To(Equal(kube_meta.ConditionFalse))
+		Expect(resolvedRefs.Reason).To(Equal(string(gatewayapi_v1.RouteReasonBackendNotFound)))
+	})
+})
@@ -0,0 +1,71 @@
+package gatewayapi
+
+import (
+	"context"
+
+	kube_meta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayapi_v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	common_api "github.com/kumahq/kuma/v3/api/common/v1alpha1"
+	meshtcproute_api "github.com/kumahq/kuma/v3/pkg/plugins/policies/meshtcproute/api/v1alpha1"
+	"github.com/kumahq/kuma/v3/pkg/util/pointer"
+)
+
+// gapiToMeshRules converts the rules of a TCPRoute. TCPRoute allows exactly
+// one rule, but we don't depend on the API server validation here.
+func (r *TCPRouteReconciler) gapiToMeshRules(
+	ctx context.Context,
+	route *gatewayapi_v1.TCPRoute,
+) ([]meshtcproute_api.Rule, []kube_meta.Condition, error) {
+	var rules []meshtcproute_api.Rule
+	var conditions []kube_meta.Condition
+
+	for _, rule := range route.Spec.Rules {
+		var backendRefs []common_api.BackendRef
+
+		for _, gapiBackendRef := range rule.BackendRefs {
+			ref, refCondition, err := r.backendRefs().resolve(ctx, route.Namespace, gapiBackendRef.BackendObjectReference)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			refCondition.AddIfFalseAndNotPresent(&conditions)
+			if refCondition.preventsBackendTarget() {
+				continue
+			}
+
+			backendRefs = append(backendRefs, common_api.BackendRef{
+				TargetRef: ref,
+				Weight:    pointer.To(uint(pointer.DerefOr(gapiBackendRef.Weight, 1))),
+			})
+		}
+
+		rules = append(rules, meshtcproute_api.Rule{
+			Default: meshtcproute_api.RuleConf{
+				BackendRefs: &backendRefs,
+			},
+		})
+	}
+
+	return rules, conditions, nil
+}
+
+// toMeshTCPRoute returns the MeshTCPRoute that applies the rules to every
+// targeted port of the parent.
+func toMeshTCPRoute(
+	targetRef common_api.TopLevelTargetRef,
+	targets []common_api.OutboundTargetRef,
+	rules []meshtcproute_api.Rule,
+) *meshtcproute_api.MeshTCPRoute {
+	var tos []meshtcproute_api.To
+	for _, target := range targets {
+		tos = append(tos, meshtcproute_api.To{
+			TargetRef: target,
+			Rules:     rules,
+		})
+	}
+	return &meshtcproute_api.MeshTCPRoute{
+		TargetRef: &targetRef,
+		To:        &tos,
+	}
+}
@@ -269,7 +269,7 @@ func addValidators(mgr kube_ctrl.Manager, rt core_runtime.Runtime, converter k8s
 		SystemNamespace:              rt.Config().Store.Kubernetes.SystemNamespace,
 		ZoneName:                     rt.Config().Multizone.Zone.Name,
 	}
-	handler := k8s_webhooks.NewValidatingWebhook(converter, core_registry.Global(), k8s_registry.Global(), resourceAdmissionChecker)
+	handler := k8s_webhooks.NewValidatingWebhook(converter, core_registry.Global(), k8s_registry.Global(), resourceAdmissionChecker, rt.AuditLog())
 	composite.AddValidator(handler)
 
 	k8sMeshValidator := k8s_webhooks.NewMeshValidatorWebhook(rt.ResourceValidators().Mesh, converter, rt.Config().Store.UnsafeDelete)
@@ -279,7 +279,7 @@ func addValidators(mgr kube_ctrl.Manager, rt core_runtime.Runtime, converter k8s
 	composite.AddValidator(k8sDataplaneValidator)
 
 	coreZoneValidator := zone.Validator{Store: rt.ResourceStore()}
-	k8sZoneValidator := k8s_webhooks.NewZoneValidatorWebhook(coreZoneValidator, rt.Config().Store.UnsafeDelete)
+	k8sZoneValidator := k8s_webhooks.NewZoneValidatorWebhook(coreZoneValidator, converter, rt.Config().Store.UnsafeDelete)
 	composite.AddValidator(k8sZoneValidator)
 
 	composite.AddValidator(&k8s_webhooks.ContainerPatchValidator{
@@ -48,15 +48,19 @@ func gatewayAPICRDsPresent(mgr kube_ctrl.Manager) (bool, []string) {
 }
 
 func gatewayAPICRDPresent(mgr kube_ctrl.Manager, kind string) bool {
+	return gatewayAPICRDVersionPresent(mgr, kind, gatewayapi.GroupVersion.Version)
+}
+
+// gatewayAPICRDVersionPresent checks that the CRD of the kind serves the
+// given version. GRPCRoute and TCPRoute are only served as v1 by
+// the supported Gateway API release.
+func gatewayAPICRDVersionPresent(mgr kube_ctrl.Manager, kind string, version string) bool {
 	gk := schema.GroupKind{
 		Group: gatewayapi.GroupVersion.Group,
 		Kind:  kind,
 	}
 
-	mappings, _ := mgr.GetClient().RESTMapper().RESTMappings(
-		gk,
-		gatewayapi.GroupVersion.Version,
-	)
+	mappings, _ := mgr.GetClient().RESTMapper().RESTMappings(gk, version)
 
 	return len(mappings) > 0
 }
@@ -94,6 +98,36 @@ func addGatewayAPIReconcilers(mgr kube_ctrl.Manager, rt core_runtime.Runtime) er
 		return errors.Wrap(err, "could not setup Gateway API HTTPRoute reconciler")
 	}
 
+	if gatewayAPICRDVersionPresent(mgr, "GRPCRoute", gatewayapi_v1.GroupVersion.Version) {
+		gatewayAPIGRPCRouteReconciler := &gatewayapi_controllers.GRPCRouteReconciler{
+			Client:          mgr.GetClient(),
+			Log:             core.Log.WithName("controllers").WithName("gatewayapi").WithName("GRPCRoute"),
+			Scheme:          mgr.GetScheme(),
+			TypeRegistry:    k8s_registry.Global(),
+			SystemNamespace: rt.Config().Store.Kubernetes.SystemNamespace,
+		}
+		if err := gatewayAPIGRPCRouteReconciler.SetupWithManager(mgr); err != nil {
+			return errors.Wrap(err, "could not setup Gateway API GRPCRoute reconciler")
+		}
+	} else {
+		log.Info("GatewayAPI GRPCRoute CRD is not registered. Disabling GRPCRoute support")
+	}
+
+	if gatewayAPICRDVersionPresent(mgr, "TCPRoute", gatewayapi_v1.GroupVersion.Version) {
+		gatewayAPITCPRouteReconciler := &gatewayapi_controllers.TCPRouteReconciler{
+			Client:          mgr.GetClient(),
+			Log:             core.Log.WithName("controllers").WithName("gatewayapi").WithName("TCPRoute"),
+			Scheme:          mgr.GetScheme(),
+			TypeRegistry:    k8s_registry.Global(),
+			SystemNamespace: rt.Config().Store.Kubernetes.SystemNamespace,
+		}
+		if err := gatewayAPITCPRouteReconciler.SetupWithManager(mgr); err != nil {
+			return errors.Wrap(err, "could not setup Gateway API TCPRoute reconciler")
+		}
+	} else {
+		log.Info("GatewayAPI TCPRoute CRD is not registered. Disabling TCPRoute support")
+	}
+
 	return nil
 }
 
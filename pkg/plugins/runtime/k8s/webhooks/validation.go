@@ -12,6 +12,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	mesh_proto "github.com/kumahq/kuma/v3/api/mesh/v1alpha1"
+	"github.com/kumahq/kuma/v3/pkg/core/audit"
 	core_mesh "github.com/kumahq/kuma/v3/pkg/core/resources/apis/mesh"
 	core_model "github.com/kumahq/kuma/v3/pkg/core/resources/model"
 	core_registry "github.com/kumahq/kuma/v3/pkg/core/resources/registry"
@@ -28,12 +29,14 @@ func NewValidatingWebhook(
 	coreRegistry core_registry.TypeRegistry,
 	k8sRegistry k8s_registry.TypeRegistry,
 	checker ResourceAdmissionChecker,
+	auditLog audit.Log,
 ) k8s_common.AdmissionValidator {
 	return &validatingHandler{
 		coreRegistry:             coreRegistry,
 		k8sRegistry:              k8sRegistry,
 		converter:                converter,
 		ResourceAdmissionChecker: checker,
+		auditLog:                 auditLog,
 	}
 }
 
@@ -44,6 +47,7 @@ type validatingHandler struct {
 	k8sRegistry  k8s_registry.TypeRegistry
 	converter    k8s_common.Converter
 	decoder      admission.Decoder
+	auditLog     audit.Log
 }
 
 func (h *validatingHandler) InjectDecoder(d admission.Decoder) {
@@ -65,6 +69,14 @@ func (h *validatingHandler) Handle(_ context.Context, req admission.Request) adm
 		return resp
 	}
 
+	resp := h.validate(req, coreRes, k8sObj)
+	if resp.Allowed {
+		h.record(req, coreRes)
+	}
+	return resp
+}
+
+func (h *validatingHandler) validate(req admission.Request, coreRes core_model.Resource, k8sObj k8s_model.KubernetesObject) admission.Response {
 	switch req.Operation {
 	case v1.Delete:
 		return admission.Allowed("")
@@ -97,6 +109,58 @@ func (h *validatingHandler) Handle(_ context.Context, req admission.Request) adm
 	}
 }
 
+// record adds changes made by users directly through the Kubernetes API to the audit log.
+// The webhook doesn't know whether the change is persisted, so the entry is an admission attempt (see audit.SourceK8sAdmission).
+// Changes made by privileged users, like the control plane itself, are recorded by the store when they come from the API server or KDS.
+func (h *validatingHandler) record(req admission.Request, coreRes core_model.Resource) {
+	if h.auditLog == nil || (req.DryRun != nil && *req.DryRun) || h.isPrivilegedUser(h.AllowedUsers, req.UserInfo) {
+		return
+	}
+	entry := audit.Entry{
+		User:   req.UserInfo.Username,
+		Groups: req.UserInfo.Groups,
+		Source: audit.SourceK8sAdmission,
+		Type:   coreRes.Descriptor().Name,
+		Mesh:   coreRes.GetMeta().GetMesh(),
+		Name:   coreRes.GetMeta().GetName(),
+	}
+	switch req.Operation {
+	case v1.Create:
+		entry.Operation = audit.OperationCreate
+		entry.AfterHash = audit.HashSpec(coreRes.GetSpec())
+	case v1.Update:
+		entry.Operation = audit.OperationUpdate
+		entry.AfterHash = audit.HashSpec(coreRes.GetSpec())
+		if old, err := h.decodeOld(req); err == nil {
+			entry.BeforeHash = audit.HashSpec(old.GetSpec())
+		}
+	case v1.Delete:
+		entry.Operation = audit.OperationDelete
+		entry.BeforeHash = audit.HashSpec(coreRes.GetSpec())
+	default:
+		return
+	}
+	h.auditLog.Record(entry)
+}
+
+func (h *validatingHandler) decodeOld(req admission.Request) (core_model.Resource, error) {
+	coreRes, err := h.coreRegistry.NewObject(core_model.ResourceType(req.Kind.Kind))
+	if err != nil {
+		return nil, err
+	}
+	k8sObj, err := h.k8sRegistry.NewObject(coreRes.GetSpec())
+	if err != nil {
+		return nil, err
+	}
+	if err := h.decoder.DecodeRaw(req.OldObject, k8sObj); err != nil {
+		return nil, err
+	}
+	if err := h.converter.ToCoreResource(k8sObj, coreRes); err != nil {
+		return nil, err
+	}
+	return coreRes, nil
+}
+
 func (h *validatingHandler) decode(req admission.Request) (core_model.Resource, k8s_model.KubernetesObject, error) {
 	coreRes, err := h.coreRegistry.NewObject(core_model.ResourceType(req.Kind.Kind))
 	if err != nil {
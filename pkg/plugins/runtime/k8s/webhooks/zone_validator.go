@@ -8,28 +8,37 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	"github.com/kumahq/kuma/v3/pkg/core/managers/apis/zone"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/v3/pkg/core/validators"
 	k8s_common "github.com/kumahq/kuma/v3/pkg/plugins/common/k8s"
 	mesh_k8s "github.com/kumahq/kuma/v3/pkg/plugins/resources/k8s/native/api/v1alpha1"
 )
 
-func NewZoneValidatorWebhook(validator zone.Validator, unsafeDelete bool) k8s_common.AdmissionValidator {
+func NewZoneValidatorWebhook(validator zone.Validator, converter k8s_common.Converter, unsafeDelete bool) k8s_common.AdmissionValidator {
 	return &ZoneValidator{
 		validator:    validator,
+		converter:    converter,
 		unsafeDelete: unsafeDelete,
 	}
 }
 
 type ZoneValidator struct {
 	validator    zone.Validator
+	converter    k8s_common.Converter
+	decoder      admission.Decoder
 	unsafeDelete bool
 }
 
-func (z *ZoneValidator) InjectDecoder(admission.Decoder) {
+func (z *ZoneValidator) InjectDecoder(d admission.Decoder) {
+	z.decoder = d
 }
 
 func (z *ZoneValidator) Handle(ctx context.Context, req admission.Request) admission.Response {
-	if req.Operation == v1.Delete {
+	switch req.Operation {
+	case v1.Delete:
 		return z.ValidateDelete(ctx, req)
+	case v1.Update:
+		return z.ValidateUpdate(ctx, req)
 	}
 	return admission.Allowed("")
 }
@@ -43,6 +52,39 @@ func (z *ZoneValidator) ValidateDelete(ctx context.Context, req admission.Reques
 	return admission.Allowed("")
 }
 
+// ValidateUpdate is skipped like the validation of delete, because removing a mesh from the sync
+// deletes its resources from the zone.
+func (z *ZoneValidator) ValidateUpdate(ctx context.Context, req admission.Request) admission.Response {
+	if z.unsafeDelete {
+		return admission.Allowed("")
+	}
+	coreRes := system.NewZoneResource()
+	k8sRes := &mesh_k8s.Zone{}
+	if err := z.decoder.DecodeRaw(req.Object, k8sRes); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+	if err := z.converter.ToCoreResource(k8sRes, coreRes); err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+
+	oldCoreRes := system.NewZoneResource()
+	oldK8sRes := &mesh_k8s.Zone{}
+	if err := z.decoder.DecodeRaw(req.OldObject, oldK8sRes); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+	if err := z.converter.ToCoreResource(oldK8sRes, oldCoreRes); err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+
+	if err := z.validator.ValidateUpdate(ctx, oldCoreRes, coreRes); err != nil {
+		if kumaErr, ok := err.(*validators.ValidationError); ok {
+			return convertSpecValidationError(kumaErr, false, k8sRes)
+		}
+		return admission.Denied(err.Error())
+	}
+	return admission.Allowed("")
+}
+
 func (z *ZoneValidator) Supports(req admission.Request) bool {
 	gvk := mesh_k8s.GroupVersion.WithKind("Zone")
 	return req.Kind.Kind == gvk.Kind && req.Kind.Version == gvk.Version && req.Kind.Group == gvk.Group
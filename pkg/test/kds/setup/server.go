@@ -13,6 +13,7 @@ import (
 	config_core "github.com/kumahq/kuma/v3/pkg/config/core"
 	config_types "github.com/kumahq/kuma/v3/pkg/config/types"
 	"github.com/kumahq/kuma/v3/pkg/core"
+	"github.com/kumahq/kuma/v3/pkg/core/audit"
 	config_manager "github.com/kumahq/kuma/v3/pkg/core/config/manager"
 	"github.com/kumahq/kuma/v3/pkg/core/datasource"
 	"github.com/kumahq/kuma/v3/pkg/core/dns/lookup"
@@ -195,6 +196,10 @@ func (t *testRuntimeContext) EventBus() events.EventBus {
 	return t.eventBus
 }
 
+func (t *testRuntimeContext) AuditLog() audit.Log {
+	return audit.NoopLog{}
+}
+
 func (t *testRuntimeContext) Extensions() context.Context {
 	return context.Background()
 }
@@ -300,6 +305,6 @@ func (b *KdsServerBuilder) Delta() (delta.Server, error) {
 	if b.rt.Config().Mode == config_core.Zone {
 		watchdogCfg = b.rt.Config().Multizone.Zone.KDS.EventBasedWatchdog.AsRuntimeConfig()
 	}
-	srv, _, err := kds_server.New(core.Log.WithName("kds-delta").WithName(b.rt.GetMode()), b.rt, b.providedTypes, b.rt.Config().Multizone.Zone.Name, b.providedFilter, b.providedMapper, 1*time.Second, watchdogCfg)
+	srv, _, err := kds_server.New(core.Log.WithName("kds-delta").WithName(b.rt.GetMode()), b.rt, b.providedTypes, b.rt.Config().Multizone.Zone.Name, b.providedFilter, b.providedMapper, nil, 1*time.Second, watchdogCfg)
 	return srv, err
 }
@@ -61,6 +61,29 @@ func (m *MeshExternalServiceBuilder) WithKumaVIP(vip string) *MeshExternalServic
 
 func (m *MeshExternalServiceBuilder) WithoutVIP() *MeshExternalServiceBuilder {
 	m.res.Status.VIP.IP = ""
+	m.res.Status.VIPs = nil
+	return m
+}
+
+func (m *MeshExternalServiceBuilder) WithWildcardHostname(hostname string) *MeshExternalServiceBuilder {
+	m.res.Spec.Match.Type = v1alpha1.WildcardHostnameType
+	m.res.Spec.Match.Hostname = &hostname
+	return m
+}
+
+func (m *MeshExternalServiceBuilder) WithPortRange(port, endPort int32) *MeshExternalServiceBuilder {
+	m.res.Spec.Match.Port = port
+	m.res.Spec.Match.EndPort = &endPort
+	return m
+}
+
+func (m *MeshExternalServiceBuilder) WithProtocol(protocol core_meta.Protocol) *MeshExternalServiceBuilder {
+	m.res.Spec.Match.Protocol = protocol
+	return m
+}
+
+func (m *MeshExternalServiceBuilder) WithTLSOrigination() *MeshExternalServiceBuilder {
+	m.res.Spec.Tls = &v1alpha1.Tls{Enabled: true}
 	return m
 }
 
@@ -153,6 +153,48 @@ func (mi *MeshIdentityBuilder) WithBundled() *MeshIdentityBuilder {
 	return mi
 }
 
+func (mi *MeshIdentityBuilder) WithVault(address string, role string, token string) *MeshIdentityBuilder {
+	mi.res.Spec.Provider = &meshidentity_api.Provider{
+		Type: meshidentity_api.VaultType,
+		Vault: &meshidentity_api.Vault{
+			Address: address,
+			PKI: meshidentity_api.VaultPKI{
+				Role: role,
+			},
+			Auth: meshidentity_api.VaultAuth{
+				Token: &datasource.SecureDataSource{
+					Type:           datasource.SecureDataSourceInline,
+					InsecureInline: &datasource.Inline{Value: token},
+				},
+			},
+			CertificateParameters: &meshidentity_api.CertificateParameters{
+				Expiry: &v1.Duration{
+					Duration: 99 * time.Minute,
+				},
+			},
+		},
+	}
+	return mi
+}
+
+func (mi *MeshIdentityBuilder) WithCertManager(kind meshidentity_api.CertManagerIssuerKind, name string) *MeshIdentityBuilder {
+	mi.res.Spec.Provider = &meshidentity_api.Provider{
+		Type: meshidentity_api.CertManagerType,
+		CertManager: &meshidentity_api.CertManager{
+			IssuerRef: meshidentity_api.CertManagerIssuerRef{
+				Name: name,
+				Kind: pointer.To(kind),
+			},
+			CertificateParameters: &meshidentity_api.CertificateParameters{
+				Expiry: &v1.Duration{
+					Duration: 99 * time.Minute,
+				},
+			},
+		},
+	}
+	return mi
+}
+
 func (mi *MeshIdentityBuilder) WithInitializedStatus() *MeshIdentityBuilder {
 	mi.res.Status = &meshidentity_api.MeshIdentityStatus{
 		Conditions: []common_api.Condition{
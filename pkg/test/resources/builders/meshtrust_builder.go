@@ -92,6 +92,24 @@ func (mtr *MeshTrustBuilder) WithCA(ca string) *MeshTrustBuilder {
 	return mtr
 }
 
+func (mtr *MeshTrustBuilder) WithMeshTrustRef(mesh, name string) *MeshTrustBuilder {
+	mtr.res.Spec.CABundles = append(mtr.res.Spec.CABundles, meshtrust_api.CABundle{
+		Type: meshtrust_api.MeshTrustRefCABundleType,
+		MeshTrustRef: &meshtrust_api.MeshTrustRef{
+			Mesh: mesh,
+			Name: name,
+		},
+	})
+	return mtr
+}
+
+func (mtr *MeshTrustBuilder) WithExport(meshes ...string) *MeshTrustBuilder {
+	mtr.res.Spec.Export = &meshtrust_api.Export{
+		Meshes: meshes,
+	}
+	return mtr
+}
+
 func (mtr *MeshTrustBuilder) WithLabels(labels map[string]string) *MeshTrustBuilder {
 	if mtr.res.Meta.(*test_model.ResourceMeta).Labels == nil {
 		mtr.res.Meta.(*test_model.ResourceMeta).Labels = map[string]string{}
@@ -16,6 +16,7 @@ import (
 	dp_server "github.com/kumahq/kuma/v3/pkg/config/dp-server"
 	"github.com/kumahq/kuma/v3/pkg/core"
 	"github.com/kumahq/kuma/v3/pkg/core/access"
+	"github.com/kumahq/kuma/v3/pkg/core/audit"
 	config_manager "github.com/kumahq/kuma/v3/pkg/core/config/manager"
 	"github.com/kumahq/kuma/v3/pkg/core/datasource"
 	"github.com/kumahq/kuma/v3/pkg/core/managers/apis/dataplane"
@@ -93,6 +94,7 @@ func BuilderFor(appCtx context.Context, cfg kuma_cp.Config) (*core_runtime.Build
 		return nil, err
 	}
 	builder.WithEventBus(eventBus)
+	builder.WithAuditLog(audit.NoopLog{})
 	builder.WithAPIManager(customization.NewAPIList())
 	xdsCtx, err := xds_runtime.WithDefaults(builder)
 	if err != nil {
@@ -237,6 +239,8 @@ type TestRuntime struct {
 	tokenIssuers         tokens_builtin.TokenIssuers
 	globalInsightService globalinsight.GlobalInsightService
 	certWatchers         *util_tls.Watchers
+	eventBus             events.EventBus
+	auditLog             audit.Log
 }
 
 func NewTestRuntime(
@@ -260,6 +264,33 @@ func NewTestRuntime(
 	}
 }
 
+// WithEventBus sets the event bus returned by the runtime, it's nil by default.
+func (r *TestRuntime) WithEventBus(eventBus events.EventBus) *TestRuntime {
+	r.eventBus = eventBus
+	return r
+}
+
+func (r *TestRuntime) EventBus() events.EventBus {
+	return r.eventBus
+}
+
+// WithAuditLog sets the audit log returned by the runtime, it's a noop log by default.
+func (r *TestRuntime) WithAuditLog(auditLog audit.Log) *TestRuntime {
+	r.auditLog = auditLog
+	return r
+}
+
+func (r *TestRuntime) AuditLog() audit.Log {
+	if r.auditLog == nil {
+		return audit.NoopLog{}
+	}
+	return r.auditLog
+}
+
+func (r *TestRuntime) Transactions() store.Transactions {
+	return store.NoTransactions{}
+}
+
 func (r *TestRuntime) GetInstanceId() string {
 	return "instance-id"
 }
@@ -0,0 +1,35 @@
+package builtin
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/kumahq/kuma/v3/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/model"
+)
+
+type TokenType = string
+
+const (
+	DataplaneTokenType TokenType = "dataplane"
+	ZoneTokenType      TokenType = "zone"
+	UserTokenType      TokenType = "user"
+)
+
+var TokenTypes = []TokenType{DataplaneTokenType, ZoneTokenType, UserTokenType}
+
+// RevocationKey returns the key of the secret that holds revocations of the given token type.
+func RevocationKey(tokenType TokenType, meshName string) (model.ResourceKey, error) {
+	switch tokenType {
+	case DataplaneTokenType:
+		if meshName == "" {
+			return model.ResourceKey{}, errors.New("mesh has to be defined for dataplane tokens")
+		}
+		return model.ResourceKey{Name: system.DataplaneTokenRevocations(meshName)}, nil
+	case ZoneTokenType:
+		return model.ResourceKey{Name: system.ZoneTokenRevocations}, nil
+	case UserTokenType:
+		return model.ResourceKey{Name: system.UserTokenRevocations}, nil
+	default:
+		return model.ResourceKey{}, errors.Errorf("unsupported token type %q, supported types: %v", tokenType, TokenTypes)
+	}
+}
@@ -0,0 +1,150 @@
+package server
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/emicklei/go-restful/v3"
+	"github.com/golang-jwt/jwt/v5"
+
+	config_store "github.com/kumahq/kuma/v3/pkg/config/core/resources/store"
+	"github.com/kumahq/kuma/v3/pkg/core"
+	resources_access "github.com/kumahq/kuma/v3/pkg/core/resources/access"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/model"
+	"github.com/kumahq/kuma/v3/pkg/core/rest/errors"
+	"github.com/kumahq/kuma/v3/pkg/core/tokens"
+	"github.com/kumahq/kuma/v3/pkg/core/user"
+	"github.com/kumahq/kuma/v3/pkg/core/validators"
+	"github.com/kumahq/kuma/v3/pkg/tokens/builtin"
+	"github.com/kumahq/kuma/v3/pkg/tokens/builtin/server/types"
+)
+
+type revocationsWebService struct {
+	resManager     manager.ResourceManager
+	resourceAccess resources_access.ResourceAccess
+	upsertCfg      config_store.UpsertConfig
+	now            func() time.Time
+}
+
+// NewRevocationsWebservice exposes revocation lists of dataplane, zone and user tokens.
+// Revocations are stored in secrets, so the caller needs the same access as to manage GlobalSecrets.
+func NewRevocationsWebservice(
+	basePath string,
+	resManager manager.ResourceManager,
+	resourceAccess resources_access.ResourceAccess,
+	upsertCfg config_store.UpsertConfig,
+) *restful.WebService {
+	ws := revocationsWebService{
+		resManager:     resManager,
+		resourceAccess: resourceAccess,
+		upsertCfg:      upsertCfg,
+		now:            core.Now,
+	}
+	webservice := new(restful.WebService).
+		Consumes(restful.MIME_JSON).
+		Produces(restful.MIME_JSON).
+		Path(basePath + "/revocations")
+	webservice.
+		Route(webservice.POST("").To(ws.revoke)).
+		Route(webservice.GET("").To(ws.list).
+			Param(webservice.QueryParameter("type", "type of the token: dataplane, zone or user").Required(true)).
+			Param(webservice.QueryParameter("mesh", "mesh of the dataplane token")))
+	return webservice
+}
+
+func (r *revocationsWebService) revoke(request *restful.Request, response *restful.Response) {
+	ctx := request.Request.Context()
+	revReq := types.TokenRevocationRequest{}
+	if err := request.ReadEntity(&revReq); err != nil {
+		log.Error(err, "Could not read a request")
+		response.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	verr := validators.ValidationError{}
+	key, err := builtin.RevocationKey(revReq.Type, revReq.Mesh)
+	if err != nil {
+		verr.AddViolation("type", err.Error())
+	}
+	revocation := tokens.Revocation{
+		ID:        revReq.ID,
+		Reason:    revReq.Reason,
+		ExpiresAt: revReq.ExpiresAt,
+	}
+	switch {
+	case revReq.Token != "" && revReq.ID != "":
+		verr.AddViolation("id", "cannot be defined together with token")
+	case revReq.Token != "":
+		claims := &jwt.RegisteredClaims{}
+		if _, _, err := jwt.NewParser().ParseUnverified(revReq.Token, claims); err != nil {
+			verr.AddViolation("token", "is not a valid token: "+err.Error())
+		} else if claims.ID == "" {
+			verr.AddViolation("token", "does not have an ID")
+		}
+		revocation.ID = claims.ID
+		if claims.ExpiresAt != nil {
+			revocation.ExpiresAt = &claims.ExpiresAt.Time
+		}
+	case revReq.ID == "":
+		verr.AddViolation("id", "either id or token has to be defined")
+	}
+	if verr.HasViolations() {
+		errors.HandleError(ctx, response, verr.OrNil(), "Invalid request")
+		return
+	}
+
+	if err := r.resourceAccess.ValidateUpdate(ctx, key, nil, nil, descriptor(key), user.FromCtx(ctx)); err != nil {
+		errors.HandleError(ctx, response, err, "Could not revoke a token")
+		return
+	}
+
+	revokedAt := r.now()
+	revocation.RevokedAt = &revokedAt
+	if err := tokens.NewRevocationManager(r.resManager, key, r.upsertCfg).Revoke(ctx, revocation); err != nil {
+		errors.HandleError(ctx, response, err, "Could not revoke a token")
+		return
+	}
+	if err := response.WriteAsJson(revocation); err != nil {
+		log.Error(err, "Could not write a response")
+	}
+}
+
+func (r *revocationsWebService) list(request *restful.Request, response *restful.Response) {
+	ctx := request.Request.Context()
+	key, err := builtin.RevocationKey(request.QueryParameter("type"), request.QueryParameter("mesh"))
+	if err != nil {
+		verr := validators.ValidationError{}
+		verr.AddViolation("type", err.Error())
+		errors.HandleError(ctx, response, verr.OrNil(), "Invalid request")
+		return
+	}
+
+	if err := r.resourceAccess.ValidateGet(ctx, key, descriptor(key), user.FromCtx(ctx)); err != nil {
+		errors.HandleError(ctx, response, err, "Could not list revocations")
+		return
+	}
+
+	revocations, err := tokens.NewRevocationManager(r.resManager, key, r.upsertCfg).List(ctx)
+	if err != nil {
+		errors.HandleError(ctx, response, err, "Could not list revocations")
+		return
+	}
+	if revocations == nil {
+		revocations = []tokens.Revocation{}
+	}
+	if err := response.WriteAsJson(types.TokenRevocationList{
+		Items: revocations,
+		Total: len(revocations),
+	}); err != nil {
+		log.Error(err, "Could not write a response")
+	}
+}
+
+func descriptor(key model.ResourceKey) model.ResourceTypeDescriptor {
+	if key.Mesh == "" {
+		return system.GlobalSecretResourceTypeDescriptor
+	}
+	return system.SecretResourceTypeDescriptor
+}
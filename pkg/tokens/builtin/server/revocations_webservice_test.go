@@ -0,0 +1,167 @@
+package server_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/emicklei/go-restful/v3"
+	"github.com/golang-jwt/jwt/v5"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	config_access "github.com/kumahq/kuma/v3/pkg/config/access"
+	config_store "github.com/kumahq/kuma/v3/pkg/config/core/resources/store"
+	resources_access "github.com/kumahq/kuma/v3/pkg/core/resources/access"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/manager"
+	core_model "github.com/kumahq/kuma/v3/pkg/core/resources/model"
+	core_store "github.com/kumahq/kuma/v3/pkg/core/resources/store"
+	"github.com/kumahq/kuma/v3/pkg/core/tokens"
+	"github.com/kumahq/kuma/v3/pkg/core/user"
+	"github.com/kumahq/kuma/v3/pkg/plugins/resources/memory"
+	"github.com/kumahq/kuma/v3/pkg/tokens/builtin/server"
+	"github.com/kumahq/kuma/v3/pkg/tokens/builtin/server/types"
+)
+
+var _ = Describe("Token Revocations Webservice", func() {
+	var url string
+	var store core_store.ResourceStore
+
+	startServer := func(allowedGroup string) {
+		store = memory.NewStore()
+		ws := server.NewRevocationsWebservice(
+			"/tokens",
+			manager.NewResourceManager(store),
+			resources_access.NewAdminResourceAccess(config_access.AdminResourcesStaticAccessConfig{
+				Groups: []string{allowedGroup},
+			}),
+			config_store.DefaultUpsertConfig(),
+		)
+		container := restful.NewContainer()
+		container.Add(ws)
+		srv := httptest.NewServer(container)
+		DeferCleanup(srv.Close)
+		url = srv.URL
+	}
+
+	revoke := func(revReq types.TokenRevocationRequest) *http.Response {
+		reqBytes, err := json.Marshal(revReq)
+		Expect(err).ToNot(HaveOccurred())
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, fmt.Sprintf("%s/tokens/revocations", url), bytes.NewReader(reqBytes))
+		Expect(err).ToNot(HaveOccurred())
+		req.Header.Add("content-type", "application/json")
+		resp, err := http.DefaultClient.Do(req)
+		Expect(err).ToNot(HaveOccurred())
+		return resp
+	}
+
+	Context("with access", func() {
+		BeforeEach(func() {
+			startServer(user.Anonymous.Groups[0])
+		})
+
+		It("should revoke a token", func() {
+			// given
+			expiresAt := time.Now().Add(time.Hour).Truncate(time.Second)
+			token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{
+				ID:        "token-1",
+				ExpiresAt: jwt.NewNumericDate(expiresAt),
+			}).SignedString([]byte("some-key"))
+			Expect(err).ToNot(HaveOccurred())
+
+			// when
+			resp := revoke(types.TokenRevocationRequest{
+				Type:   "user",
+				Token:  token,
+				Reason: "leaked",
+			})
+
+			// then
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			revocation := tokens.Revocation{}
+			Expect(json.NewDecoder(resp.Body).Decode(&revocation)).To(Succeed())
+			Expect(revocation.ID).To(Equal("token-1"))
+			Expect(revocation.ExpiresAt.Equal(expiresAt)).To(BeTrue())
+			Expect(revocation.RevokedAt).ToNot(BeNil())
+
+			// and the revocation is listed
+			listResp, err := http.DefaultClient.Get(fmt.Sprintf("%s/tokens/revocations?type=user", url))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(listResp.StatusCode).To(Equal(http.StatusOK))
+			list := types.TokenRevocationList{}
+			Expect(json.NewDecoder(listResp.Body).Decode(&list)).To(Succeed())
+			Expect(list.Total).To(Equal(1))
+			Expect(list.Items[0].Reason).To(Equal("leaked"))
+
+			// and it's stored in the user token revocation secret
+			Expect(store.Get(context.Background(), system.NewGlobalSecretResource(), core_store.GetByKey(system.UserTokenRevocations, ""))).To(Succeed())
+		})
+
+		It("should revoke a dataplane token by id", func() {
+			// when
+			resp := revoke(types.TokenRevocationRequest{
+				Type: "dataplane",
+				Mesh: "default",
+				ID:   "token-2",
+			})
+
+			// then
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			revocations := tokens.NewRevocations(manager.NewResourceManager(store), core_model.ResourceKey{Name: system.DataplaneTokenRevocations("default")})
+			Expect(revocations.IsRevoked(context.Background(), "token-2")).To(BeTrue())
+		})
+
+		It("should return empty list when nothing is revoked", func() {
+			// when
+			resp, err := http.DefaultClient.Get(fmt.Sprintf("%s/tokens/revocations?type=zone", url))
+
+			// then
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			body, err := io.ReadAll(resp.Body)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(body).To(MatchJSON(`{"items":[],"total":0}`))
+		})
+
+		DescribeTable("should validate the request",
+			func(revReq types.TokenRevocationRequest, violation string) {
+				// when
+				resp := revoke(revReq)
+
+				// then
+				Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+				body, err := io.ReadAll(resp.Body)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(body)).To(ContainSubstring(violation))
+			},
+			Entry("unknown type", types.TokenRevocationRequest{Type: "other", ID: "x"}, `unsupported token type`),
+			Entry("dataplane without mesh", types.TokenRevocationRequest{Type: "dataplane", ID: "x"}, `mesh has to be defined`),
+			Entry("no id", types.TokenRevocationRequest{Type: "user"}, `either id or token has to be defined`),
+			Entry("id and token", types.TokenRevocationRequest{Type: "user", ID: "x", Token: "y"}, `cannot be defined together with token`),
+			Entry("invalid token", types.TokenRevocationRequest{Type: "user", Token: "y"}, `is not a valid token`),
+		)
+	})
+
+	Context("without access", func() {
+		BeforeEach(func() {
+			startServer("mesh-system:admin")
+		})
+
+		It("should forbid revoking a token", func() {
+			// when
+			resp := revoke(types.TokenRevocationRequest{
+				Type: "user",
+				ID:   "token-1",
+			})
+
+			// then
+			Expect(resp.StatusCode).To(Equal(http.StatusForbidden))
+		})
+	})
+})
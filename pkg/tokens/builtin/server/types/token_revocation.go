@@ -0,0 +1,28 @@
+package types
+
+import (
+	"time"
+
+	"github.com/kumahq/kuma/v3/pkg/core/tokens"
+)
+
+type TokenRevocationRequest struct {
+	// Type of the token, one of "dataplane", "zone" or "user".
+	Type string `json:"type"`
+	// Mesh of the token, required for dataplane tokens.
+	Mesh string `json:"mesh,omitempty"`
+	// Token to revoke. ID and expiration time are taken from the token.
+	// Either Token or ID has to be defined.
+	Token string `json:"token,omitempty"`
+	// ID of the token to revoke.
+	ID string `json:"id,omitempty"`
+	// ExpiresAt is the expiration time of the token, used only with ID.
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+	// Reason of the revocation.
+	Reason string `json:"reason,omitempty"`
+}
+
+type TokenRevocationList struct {
+	Items []tokens.Revocation `json:"items"`
+	Total int                 `json:"total"`
+}
@@ -0,0 +1,17 @@
+package revocations
+
+import (
+	"time"
+
+	core_runtime "github.com/kumahq/kuma/v3/pkg/core/runtime"
+)
+
+func Setup(rt core_runtime.Runtime) error {
+	if rt.Config().IsFederatedZoneCP() {
+		// revocations are synced from Global, so they are pruned there
+		return nil
+	}
+	return rt.Add(NewPruner(rt.ResourceManager(), rt.Config().Store.Upsert, func() *time.Ticker {
+		return time.NewTicker(PruneInterval)
+	}))
+}
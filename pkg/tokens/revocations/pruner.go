@@ -0,0 +1,94 @@
+package revocations
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/pkg/errors"
+
+	config_store "github.com/kumahq/kuma/v3/pkg/config/core/resources/store"
+	"github.com/kumahq/kuma/v3/pkg/core"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/apis/system"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/manager"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/model"
+	"github.com/kumahq/kuma/v3/pkg/core/runtime/component"
+	"github.com/kumahq/kuma/v3/pkg/core/tokens"
+	"github.com/kumahq/kuma/v3/pkg/core/user"
+	"github.com/kumahq/kuma/v3/pkg/multitenant"
+	"github.com/kumahq/kuma/v3/pkg/tokens/builtin"
+)
+
+// PruneInterval is how often revocations of expired tokens are removed.
+var PruneInterval = time.Hour
+
+type pruner struct {
+	resManager manager.ResourceManager
+	upsertCfg  config_store.UpsertConfig
+	newTicker  func() *time.Ticker
+	log        logr.Logger
+}
+
+var _ component.Component = &pruner{}
+
+// NewPruner returns a component that removes entries of already expired tokens from all revocation lists.
+func NewPruner(resManager manager.ResourceManager, upsertCfg config_store.UpsertConfig, newTicker func() *time.Ticker) component.Component {
+	return &pruner{
+		resManager: resManager,
+		upsertCfg:  upsertCfg,
+		newTicker:  newTicker,
+		log:        core.Log.WithName("token-revocation-pruner"),
+	}
+}
+
+func (r *pruner) Start(stop <-chan struct{}) error {
+	ticker := r.newTicker()
+	defer ticker.Stop()
+	ctx := user.Ctx(context.Background(), user.ControlPlane)
+	ctx = multitenant.WithTenant(ctx, multitenant.GlobalTenantID)
+	r.log.Info("started")
+	for {
+		select {
+		case now := <-ticker.C:
+			if err := r.prune(ctx, now); err != nil {
+				r.log.Error(err, "could not prune token revocations")
+			}
+		case <-stop:
+			r.log.Info("stopped")
+			return nil
+		}
+	}
+}
+
+func (r *pruner) NeedLeaderElection() bool {
+	return true
+}
+
+func (r *pruner) prune(ctx context.Context, now time.Time) error {
+	keys := []model.ResourceKey{
+		{Name: system.ZoneTokenRevocations},
+		{Name: system.UserTokenRevocations},
+	}
+	meshes := &mesh.MeshResourceList{}
+	if err := r.resManager.List(ctx, meshes); err != nil {
+		return err
+	}
+	for _, m := range meshes.Items {
+		key, err := builtin.RevocationKey(builtin.DataplaneTokenType, m.GetMeta().GetName())
+		if err != nil {
+			return err
+		}
+		keys = append(keys, key)
+	}
+	for _, key := range keys {
+		pruned, err := tokens.NewRevocationManager(r.resManager, key, r.upsertCfg).Prune(ctx, now)
+		if err != nil {
+			return errors.Wrapf(err, "could not prune revocations in %s", key.Name)
+		}
+		if pruned > 0 {
+			r.log.Info("pruned revocations of expired tokens", "secret", key.Name, "count", pruned)
+		}
+	}
+	return nil
+}
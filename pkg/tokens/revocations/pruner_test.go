@@ -0,0 +1,81 @@
+package revocations_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	config_store "github.com/kumahq/kuma/v3/pkg/config/core/resources/store"
+	core_mesh "github.com/kumahq/kuma/v3/pkg/core/resources/apis/mesh"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/manager"
+	core_model "github.com/kumahq/kuma/v3/pkg/core/resources/model"
+	"github.com/kumahq/kuma/v3/pkg/core/resources/store"
+	"github.com/kumahq/kuma/v3/pkg/core/tokens"
+	"github.com/kumahq/kuma/v3/pkg/plugins/resources/memory"
+	"github.com/kumahq/kuma/v3/pkg/tokens/builtin"
+	"github.com/kumahq/kuma/v3/pkg/tokens/revocations"
+)
+
+var _ = Describe("Pruner", func() {
+	var rm manager.ResourceManager
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	revoke := func(tokenType builtin.TokenType, mesh string, id string, expiresAt time.Time) {
+		key, err := builtin.RevocationKey(tokenType, mesh)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(tokens.NewRevocationManager(rm, key, config_store.DefaultUpsertConfig()).Revoke(context.Background(), tokens.Revocation{
+			ID:        id,
+			ExpiresAt: &expiresAt,
+		})).To(Succeed())
+	}
+
+	list := func(g Gomega, tokenType builtin.TokenType, mesh string) []string {
+		key, err := builtin.RevocationKey(tokenType, mesh)
+		g.Expect(err).ToNot(HaveOccurred())
+		revs, err := tokens.NewRevocationManager(rm, key, config_store.DefaultUpsertConfig()).List(context.Background())
+		g.Expect(err).ToNot(HaveOccurred())
+		var ids []string
+		for _, rev := range revs {
+			ids = append(ids, rev.ID)
+		}
+		return ids
+	}
+
+	BeforeEach(func() {
+		rm = manager.NewResourceManager(memory.NewStore())
+		err := rm.Create(context.Background(), core_mesh.NewMeshResource(), store.CreateByKey(core_model.DefaultMesh, core_model.NoMesh))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should prune revocations of expired tokens", func() {
+		// given
+		revoke(builtin.UserTokenType, "", "user-expired", now.Add(-time.Minute))
+		revoke(builtin.UserTokenType, "", "user-valid", now.Add(time.Hour))
+		revoke(builtin.ZoneTokenType, "", "zone-expired", now.Add(-time.Minute))
+		revoke(builtin.DataplaneTokenType, core_model.DefaultMesh, "dp-expired", now.Add(-time.Minute))
+		revoke(builtin.DataplaneTokenType, core_model.DefaultMesh, "dp-valid", now.Add(time.Hour))
+
+		ticks := make(chan time.Time)
+		defer close(ticks)
+		pruner := revocations.NewPruner(rm, config_store.DefaultUpsertConfig(), func() *time.Ticker {
+			return &time.Ticker{C: ticks}
+		})
+		stop := make(chan struct{})
+		defer close(stop)
+		go func() {
+			_ = pruner.Start(stop)
+		}()
+
+		// when
+		ticks <- now
+
+		// then
+		Eventually(func(g Gomega) {
+			g.Expect(list(g, builtin.UserTokenType, "")).To(Equal([]string{"user-valid"}))
+			g.Expect(list(g, builtin.ZoneTokenType, "")).To(BeEmpty())
+			g.Expect(list(g, builtin.DataplaneTokenType, core_model.DefaultMesh)).To(Equal([]string{"dp-valid"}))
+		}).Should(Succeed())
+	})
+})
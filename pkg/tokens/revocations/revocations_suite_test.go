@@ -0,0 +1,11 @@
+package revocations_test
+
+import (
+	"testing"
+
+	"github.com/kumahq/kuma/v3/pkg/test"
+)
+
+func TestRevocations(t *testing.T) {
+	test.RunSpecs(t, "Token Revocations Suite")
+}
@@ -552,7 +552,13 @@ type Config struct {
 	// crucial for environments where both IP families are in use, ensuring that
 	// the correct iptables rules are applied for the specified IP family
 	IPFamilyMode IPFamilyMode `json:"ipFamilyMode" envconfig:"ip_family_mode"` // KUMA_TRANSPARENT_PROXY_IP_FAMILY_MODE
-	CNIMode      bool         `json:"cniMode,omitempty" envconfig:"cni_mode"`  // KUMA_TRANSPARENT_PROXY_CNI_MODE
+	// Backend specifies the tooling used to configure traffic redirection.
+	// The iptables backend (default) generates rules for iptables-restore,
+	// while the nftables backend generates a native nftables ruleset applied
+	// with nft, which is required on systems that ship without iptables or
+	// where the iptables-nft compatibility layer is not reliable
+	Backend Backend `json:"backend" envconfig:"backend"`            // KUMA_TRANSPARENT_PROXY_BACKEND
+	CNIMode bool    `json:"cniMode,omitempty" envconfig:"cni_mode"` // KUMA_TRANSPARENT_PROXY_CNI_MODE
 }
 
 func (c Config) WithStdout(stdout io.Writer) Config {
@@ -572,6 +578,7 @@ func (c Config) MarshalJSON() ([]byte, error) {
 		Comments    any `json:"comments,omitempty"`
 		Log         any `json:"log,omitempty"`
 		Executables any `json:"iptablesExecutables,omitempty"`
+		Backend     any `json:"backend,omitempty"`
 	}
 
 	result := ConfigAliasOmitEmpty{
@@ -579,6 +586,7 @@ func (c Config) MarshalJSON() ([]byte, error) {
 		Comments:    c.Comments,
 		Log:         c.Log,
 		Executables: c.Executables,
+		Backend:     c.Backend,
 	}
 
 	if !c.Comments.Disabled {
@@ -593,6 +601,10 @@ func (c Config) MarshalJSON() ([]byte, error) {
 		result.Executables = nil
 	}
 
+	if c.Backend == "" || c.Backend == BackendIptables {
+		result.Backend = nil
+	}
+
 	return json.Marshal(result)
 }
 
@@ -684,6 +696,58 @@ func AllowedIPFamilyModes() string {
 	return fmt.Sprintf("'%s' or '%s'", IPFamilyModeDualStack, IPFamilyModeIPv4)
 }
 
+type Backend string
+
+func (e *Backend) UnmarshalJSON(bs []byte) error {
+	var value string
+
+	if err := json.Unmarshal(bs, &value); err != nil {
+		return errors.Wrapf(err, "value '%s' is not a valid Backend", bs)
+	}
+
+	if err := e.Set(value); err != nil {
+		return errors.Wrapf(err, "value '%s' is not a valid Backend", value)
+	}
+
+	return nil
+}
+
+const (
+	BackendIptables Backend = "iptables"
+	BackendNftables Backend = "nftables"
+)
+
+// String returns the string representation of the Backend.
+// This is used both by fmt.Print and by Cobra in help text
+func (e *Backend) String() string {
+	return string(*e)
+}
+
+// Type returns the type of the Backend.
+// This is only used in help text by Cobra
+func (e *Backend) Type() string {
+	return "string"
+}
+
+// Set assigns the Backend based on the provided value. It validates the input
+// and sets the appropriate backend or returns an error if the input is invalid
+func (e *Backend) Set(v string) error {
+	switch strings.ToLower(v) {
+	case "": // Default value is "iptables"
+		*e = BackendIptables
+	case string(BackendIptables), string(BackendNftables):
+		*e = Backend(strings.ToLower(v))
+	default:
+		return errors.Errorf("must be one of %s", AllowedBackends())
+	}
+
+	return nil
+}
+
+func AllowedBackends() string {
+	return fmt.Sprintf("'%s' or '%s'", BackendIptables, BackendNftables)
+}
+
 // InitializedConfigIPvX extends the Config struct by adding fields that require
 // additional logic to retrieve their values. These values typically involve
 // interacting with the system or external resources
@@ -750,6 +814,12 @@ type InitializedConfig struct {
 	// DryRun when set will not execute, but just display instructions which
 	// otherwise would have served to install transparent proxy
 	DryRun bool
+	// Backend is the tooling used to configure traffic redirection
+	Backend Backend
+	// Nft is the nft executable used to apply the ruleset when the nftables
+	// backend is selected. Its path is empty when the iptables backend is
+	// used, or when nft could not be found during a dry run
+	Nft InitializedExecutable
 	// IPv4 contains the initialized configuration specific to IPv4. This
 	// includes all settings, executables, and rules relevant to IPv4 iptables
 	// management
@@ -777,8 +847,16 @@ func (c Config) Initialize(ctx context.Context) (InitializedConfig, error) {
 		return InitializedConfig{}, errors.Wrap(err, "unable to initialize loopback interface name")
 	}
 
-	executablesIPv4, err := c.Executables.InitializeIPv4(ctx, loggerIPv4, c)
-	if err != nil {
+	var nft InitializedExecutable
+	var executablesIPv4 InitializedExecutablesIPvX
+
+	if c.Backend == BackendNftables {
+		if nft, err = initializeNft(ctx, l, c); err != nil {
+			return InitializedConfig{}, errors.Wrap(err, "unable to initialize nft executable")
+		}
+
+		executablesIPv4 = InitializedExecutablesIPvX{Functionality: nftablesFunctionality()}
+	} else if executablesIPv4, err = c.Executables.InitializeIPv4(ctx, loggerIPv4, c); err != nil {
 		return InitializedConfig{}, errors.Wrap(err, "unable to initialize IPv4 executables")
 	}
 
@@ -788,8 +866,10 @@ func (c Config) Initialize(ctx context.Context) (InitializedConfig, error) {
 	}
 
 	initialized := InitializedConfig{
-		Logger: l,
-		DryRun: c.DryRun,
+		Logger:  l,
+		DryRun:  c.DryRun,
+		Backend: c.Backend,
+		Nft:     nft,
 		IPv4: InitializedConfigIPvX{
 			Config:                 c,
 			Logger:                 loggerIPv4,
@@ -825,10 +905,12 @@ func (c Config) Initialize(ctx context.Context) (InitializedConfig, error) {
 		return initialized, nil
 	}
 
-	executablesIPv6, err := c.Executables.InitializeIPv6(ctx, loggerIPv6, c, executablesIPv4.mode)
-	if err != nil {
-		loggerIPv6.Warn("failed to initialize IPv6 executables:", err)
-		return initialized, nil
+	executablesIPv6 := InitializedExecutablesIPvX{Functionality: nftablesFunctionality()}
+	if c.Backend != BackendNftables {
+		if executablesIPv6, err = c.Executables.InitializeIPv6(ctx, loggerIPv6, c, executablesIPv4.mode); err != nil {
+			loggerIPv6.Warn("failed to initialize IPv6 executables:", err)
+			return initialized, nil
+		}
 	}
 
 	redirectIPv6, err := c.Redirect.Initialize(loggerIPv6, executablesIPv6, true)
@@ -858,6 +940,7 @@ func DefaultConfig() Config {
 	return Config{
 		KumaDPUser:   "",
 		IPFamilyMode: IPFamilyModeDualStack,
+		Backend:      BackendIptables,
 		Redirect: Redirect{
 			NamePrefix: consts.IptablesChainsPrefix,
 			Inbound: TrafficFlow{
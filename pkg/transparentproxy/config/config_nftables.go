@@ -0,0 +1,127 @@
+package config
+
+import (
+	"bytes"
+	"context"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/kumahq/kuma/v3/pkg/transparentproxy/consts"
+)
+
+const (
+	WarningDryRunNoValidNftFound = "[dry-run]: no valid nft executable found; the generated nftables ruleset will not be validated"
+)
+
+// nftablesFunctionality returns the functionality available when the nftables
+// backend is used. Unlike iptables, where tables and matches are provided by
+// separate modules which have to be probed, everything the transparent proxy
+// needs (nat, conntrack zones, socket owner matching) is part of nftables
+// itself. Rules are placed in tables owned by us, so there is no need to
+// detect chains created by other tools (i.e. DOCKER_OUTPUT)
+func nftablesFunctionality() Functionality {
+	return Functionality{
+		Tables: FunctionalityTables{
+			Nat:    true,
+			Raw:    true,
+			Mangle: true,
+		},
+		Modules: FunctionalityModules{
+			Tcp:       true,
+			Udp:       true,
+			Owner:     true,
+			Comment:   true,
+			Conntrack: true,
+			Multiport: true,
+		},
+	}
+}
+
+// initializeNft locates the nft executable in the user's PATH or in one of the
+// fallback locations and verifies it can be executed. When the executable
+// can't be found during a dry run, a warning is logged and an executable with
+// an empty path is returned, so the ruleset can still be generated
+func initializeNft(ctx context.Context, l Logger, cfg Config) (InitializedExecutable, error) {
+	for _, path := range nftSearchPaths() {
+		found := findPath(path)
+		if found == "" {
+			continue
+		}
+
+		if err := verifyNft(ctx, found); err != nil {
+			l.Warnf("ignoring invalid nft executable at '%s': %s", found, err)
+			continue
+		}
+
+		return InitializedExecutable{
+			Path:    found,
+			logger:  l,
+			name:    consts.Nft,
+			cniMode: cfg.CNIMode,
+			// nft doesn't use the xtables lock, marking the executable as nft
+			// ensures the lock is not set up when running in the sandbox
+			version: Version{Mode: consts.IptablesModeNft},
+		}, nil
+	}
+
+	if cfg.DryRun {
+		l.Warn(WarningDryRunNoValidNftFound)
+		return InitializedExecutable{}, nil
+	}
+
+	return InitializedExecutable{}, errors.Errorf("could not locate executable '%s'", consts.Nft)
+}
+
+func nftSearchPaths() []string {
+	paths := []string{consts.Nft}
+	for _, fallbackPath := range consts.FallbackExecutablesSearchLocations {
+		paths = append(paths, filepath.Join(fallbackPath, consts.Nft))
+	}
+	return paths
+}
+
+// DetectInstalledBackend returns the backend which was used to install the
+// transparent proxy. The nftables backend is detected by the presence of the
+// tables of the transparent proxy, otherwise the iptables backend is assumed
+func DetectInstalledBackend(ctx context.Context) Backend {
+	for _, path := range nftSearchPaths() {
+		found := findPath(path)
+		if found == "" {
+			continue
+		}
+
+		for _, ipv6 := range []bool{consts.IPv4, consts.IPv6} {
+			// #nosec G204 -- path is found by searching well known locations
+			cmd := exec.CommandContext(ctx, found, "list", "table", consts.NftablesFamilyByFamily[ipv6], consts.NftablesTableName)
+			if err := cmd.Run(); err == nil {
+				return BackendNftables
+			}
+		}
+
+		return BackendIptables
+	}
+
+	return BackendIptables
+}
+
+func verifyNft(ctx context.Context, path string) error {
+	var stdout bytes.Buffer
+	var stderr bytes.Buffer
+	// #nosec G204 -- path is found by searching well known locations
+	cmd := exec.CommandContext(ctx, path, consts.FlagVersion)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return handleRunError(err, &stderr)
+	}
+
+	if !strings.HasPrefix(stdout.String(), "nftables") {
+		return errors.Errorf("unexpected version output: %q", strings.TrimSpace(stdout.String()))
+	}
+
+	return nil
+}
@@ -20,6 +20,7 @@ const (
 	Ip6tables        = "ip6tables"
 	Ip6tablesSave    = "ip6tables-save"
 	Ip6tablesRestore = "ip6tables-restore"
+	Nft              = "nft"
 )
 
 // IptablesCommandByFamily maps a boolean value indicating IPv4 (false) or IPv6
@@ -30,6 +31,13 @@ var IptablesCommandByFamily = map[bool]string{
 	IPv6: Ip6tables,
 }
 
+// NftablesFamilyByFamily maps a boolean value indicating IPv4 (false) or IPv6
+// (true) usage to the corresponding nftables address family
+var NftablesFamilyByFamily = map[bool]string{
+	IPv4: "ip",
+	IPv6: "ip6",
+}
+
 // IPTypeMap is a map that translates a boolean value to a string representing
 // the type of IP address (IPv4 or IPv6). The key is a boolean where 'false'
 // corresponds to "IPv4" and 'true' corresponds to "IPv6"
@@ -197,6 +205,12 @@ const IptablesRuleCommentPrefix = "kuma/mesh/transparent/proxy"
 // necessary for the operation of the transparent proxy
 const IptablesChainsPrefix = "KUMA_MESH"
 
+// NftablesTableName is the name of the nftables tables (one for each address
+// family) holding all rules and chains of the transparent proxy. Since the
+// whole ruleset lives in tables owned by us, it can be removed by deleting
+// them, without inspecting rules created by other tools
+const NftablesTableName = "kuma_mesh_transparent_proxy"
+
 // Default user identification constants used for running kuma-dp. These defaults
 // are utilized when no specific user is provided
 const (
@@ -0,0 +1,666 @@
+package nftables
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/kumahq/kuma/v3/pkg/transparentproxy/config"
+	"github.com/kumahq/kuma/v3/pkg/transparentproxy/consts"
+	"github.com/kumahq/kuma/v3/pkg/util/maps"
+)
+
+// Priorities of the base chains, they are the same as priorities of the
+// corresponding iptables tables, so the rules interact with other tools (i.e.
+// Docker) the same way as the ones generated by the iptables backend
+// ref. nft(8) > CHAINS > Table 6. Standard priority names, family and hook compatibility matrix
+const (
+	priorityRaw    = -300
+	priorityMangle = -150
+	priorityNat    = -100
+)
+
+// Conntrack zones used to split DNS traffic, see buildRawChains
+const (
+	conntrackZoneUpstream = 1
+	conntrackZoneProxy    = 2
+)
+
+// logLevels maps syslog levels used by the iptables LOG target to the names
+// accepted by the nftables log statement
+var logLevels = map[uint16]string{
+	0: "emerg",
+	1: "alert",
+	2: "crit",
+	3: "err",
+	4: "warn",
+	5: "notice",
+	6: "info",
+	7: "debug",
+}
+
+type rule struct {
+	statement string
+	comment   string
+}
+
+func newRule(comment string, statement ...string) rule {
+	return rule{
+		statement: strings.Join(slices.DeleteFunc(statement, func(s string) bool { return s == "" }), " "),
+		comment:   comment,
+	}
+}
+
+type chain struct {
+	name string
+	// hook is set only for base chains, which are attached to netfilter hooks
+	// (i.e. "type nat hook output priority -100; policy accept;")
+	hook  string
+	rules []rule
+}
+
+func newChain(name string) *chain {
+	return &chain{name: name}
+}
+
+func newBaseChain(name string, typ string, hook string, priority int) *chain {
+	return &chain{
+		name: name,
+		hook: fmt.Sprintf("type %s hook %s priority %d; policy accept;", typ, hook, priority),
+	}
+}
+
+func (c *chain) addRules(rules ...rule) *chain {
+	c.rules = append(c.rules, rules...)
+	return c
+}
+
+func (c *chain) build(comments bool) string {
+	var lines []string
+
+	lines = append(lines, fmt.Sprintf("\tchain %s {", c.name))
+
+	if c.hook != "" {
+		lines = append(lines, "\t\t"+c.hook)
+	}
+
+	for _, r := range c.rules {
+		if comments && r.comment != "" {
+			lines = append(lines, "\t\t# "+r.comment)
+		}
+
+		lines = append(lines, "\t\t"+r.statement)
+	}
+
+	return strings.Join(append(lines, "\t}"), "\n")
+}
+
+// family holds helpers to render matches which depend on the address family
+type family struct {
+	name string
+}
+
+func (f family) saddr(address string) string {
+	if address == "" {
+		return ""
+	}
+
+	return fmt.Sprintf("%s saddr %s", f.name, address)
+}
+
+func (f family) daddr(address string) string {
+	if address == "" {
+		return ""
+	}
+
+	return fmt.Sprintf("%s daddr %s", f.name, address)
+}
+
+func (f family) notDaddr(address string) string {
+	return fmt.Sprintf("%s daddr != %s", f.name, address)
+}
+
+// BuildRuleset generates the nftables ruleset for the transparent proxy with
+// a table for every enabled IP family. The ruleset is equivalent to the rules
+// generated by the iptables backend, but all chains are placed in tables owned
+// by the transparent proxy instead of the shared nat, raw and mangle tables
+func BuildRuleset(cfg config.InitializedConfig) string {
+	var tables []string
+
+	if cfg.IPv4.Enabled() {
+		tables = append(tables, buildTable(cfg.IPv4, consts.IPv4))
+	}
+
+	if cfg.IPv6.Enabled() {
+		tables = append(tables, buildTable(cfg.IPv6, consts.IPv6))
+	}
+
+	return strings.Join(tables, "\n\n") + "\n"
+}
+
+// buildCleanupRuleset generates a ruleset removing the tables of the
+// transparent proxy for both IP families. Each table is declared before it's
+// deleted, so the ruleset can be applied even if the table doesn't exist
+func buildCleanupRuleset() string {
+	var lines []string
+
+	for _, ipv6 := range []bool{consts.IPv4, consts.IPv6} {
+		lines = append(
+			lines,
+			fmt.Sprintf("table %s %s", consts.NftablesFamilyByFamily[ipv6], consts.NftablesTableName),
+			fmt.Sprintf("delete table %s %s", consts.NftablesFamilyByFamily[ipv6], consts.NftablesTableName),
+		)
+	}
+
+	return strings.Join(lines, "\n") + "\n"
+}
+
+func buildTable(cfg config.InitializedConfigIPvX, ipv6 bool) string {
+	f := family{name: consts.NftablesFamilyByFamily[ipv6]}
+
+	// Regular chains are defined before base chains, so every chain is already
+	// defined when a rule jumping to it is added
+	chains := []*chain{
+		buildMeshRedirect(cfg.Redirect.Inbound),
+		buildMeshRedirect(cfg.Redirect.Outbound),
+		buildMeshInbound(f, cfg.Redirect.Inbound),
+		buildMeshOutbound(f, cfg),
+	}
+
+	chains = append(chains, buildRawChains(f, cfg)...)
+	chains = append(chains, buildMangleChains(cfg)...)
+	chains = append(chains, buildPrerouting(f, cfg), buildOutput(f, cfg))
+
+	var result []string
+	for _, c := range chains {
+		if c.hook != "" && len(c.rules) == 0 {
+			continue
+		}
+
+		result = append(result, c.build(cfg.Comments.Enabled))
+	}
+
+	return fmt.Sprintf(
+		"table %s %s {\n%s\n}",
+		f.name,
+		consts.NftablesTableName,
+		strings.Join(result, "\n\n"),
+	)
+}
+
+// buildMeshRedirect creates a chain redirecting TCP traffic to the port of the
+// traffic flow, which can be different for IPv4 and IPv6
+func buildMeshRedirect(cfg config.InitializedTrafficFlow) *chain {
+	return newChain(cfg.RedirectChainName).addRules(
+		newRule(
+			fmt.Sprintf("redirect TCP traffic to envoy (port %d)", cfg.Port),
+			"meta l4proto tcp",
+			redirectTo(cfg.Port),
+		),
+	)
+}
+
+func buildMeshInbound(f family, cfg config.InitializedTrafficFlow) *chain {
+	meshInbound := newChain(cfg.ChainName)
+
+	if !cfg.Enabled {
+		return meshInbound.addRules(
+			newRule("inbound traffic redirection is disabled", "meta l4proto tcp", "return"),
+		)
+	}
+
+	for _, exclusion := range cfg.Exclusions {
+		meshInbound.addRules(
+			newRule(
+				"skip further processing for configured IP address",
+				f.saddr(exclusion.Address),
+				"return",
+			),
+		)
+	}
+
+	if len(cfg.IncludePorts) > 0 {
+		return meshInbound.addRules(
+			newRule(
+				fmt.Sprintf("redirect inbound traffic from ports %s to the custom chain for processing", cfg.IncludePorts.String()),
+				"tcp dport "+portSet(cfg.IncludePorts),
+				"jump "+cfg.RedirectChainName,
+			),
+		)
+	}
+
+	if len(cfg.ExcludePorts) > 0 {
+		meshInbound.addRules(
+			newRule(
+				fmt.Sprintf("exclude inbound traffic from ports %s from redirection", cfg.ExcludePorts.String()),
+				"tcp dport "+portSet(cfg.ExcludePorts),
+				"return",
+			),
+		)
+	}
+
+	return meshInbound.addRules(
+		newRule(
+			"redirect all inbound traffic to the custom chain for processing",
+			"meta l4proto tcp",
+			"jump "+cfg.RedirectChainName,
+		),
+	)
+}
+
+func buildMeshOutbound(f family, cfg config.InitializedConfigIPvX) *chain {
+	outbound := cfg.Redirect.Outbound
+	meshOutbound := newChain(outbound.ChainName)
+
+	if !outbound.Enabled {
+		return meshOutbound.addRules(
+			newRule("outbound traffic redirection is disabled", "meta l4proto tcp", "return"),
+		)
+	}
+
+	if len(outbound.IncludePorts) == 0 && len(outbound.ExcludePorts) > 0 {
+		meshOutbound.addRules(
+			newRule(
+				fmt.Sprintf("exclude outbound traffic from ports %s from redirection", outbound.ExcludePorts.String()),
+				"tcp dport "+portSet(outbound.ExcludePorts),
+				"return",
+			),
+		)
+	}
+
+	oif := fmt.Sprintf("oifname %q", cfg.LoopbackInterfaceName)
+
+	meshOutbound.addRules(
+		newRule(
+			fmt.Sprintf("prevent traffic loops by ensuring traffic from the sidecar proxy (using %s) to loopback interface is not redirected again", cfg.InboundPassthroughCIDR.String()),
+			f.saddr(cfg.InboundPassthroughCIDR.String()),
+			oif,
+			"return",
+		),
+		newRule(
+			fmt.Sprintf("redirect outbound TCP traffic destined for loopback interface, but not targeting address %s, and owned by UID %s (kuma-dp user) to %s chain for proper handling", cfg.LocalhostCIDR.String(), cfg.KumaDPUser, cfg.Redirect.Inbound.RedirectChainName),
+			oif,
+			f.notDaddr(cfg.LocalhostCIDR.String()),
+			"meta l4proto tcp",
+			"meta skuid "+cfg.KumaDPUser,
+			"jump "+cfg.Redirect.Inbound.RedirectChainName,
+		),
+		newRule(
+			fmt.Sprintf("return outbound TCP traffic destined for loopback interface, owned by any UID other than %s (kuma-dp user)", cfg.KumaDPUser),
+			oif,
+			"meta l4proto tcp",
+			"meta skuid != "+cfg.KumaDPUser,
+			"return",
+		),
+		newRule(
+			fmt.Sprintf("return outbound traffic owned by UID %s (kuma-dp user)", cfg.KumaDPUser),
+			"meta skuid "+cfg.KumaDPUser,
+			"return",
+		),
+		newRule(
+			fmt.Sprintf("return traffic destined for localhost (%s) to avoid redirection", cfg.LocalhostCIDR.String()),
+			f.daddr(cfg.LocalhostCIDR.String()),
+			"return",
+		),
+	)
+
+	if len(outbound.IncludePorts) > 0 {
+		return meshOutbound.addRules(
+			newRule(
+				fmt.Sprintf("redirect outbound TCP traffic to ports %s to our custom chain for further processing", outbound.IncludePorts.String()),
+				"tcp dport "+portSet(outbound.IncludePorts),
+				"jump "+outbound.RedirectChainName,
+			),
+		)
+	}
+
+	return meshOutbound.addRules(
+		newRule(
+			"redirect all other outbound traffic to our custom chain for further processing",
+			"jump "+outbound.RedirectChainName,
+		),
+	)
+}
+
+// buildOutput creates the base chain attached to the output hook with the
+// priority of the iptables nat table. In contrast to iptables, where the jump
+// to the DOCKER_OUTPUT chain is needed for DNS traffic of kuma-dp, accepting
+// the traffic is enough, as Docker rules in its own table are evaluated
+// independently
+func buildOutput(f family, cfg config.InitializedConfigIPvX) *chain {
+	output := newBaseChain("output", "nat", "output", priorityNat)
+
+	if cfg.Log.Enabled {
+		output.addRules(
+			newRule("log matching packets using kernel logging", logStatement(consts.ChainOutput, cfg.Log.Level)),
+		)
+	}
+
+	for _, exclusion := range cfg.Redirect.Outbound.Exclusions {
+		output.addRules(
+			newRule(
+				"skip further processing for configured IP addresses, ports and UIDs",
+				exclusionProtocol(exclusion),
+				uidMatch(exclusion.UIDs),
+				f.daddr(exclusion.Address),
+				"return",
+			),
+		)
+	}
+
+	if cfg.Redirect.DNS.Enabled {
+		output.addRules(
+			newRule(
+				"return early for DNS traffic from kuma-dp",
+				fmt.Sprintf("udp dport %d", consts.DNSPort),
+				"meta skuid "+cfg.KumaDPUser,
+				"return",
+			),
+		)
+
+		if cfg.Redirect.DNS.CaptureAll {
+			output.addRules(
+				newRule(
+					fmt.Sprintf("redirect all DNS requests to the kuma-dp DNS proxy (listening on port %d)", cfg.Redirect.DNS.Port),
+					fmt.Sprintf("udp dport %d", consts.DNSPort),
+					redirectTo(cfg.Redirect.DNS.Port),
+				),
+			)
+		} else {
+			for _, ip := range cfg.Redirect.DNS.Servers {
+				output.addRules(
+					newRule(
+						fmt.Sprintf("redirect DNS requests to %s to the kuma-dp DNS proxy (listening on port %d)", ip, cfg.Redirect.DNS.Port),
+						f.daddr(ip.String()),
+						fmt.Sprintf("udp dport %d", consts.DNSPort),
+						redirectTo(cfg.Redirect.DNS.Port),
+					),
+				)
+			}
+		}
+
+		output.addRules(
+			newRule(
+				fmt.Sprintf("allow applications to perform DNS queries over TCP to port %d without mesh redirection (only traffic not owned by UID %s (kuma-dp user))", consts.DNSPort, cfg.KumaDPUser),
+				fmt.Sprintf("tcp dport %d", consts.DNSPort),
+				"meta skuid != "+cfg.KumaDPUser,
+				"return",
+			),
+		)
+	}
+
+	return output.addRules(
+		newRule(
+			"redirect outbound TCP traffic to our custom chain for processing",
+			"meta l4proto tcp",
+			"jump "+cfg.Redirect.Outbound.ChainName,
+		),
+	)
+}
+
+// buildPrerouting creates the base chain attached to the prerouting hook with
+// the priority of the iptables nat table
+func buildPrerouting(f family, cfg config.InitializedConfigIPvX) *chain {
+	prerouting := newBaseChain("prerouting", "nat", "prerouting", priorityNat)
+
+	if cfg.Log.Enabled {
+		prerouting.addRules(
+			newRule("log matching packets using kernel logging", logStatement(consts.ChainPrerouting, cfg.Log.Level)),
+		)
+	}
+
+	for _, iface := range maps.SortedKeys(cfg.Redirect.VNet.InterfaceCIDRs) {
+		iif := fmt.Sprintf("iifname %q", interfaceName(iface))
+		cidr := cfg.Redirect.VNet.InterfaceCIDRs[iface]
+
+		prerouting.addRules(
+			newRule(
+				fmt.Sprintf("redirect DNS requests on interface %s to the kuma-dp DNS proxy (listening on port %d)", iface, cfg.Redirect.DNS.Port),
+				iif,
+				fmt.Sprintf("udp dport %d", consts.DNSPort),
+				redirectTo(cfg.Redirect.DNS.Port),
+			),
+			newRule(
+				fmt.Sprintf("redirect TCP traffic on interface %s, excluding destination %s, to the envoy's outbound passthrough port %d", iface, cidr, cfg.Redirect.Outbound.Port),
+				iif,
+				f.notDaddr(cidr),
+				"meta l4proto tcp",
+				redirectTo(cfg.Redirect.Outbound.Port),
+			),
+		)
+	}
+
+	return prerouting.addRules(
+		newRule(
+			"redirect inbound TCP traffic to our custom chain for processing",
+			"meta l4proto tcp",
+			"jump "+cfg.Redirect.Inbound.ChainName,
+		),
+	)
+}
+
+// buildRawChains creates base chains with the priority of the iptables raw
+// table, which assign conntrack zones to DNS traffic. Zone 1 handles packets
+// between the kuma-dp DNS proxy and upstream DNS servers, while zone 2 handles
+// packets between applications and the DNS proxy
+func buildRawChains(f family, cfg config.InitializedConfigIPvX) []*chain {
+	output := newBaseChain("raw_output", "filter", "output", priorityRaw)
+	prerouting := newBaseChain("raw_prerouting", "filter", "prerouting", priorityRaw)
+
+	if !cfg.Redirect.DNS.ConntrackZoneSplit {
+		return nil
+	}
+
+	output.addRules(
+		newRule(
+			fmt.Sprintf("assign connection tracking zone 1 to DNS traffic from the kuma-dp user (UID %s)", cfg.KumaDPUser),
+			fmt.Sprintf("udp dport %d", consts.DNSPort),
+			"meta skuid "+cfg.KumaDPUser,
+			zoneSet(conntrackZoneUpstream),
+		),
+		newRule(
+			"assign connection tracking zone 2 to DNS responses from the kuma-dp DNS proxy",
+			fmt.Sprintf("udp sport %d", cfg.Redirect.DNS.Port),
+			"meta skuid "+cfg.KumaDPUser,
+			zoneSet(conntrackZoneProxy),
+		),
+	)
+
+	if cfg.Redirect.DNS.CaptureAll {
+		output.addRules(
+			newRule(
+				"assign connection tracking zone 2 to all DNS requests",
+				fmt.Sprintf("udp dport %d", consts.DNSPort),
+				zoneSet(conntrackZoneProxy),
+			),
+		)
+
+		prerouting.addRules(
+			newRule(
+				"assign connection tracking zone 1 to all DNS responses",
+				fmt.Sprintf("udp sport %d", consts.DNSPort),
+				zoneSet(conntrackZoneUpstream),
+			),
+		)
+
+		return []*chain{prerouting, output}
+	}
+
+	for _, ip := range cfg.Redirect.DNS.Servers {
+		output.addRules(
+			newRule(
+				fmt.Sprintf("assign connection tracking zone 2 to DNS requests destined for %s", ip),
+				f.daddr(ip.String()),
+				fmt.Sprintf("udp dport %d", consts.DNSPort),
+				zoneSet(conntrackZoneProxy),
+			),
+		)
+
+		// See the corresponding rule of the iptables backend, it's needed when
+		// the DNS query port is altered by DNAT rules of Docker containers in
+		// custom networks
+		if ip.IsLoopback() {
+			output.addRules(
+				newRule(
+					"assign conntrack zone 1 to DNS responses from the local DNS server to localhost",
+					f.saddr(ip.String()),
+					f.daddr(cfg.LocalhostCIDR.String()),
+					"meta l4proto udp",
+					zoneSet(conntrackZoneUpstream),
+				),
+			)
+		}
+
+		prerouting.addRules(
+			newRule(
+				fmt.Sprintf("assign connection tracking zone 1 to DNS responses from %s", ip),
+				f.saddr(ip.String()),
+				fmt.Sprintf("udp sport %d", consts.DNSPort),
+				zoneSet(conntrackZoneUpstream),
+			),
+		)
+	}
+
+	return []*chain{prerouting, output}
+}
+
+// buildMangleChains creates a base chain with the priority of the iptables
+// mangle table, which drops packets in the INVALID state
+func buildMangleChains(cfg config.InitializedConfigIPvX) []*chain {
+	if !cfg.DropInvalidPackets {
+		return nil
+	}
+
+	return []*chain{
+		newBaseChain("mangle_prerouting", "filter", "prerouting", priorityMangle).addRules(
+			newRule(
+				"drop packets in the INVALID state to prevent potential issues with malformed or out-of-order packets",
+				"ct state invalid",
+				"drop",
+			),
+		),
+	}
+}
+
+func redirectTo(port config.Port) string {
+	return fmt.Sprintf("redirect to :%d", port)
+}
+
+func zoneSet(zone int) string {
+	return fmt.Sprintf("ct zone set %d", zone)
+}
+
+func logStatement(prefix string, level uint16) string {
+	statement := fmt.Sprintf("log prefix %q", prefix+":")
+
+	if name, ok := logLevels[level]; ok {
+		statement += " level " + name
+	}
+
+	return statement
+}
+
+// interfaceName converts an interface name in the iptables format, where
+// the "+" suffix matches all interfaces starting with the given prefix, to the
+// nftables format, which uses the "*" wildcard instead
+func interfaceName(iface string) string {
+	if prefix, ok := strings.CutSuffix(iface, "+"); ok {
+		return prefix + "*"
+	}
+
+	return iface
+}
+
+func exclusionProtocol(exclusion config.Exclusion) string {
+	switch {
+	case exclusion.Protocol == consts.ProtocolUndefined:
+		return ""
+	case exclusion.Ports == "":
+		return "meta l4proto " + string(exclusion.Protocol)
+	default:
+		return fmt.Sprintf("%s dport %s", exclusion.Protocol, valueOrRangeSet(exclusion.Ports))
+	}
+}
+
+func uidMatch(uids config.ValueOrRangeList) string {
+	if uids == "" {
+		return ""
+	}
+
+	return "meta skuid " + valueOrRangeSet(uids)
+}
+
+func portSet(ports config.Ports) string {
+	sorted := slices.Compact(slices.Sorted(slices.Values(ports)))
+
+	var elements []string
+	for _, port := range sorted {
+		elements = append(elements, strconv.Itoa(int(port)))
+	}
+
+	return set(elements)
+}
+
+type interval struct {
+	from, to uint64
+}
+
+// valueOrRangeSet converts values and ranges in the iptables format (i.e.
+// 1000,1005:1006) to an nftables set ({ 1000, 1005-1006 }). Overlapping and
+// adjacent elements are merged, as nftables rejects anonymous sets with
+// overlapping intervals
+func valueOrRangeSet(list config.ValueOrRangeList) string {
+	var intervals []interval
+
+	for element := range strings.SplitSeq(string(list), ",") {
+		from, to, _ := strings.Cut(strings.TrimSpace(element), ":")
+		if to == "" {
+			to = from
+		}
+
+		first, errFrom := strconv.ParseUint(from, 10, 32)
+		last, errTo := strconv.ParseUint(to, 10, 32)
+		if errFrom != nil || errTo != nil {
+			// values are validated during the initialization of the config,
+			// so they are only converted to the nftables format here
+			return set([]string{strings.ReplaceAll(string(list), ":", "-")})
+		}
+
+		intervals = append(intervals, interval{from: min(first, last), to: max(first, last)})
+	}
+
+	slices.SortFunc(intervals, func(a, b interval) int {
+		return cmp.Compare(a.from, b.from)
+	})
+
+	var merged []interval
+	for _, i := range intervals {
+		if last := len(merged) - 1; last >= 0 && i.from <= merged[last].to+1 {
+			merged[last].to = max(merged[last].to, i.to)
+			continue
+		}
+
+		merged = append(merged, i)
+	}
+
+	var elements []string
+	for _, i := range merged {
+		if i.from == i.to {
+			elements = append(elements, strconv.FormatUint(i.from, 10))
+		} else {
+			elements = append(elements, fmt.Sprintf("%d-%d", i.from, i.to))
+		}
+	}
+
+	return set(elements)
+}
+
+func set(elements []string) string {
+	if len(elements) == 1 {
+		return elements[0]
+	}
+
+	return fmt.Sprintf("{ %s }", strings.Join(elements, ", "))
+}
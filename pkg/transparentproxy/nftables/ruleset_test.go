@@ -0,0 +1,111 @@
+package nftables
+
+import (
+	"net"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/kumahq/kuma/v3/pkg/test/matchers"
+	"github.com/kumahq/kuma/v3/pkg/transparentproxy/config"
+	"github.com/kumahq/kuma/v3/pkg/transparentproxy/consts"
+	tproxy_test "github.com/kumahq/kuma/v3/pkg/transparentproxy/test"
+)
+
+var _ = Describe("Ruleset", func() {
+	type testCase struct {
+		ipv6       bool
+		configure  func(cfg *config.Config)
+		initialize func(cfg *config.InitializedConfigIPvX)
+		goldenFile string
+	}
+
+	DescribeTable("should build table",
+		func(given testCase) {
+			// given
+			cfg := config.DefaultConfig()
+			if given.configure != nil {
+				given.configure(&cfg)
+			}
+			initialized := tproxy_test.InitializeConfigIPvX(cfg, given.ipv6)
+			initialized.KumaDPUser = "5678"
+			initialized.LoopbackInterfaceName = "lo"
+			initialized.LocalhostCIDR = consts.LocalhostAddress[given.ipv6]
+			initialized.InboundPassthroughCIDR = consts.InboundPassthroughSourceAddress[given.ipv6]
+			if given.initialize != nil {
+				given.initialize(&initialized)
+			}
+
+			// when
+			table := buildTable(initialized, given.ipv6)
+
+			// then
+			Expect(table + "\n").To(matchers.MatchGoldenEqual("testdata", given.goldenFile))
+		},
+		Entry("with default configuration", testCase{
+			goldenFile: "defaults.golden.nft",
+		}),
+		Entry("with default configuration for IPv6 and comments", testCase{
+			ipv6: true,
+			initialize: func(cfg *config.InitializedConfigIPvX) {
+				cfg.Comments.Enabled = true
+			},
+			goldenFile: "defaults.ipv6.golden.nft",
+		}),
+		Entry("with DNS redirected to servers from resolv.conf", testCase{
+			configure: func(cfg *config.Config) {
+				cfg.Log.Enabled = true
+			},
+			initialize: func(cfg *config.InitializedConfigIPvX) {
+				cfg.Redirect.DNS.Enabled = true
+				cfg.Redirect.DNS.ConntrackZoneSplit = true
+				cfg.Redirect.DNS.Servers = []net.IP{net.ParseIP("8.8.8.8"), net.ParseIP("127.0.0.11")}
+			},
+			goldenFile: "dns.golden.nft",
+		}),
+		Entry("with all DNS traffic redirected and invalid packets dropped", testCase{
+			ipv6: true,
+			configure: func(cfg *config.Config) {
+				cfg.Redirect.DNS.CaptureAll = true
+			},
+			initialize: func(cfg *config.InitializedConfigIPvX) {
+				cfg.Redirect.DNS.Enabled = true
+				cfg.Redirect.DNS.ConntrackZoneSplit = true
+				cfg.DropInvalidPackets = true
+			},
+			goldenFile: "dns-capture-all.ipv6.golden.nft",
+		}),
+		Entry("with virtual networks and exclusions", testCase{
+			configure: func(cfg *config.Config) {
+				cfg.Redirect.VNet.Networks = []string{"docker0:172.17.0.0/16", "br+:172.18.0.0/16", "iface:fd00::/8"}
+				cfg.Redirect.Inbound.ExcludePorts = config.Ports{22, 8080}
+				cfg.Redirect.Inbound.ExcludePortsForIPs = []string{"10.0.0.1", "192.168.0.0/24"}
+				cfg.Redirect.Outbound.ExcludePorts = config.Ports{9090}
+				cfg.Redirect.Outbound.ExcludePortsForIPs = []string{"10.0.0.2"}
+				cfg.Redirect.Outbound.ExcludePortsForUIDs = []string{
+					"tcp:1900,1902,1000-2000:106-108",
+					"udp:3900:303",
+					"107",
+				}
+			},
+			goldenFile: "vnet-exclusions.golden.nft",
+		}),
+		Entry("with included ports and inbound redirection disabled", testCase{
+			configure: func(cfg *config.Config) {
+				cfg.Redirect.Inbound.Enabled = false
+				cfg.Redirect.Outbound.IncludePorts = config.Ports{443, 80}
+			},
+			goldenFile: "include-ports.golden.nft",
+		}),
+	)
+
+	DescribeTable("should convert values and ranges to sets",
+		func(given config.ValueOrRangeList, expected string) {
+			Expect(valueOrRangeSet(given)).To(Equal(expected))
+		},
+		Entry("single value", config.ValueOrRangeList("53"), "53"),
+		Entry("single range", config.ValueOrRangeList("106:108"), "106-108"),
+		Entry("overlapping values and ranges", config.ValueOrRangeList("1900,1902,1000:2000"), "1000-2000"),
+		Entry("adjacent and unsorted values", config.ValueOrRangeList("3000:5000,2902,2900,2901"), "{ 2900-2902, 3000-5000 }"),
+	)
+})
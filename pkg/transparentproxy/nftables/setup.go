@@ -0,0 +1,82 @@
+package nftables
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/kumahq/kuma/v3/pkg/transparentproxy/config"
+)
+
+// Setup applies the nftables ruleset of the transparent proxy. Existing tables
+// of the transparent proxy are removed in the same transaction, so the ruleset
+// is replaced atomically and there is no window without traffic redirection
+// when the transparent proxy is installed again
+func Setup(ctx context.Context, cfg config.InitializedConfig) (string, error) {
+	ruleset := BuildRuleset(cfg)
+
+	if cfg.DryRun {
+		cfg.Logger.InfoWithoutPrefix(strings.TrimSpace(ruleset))
+		return ruleset, nil
+	}
+
+	cfg.Logger.Info("kumactl is about to apply the nftables ruleset that will enable transparent proxying on the machine. The SSH connection may drop. If that happens, just reconnect again")
+
+	if err := apply(ctx, cfg, buildCleanupRuleset()+ruleset); err != nil {
+		return "", errors.Wrap(err, "unable to apply nftables ruleset")
+	}
+
+	return ruleset, nil
+}
+
+// Cleanup removes the nftables tables of the transparent proxy for both IPv4
+// and IPv6. Rules and chains of other tools are not affected, as they can't
+// be placed in our tables
+func Cleanup(ctx context.Context, cfg config.InitializedConfig) error {
+	ruleset := buildCleanupRuleset()
+
+	if cfg.DryRun {
+		cfg.Logger.Info("[dry-run]: ruleset removing the transparent proxy tables:")
+		cfg.Logger.InfoWithoutPrefix(strings.TrimSpace(ruleset))
+		return nil
+	}
+
+	if err := apply(ctx, cfg, ruleset); err != nil {
+		return errors.Wrap(err, "failed to remove nftables tables")
+	}
+
+	cfg.Logger.Info("cleanup of existing transparent proxy nftables tables completed successfully")
+
+	return nil
+}
+
+// apply writes the ruleset to a temporary file and loads it with nft, which
+// applies all commands from the file in a single transaction
+func apply(ctx context.Context, cfg config.InitializedConfig, ruleset string) error {
+	if cfg.Nft.Path == "" {
+		return errors.New("nft executable is not available")
+	}
+
+	f, err := os.CreateTemp("", "kuma-transparent-proxy-*.nft")
+	if err != nil {
+		return errors.Wrap(err, "unable to create temporary file for nftables ruleset")
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	if _, err := f.WriteString(ruleset); err != nil {
+		return errors.Wrapf(err, "unable to write nftables ruleset to %s", f.Name())
+	}
+
+	if err := f.Sync(); err != nil {
+		return errors.Wrapf(err, "unable to flush nftables ruleset to %s", f.Name())
+	}
+
+	if _, _, err := cfg.Nft.Exec(ctx, "--file", f.Name()); err != nil {
+		return errors.Wrap(err, "nft failed")
+	}
+
+	return nil
+}
@@ -5,12 +5,21 @@ import (
 
 	"github.com/kumahq/kuma/v3/pkg/transparentproxy/config"
 	"github.com/kumahq/kuma/v3/pkg/transparentproxy/iptables"
+	"github.com/kumahq/kuma/v3/pkg/transparentproxy/nftables"
 )
 
 func Setup(ctx context.Context, cfg config.InitializedConfig) (string, error) {
+	if cfg.Backend == config.BackendNftables {
+		return nftables.Setup(ctx, cfg)
+	}
+
 	return iptables.Setup(ctx, cfg)
 }
 
 func Cleanup(ctx context.Context, cfg config.InitializedConfig) error {
+	if cfg.Backend == config.BackendNftables {
+		return nftables.Cleanup(ctx, cfg)
+	}
+
 	return iptables.Cleanup(ctx, cfg)
 }
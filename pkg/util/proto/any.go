@@ -40,6 +40,15 @@ func UnmarshalAnyTo(src *anypb.Any, dst proto.Message) error {
 // MergeAnys merges two Any messages of the same type. We cannot just use proto#Merge on Any directly because values are encoded in byte slices.
 // Instead we have to unmarshal types, merge them and marshal again.
 func MergeAnys(dst *anypb.Any, src *anypb.Any) (*anypb.Any, error) {
+	return mergeAnys(dst, src, Merge)
+}
+
+// StrategicMergeAnys merges messages packed in Anys using StrategicMerge.
+func StrategicMergeAnys(dst *anypb.Any, src *anypb.Any) (*anypb.Any, error) {
+	return mergeAnys(dst, src, StrategicMerge)
+}
+
+func mergeAnys(dst *anypb.Any, src *anypb.Any, mergeFn func(dst, src proto.Message)) (*anypb.Any, error) {
 	if src == nil {
 		return dst, nil
 	}
@@ -65,7 +74,7 @@ func MergeAnys(dst *anypb.Any, src *anypb.Any) (*anypb.Any, error) {
 		return nil, err
 	}
 
-	Merge(dstMsg, srcMsg)
+	mergeFn(dstMsg, srcMsg)
 	return MarshalAnyDeterministic(dstMsg)
 }
 
@@ -40,6 +40,7 @@ import (
 
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/durationpb"
 )
 
@@ -47,6 +48,7 @@ type (
 	MergeFunction func(dst, src protoreflect.Message)
 	mergeOptions  struct {
 		customMergeFn map[protoreflect.FullName]MergeFunction
+		strategic     bool
 	}
 )
 type OptionFn func(options mergeOptions) mergeOptions
@@ -79,6 +81,41 @@ func Merge(dst, src proto.Message) {
 	merge(dst, src, MergeFunctionOptionFn(duration.ProtoReflect().Descriptor().FullName(), ReplaceMergeFn))
 }
 
+// StrategicMerge merges src into dst like Merge, except for repeated fields.
+// Items of repeated messages with a "name" field (clusters, filters, virtual hosts, routes etc.) are merged into
+// items with the same name and appended only when there is no such item. Other repeated fields are replaced.
+// Messages packed in Any of the same type are merged the same way instead of being replaced.
+// This way a patch does not depend on the order of items generated by Kuma.
+func StrategicMerge(dst, src proto.Message) {
+	duration := &durationpb.Duration{}
+	merge(
+		dst,
+		src,
+		MergeFunctionOptionFn(duration.ProtoReflect().Descriptor().FullName(), ReplaceMergeFn),
+		MergeFunctionOptionFn((&anypb.Any{}).ProtoReflect().Descriptor().FullName(), strategicMergeAnyFn),
+		func(options mergeOptions) mergeOptions {
+			options.strategic = true
+			return options
+		},
+	)
+}
+
+// strategicMergeAnyFn merges messages packed in Any when they are of the same type, otherwise it replaces dst.
+func strategicMergeAnyFn(dst, src protoreflect.Message) {
+	dstAny, dstOk := dst.Interface().(*anypb.Any)
+	srcAny, srcOk := src.Interface().(*anypb.Any)
+	if !dstOk || !srcOk || dstAny.TypeUrl != srcAny.TypeUrl {
+		ReplaceMergeFn(dst, src)
+		return
+	}
+	merged, err := StrategicMergeAnys(dstAny, srcAny)
+	if err != nil {
+		ReplaceMergeFn(dst, src)
+		return
+	}
+	dstAny.Value = merged.Value
+}
+
 // Merge Code of proto.Merge with modifications to support custom types
 func merge(dst, src proto.Message, opts ...OptionFn) {
 	mo := mergeOptions{customMergeFn: map[protoreflect.FullName]MergeFunction{}}
@@ -97,6 +134,8 @@ func (o mergeOptions) mergeMessage(dst, src protoreflect.Message) {
 
 	src.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
 		switch {
+		case fd.IsList() && o.strategic:
+			o.strategicMergeList(dst, v.List(), fd)
 		case fd.IsList():
 			o.mergeList(dst.Mutable(fd).List(), v.List(), fd)
 		case fd.IsMap():
@@ -137,6 +176,50 @@ func (o mergeOptions) mergeList(dst, src protoreflect.List, fd protoreflect.Fiel
 	}
 }
 
+func (o mergeOptions) strategicMergeList(dst protoreflect.Message, src protoreflect.List, fd protoreflect.FieldDescriptor) {
+	nameFd := listItemNameField(fd)
+	if nameFd == nil {
+		dst.Clear(fd)
+		o.mergeList(dst.Mutable(fd).List(), src, fd)
+		return
+	}
+	dstList := dst.Mutable(fd).List()
+	for i, n := 0, src.Len(); i < n; i++ {
+		srcItem := src.Get(i).Message()
+		if idx := indexOfNamedItem(dstList, nameFd, srcItem.Get(nameFd).String()); idx != -1 {
+			o.mergeMessage(dstList.Get(idx).Message(), srcItem)
+			continue
+		}
+		dstv := dstList.NewElement()
+		o.mergeMessage(dstv.Message(), srcItem)
+		dstList.Append(dstv)
+	}
+}
+
+// listItemNameField returns the "name" field of messages in the repeated field or nil if they are not named.
+func listItemNameField(fd protoreflect.FieldDescriptor) protoreflect.FieldDescriptor {
+	if fd.Message() == nil {
+		return nil
+	}
+	nameFd := fd.Message().Fields().ByName("name")
+	if nameFd == nil || nameFd.Kind() != protoreflect.StringKind || nameFd.IsList() {
+		return nil
+	}
+	return nameFd
+}
+
+func indexOfNamedItem(list protoreflect.List, nameFd protoreflect.FieldDescriptor, name string) int {
+	if name == "" {
+		return -1
+	}
+	for i, n := 0, list.Len(); i < n; i++ {
+		if list.Get(i).Message().Get(nameFd).String() == name {
+			return i
+		}
+	}
+	return -1
+}
+
 func (o mergeOptions) mergeMap(dst, src protoreflect.Map, fd protoreflect.FieldDescriptor) {
 	// Merge semantics replaces, rather than merges into existing entries.
 	src.Range(func(k protoreflect.MapKey, v protoreflect.Value) bool {
@@ -5,6 +5,8 @@ import (
 
 	envoy_cluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	envoy_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"google.golang.org/protobuf/types/known/durationpb"
@@ -43,3 +45,66 @@ var _ = Describe("MergeKuma", func() {
 		Expect(dest.EdsClusterConfig.EdsConfig.ResourceApiVersion).To(Equal(envoy_config_core_v3.ApiVersion_V3))
 	})
 })
+
+var _ = Describe("StrategicMerge", func() {
+	It("should merge named items of repeated fields by name", func() {
+		dest := &envoy_listener.Listener{
+			Name: "inbound",
+			FilterChains: []*envoy_listener.FilterChain{{
+				Name: "first",
+				Filters: []*envoy_listener.Filter{
+					{Name: "envoy.filters.network.rbac"},
+					{
+						Name: "envoy.filters.network.http_connection_manager",
+						ConfigType: &envoy_listener.Filter_TypedConfig{
+							TypedConfig: util_proto.MustMarshalAny(&envoy_hcm.HttpConnectionManager{
+								StatPrefix: "inbound",
+								HttpFilters: []*envoy_hcm.HttpFilter{
+									{Name: "envoy.filters.http.fault"},
+									{Name: "envoy.filters.http.router"},
+								},
+							}),
+						},
+					},
+				},
+				FilterChainMatch: &envoy_listener.FilterChainMatch{ServerNames: []string{"a", "b"}},
+			}},
+		}
+		src := &envoy_listener.Listener{
+			FilterChains: []*envoy_listener.FilterChain{
+				{
+					Name: "first",
+					Filters: []*envoy_listener.Filter{{
+						Name: "envoy.filters.network.http_connection_manager",
+						ConfigType: &envoy_listener.Filter_TypedConfig{
+							TypedConfig: util_proto.MustMarshalAny(&envoy_hcm.HttpConnectionManager{
+								HttpFilters: []*envoy_hcm.HttpFilter{
+									{Name: "envoy.filters.http.router", IsOptional: true},
+								},
+							}),
+						},
+					}},
+					FilterChainMatch: &envoy_listener.FilterChainMatch{ServerNames: []string{"c"}},
+				},
+				{Name: "second"},
+			},
+		}
+
+		util_proto.StrategicMerge(dest, src)
+
+		Expect(dest.Name).To(Equal("inbound"))
+		Expect(dest.FilterChains).To(HaveLen(2))
+		Expect(dest.FilterChains[1].Name).To(Equal("second"))
+		chain := dest.FilterChains[0]
+		Expect(chain.FilterChainMatch.ServerNames).To(Equal([]string{"c"}))
+		Expect(chain.Filters).To(HaveLen(2))
+		Expect(chain.Filters[0].Name).To(Equal("envoy.filters.network.rbac"))
+		hcm := &envoy_hcm.HttpConnectionManager{}
+		Expect(util_proto.UnmarshalAnyTo(chain.Filters[1].GetTypedConfig(), hcm)).To(Succeed())
+		Expect(hcm.StatPrefix).To(Equal("inbound"))
+		Expect(hcm.HttpFilters).To(HaveLen(2))
+		Expect(hcm.HttpFilters[0].Name).To(Equal("envoy.filters.http.fault"))
+		Expect(hcm.HttpFilters[1].Name).To(Equal("envoy.filters.http.router"))
+		Expect(hcm.HttpFilters[1].IsOptional).To(BeTrue())
+	})
+})
@@ -62,6 +62,8 @@ func genConfig(parameters configParameters, enableReloadableTokens bool, _ *core
 			StaticLayer: util_proto.MustStruct(map[string]any{
 				"re2.max_program_size.error_level": 4294967295,
 				"re2.max_program_size.warn_level":  1000,
+				// MeshRateLimit sends the SPIFFE ID of the client as a descriptor value
+				"envoy.reloadable_features.enable_formatter_for_ratelimit_action_descriptor_value": true,
 			}),
 		},
 	}}
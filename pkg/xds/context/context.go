@@ -9,6 +9,7 @@ import (
 	core_resources "github.com/kumahq/kuma/v3/pkg/core/resources/apis/core"
 	core_mesh "github.com/kumahq/kuma/v3/pkg/core/resources/apis/mesh"
 	"github.com/kumahq/kuma/v3/pkg/core/resources/apis/meshidentity/providers"
+	meshtrust_api "github.com/kumahq/kuma/v3/pkg/core/resources/apis/meshtrust/api/v1alpha1"
 	core_model "github.com/kumahq/kuma/v3/pkg/core/resources/model"
 	"github.com/kumahq/kuma/v3/pkg/core/xds"
 	xds_types "github.com/kumahq/kuma/v3/pkg/core/xds/types"
@@ -34,7 +35,11 @@ type ControlPlaneContext struct {
 // GlobalContext holds resources that are Global
 type GlobalContext struct {
 	ResourceMap ResourceMap
-	hash        []byte
+	// MeshTrustsByKey holds MeshTrusts of all meshes. They are not part of ResourceMap
+	// because they are mesh scoped, but a MeshTrust can import CA bundles of a MeshTrust
+	// of another mesh.
+	MeshTrustsByKey map[core_model.ResourceKey]*meshtrust_api.MeshTrustResource
+	hash            []byte
 }
 
 // Hash base64 version of the hash mostly used for testing
@@ -81,6 +86,10 @@ type MeshContext struct {
 	VIPOutbounds       xds_types.Outbounds
 	DataSourceLoader   datasource.Loader
 	CAsByTrustDomain   map[string][]PEMBytes
+	// MeshTrustsByKey holds MeshTrusts of all meshes, see GlobalContext.MeshTrustsByKey.
+	// CA bundles referencing a MeshTrust of another mesh are resolved against it when
+	// the validation context is generated, they are not part of CAsByTrustDomain.
+	MeshTrustsByKey map[core_model.ResourceKey]*meshtrust_api.MeshTrustResource
 	// ZoneEgresses holds one entry per zone egress instance, resolved from Dataplanes
 	// that expose a ZoneEgress listener. Each entry carries the address, port and, when
 	// WorkloadIdentity is enabled, the SPIFFE ID (SAN) that clients must verify when
@@ -204,6 +204,7 @@ func (m *meshContextBuilder) BuildIfChanged(ctx context.Context, meshName string
 		VIPOutbounds:                    outbounds,
 		DataSourceLoader:                loader,
 		CAsByTrustDomain:                casByTrustDomain,
+		MeshTrustsByKey:                 globalContext.MeshTrustsByKey,
 		ZoneEgresses:                    zoneEgressList,
 		DataplaneZoneIngressEndpointMap: dpZoneIngressEndpointMap,
 		DataplaneZoneEgressEndpointMap:  dpZoneEgressEndpointMap,
@@ -227,12 +228,32 @@ func (m *meshContextBuilder) BuildGlobalContextIfChanged(ctx context.Context, la
 	}
 
 	newHash := rmap.Hash()
+	// MeshTrusts are listed for all meshes, so a mesh can import CA bundles of another mesh
+	// without fetching them on every build of its context.
+	var meshTrusts core_model.ResourceList = &meshtrust_api.MeshTrustResourceList{}
+	if _, ok := m.typeSet[meshtrust_api.MeshTrustType]; ok {
+		var err error
+		meshTrusts, err = m.fetchResourceList(ctx, meshtrust_api.MeshTrustType, nil)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to build global context")
+		}
+		hasher := fnv.New128a()
+		_, _ = hasher.Write(newHash)
+		_, _ = hasher.Write(resourceListXDSHash(meshTrusts))
+		newHash = hasher.Sum(nil)
+	}
+
 	if latest != nil && bytes.Equal(newHash, latest.hash) {
 		return latest, nil
 	}
+	meshTrustsByKey := map[core_model.ResourceKey]*meshtrust_api.MeshTrustResource{}
+	for _, trust := range meshTrusts.GetItems() {
+		meshTrustsByKey[core_model.MetaToResourceKey(trust.GetMeta())] = trust.(*meshtrust_api.MeshTrustResource)
+	}
 	return &GlobalContext{
-		hash:        newHash,
-		ResourceMap: rmap,
+		hash:            newHash,
+		ResourceMap:     rmap,
+		MeshTrustsByKey: meshTrustsByKey,
 	}, nil
 }
 
@@ -436,11 +457,20 @@ func resolveZoneEgresses(
 	return dpEgresses
 }
 
+// getCAsByTrustDomain groups PEM CA bundles of the MeshTrusts by trust domain. Every trust domain
+// of the MeshTrusts is present, even if its CA bundles only reference a MeshTrust of another mesh.
+// Those are imported when the validation context is generated, see meshtrust/generator.
 func getCAsByTrustDomain(trusts []*meshtrust_api.MeshTrustResource) map[string][]PEMBytes {
 	casByTrustDomain := map[string][]PEMBytes{}
 	for _, trust := range trusts {
+		trustDomain := trust.Spec.TrustDomain
+		if _, ok := casByTrustDomain[trustDomain]; !ok {
+			casByTrustDomain[trustDomain] = nil
+		}
 		for _, ca := range trust.Spec.CABundles {
-			casByTrustDomain[trust.Spec.TrustDomain] = append(casByTrustDomain[trust.Spec.TrustDomain], PEMBytes(ca.PEM.Value))
+			if ca.Type == meshtrust_api.PemCABundleType && ca.PEM != nil {
+				casByTrustDomain[trustDomain] = append(casByTrustDomain[trustDomain], PEMBytes(ca.PEM.Value))
+			}
 		}
 	}
 	return casByTrustDomain
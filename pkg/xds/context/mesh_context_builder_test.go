@@ -17,6 +17,7 @@ import (
 	core_mesh "github.com/kumahq/kuma/v3/pkg/core/resources/apis/mesh"
 	meshexternalservice_api "github.com/kumahq/kuma/v3/pkg/core/resources/apis/meshexternalservice/api/v1alpha1"
 	meshservice_api "github.com/kumahq/kuma/v3/pkg/core/resources/apis/meshservice/api/v1alpha1"
+	meshtrust_api "github.com/kumahq/kuma/v3/pkg/core/resources/apis/meshtrust/api/v1alpha1"
 	"github.com/kumahq/kuma/v3/pkg/core/resources/manager"
 	core_model "github.com/kumahq/kuma/v3/pkg/core/resources/model"
 	"github.com/kumahq/kuma/v3/pkg/core/resources/store"
@@ -511,6 +512,71 @@ spec:
 		Expect(endpoints[0].Target).To(Equal("192.168.0.10"))
 	})
 
+	It("holds MeshTrusts of all meshes to resolve CA bundles referencing another mesh", func() {
+		// given a mesh trusting the trust domain of a peer mesh by reference
+		Expect(builders.Mesh().WithName("mesh-1").Create(resourceStore)).To(Succeed())
+		Expect(builders.Mesh().WithName("mesh-2").Create(resourceStore)).To(Succeed())
+		Expect(builders.MeshTrust().
+			WithMesh("mesh-2").
+			WithName("mesh-2-trust").
+			WithTrustDomain("mesh-2.zone-1.mesh.local").
+			WithCA("peer-ca-1").
+			WithExport("mesh-1").
+			Create(resourceStore)).To(Succeed())
+		Expect(builders.MeshTrust().
+			WithMesh("mesh-1").
+			WithName("peer-trust").
+			WithTrustDomain("mesh-2.zone-1.mesh.local").
+			WithCA("local-ca").
+			WithMeshTrustRef("mesh-2", "mesh-2-trust").
+			Create(resourceStore)).To(Succeed())
+
+		// when
+		before, err := meshContextBuilder.BuildIfChanged(context.Background(), "mesh-1", nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		// then only the CAs of the mesh are grouped by trust domain
+		Expect(before.CAsByTrustDomain).To(Equal(map[string][]xds_context.PEMBytes{
+			"mesh-2.zone-1.mesh.local": {xds_context.PEMBytes("local-ca")},
+		}))
+		// and the referenced MeshTrust can be resolved
+		referencedKey := core_model.ResourceKey{Mesh: "mesh-2", Name: "mesh-2-trust"}
+		Expect(before.MeshTrustsByKey).To(HaveKey(referencedKey))
+
+		// when the referenced MeshTrust rotates its CA
+		referenced := meshtrust_api.NewMeshTrustResource()
+		Expect(resourceStore.Get(context.Background(), referenced, store.GetBy(referencedKey))).To(Succeed())
+		referenced.Spec.CABundles[0].PEM.Value = "peer-ca-2"
+		Expect(resourceStore.Update(context.Background(), referenced)).To(Succeed())
+
+		after, err := meshContextBuilder.BuildIfChanged(context.Background(), "mesh-1", before)
+		Expect(err).ToNot(HaveOccurred())
+
+		// then the mesh context is recomputed with the new CA of the referenced MeshTrust
+		Expect(after.Hash).ToNot(Equal(before.Hash))
+		Expect(after.MeshTrustsByKey[referencedKey].Spec.CABundles[0].PEM.Value).To(Equal("peer-ca-2"))
+	})
+
+	It("keeps trust domains of MeshTrusts that only reference another mesh", func() {
+		// given
+		Expect(builders.Mesh().WithName("mesh-1").Create(resourceStore)).To(Succeed())
+		trust := builders.MeshTrust().
+			WithMesh("mesh-1").
+			WithName("peer-trust").
+			WithTrustDomain("mesh-2.zone-1.mesh.local").
+			WithMeshTrustRef("mesh-2", "mesh-2-trust").
+			Build()
+		trust.Spec.CABundles = trust.Spec.CABundles[1:]
+		Expect(resourceStore.Create(context.Background(), trust, store.CreateBy(core_model.MetaToResourceKey(trust.GetMeta())))).To(Succeed())
+
+		// when
+		meshCtx, err := meshContextBuilder.BuildIfChanged(context.Background(), "mesh-1", nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		// then the trust domain is known, its CAs are imported when the validation context is generated
+		Expect(meshCtx.CAsByTrustDomain).To(HaveKeyWithValue("mesh-2.zone-1.mesh.local", BeEmpty()))
+	})
+
 	It("returns an error instead of panicking when listing resources fails", func() {
 		// given a mesh exists but listing any other resource fails (e.g. DB connection lost)
 		Expect(samples.MeshDefaultBuilder().Create(resourceStore)).To(Succeed())
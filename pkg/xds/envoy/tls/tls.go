@@ -1,8 +1,12 @@
 package tls
 
+import (
+	common_tls "github.com/kumahq/kuma/v3/api/common/v1alpha1/tls"
+)
+
 const CpValidationCtx = "cp_validation_ctx"
 
 // KumaALPNProtocols are set for UpstreamTlsContext to show that mTLS is created by mesh.
 // On the inbound side we have to distinguish Kuma mTLS and application TLS to properly
 // support PERMISSIVE mode
-var KumaALPNProtocols = []string{"kuma"}
+var KumaALPNProtocols = []string{common_tls.KumaALPNProtocol}
@@ -41,8 +41,13 @@ func (g DNSGenerator) Generate(_ context.Context, rs *core_xds.ResourceSet, xdsC
 			addresses = append(addresses, v6)
 		}
 
+		// a domain can have a VIP of each IP family, so addresses are merged
 		for _, domain := range dnsOutbound.Domains {
-			vips[domain] = addresses
+			for _, address := range addresses {
+				if !slices.Contains(vips[domain], address) {
+					vips[domain] = append(vips[domain], address)
+				}
+			}
 		}
 	}
 	// This is purposefully set to 30s to avoid DNS cache stale with ExternalService and Kong Gateway see: https://github.com/kumahq/kuma/issues/13353.
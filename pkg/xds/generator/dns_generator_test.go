@@ -46,6 +46,7 @@ var _ = Describe("DNSGenerator", func() {
 						{Address: "240.0.0.1", Domains: []string{"httpbin.mesh"}},
 						{Address: "240.0.0.0", Domains: []string{"backend.test-ns.svc.8080.mesh", "backend_test-ns_svc_8080.mesh"}},
 						{Address: "2001:db8::ff00:42:8329", Domains: []string{"frontend.test-ns.svc.8080.mesh", "frontend_test-ns_svc_8080.mesh"}}, // this is ignored because there is no outbounds for it
+						{Address: "fd00:fd01::", Domains: []string{"backend.test-ns.svc.8080.mesh", "backend_test-ns_svc_8080.mesh"}},
 					},
 				},
 			}
@@ -122,5 +123,9 @@ var _ = Describe("DNSGenerator", func() {
 			dataplaneFile: "6-dataplane.input.yaml",
 			expected:      "6-envoy-config.golden.yaml",
 		}),
+		Entry("07. DNS enabled with IPv4 and IPv6 VIPs", testCase{
+			dataplaneFile: "7-dataplane.input.yaml",
+			expected:      "7-envoy-config.golden.yaml",
+		}),
 	)
 })
@@ -2,6 +2,7 @@ package generator
 
 import (
 	"context"
+	"fmt"
 
 	envoy_tls "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
 
@@ -186,34 +187,41 @@ func (g ZoneProxyListenerGenerator) generateEgressListener(
 
 	addedFilterChains := 0
 	for _, dst := range destinations {
-		ports := dst.GetPorts()
-		if len(ports) == 0 {
-			// there is a validation in API to disallow MeshExternalServices without ports
-			continue
+		var hostnames []string
+		if withHostnames, ok := dst.(interface{ MatchedHostnames() []string }); ok {
+			hostnames = withHostnames.MatchedHostnames()
 		}
-		esPort := ports[0]
-		id := kri.WithSectionName(kri.From(dst), esPort.GetName())
-		sni := core_sni.FromKRI(id)
-		clusterName := id.String()
-		group := endpointMap[clusterName]
+		// there is a validation in API to disallow MeshExternalServices without ports,
+		// a port range results in a filter chain for every port
+		for _, esPort := range dst.GetPorts() {
+			id := kri.WithSectionName(kri.From(dst), esPort.GetName())
+			sni := core_sni.FromKRI(id)
+			clusterName := id.String()
+			group := endpointMap[clusterName]
+
+			if len(group.Endpoints) == 0 {
+				continue
+			}
 
-		if len(group.Endpoints) == 0 {
-			continue
-		}
+			split := plugins_xds.NewSplitBuilder().
+				WithClusterName(clusterName).
+				WithExternalService(true).
+				WithWeight(1).
+				Build()
 
-		split := plugins_xds.NewSplitBuilder().
-			WithClusterName(clusterName).
-			WithExternalService(true).
-			WithWeight(1).
-			Build()
+			var domains []string
+			for _, hostname := range hostnames {
+				domains = append(domains, hostname, fmt.Sprintf("%s:%d", hostname, esPort.GetValue()))
+			}
 
-		listenerBuilder.Configure(envoy_listeners.FilterChain(g.buildEgressFilterChain(proxy, group.Protocol, downstreamTLS, split, sni)))
-		cds, err := g.genClusterCDS(proxy, group, clusterName)
-		if err != nil {
-			return nil, err
+			listenerBuilder.Configure(envoy_listeners.FilterChain(g.buildEgressFilterChain(proxy, group.Protocol, downstreamTLS, split, sni, domains)))
+			cds, err := g.genClusterCDS(proxy, group, clusterName)
+			if err != nil {
+				return nil, err
+			}
+			rs.Add(cds)
+			addedFilterChains++
 		}
-		rs.Add(cds)
-		addedFilterChains++
 	}
 
 	if addedFilterChains == 0 {
@@ -266,6 +274,7 @@ func (g ZoneProxyListenerGenerator) buildEgressFilterChain(
 	downstreamTLS *envoy_tls.DownstreamTlsContext,
 	split envoy_common.Split,
 	sni string,
+	domains []string,
 ) *envoy_listeners.FilterChainBuilder {
 	esName := split.ClusterName()
 
@@ -283,6 +292,7 @@ func (g ZoneProxyListenerGenerator) buildEgressFilterChain(
 		Configure(envoy_listeners.AddFilterChainConfigurer(&xds.HttpOutboundRouteConfigurer{
 			RouteConfigName: esName,
 			VirtualHostName: esName,
+			Domains:         domains,
 			Routes: []xds.OutboundRoute{
 				{
 					Match: meshhttproute_api.Match{
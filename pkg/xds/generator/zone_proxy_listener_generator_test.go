@@ -23,6 +23,7 @@ import (
 	. "github.com/kumahq/kuma/v3/pkg/test/matchers"
 	"github.com/kumahq/kuma/v3/pkg/test/resources/builders"
 	"github.com/kumahq/kuma/v3/pkg/test/resources/samples"
+	"github.com/kumahq/kuma/v3/pkg/util/pointer"
 	util_proto "github.com/kumahq/kuma/v3/pkg/util/proto"
 	xds_context "github.com/kumahq/kuma/v3/pkg/xds/context"
 	envoy_common "github.com/kumahq/kuma/v3/pkg/xds/envoy"
@@ -386,6 +387,72 @@ var _ = Describe("ZoneProxyListenerGenerator", func() {
 				expected: "egress-meshexternalservice.envoy.golden.yaml",
 			}
 		}()),
+		Entry("egress: MeshExternalService with wildcard hostname and port range", func() testCase {
+			mes := builders.MeshExternalService().
+				WithKumaVIP("242.0.0.1").
+				WithWildcardHostname("*.s3.amazonaws.com").
+				WithPortRange(9000, 9001).
+				Build()
+			mesKRI := kri.From(mes)
+
+			dp := samples.DataplaneBackendBuilder().
+				With(func(r *core_mesh.DataplaneResource) {
+					r.Spec.Networking.Listeners = []*mesh_proto.Dataplane_Networking_Listener{
+						{
+							Type:    mesh_proto.Dataplane_Networking_Listener_ZoneEgress,
+							Address: "10.0.0.1",
+							Port:    10002,
+							Name:    "zone-egress-port",
+						},
+					}
+				}).
+				Build()
+
+			endpointMap := core_xds.EgressEndpointMap{}
+			for _, port := range mes.Spec.Match.Ports() {
+				endpointMap[kri.WithSectionName(mesKRI, port.GetName()).String()] = core_xds.EgressEndpointGroup{
+					Protocol:      core_meta.ProtocolHTTP,
+					OwnerResource: mesKRI,
+					Endpoints: []core_xds.Endpoint{
+						{
+							Target: "192.168.0.1",
+							Port:   uint32(mes.Spec.Match.EndpointPort(port.Port, pointer.Deref(mes.Spec.Endpoints)[0])),
+							Tags:   map[string]string{},
+							Weight: 1,
+							ExternalService: &core_xds.ExternalService{
+								Protocol:      core_meta.ProtocolHTTP,
+								OwnerResource: mesKRI,
+							},
+						},
+					},
+				}
+			}
+
+			return testCase{
+				proxy: &core_xds.Proxy{
+					Id:         *core_xds.BuildProxyId("default", "dp-1"),
+					APIVersion: envoy_common.APIV3,
+					Dataplane:  dp,
+					Metadata: &core_xds.DataplaneMetadata{
+						SystemCaPath: "/etc/ssl/certs/ca-certificates.crt",
+					},
+					WorkloadIdentity:  testWorkloadIdentity,
+					InternalAddresses: DummyInternalAddresses,
+				},
+				meshContext: xds_context.MeshContext{
+					Resource:                       builders.Mesh().WithName("default").Build(),
+					DataplaneZoneEgressEndpointMap: endpointMap,
+					Resources: xds_context.Resources{
+						MeshLocalResources: map[core_model.ResourceType]core_model.ResourceList{
+							meshexternalservice_api.MeshExternalServiceType: &meshexternalservice_api.MeshExternalServiceResourceList{
+								Items: []*meshexternalservice_api.MeshExternalServiceResource{mes},
+							},
+						},
+					},
+				},
+				expected: "egress-meshexternalservice-wildcard-port-range.envoy.golden.yaml",
+			}
+		}()),
 		Entry("egress: MeshExternalService TCP with WorkloadIdentity", func() testCase {
 			mes := builders.MeshExternalService().WithKumaVIP("242.0.0.1").Build()
 			mesKRI := kri.From(mes)
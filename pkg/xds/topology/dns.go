@@ -12,12 +12,10 @@ func Outbounds[T interface{ AsOutbounds() xds_types.Outbounds }](list []T) xds_t
 	return outbounds
 }
 
-func Domains[T interface{ Domains() *xds_types.VIPDomains }](list []T) []xds_types.VIPDomains {
+func Domains[T interface{ Domains() []xds_types.VIPDomains }](list []T) []xds_types.VIPDomains {
 	var domains []xds_types.VIPDomains
 	for _, item := range list {
-		if d := item.Domains(); d != nil {
-			domains = append(domains, *d)
-		}
+		domains = append(domains, item.Domains()...)
 	}
 	return domains
 }
@@ -229,18 +229,10 @@ func fillRemoteMeshServices(
 }
 
 // endpointIdentity returns the tags that make up an endpoint's load-balancing
-// identity, sourced from the Dataplane's own resource labels. The inbound's
-// protocol is carried alongside them because it is a per-port property that
-// resource labels cannot express, and it is published as endpoint metadata.
+// identity, which are the tags of the inbound (see DataplaneResource.InboundTags).
+// They are published as endpoint metadata.
 func endpointIdentity(dataplane *core_mesh.DataplaneResource, inbound *mesh_proto.Dataplane_Networking_Inbound) map[string]string {
-	tags := maps.Clone(dataplane.GetMeta().GetLabels())
-	if tags == nil {
-		tags = map[string]string{}
-	}
-	if protocol := inbound.GetProtocol(); protocol != "" {
-		tags[mesh_proto.ProtocolTag] = protocol
-	}
-	return tags
+	return dataplane.InboundTags(inbound)
 }
 
 func meshServiceTagValue(ms *meshservice_api.MeshServiceResource) string {
@@ -377,7 +369,6 @@ func fillMeshExternalServicesOnDataplane(
 	for _, mes := range meshExternalServices {
 		// deep copy map to not modify tags in ExternalService.
 		serviceTags := maps.Clone(mes.Meta.GetLabels())
-		serviceName := destinationname.MustResolve(mes, mes.Spec.Match)
 		locality := GetLocality(nil)
 		tls := mes.Spec.Tls
 		es := &core_xds.ExternalService{
@@ -392,19 +383,23 @@ func fillMeshExternalServicesOnDataplane(
 			}
 		}
 
-		for _, ze := range egressAddresses {
-			endpoint := core_xds.Endpoint{
-				Target: ze.Address,
-				Port:   ze.Port,
-				Tags:   serviceTags,
-				// AS it's a role of zone egress to load balance traffic between
-				// instances, we can safely set weight to 1
-				Weight:          1,
-				Locality:        locality,
-				ExternalService: es,
-			}
+		// every port of a port range is a separate destination, all of them are served by the same egress listener
+		for _, port := range mes.Spec.Match.Ports() {
+			serviceName := destinationname.MustResolve(mes, port)
+			for _, ze := range egressAddresses {
+				endpoint := core_xds.Endpoint{
+					Target: ze.Address,
+					Port:   ze.Port,
+					Tags:   serviceTags,
+					// AS it's a role of zone egress to load balance traffic between
+					// instances, we can safely set weight to 1
+					Weight:          1,
+					Locality:        locality,
+					ExternalService: es,
+				}
 
-			outbound[serviceName] = append(outbound[serviceName], endpoint)
+				outbound[serviceName] = append(outbound[serviceName], endpoint)
+			}
 		}
 	}
 }
@@ -437,37 +432,43 @@ func fillMeshExternalServicesOnEgress(
 			}
 		}
 
-		// if all ip make it static - it's done in endpoint_cluster_configurer
-		var endpoints []core_xds.Endpoint
 		for i, endpoint := range pointer.Deref(mes.Spec.Endpoints) {
 			if i == 0 && es.ServerName == "" && govalidator.IsDNSName(endpoint.Address) && tls != nil && tls.Enabled {
 				es.ServerName = endpoint.Address
 			}
-			priority := pointer.DerefOr(endpoint.Priority, 0)
-			endpoints = append(endpoints, core_xds.Endpoint{
-				Target:          endpoint.Address,
-				Port:            uint32(endpoint.Port),
-				Weight:          1,
-				ExternalService: es,
-				Tags:            tags,
-				Locality: &core_xds.Locality{
-					Priority: priority,
-					SubZone:  "priority-" + strconv.Itoa(int(priority)),
-				},
-			})
-		}
-		if len(endpoints) == 0 {
-			continue
 		}
 
-		// Always unified (KRI) naming: the embedded egress is new infrastructure that only
-		// supports Exclusive MeshServices mode.
-		serviceName := destinationname.MustResolve(mes, mes.Spec.Match)
-		group := outbound[serviceName]
-		group.Protocol = es.Protocol
-		group.OwnerResource = es.OwnerResource
-		group.Endpoints = append(group.Endpoints, endpoints...)
-		outbound[serviceName] = group
+		// every port of a port range is forwarded to the same offset from the port of an endpoint
+		for _, port := range mes.Spec.Match.Ports() {
+			// if all ip make it static - it's done in endpoint_cluster_configurer
+			var endpoints []core_xds.Endpoint
+			for _, endpoint := range pointer.Deref(mes.Spec.Endpoints) {
+				priority := pointer.DerefOr(endpoint.Priority, 0)
+				endpoints = append(endpoints, core_xds.Endpoint{
+					Target:          endpoint.Address,
+					Port:            uint32(mes.Spec.Match.EndpointPort(port.Port, endpoint)),
+					Weight:          1,
+					ExternalService: es,
+					Tags:            tags,
+					Locality: &core_xds.Locality{
+						Priority: priority,
+						SubZone:  "priority-" + strconv.Itoa(int(priority)),
+					},
+				})
+			}
+			if len(endpoints) == 0 {
+				continue
+			}
+
+			// Always unified (KRI) naming: the embedded egress is new infrastructure that only
+			// supports Exclusive MeshServices mode.
+			serviceName := destinationname.MustResolve(mes, port)
+			group := outbound[serviceName]
+			group.Protocol = es.Protocol
+			group.OwnerResource = es.OwnerResource
+			group.Endpoints = append(group.Endpoints, endpoints...)
+			outbound[serviceName] = group
+		}
 	}
 }
 